@@ -12,8 +12,10 @@
 package clients
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -63,3 +65,39 @@ func (c *RemoteNode) GetNodeStatus(ctx context.Context, hostName string, classNa
 
 	return &nodeStatus, nil
 }
+
+func (c *RemoteNode) GetShardsStatus(ctx context.Context, hostName string, className string,
+	shards []string,
+) ([]*models.NodeShardStatus, error) {
+	body, err := json.Marshal(shards)
+	if err != nil {
+		return nil, fmt.Errorf("marshal shard names: %w", err)
+	}
+	p := path.Join("/nodes/shards", className)
+	url := url.URL{Scheme: "http", Host: hostName, Path: p}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(),
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, enterrors.NewErrOpenHttpRequest(err)
+	}
+	req.Header.Set("content-type", "application/json")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, enterrors.NewErrSendHttpRequest(err)
+	}
+
+	defer res.Body.Close()
+	resBody, _ := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusOK {
+		return nil, enterrors.NewErrUnexpectedStatusCode(res.StatusCode, resBody)
+	}
+
+	var statuses []*models.NodeShardStatus
+	if err := json.Unmarshal(resBody, &statuses); err != nil {
+		return nil, enterrors.NewErrUnmarshalBody(err)
+	}
+
+	return statuses, nil
+}
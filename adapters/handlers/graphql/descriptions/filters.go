@@ -68,6 +68,12 @@ const (
 	WhereValueRangeDistanceMax             = "The maximum distance from the point specified geoCoordinates."
 	WhereValueText                         = "Specify a Text value that the target property will be compared to"
 	WhereValueDate                         = "Specify a Date value that the target property will be compared to"
+	WhereValueIntArray                     = "Specify Integer values that the target property will be compared to, used with ContainsAny/ContainsAll"
+	WhereValueNumberArray                  = "Specify Float values that the target property will be compared to, used with ContainsAny/ContainsAll"
+	WhereValueBooleanArray                 = "Specify Boolean values that the target property will be compared to, used with ContainsAny/ContainsAll"
+	WhereValueStringArray                  = "Specify String values that the target property will be compared to, used with ContainsAny/ContainsAll"
+	WhereValueTextArray                    = "Specify Text values that the target property will be compared to, used with ContainsAny/ContainsAll"
+	WhereValueDateArray                    = "Specify Date values that the target property will be compared to, used with ContainsAny/ContainsAll"
 )
 
 // Properties and Classes filter elements (used by Fetch and Introspect Where filters)
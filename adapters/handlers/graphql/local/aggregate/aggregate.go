@@ -40,7 +40,8 @@ func Build(dbSchema *schema.Schema, config config.Config,
 	var err error
 	var localAggregateObjects *graphql.Object
 	if len(dbSchema.Objects.Classes) > 0 {
-		localAggregateObjects, err = classFields(dbSchema.Objects.Classes, config, modulesProvider)
+		localAggregateObjects, err = classFields(dbSchema.Objects.Classes, dbSchema.Aliases,
+			config, modulesProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -56,7 +57,7 @@ func Build(dbSchema *schema.Schema, config config.Config,
 	return &field, nil
 }
 
-func classFields(databaseSchema []*models.Class,
+func classFields(databaseSchema []*models.Class, aliases map[string]string,
 	config config.Config, modulesProvider ModulesProvider,
 ) (*graphql.Object, error) {
 	fields := graphql.Fields{}
@@ -69,6 +70,12 @@ func classFields(databaseSchema []*models.Class,
 
 		fields[class.Class] = field
 	}
+	// aliases are served by the fields of the classes they point to
+	for alias, className := range aliases {
+		if field, ok := fields[className]; ok {
+			fields[alias] = field
+		}
+	}
 
 	return graphql.NewObject(graphql.ObjectConfig{
 		Name:        "AggregateObjectsObj",
@@ -240,6 +247,9 @@ func classPropertyField(dataType schema.DataType, class *models.Class, property
 	case schema.DataTypeUUID, schema.DataTypeUUIDArray:
 		// not aggregatable
 		return nil, nil
+	case schema.DataTypeObject, schema.DataTypeObjectArray:
+		// not aggregatable
+		return nil, nil
 	default:
 		return nil, fmt.Errorf(schema.ErrorNoSuchDatatype+": %s", dataType)
 	}
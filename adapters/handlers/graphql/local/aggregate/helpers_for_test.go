@@ -31,7 +31,13 @@ type mockResolver struct {
 }
 
 func newMockResolver(cfg config.Config) *mockResolver {
-	field, err := Build(&testhelper.CarSchema, cfg, nil)
+	return newMockResolverWithAliases(cfg, nil)
+}
+
+func newMockResolverWithAliases(cfg config.Config, aliases map[string]string) *mockResolver {
+	carSchema := testhelper.CarSchema
+	carSchema.Aliases = aliases
+	field, err := Build(&carSchema, cfg, nil)
 	if err != nil {
 		panic(fmt.Sprintf("could not build graphql test schema: %s", err))
 	}
@@ -51,14 +51,14 @@ func makeResolveClass(modulesProvider ModulesProvider, class *models.Class) grap
 	return func(p graphql.ResolveParams) (interface{}, error) {
 		res, err := resolveAggregate(p, modulesProvider, class)
 		if err != nil {
-			return res, enterrors.NewErrGraphQLUser(err, "Aggregate", schema.ClassName(p.Info.FieldName).String())
+			return res, enterrors.NewErrGraphQLUser(err, "Aggregate", class.Class)
 		}
 		return res, nil
 	}
 }
 
 func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider, class *models.Class) (interface{}, error) {
-	className := schema.ClassName(p.Info.FieldName)
+	className := schema.ClassName(class.Class)
 	source, ok := p.Source.(map[string]interface{})
 	if !ok {
 		return nil, fmt.Errorf("expected source to be a map, but was %t", p.Source)
@@ -80,7 +80,7 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		return nil, fmt.Errorf("could not extract properties for class '%s': %w", className, err)
 	}
 
-	groupBy, err := extractGroupBy(p.Args, p.Info.FieldName)
+	groupBy, err := extractGroupBy(p.Args, class.Class)
 	if err != nil {
 		return nil, fmt.Errorf("could not extract groupBy path: %w", err)
 	}
@@ -98,7 +98,7 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		return nil, fmt.Errorf("could not extract objectLimit: %w", err)
 	}
 
-	filters, err := common_filters.ExtractFilters(p.Args, p.Info.FieldName)
+	filters, err := common_filters.ExtractFilters(p.Args, class.Class)
 	if err != nil {
 		return nil, fmt.Errorf("could not extract filters: %w", err)
 	}
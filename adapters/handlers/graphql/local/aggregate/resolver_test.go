@@ -931,6 +931,41 @@ func Test_Resolve(t *testing.T) {
 	tests.AssertExtraction(t, "Car")
 }
 
+func Test_ResolveWithAlias(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithAliases(config.Config{}, map[string]string{"Vehicle": "Car"})
+
+	expectedParams := &aggregation.Params{
+		ClassName: schema.ClassName("Car"),
+		Properties: []aggregation.ParamProperty{
+			{
+				Name:        "horsepower",
+				Aggregators: []aggregation.Aggregator{aggregation.MeanAggregator},
+			},
+		},
+		GroupBy: groupCarByMadeByManufacturerName(),
+	}
+	resolverReturn := []aggregation.Group{
+		{
+			Properties: map[string]aggregation.Property{
+				"horsepower": {
+					Type:                  aggregation.PropertyTypeNumerical,
+					NumericalAggregations: map[string]interface{}{"mean": 275.7773},
+				},
+			},
+		},
+	}
+	resolver.On("Aggregate", expectedParams).Return(resolverReturn, nil).Once()
+
+	query := `{ Aggregate { Vehicle(groupBy:["madeBy", "Manufacturer", "name"]) { horsepower { mean } } } }`
+	result := resolver.AssertResolve(t, query)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"horsepower": map[string]interface{}{"mean": 275.7773},
+		},
+	}, result.Get("Aggregate", "Vehicle").Result)
+}
+
 func (tests testCases) AssertExtraction(t *testing.T, className string) {
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
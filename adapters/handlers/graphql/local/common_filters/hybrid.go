@@ -113,6 +113,8 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		}
 	}
 
+	args.TargetVectors = extractTargetVectors(source)
+
 	args.Type = "hybrid"
 	return &args, nil
 }
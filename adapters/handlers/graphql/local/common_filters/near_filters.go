@@ -45,6 +45,10 @@ func nearVectorFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Distance,
 			Type:        graphql.Float,
 		},
+		"targetVectors": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TargetVectors,
+			Type:        graphql.NewList(graphql.String),
+		},
 	}
 }
 
@@ -78,5 +82,9 @@ func nearObjectFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: descriptions.Distance,
 			Type:        graphql.Float,
 		},
+		"targetVectors": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TargetVectors,
+			Type:        graphql.NewList(graphql.String),
+		},
 	}
 }
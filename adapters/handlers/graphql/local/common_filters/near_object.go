@@ -47,5 +47,22 @@ func ExtractNearObject(source map[string]interface{}) (searchparams.NearObject,
 			fmt.Errorf("cannot provide distance and certainty")
 	}
 
+	args.TargetVectors = extractTargetVectors(source)
+
 	return args, nil
 }
+
+// extractTargetVectors returns the optional "targetVectors" argument
+func extractTargetVectors(source map[string]interface{}) []string {
+	targetVectors, ok := source["targetVectors"]
+	if !ok {
+		return nil
+	}
+
+	targetVectorsArray := targetVectors.([]interface{})
+	out := make([]string, len(targetVectorsArray))
+	for i, value := range targetVectorsArray {
+		out[i] = value.(string)
+	}
+	return out
+}
@@ -44,5 +44,7 @@ func ExtractNearVector(source map[string]interface{}) (searchparams.NearVector,
 			fmt.Errorf("cannot provide distance and certainty")
 	}
 
+	args.TargetVectors = extractTargetVectors(source)
+
 	return args, nil
 }
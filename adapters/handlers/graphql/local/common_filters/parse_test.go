@@ -160,6 +160,60 @@ func TestExtractFilterIsNull(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractFilterContains(t *testing.T) {
+	t.Parallel()
+
+	t.Run("extracts ContainsAny with valueTextArray", func(t *testing.T) {
+		resolver := newMockResolver(t, mockParams{reportFilter: true})
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorContainsAny,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("name"),
+			},
+			Value: &filters.Value{
+				Value: []string{"foo", "bar"},
+				Type:  schema.DataTypeTextArray,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: {
+				path: ["name"],
+				operator: ContainsAny,
+				valueTextArray: ["foo", "bar"],
+			}) }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("extracts ContainsAll with valueIntArray", func(t *testing.T) {
+		resolver := newMockResolver(t, mockParams{reportFilter: true})
+		expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorContainsAll,
+			On: &filters.Path{
+				Class:    schema.AssertValidClassName("SomeAction"),
+				Property: schema.AssertValidPropertyName("intField"),
+			},
+			Value: &filters.Value{
+				Value: []int{1, 2},
+				Type:  schema.DataTypeIntArray,
+			},
+		}}
+
+		resolver.On("ReportFilters", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ SomeAction(where: {
+				path: ["intField"],
+				operator: ContainsAll,
+				valueIntArray: [1, 2],
+			}) }`
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestExtractFilterGeoLocation(t *testing.T) {
 	t.Parallel()
 
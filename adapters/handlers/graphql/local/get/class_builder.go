@@ -87,6 +87,12 @@ func (b *classBuilder) kinds(kindSchema *models.Schema) (*graphql.Object, error)
 		}
 		classFields[class.Class] = classField
 	}
+	// aliases are served by the fields of the classes they point to
+	for alias, className := range b.schema.Aliases {
+		if classField, ok := classFields[className]; ok {
+			classFields[alias] = classField
+		}
+	}
 
 	classes := graphql.NewObject(graphql.ObjectConfig{
 		Name:        "GetObjectsObj",
@@ -132,6 +138,9 @@ func (b *classBuilder) classObject(class *models.Class) *graphql.Object {
 				if propertyType.IsPrimitive() {
 					classProperties[property.Name] = b.primitiveField(propertyType, property,
 						class.Class)
+				} else if propertyType.IsNested() {
+					classProperties[property.Name] = b.nestedField(propertyType, property,
+						class.Class)
 				} else {
 					classProperties[property.Name] = b.referenceField(propertyType, property,
 						class.Class)
@@ -151,6 +160,7 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["classification"] = b.additionalClassificationField(class)
 	additionalProperties["certainty"] = b.additionalCertaintyField(class)
 	additionalProperties["distance"] = b.additionalDistanceField(class)
+	additionalProperties["compressedDistance"] = b.additionalCompressedDistanceField(class)
 	additionalProperties["vector"] = b.additionalVectorField(class)
 	additionalProperties["id"] = b.additionalIDField()
 	additionalProperties["creationTimeUnix"] = b.additionalCreationTimeUnix()
@@ -209,6 +219,12 @@ func (b *classBuilder) additionalDistanceField(class *models.Class) *graphql.Fie
 	}
 }
 
+func (b *classBuilder) additionalCompressedDistanceField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.Float,
+	}
+}
+
 func (b *classBuilder) additionalVectorField(class *models.Class) *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.NewList(graphql.Float),
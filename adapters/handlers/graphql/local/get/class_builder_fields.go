@@ -138,6 +138,57 @@ func (b *classBuilder) primitiveField(propertyType schema.PropertyDataType,
 	}
 }
 
+func (b *classBuilder) nestedField(propertyType schema.PropertyDataType,
+	property *models.Property, className string,
+) *graphql.Field {
+	return b.nestedObjectField(className+property.Name, propertyType.AsNested(),
+		property, className)
+}
+
+// nestedObjectField builds an object type for the nested properties of an
+// object or object[] property. Nested objects get their own object types, named
+// by the path to them.
+func (b *classBuilder) nestedObjectField(typeName string, dataType schema.DataType,
+	property *models.Property, className string,
+) *graphql.Field {
+	fields := graphql.Fields{}
+	for _, nestedProp := range property.NestedProperties {
+		nested := &models.Property{
+			Name:             nestedProp.Name,
+			DataType:         nestedProp.DataType,
+			Description:      nestedProp.Description,
+			NestedProperties: nestedProp.NestedProperties,
+		}
+		nestedType, err := b.schema.FindPropertyDataType(nested.DataType)
+		if err != nil {
+			// We can't return an error in this FieldsThunk function, so we need to panic
+			panic(fmt.Sprintf("buildGetClass: wrong propertyType for %s.%s.%s; %s",
+				className, property.Name, nested.Name, err.Error()))
+		}
+
+		if nestedType.IsNested() {
+			fields[nested.Name] = b.nestedObjectField(typeName+nested.Name,
+				nestedType.AsNested(), nested, className)
+		} else {
+			fields[nested.Name] = b.primitiveField(nestedType, nested, className)
+		}
+	}
+
+	var fieldType graphql.Output = graphql.NewObject(graphql.ObjectConfig{
+		Name:   fmt.Sprintf("%sObj", typeName),
+		Fields: fields,
+	})
+	if dataType == schema.DataTypeObjectArray {
+		fieldType = graphql.NewList(fieldType)
+	}
+
+	return &graphql.Field{
+		Description: property.Description,
+		Name:        property.Name,
+		Type:        fieldType,
+	}
+}
+
 func newGeoCoordinatesObject(className string, propertyName string) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
 		Description: "GeoCoordinates as latitude and longitude in decimal form",
@@ -364,7 +415,7 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		sort = filters.ExtractSortFromArgs(sortArg.([]interface{}))
 	}
 
-	filters, err := common_filters.ExtractFilters(p.Args, p.Info.FieldName)
+	filters, err := common_filters.ExtractFilters(p.Args, className)
 	if err != nil {
 		return nil, fmt.Errorf("could not extract filters: %s", err)
 	}
@@ -556,13 +607,27 @@ func isPrimitive(selectionSet *ast.SelectionSet) bool {
 	return false
 }
 
+// selectsNestedFields returns whether fields are selected directly, as done
+// for nested objects. Cross-refs select inline fragments instead.
+func selectsNestedFields(selectionSet *ast.SelectionSet) bool {
+	for _, subSelection := range selectionSet.Selections {
+		if subsectionField, ok := subSelection.(*ast.Field); ok {
+			if subsectionField.Name.Value != "__typename" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 type additionalCheck struct {
 	modulesProvider ModulesProvider
 }
 
 func (ac *additionalCheck) isAdditional(name string) bool {
 	if name == "classification" || name == "certainty" ||
-		name == "distance" || name == "id" || name == "vector" ||
+		name == "distance" || name == "compressedDistance" || name == "id" || name == "vector" ||
 		name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 		name == "score" || name == "explainScore" || name == "isConsistent" ||
 		name == "group" {
@@ -614,7 +679,8 @@ func extractProperties(className string, selections *ast.SelectionSet,
 		name := field.Name.Value
 		property := search.SelectProperty{Name: name}
 
-		property.IsPrimitive = isPrimitive(field.SelectionSet)
+		property.IsPrimitive = isPrimitive(field.SelectionSet) ||
+			(name != "_additional" && selectsNestedFields(field.SelectionSet))
 		if !property.IsPrimitive {
 			// We can interpret this property in different ways
 			for _, subSelection := range field.SelectionSet.Selections {
@@ -638,6 +704,10 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.Distance = true
 							continue
 						}
+						if additionalProperty == "compressedDistance" {
+							additionalProps.CompressedDistance = true
+							continue
+						}
 						if additionalProperty == "id" {
 							additionalProps.ID = true
 							continue
@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	helper "github.com/weaviate/weaviate/test/helper"
@@ -47,6 +48,28 @@ func TestSimpleFieldParamsOK(t *testing.T) {
 	resolver.AssertResolve(t, "{ Get { SomeAction { intField } } }")
 }
 
+func TestGetWithAlias(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithAliases(map[string]string{"Deed": "SomeAction"})
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "SomeAction", Property: "intField"},
+			Value:    &filters.Value{Value: 1, Type: schema.DataTypeInt},
+		}},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{map[string]interface{}{"intField": 1}}, nil).Once()
+
+	query := `{ Get { Deed(where: {path: ["intField"], operator: Equal, valueInt: 1}) { intField } } }`
+	result := resolver.AssertResolve(t, query)
+	assert.Equal(t, []interface{}{map[string]interface{}{"intField": 1}},
+		result.Get("Get", "Deed").Result)
+}
+
 func TestExtractIntField(t *testing.T) {
 	t.Parallel()
 
@@ -388,6 +411,31 @@ func TestExtractAdditionalFields(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "with _additional compressedDistance",
+			query: "{ Get { SomeAction { _additional { distance compressedDistance } } } }",
+			expectedParams: dto.GetParams{
+				ClassName: "SomeAction",
+				AdditionalProperties: additional.Properties{
+					Distance:           true,
+					CompressedDistance: true,
+				},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"distance":           float32(0.25),
+						"compressedDistance": float32(0.5),
+					},
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"_additional": map[string]interface{}{
+					"distance":           float32(0.25),
+					"compressedDistance": float32(0.5),
+				},
+			},
+		},
 		{
 			name:  "with _additional certainty",
 			query: "{ Get { SomeAction { _additional { certainty } } } }",
@@ -1204,6 +1252,27 @@ func TestNearVectorRanker(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("for actions with target vectors set", func(t *testing.T) {
+		query := `{ Get { SomeAction(nearVector: {
+								vector: [0.123, 0.984]
+								targetVectors: ["title"]
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vector:        []float32{0.123, 0.984},
+				TargetVectors: []string{"title"},
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
 	t.Run("for things with optional distance set", func(t *testing.T) {
 		query := `{ Get { SomeThing(nearVector: {
 								vector: [0.123, 0.984]
@@ -518,7 +518,7 @@ func (fmp *fakeModulesProvider) GetAll() []modulecapabilities.Module {
 	panic("implement me")
 }
 
-func (fmp *fakeModulesProvider) VectorFromInput(ctx context.Context, className string, input string) ([]float32, error) {
+func (fmp *fakeModulesProvider) VectorFromInput(ctx context.Context, className, input, targetVector string) ([]float32, error) {
 	panic("not implemented")
 }
 
@@ -631,6 +631,22 @@ func newMockResolverWithVectorizer(vectorizer string) *mockResolver {
 	return mocker
 }
 
+func newMockResolverWithAliases(aliases map[string]string) *mockResolver {
+	logger, _ := test.NewNullLogger()
+	simpleSchema := test_helper.CreateSimpleSchema(config.VectorizerModuleText2VecContextionary)
+	simpleSchema.Aliases = aliases
+	field, err := Build(&simpleSchema, logger, getFakeModulesProvider())
+	if err != nil {
+		panic(fmt.Sprintf("could not build graphql test schema: %s", err))
+	}
+	mocker := &mockResolver{}
+	mockLog := &mockRequestsLog{}
+	mocker.RootFieldName = "Get"
+	mocker.RootField = field
+	mocker.RootObject = map[string]interface{}{"Resolver": Resolver(mocker), "RequestsLog": RequestsLog(mockLog)}
+	return mocker
+}
+
 func newMockResolverWithNoModules() *mockResolver {
 	logger, _ := test.NewNullLogger()
 	field, err := Build(&test_helper.SimpleSchema, logger, nil)
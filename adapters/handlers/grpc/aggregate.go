@@ -0,0 +1,363 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var aggregators = map[pb.AggregateRequest_Aggregator]aggregation.Aggregator{
+	pb.AggregateRequest_AGGREGATOR_COUNT:            aggregation.CountAggregator,
+	pb.AggregateRequest_AGGREGATOR_TYPE:             aggregation.TypeAggregator,
+	pb.AggregateRequest_AGGREGATOR_MEAN:             aggregation.MeanAggregator,
+	pb.AggregateRequest_AGGREGATOR_MEDIAN:           aggregation.MedianAggregator,
+	pb.AggregateRequest_AGGREGATOR_MODE:             aggregation.ModeAggregator,
+	pb.AggregateRequest_AGGREGATOR_MAXIMUM:          aggregation.MaximumAggregator,
+	pb.AggregateRequest_AGGREGATOR_MINIMUM:          aggregation.MinimumAggregator,
+	pb.AggregateRequest_AGGREGATOR_SUM:              aggregation.SumAggregator,
+	pb.AggregateRequest_AGGREGATOR_TOTAL_TRUE:       aggregation.TotalTrueAggregator,
+	pb.AggregateRequest_AGGREGATOR_TOTAL_FALSE:      aggregation.TotalFalseAggregator,
+	pb.AggregateRequest_AGGREGATOR_PERCENTAGE_TRUE:  aggregation.PercentageTrueAggregator,
+	pb.AggregateRequest_AGGREGATOR_PERCENTAGE_FALSE: aggregation.PercentageFalseAggregator,
+	pb.AggregateRequest_AGGREGATOR_POINTING_TO:      aggregation.PointingToAggregator,
+}
+
+// same default as in the GraphQL API
+const defaultTopOccurrencesLimit = 5
+
+func (s *Server) Aggregate(ctx context.Context, req *pb.AggregateRequest) (*pb.AggregateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	// resolve the alias first, the class name is used to extract the params
+	req.ClassName = s.schemaManager.ResolveAlias(req.ClassName)
+	params, err := aggregateParamsFromProto(req, s.modulesProvider)
+	if err != nil {
+		return nil, fmt.Errorf("extract params: %w", err)
+	}
+
+	res, err := s.traverser.Aggregate(ctx, principal, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregateResultsToProto(res, params, before)
+}
+
+func aggregateParamsFromProto(req *pb.AggregateRequest, provider moduleArgumentsProvider) (*aggregation.Params, error) {
+	params := &aggregation.Params{
+		ClassName:        schema.ClassName(req.ClassName),
+		IncludeMetaCount: req.MetaCount,
+		Tenant:           req.Tenant,
+	}
+
+	for _, agg := range req.Aggregations {
+		prop := aggregation.ParamProperty{Name: schema.PropertyName(agg.Property)}
+		for _, a := range agg.Aggregators {
+			if a == pb.AggregateRequest_AGGREGATOR_TOP_OCCURRENCES {
+				limit := defaultTopOccurrencesLimit
+				if agg.TopOccurrencesLimit != nil {
+					limit = int(*agg.TopOccurrencesLimit)
+				}
+				prop.Aggregators = append(prop.Aggregators, aggregation.NewTopOccurrencesAggregator(&limit))
+				continue
+			}
+
+			aggregator, ok := aggregators[a]
+			if !ok {
+				return nil, fmt.Errorf("property %q: unknown aggregator %v", agg.Property, a)
+			}
+			prop.Aggregators = append(prop.Aggregators, aggregator)
+		}
+		params.Properties = append(params.Properties, prop)
+	}
+
+	if len(req.GroupBy) > 0 {
+		segments := make([]interface{}, len(req.GroupBy))
+		for i := range req.GroupBy {
+			segments[i] = req.GroupBy[i]
+		}
+		path, err := filters.ParsePath(segments, req.ClassName)
+		if err != nil {
+			return nil, fmt.Errorf("group by: %w", err)
+		}
+		params.GroupBy = path
+	}
+
+	if req.Limit != nil {
+		limit := int(*req.Limit)
+		params.Limit = &limit
+	}
+
+	if req.ObjectLimit != nil {
+		if *req.ObjectLimit == 0 {
+			return nil, fmt.Errorf("objectLimit must be a positive integer")
+		}
+		objectLimit := int(*req.ObjectLimit)
+		params.ObjectLimit = &objectLimit
+	}
+
+	if req.Filters != nil {
+		clause, err := extractFilters(req.Filters, req.ClassName)
+		if err != nil {
+			return nil, fmt.Errorf("filters: %w", err)
+		}
+		params.Filters = clause
+	}
+
+	if req.NearVector != nil {
+		nearVector, err := nearVectorParamsFromProto(req.NearVector)
+		if err != nil {
+			return nil, err
+		}
+		params.NearVector = nearVector
+	}
+
+	if req.NearObject != nil {
+		nearObject, err := nearObjectParamsFromProto(req.NearObject)
+		if err != nil {
+			return nil, err
+		}
+		params.NearObject = nearObject
+	}
+
+	if req.Hybrid != nil {
+		params.Hybrid = hybridParamsFromProto(req.Hybrid)
+	}
+
+	if len(req.ModuleSearch) > 0 {
+		moduleParams, err := moduleSearchParamsFromProto(req.ModuleSearch, provider, req.ClassName)
+		if err != nil {
+			return nil, err
+		}
+		params.ModuleParams = moduleParams
+	}
+
+	// same as in the GraphQL API, the object limit only makes sense for
+	// searches that rank the objects
+	if params.ObjectLimit != nil && params.NearVector == nil && params.NearObject == nil &&
+		params.Hybrid == nil && len(params.ModuleParams) == 0 {
+		return nil, fmt.Errorf("objectLimit can only be used with a near<Media> or hybrid filter")
+	}
+
+	return params, nil
+}
+
+func aggregateResultsToProto(res interface{}, params *aggregation.Params,
+	before time.Time,
+) (*pb.AggregateReply, error) {
+	out := &pb.AggregateReply{}
+	if res != nil {
+		result, ok := res.(*aggregation.Result)
+		if !ok {
+			return nil, fmt.Errorf("unexpected aggregation result of type %T", res)
+		}
+
+		out.Groups = make([]*pb.AggregateGroup, len(result.Groups))
+		for i, group := range result.Groups {
+			converted, err := aggregateGroupToProto(group, params)
+			if err != nil {
+				return nil, err
+			}
+			out.Groups[i] = converted
+		}
+	}
+
+	out.Took = float32(time.Since(before).Seconds())
+	return out, nil
+}
+
+func aggregateGroupToProto(group aggregation.Group, params *aggregation.Params) (*pb.AggregateGroup, error) {
+	out := &pb.AggregateGroup{}
+
+	if group.GroupedBy != nil {
+		value, err := structpb.NewValue(group.GroupedBy.Value)
+		if err != nil {
+			// values of types the struct representation does not know, e.g.
+			// typed slices, are returned in their string representation
+			value = structpb.NewStringValue(fmt.Sprint(group.GroupedBy.Value))
+		}
+		out.GroupedBy = &pb.AggregateGroup_GroupedBy{Path: group.GroupedBy.Path, Value: value}
+	}
+
+	if params.IncludeMetaCount {
+		count := int64(group.Count)
+		out.Count = &count
+	}
+
+	for _, prop := range params.Properties {
+		aggregated, ok := group.Properties[prop.Name.String()]
+		if !ok {
+			continue
+		}
+		converted, err := aggregatePropertyToProto(prop, aggregated)
+		if err != nil {
+			return nil, fmt.Errorf("property %q: %w", prop.Name, err)
+		}
+		out.Properties = append(out.Properties, converted)
+	}
+
+	return out, nil
+}
+
+func aggregatePropertyToProto(prop aggregation.ParamProperty, in aggregation.Property) (*pb.AggregateProperty, error) {
+	out := &pb.AggregateProperty{Name: prop.Name.String(), SchemaType: in.SchemaType}
+
+	switch in.Type {
+	case aggregation.PropertyTypeNumerical:
+		numerical := &pb.NumericalAggregation{}
+		for _, agg := range prop.Aggregators {
+			raw, ok := in.NumericalAggregations[agg.String()]
+			if !ok {
+				continue
+			}
+			value, err := aggregationFloat(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", agg, err)
+			}
+			switch agg {
+			case aggregation.CountAggregator:
+				count := int64(value)
+				numerical.Count = &count
+			case aggregation.MeanAggregator:
+				numerical.Mean = &value
+			case aggregation.MedianAggregator:
+				numerical.Median = &value
+			case aggregation.ModeAggregator:
+				numerical.Mode = &value
+			case aggregation.MaximumAggregator:
+				numerical.Maximum = &value
+			case aggregation.MinimumAggregator:
+				numerical.Minimum = &value
+			case aggregation.SumAggregator:
+				numerical.Sum = &value
+			}
+		}
+		out.Aggregation = &pb.AggregateProperty_Numerical{Numerical: numerical}
+
+	case aggregation.PropertyTypeDate:
+		date := &pb.DateAggregation{}
+		for _, agg := range prop.Aggregators {
+			raw, ok := in.DateAggregations[agg.String()]
+			if !ok {
+				continue
+			}
+			if agg == aggregation.CountAggregator {
+				value, err := aggregationFloat(raw)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", agg, err)
+				}
+				count := int64(value)
+				date.Count = &count
+				continue
+			}
+			value, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: expected a date string, got %T", agg, raw)
+			}
+			switch agg {
+			case aggregation.MedianAggregator:
+				date.Median = &value
+			case aggregation.ModeAggregator:
+				date.Mode = &value
+			case aggregation.MaximumAggregator:
+				date.Maximum = &value
+			case aggregation.MinimumAggregator:
+				date.Minimum = &value
+			}
+		}
+		out.Aggregation = &pb.AggregateProperty_Date{Date: date}
+
+	case aggregation.PropertyTypeText:
+		text := &pb.TextAggregation{}
+		for _, agg := range prop.Aggregators {
+			switch agg.Type {
+			case aggregation.CountAggregator.Type:
+				count := int64(in.TextAggregation.Count)
+				text.Count = &count
+			case aggregation.TopOccurrencesType:
+				text.TopOccurrences = make([]*pb.TextAggregation_Occurrence, len(in.TextAggregation.Items))
+				for i, item := range in.TextAggregation.Items {
+					text.TopOccurrences[i] = &pb.TextAggregation_Occurrence{
+						Value:  item.Value,
+						Occurs: int64(item.Occurs),
+					}
+				}
+			}
+		}
+		out.Aggregation = &pb.AggregateProperty_Text{Text: text}
+
+	case aggregation.PropertyTypeBoolean:
+		boolean := &pb.BooleanAggregation{}
+		b := in.BooleanAggregation
+		for _, agg := range prop.Aggregators {
+			switch agg {
+			case aggregation.CountAggregator:
+				count := int64(b.Count)
+				boolean.Count = &count
+			case aggregation.TotalTrueAggregator:
+				totalTrue := int64(b.TotalTrue)
+				boolean.TotalTrue = &totalTrue
+			case aggregation.TotalFalseAggregator:
+				totalFalse := int64(b.TotalFalse)
+				boolean.TotalFalse = &totalFalse
+			case aggregation.PercentageTrueAggregator:
+				percentageTrue := b.PercentageTrue
+				boolean.PercentageTrue = &percentageTrue
+			case aggregation.PercentageFalseAggregator:
+				percentageFalse := b.PercentageFalse
+				boolean.PercentageFalse = &percentageFalse
+			}
+		}
+		out.Aggregation = &pb.AggregateProperty_Boolean{Boolean: boolean}
+
+	case aggregation.PropertyTypeReference:
+		out.Aggregation = &pb.AggregateProperty_Reference{Reference: &pb.ReferenceAggregation{
+			PointingTo: in.ReferenceAggregation.PointingTo,
+		}}
+	}
+
+	return out, nil
+}
+
+// aggregationFloat reads numerical aggregation values, which depending on
+// the aggregator and whether they were merged from remote shards can have
+// different types
+func aggregationFloat(in interface{}) (float64, error) {
+	switch v := in.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case json.Number:
+		return v.Float64()
+	default:
+		return 0, fmt.Errorf("unexpected numerical value of type %T", in)
+	}
+}
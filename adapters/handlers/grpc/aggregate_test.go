@@ -0,0 +1,224 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+func TestAggregateParamsFromProto(t *testing.T) {
+	uint32Ptr := func(in uint32) *uint32 { return &in }
+	intPtr := func(in int) *int { return &in }
+
+	t.Run("aggregators, group by and limits", func(t *testing.T) {
+		req := &pb.AggregateRequest{
+			ClassName: "Foo",
+			MetaCount: true,
+			Tenant:    "tenant1",
+			Aggregations: []*pb.AggregateRequest_Aggregation{
+				{
+					Property: "price",
+					Aggregators: []pb.AggregateRequest_Aggregator{
+						pb.AggregateRequest_AGGREGATOR_MEAN,
+						pb.AggregateRequest_AGGREGATOR_COUNT,
+					},
+				},
+				{
+					Property:            "name",
+					Aggregators:         []pb.AggregateRequest_Aggregator{pb.AggregateRequest_AGGREGATOR_TOP_OCCURRENCES},
+					TopOccurrencesLimit: uint32Ptr(3),
+				},
+				{
+					Property:    "description",
+					Aggregators: []pb.AggregateRequest_Aggregator{pb.AggregateRequest_AGGREGATOR_TOP_OCCURRENCES},
+				},
+			},
+			GroupBy:     []string{"category"},
+			Limit:       uint32Ptr(10),
+			ObjectLimit: uint32Ptr(100),
+			NearVector:  &pb.NearVectorParams{Vector: []float32{1, 2}},
+		}
+
+		params, err := aggregateParamsFromProto(req, nil)
+		require.Nil(t, err)
+		assert.Equal(t, schema.ClassName("Foo"), params.ClassName)
+		assert.True(t, params.IncludeMetaCount)
+		assert.Equal(t, "tenant1", params.Tenant)
+		assert.Equal(t, []aggregation.ParamProperty{
+			{Name: "price", Aggregators: []aggregation.Aggregator{aggregation.MeanAggregator, aggregation.CountAggregator}},
+			{Name: "name", Aggregators: []aggregation.Aggregator{aggregation.NewTopOccurrencesAggregator(intPtr(3))}},
+			{Name: "description", Aggregators: []aggregation.Aggregator{aggregation.NewTopOccurrencesAggregator(intPtr(5))}},
+		}, params.Properties)
+		assert.Equal(t, &filters.Path{Class: "Foo", Property: "category"}, params.GroupBy)
+		assert.Equal(t, intPtr(10), params.Limit)
+		assert.Equal(t, intPtr(100), params.ObjectLimit)
+		assert.Equal(t, []float32{1, 2}, params.NearVector.Vector)
+	})
+
+	tests := []struct {
+		name        string
+		req         *pb.AggregateRequest
+		expectedErr string
+	}{
+		{
+			name: "object limit without vector search",
+			req: &pb.AggregateRequest{
+				ClassName:   "Foo",
+				ObjectLimit: uint32Ptr(10),
+			},
+			expectedErr: "objectLimit can only be used with a near<Media> or hybrid filter",
+		},
+		{
+			name: "zero object limit",
+			req: &pb.AggregateRequest{
+				ClassName:   "Foo",
+				ObjectLimit: uint32Ptr(0),
+				Hybrid:      &pb.HybridSearchParams{Query: "foo"},
+			},
+			expectedErr: "objectLimit must be a positive integer",
+		},
+		{
+			name: "unspecified aggregator",
+			req: &pb.AggregateRequest{
+				ClassName: "Foo",
+				Aggregations: []*pb.AggregateRequest_Aggregation{
+					{Property: "price", Aggregators: []pb.AggregateRequest_Aggregator{pb.AggregateRequest_AGGREGATOR_UNSPECIFIED}},
+				},
+			},
+			expectedErr: "property \"price\": unknown aggregator AGGREGATOR_UNSPECIFIED",
+		},
+		{
+			name: "module search without modules",
+			req: &pb.AggregateRequest{
+				ClassName:    "Foo",
+				ModuleSearch: []*pb.ModuleArgument{{Name: "nearText"}},
+			},
+			expectedErr: "no modules enabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := aggregateParamsFromProto(tt.req, nil)
+			require.NotNil(t, err)
+			assert.Equal(t, tt.expectedErr, err.Error())
+		})
+	}
+}
+
+func TestAggregateResultsToProto(t *testing.T) {
+	limit := 2
+	params := &aggregation.Params{
+		ClassName:        "Foo",
+		IncludeMetaCount: true,
+		Properties: []aggregation.ParamProperty{
+			{Name: "price", Aggregators: []aggregation.Aggregator{
+				aggregation.CountAggregator, aggregation.MeanAggregator, aggregation.MaximumAggregator,
+			}},
+			{Name: "name", Aggregators: []aggregation.Aggregator{
+				aggregation.TypeAggregator, aggregation.NewTopOccurrencesAggregator(&limit),
+			}},
+			{Name: "inStock", Aggregators: []aggregation.Aggregator{
+				aggregation.TotalTrueAggregator, aggregation.PercentageTrueAggregator,
+			}},
+			{Name: "released", Aggregators: []aggregation.Aggregator{
+				aggregation.CountAggregator, aggregation.MinimumAggregator,
+			}},
+			{Name: "ofBrand", Aggregators: []aggregation.Aggregator{aggregation.PointingToAggregator}},
+		},
+	}
+
+	res := &aggregation.Result{Groups: []aggregation.Group{
+		{
+			Count:     3,
+			GroupedBy: &aggregation.GroupedBy{Path: []string{"category"}, Value: "shoes"},
+			Properties: map[string]aggregation.Property{
+				"price": {
+					Type: aggregation.PropertyTypeNumerical,
+					NumericalAggregations: map[string]interface{}{
+						"count": float64(3), "mean": 20.5, "maximum": 40.0,
+					},
+				},
+				"name": {
+					Type:       aggregation.PropertyTypeText,
+					SchemaType: "text",
+					TextAggregation: aggregation.Text{Items: []aggregation.TextOccurrence{
+						{Value: "runner", Occurs: 2}, {Value: "boot", Occurs: 1},
+					}},
+				},
+				"inStock": {
+					Type: aggregation.PropertyTypeBoolean,
+					BooleanAggregation: aggregation.Boolean{
+						Count: 3, TotalTrue: 2, TotalFalse: 1, PercentageTrue: 0.66, PercentageFalse: 0.33,
+					},
+				},
+				"released": {
+					Type: aggregation.PropertyTypeDate,
+					DateAggregations: map[string]interface{}{
+						"count": int64(3), "minimum": "2023-01-01T00:00:00Z",
+					},
+				},
+				"ofBrand": {
+					Type:                 aggregation.PropertyTypeReference,
+					ReferenceAggregation: aggregation.Reference{PointingTo: []string{"Brand"}},
+				},
+			},
+		},
+	}}
+
+	out, err := aggregateResultsToProto(res, params, time.Now())
+	require.Nil(t, err)
+	require.Len(t, out.Groups, 1)
+
+	group := out.Groups[0]
+	assert.Equal(t, []string{"category"}, group.GroupedBy.Path)
+	assert.Equal(t, "shoes", group.GroupedBy.Value.GetStringValue())
+	require.NotNil(t, group.Count)
+	assert.Equal(t, int64(3), *group.Count)
+	require.Len(t, group.Properties, 5)
+
+	price := group.Properties[0].GetNumerical()
+	require.NotNil(t, price)
+	assert.Equal(t, int64(3), price.GetCount())
+	assert.Equal(t, 20.5, price.GetMean())
+	assert.Equal(t, 40.0, price.GetMaximum())
+	assert.Nil(t, price.Median)
+
+	name := group.Properties[1]
+	assert.Equal(t, "text", name.SchemaType)
+	require.NotNil(t, name.GetText())
+	require.Len(t, name.GetText().TopOccurrences, 2)
+	assert.Equal(t, "runner", name.GetText().TopOccurrences[0].Value)
+	assert.Equal(t, int64(2), name.GetText().TopOccurrences[0].Occurs)
+	assert.Nil(t, name.GetText().Count)
+
+	inStock := group.Properties[2].GetBoolean()
+	require.NotNil(t, inStock)
+	assert.Equal(t, int64(2), inStock.GetTotalTrue())
+	assert.Equal(t, 0.66, inStock.GetPercentageTrue())
+	assert.Nil(t, inStock.TotalFalse)
+
+	released := group.Properties[3].GetDate()
+	require.NotNil(t, released)
+	assert.Equal(t, int64(3), released.GetCount())
+	assert.Equal(t, "2023-01-01T00:00:00Z", released.GetMinimum())
+
+	assert.Equal(t, []string{"Brand"}, group.Properties[4].GetReference().PointingTo)
+}
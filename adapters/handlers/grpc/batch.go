@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func (s *Server) BatchObjects(ctx context.Context, req *pb.BatchObjectsRequest) (*pb.BatchObjectsReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	objs, err := batchObjectsFromProto(req.Objects)
+	if err != nil {
+		return nil, fmt.Errorf("extract objects: %w", err)
+	}
+
+	repl, err := replicationPropertiesFromProto(req.ConsistencyLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.batchManager.AddObjects(ctx, principal, objs, nil, repl)
+	if err != nil {
+		return nil, err
+	}
+
+	return batchObjectsResultsToProto(res, before), nil
+}
+
+func batchObjectsFromProto(in []*pb.BatchObject) ([]*models.Object, error) {
+	objs := make([]*models.Object, len(in))
+	for i, obj := range in {
+		vector, err := byteVectorToFloat32(obj.VectorBytes)
+		if err != nil {
+			return nil, fmt.Errorf("object at index %d: vector: %w", i, err)
+		}
+
+		var vectors models.Vectors
+		if len(obj.Vectors) > 0 {
+			vectors = make(models.Vectors, len(obj.Vectors))
+			for _, named := range obj.Vectors {
+				vec, err := byteVectorToFloat32(named.VectorBytes)
+				if err != nil {
+					return nil, fmt.Errorf("object at index %d: vector %q: %w", i, named.Name, err)
+				}
+				vectors[named.Name] = vec
+			}
+		}
+
+		objs[i] = &models.Object{
+			Class:      obj.ClassName,
+			ID:         strfmt.UUID(obj.Uuid),
+			Tenant:     obj.Tenant,
+			Vector:     vector,
+			Vectors:    vectors,
+			Properties: batchPropertiesFromProto(obj.Properties),
+		}
+	}
+
+	return objs, nil
+}
+
+func batchPropertiesFromProto(in *pb.BatchObject_Properties) models.PropertySchema {
+	if in == nil {
+		return nil
+	}
+
+	props := map[string]interface{}{}
+	if in.NonRefProperties != nil {
+		props = numbersToJSONNumbers(in.NonRefProperties.AsMap()).(map[string]interface{})
+	}
+
+	for name, value := range in.IntProperties {
+		props[name] = json.Number(strconv.FormatInt(value, 10))
+	}
+	for _, prop := range in.IntArrayProperties {
+		values := make([]interface{}, len(prop.Values))
+		for j, value := range prop.Values {
+			values[j] = json.Number(strconv.FormatInt(value, 10))
+		}
+		props[prop.PropName] = values
+	}
+
+	for _, ref := range in.RefProps {
+		beacons := make([]interface{}, len(ref.Uuids))
+		for j, uuid := range ref.Uuids {
+			beacons[j] = map[string]interface{}{
+				"beacon": crossref.NewLocalhost(ref.TargetClass, strfmt.UUID(uuid)).String(),
+			}
+		}
+		props[ref.PropName] = beacons
+	}
+
+	return props
+}
+
+// numbersToJSONNumbers replaces the float64 values decoded from a
+// structpb.Struct with json.Number values, as they are decoded from the
+// bodies of REST requests, so that they are validated and auto-schema'd the
+// same way
+func numbersToJSONNumbers(in interface{}) interface{} {
+	switch v := in.(type) {
+	case float64:
+		return json.Number(strconv.FormatFloat(v, 'f', -1, 64))
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = numbersToJSONNumbers(value)
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = numbersToJSONNumbers(value)
+		}
+		return v
+	default:
+		return in
+	}
+}
+
+// byteVectorToFloat32 decodes a vector of little endian encoded float32
+// values as sent by the clients
+func byteVectorToFloat32(in []byte) ([]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if len(in)%4 != 0 {
+		return nil, fmt.Errorf("length %d is not a multiple of 4", len(in))
+	}
+
+	out := make([]float32, len(in)/4)
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[i*4 : (i+1)*4]))
+	}
+	return out, nil
+}
+
+func replicationPropertiesFromProto(lvl *pb.ConsistencyLevel) (*additional.ReplicationProperties, error) {
+	if lvl == nil {
+		return nil, nil
+	}
+
+	switch *lvl {
+	case pb.ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED:
+		return nil, nil
+	case pb.ConsistencyLevel_CONSISTENCY_LEVEL_ONE:
+		return &additional.ReplicationProperties{ConsistencyLevel: string(replica.One)}, nil
+	case pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM:
+		return &additional.ReplicationProperties{ConsistencyLevel: string(replica.Quorum)}, nil
+	case pb.ConsistencyLevel_CONSISTENCY_LEVEL_ALL:
+		return &additional.ReplicationProperties{ConsistencyLevel: string(replica.All)}, nil
+	default:
+		return nil, fmt.Errorf("unrecognized consistency level %v", *lvl)
+	}
+}
+
+func batchObjectsResultsToProto(res objects.BatchObjects, before time.Time) *pb.BatchObjectsReply {
+	results := make([]*pb.BatchObjectsReply_BatchResult, len(res))
+	for _, obj := range res {
+		result := &pb.BatchObjectsReply_BatchResult{Uuid: obj.UUID.String()}
+		if obj.Err != nil {
+			result.Error = obj.Err.Error()
+		}
+		results[obj.OriginalIndex] = result
+	}
+
+	return &pb.BatchObjectsReply{
+		Results: results,
+		Took:    float32(time.Since(before).Seconds()),
+	}
+}
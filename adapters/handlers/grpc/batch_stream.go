@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	defaultChunkSize = 100
+	minChunkSize     = 10
+	maxChunkSize     = 1000
+)
+
+// BatchStream imports objects sent in chunks over a bidirectional stream.
+// Each chunk is acknowledged once it has been persisted. The reply contains
+// the chunk's failed objects and a suggested size for the next chunk, so
+// clients can adjust to the server's load without their own heuristics.
+func (s *Server) BatchStream(stream pb.Weaviate_BatchStreamServer) error {
+	ctx := stream.Context()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	sizer := newChunkSizer()
+	for chunk := int64(0); ; chunk++ {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		before := time.Now()
+
+		objs, err := batchObjectsFromProto(req.Objects)
+		if err != nil {
+			return fmt.Errorf("chunk %d: extract objects: %w", chunk, err)
+		}
+
+		repl, err := replicationPropertiesFromProto(req.ConsistencyLevel)
+		if err != nil {
+			return fmt.Errorf("chunk %d: %w", chunk, err)
+		}
+
+		res, err := s.batchManager.AddObjects(ctx, principal, objs, nil, repl)
+		if err != nil {
+			return fmt.Errorf("chunk %d: %w", chunk, err)
+		}
+
+		pressure := s.backpressure.underPressure()
+		reply := batchStreamReplyToProto(chunk, res, before)
+		reply.Backpressure = pressure
+		reply.NextChunkSize = int32(sizer.next(pressure))
+		if err := stream.Send(reply); err != nil {
+			return err
+		}
+
+		if pressure {
+			// not reading the next chunk lets the transport's flow control
+			// push back on the client
+			s.backpressure.wait(ctx)
+		}
+	}
+}
+
+func batchStreamReplyToProto(chunk int64, res objects.BatchObjects, before time.Time) *pb.BatchStreamReply {
+	reply := &pb.BatchStreamReply{Chunk: chunk}
+	for _, obj := range res {
+		if obj.Err == nil {
+			reply.Persisted++
+			continue
+		}
+		reply.Errors = append(reply.Errors, &pb.BatchStreamReply_BatchError{
+			Index: int32(obj.OriginalIndex),
+			Uuid:  obj.UUID.String(),
+			Error: obj.Err.Error(),
+		})
+	}
+	reply.Took = float32(time.Since(before).Seconds())
+
+	return reply
+}
+
+type memoryMonitor interface {
+	Ratio() float64
+}
+
+type memtableMonitor interface {
+	MemtablePressure() bool
+}
+
+// backpressure decides whether imports should slow down, either because the
+// heap is close to the memory limit or because memtables fill up faster
+// than they can be flushed.
+type backpressure struct {
+	memory       memoryMonitor
+	memtables    memtableMonitor
+	maxMemRatio  float64
+	pollInterval time.Duration
+	maxWait      time.Duration
+}
+
+func newBackpressure(memory memoryMonitor, memtables memtableMonitor,
+	maxMemPercentage uint64,
+) *backpressure {
+	return &backpressure{
+		memory:       memory,
+		memtables:    memtables,
+		maxMemRatio:  float64(maxMemPercentage) / 100,
+		pollInterval: 100 * time.Millisecond,
+		maxWait:      10 * time.Second,
+	}
+}
+
+func (b *backpressure) underPressure() bool {
+	if b == nil {
+		return false
+	}
+	if b.memtables != nil && b.memtables.MemtablePressure() {
+		return true
+	}
+	return b.memory != nil && b.maxMemRatio > 0 && b.memory.Ratio() >= b.maxMemRatio
+}
+
+// wait blocks until the pressure is relieved, maxWait has passed or the
+// context is done, whatever comes first
+func (b *backpressure) wait(ctx context.Context) {
+	deadline := time.NewTimer(b.maxWait)
+	defer deadline.Stop()
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-deadline.C:
+			return
+		case <-ticker.C:
+			if !b.underPressure() {
+				return
+			}
+		}
+	}
+}
+
+// chunkSizer suggests chunk sizes using additive increase and multiplicative
+// decrease: grow steadily while the server keeps up, halve on pressure
+type chunkSizer struct {
+	size int
+}
+
+func newChunkSizer() *chunkSizer {
+	return &chunkSizer{size: defaultChunkSize}
+}
+
+func (c *chunkSizer) next(pressure bool) int {
+	if pressure {
+		c.size /= 2
+		if c.size < minChunkSize {
+			c.size = minChunkSize
+		}
+	} else {
+		c.size += defaultChunkSize
+		if c.size > maxChunkSize {
+			c.size = maxChunkSize
+		}
+	}
+	return c.size
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+type fakeMemoryMonitor struct {
+	ratio float64
+}
+
+func (f *fakeMemoryMonitor) Ratio() float64 {
+	return f.ratio
+}
+
+type fakeMemtableMonitor struct {
+	// number of calls reporting pressure before it is relieved
+	pressured atomic.Int32
+}
+
+func (f *fakeMemtableMonitor) MemtablePressure() bool {
+	return f.pressured.Add(-1) >= 0
+}
+
+func TestBackpressure(t *testing.T) {
+	t.Run("no pressure", func(t *testing.T) {
+		b := newBackpressure(&fakeMemoryMonitor{ratio: 0.5}, &fakeMemtableMonitor{}, 80)
+		assert.False(t, b.underPressure())
+	})
+
+	t.Run("memory above threshold", func(t *testing.T) {
+		b := newBackpressure(&fakeMemoryMonitor{ratio: 0.85}, &fakeMemtableMonitor{}, 80)
+		assert.True(t, b.underPressure())
+	})
+
+	t.Run("memory check disabled", func(t *testing.T) {
+		b := newBackpressure(&fakeMemoryMonitor{ratio: 0.99}, nil, 0)
+		assert.False(t, b.underPressure())
+	})
+
+	t.Run("memtable pressure", func(t *testing.T) {
+		memtables := &fakeMemtableMonitor{}
+		memtables.pressured.Store(1)
+		b := newBackpressure(&fakeMemoryMonitor{}, memtables, 80)
+		assert.True(t, b.underPressure())
+		assert.False(t, b.underPressure())
+	})
+
+	t.Run("nil backpressure", func(t *testing.T) {
+		var b *backpressure
+		assert.False(t, b.underPressure())
+	})
+
+	t.Run("wait returns once pressure is relieved", func(t *testing.T) {
+		memtables := &fakeMemtableMonitor{}
+		memtables.pressured.Store(3)
+		b := newBackpressure(&fakeMemoryMonitor{}, memtables, 80)
+		b.pollInterval = time.Millisecond
+		b.maxWait = time.Minute
+
+		before := time.Now()
+		b.wait(context.Background())
+		assert.Less(t, time.Since(before), time.Minute)
+		assert.False(t, b.underPressure())
+	})
+
+	t.Run("wait gives up after max wait", func(t *testing.T) {
+		b := newBackpressure(&fakeMemoryMonitor{ratio: 1}, nil, 80)
+		b.pollInterval = time.Millisecond
+		b.maxWait = 20 * time.Millisecond
+
+		before := time.Now()
+		b.wait(context.Background())
+		assert.GreaterOrEqual(t, time.Since(before), 20*time.Millisecond)
+	})
+
+	t.Run("wait stops when context is done", func(t *testing.T) {
+		b := newBackpressure(&fakeMemoryMonitor{ratio: 1}, nil, 80)
+		b.pollInterval = time.Millisecond
+		b.maxWait = time.Minute
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		before := time.Now()
+		b.wait(ctx)
+		assert.Less(t, time.Since(before), time.Minute)
+	})
+}
+
+func TestChunkSizer(t *testing.T) {
+	c := newChunkSizer()
+	assert.Equal(t, 200, c.next(false))
+	assert.Equal(t, 300, c.next(false))
+	assert.Equal(t, 150, c.next(true))
+	assert.Equal(t, 75, c.next(true))
+
+	for i := 0; i < 20; i++ {
+		c.next(false)
+	}
+	assert.Equal(t, maxChunkSize, c.next(false))
+
+	for i := 0; i < 20; i++ {
+		c.next(true)
+	}
+	assert.Equal(t, minChunkSize, c.next(true))
+}
+
+func TestBatchStreamReplyToProto(t *testing.T) {
+	res := objects.BatchObjects{
+		{OriginalIndex: 0, UUID: "uuid-0"},
+		{OriginalIndex: 1, UUID: "uuid-1", Err: errors.New("invalid object")},
+		{OriginalIndex: 2, UUID: "uuid-2"},
+	}
+
+	reply := batchStreamReplyToProto(3, res, time.Now())
+	assert.Equal(t, int64(3), reply.Chunk)
+	assert.Equal(t, int32(2), reply.Persisted)
+	require.Len(t, reply.Errors, 1)
+	assert.Equal(t, int32(1), reply.Errors[0].Index)
+	assert.Equal(t, "uuid-1", reply.Errors[0].Uuid)
+	assert.Equal(t, "invalid object", reply.Errors[0].Error)
+}
@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func vectorToBytes(vec []float32) []byte {
+	out := make([]byte, len(vec)*4)
+	for i, v := range vec {
+		binary.LittleEndian.PutUint32(out[i*4:], math.Float32bits(v))
+	}
+	return out
+}
+
+func TestBatchObjectsFromProto(t *testing.T) {
+	uuid1 := "6e3c1c9b-8d6b-4c1f-9e3a-6c9d3c1e7a01"
+	uuid2 := "6e3c1c9b-8d6b-4c1f-9e3a-6c9d3c1e7a02"
+
+	nonRef, err := structpb.NewStruct(map[string]interface{}{
+		"name":  "foo",
+		"count": float64(3),
+	})
+	require.Nil(t, err)
+	nested, err := structpb.NewStruct(map[string]interface{}{
+		"price":  1.5,
+		"scores": []interface{}{float64(1), 2.25},
+		"address": map[string]interface{}{
+			"street": "Main St",
+			"zip":    float64(1012),
+		},
+	})
+	require.Nil(t, err)
+
+	tests := []struct {
+		name        string
+		in          *pb.BatchObject
+		expected    *models.Object
+		expectedErr string
+	}{
+		{
+			name: "properties and vector",
+			in: &pb.BatchObject{
+				Uuid:        uuid1,
+				ClassName:   "Foo",
+				Tenant:      "tenant1",
+				VectorBytes: vectorToBytes([]float32{1, 2.5, -3}),
+				Properties:  &pb.BatchObject_Properties{NonRefProperties: nonRef},
+			},
+			expected: &models.Object{
+				Class:      "Foo",
+				ID:         strfmt.UUID(uuid1),
+				Tenant:     "tenant1",
+				Vector:     []float32{1, 2.5, -3},
+				Properties: map[string]interface{}{"name": "foo", "count": json.Number("3")},
+			},
+		},
+		{
+			name: "nested numbers",
+			in: &pb.BatchObject{
+				ClassName:  "Foo",
+				Properties: &pb.BatchObject_Properties{NonRefProperties: nested},
+			},
+			expected: &models.Object{
+				Class: "Foo",
+				Properties: map[string]interface{}{
+					"price":  json.Number("1.5"),
+					"scores": []interface{}{json.Number("1"), json.Number("2.25")},
+					"address": map[string]interface{}{
+						"street": "Main St",
+						"zip":    json.Number("1012"),
+					},
+				},
+			},
+		},
+		{
+			name: "integer properties beyond 2^53",
+			in: &pb.BatchObject{
+				ClassName: "Foo",
+				Properties: &pb.BatchObject_Properties{
+					IntProperties: map[string]int64{"big": math.MaxInt64},
+					IntArrayProperties: []*pb.BatchObject_IntArrayProperties{
+						{PropName: "bigs", Values: []int64{1<<53 + 1, math.MinInt64}},
+					},
+				},
+			},
+			expected: &models.Object{
+				Class: "Foo",
+				Properties: map[string]interface{}{
+					"big": json.Number("9223372036854775807"),
+					"bigs": []interface{}{
+						json.Number("9007199254740993"),
+						json.Number("-9223372036854775808"),
+					},
+				},
+			},
+		},
+		{
+			name: "references",
+			in: &pb.BatchObject{
+				ClassName: "Foo",
+				Properties: &pb.BatchObject_Properties{
+					RefProps: []*pb.BatchObject_RefProperties{
+						{PropName: "ofBar", Uuids: []string{uuid1, uuid2}, TargetClass: "Bar"},
+						{PropName: "other", Uuids: []string{uuid1}},
+					},
+				},
+			},
+			expected: &models.Object{
+				Class: "Foo",
+				Properties: map[string]interface{}{
+					"ofBar": []interface{}{
+						map[string]interface{}{"beacon": "weaviate://localhost/Bar/" + uuid1},
+						map[string]interface{}{"beacon": "weaviate://localhost/Bar/" + uuid2},
+					},
+					"other": []interface{}{
+						map[string]interface{}{"beacon": "weaviate://localhost/" + uuid1},
+					},
+				},
+			},
+		},
+		{
+			name: "named vectors",
+			in: &pb.BatchObject{
+				ClassName: "Foo",
+				Vectors: []*pb.BatchObject_NamedVector{
+					{Name: "title", VectorBytes: vectorToBytes([]float32{1, 2})},
+					{Name: "body", VectorBytes: vectorToBytes([]float32{3})},
+				},
+			},
+			expected: &models.Object{
+				Class: "Foo",
+				Vectors: models.Vectors{
+					"title": []float32{1, 2},
+					"body":  []float32{3},
+				},
+			},
+		},
+		{
+			name: "invalid vector length",
+			in: &pb.BatchObject{
+				ClassName:   "Foo",
+				VectorBytes: []byte{1, 2, 3},
+			},
+			expectedErr: "object at index 0: vector: length 3 is not a multiple of 4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := batchObjectsFromProto([]*pb.BatchObject{tt.in})
+			if tt.expectedErr != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.expectedErr, err.Error())
+				return
+			}
+			require.Nil(t, err)
+			require.Len(t, out, 1)
+			assert.Equal(t, tt.expected, out[0])
+		})
+	}
+}
+
+func TestReplicationPropertiesFromProto(t *testing.T) {
+	lvl := func(l pb.ConsistencyLevel) *pb.ConsistencyLevel { return &l }
+
+	tests := []struct {
+		in       *pb.ConsistencyLevel
+		expected *additional.ReplicationProperties
+	}{
+		{in: nil, expected: nil},
+		{in: lvl(pb.ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED), expected: nil},
+		{
+			in:       lvl(pb.ConsistencyLevel_CONSISTENCY_LEVEL_ONE),
+			expected: &additional.ReplicationProperties{ConsistencyLevel: "ONE"},
+		},
+		{
+			in:       lvl(pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM),
+			expected: &additional.ReplicationProperties{ConsistencyLevel: "QUORUM"},
+		},
+		{
+			in:       lvl(pb.ConsistencyLevel_CONSISTENCY_LEVEL_ALL),
+			expected: &additional.ReplicationProperties{ConsistencyLevel: "ALL"},
+		},
+	}
+
+	for _, tt := range tests {
+		repl, err := replicationPropertiesFromProto(tt.in)
+		require.Nil(t, err)
+		assert.Equal(t, tt.expected, repl)
+	}
+}
+
+func TestBatchObjectsResultsToProto(t *testing.T) {
+	res := objects.BatchObjects{
+		{OriginalIndex: 1, UUID: "uuid-1", Err: errors.New("invalid object")},
+		{OriginalIndex: 0, UUID: "uuid-0"},
+	}
+
+	out := batchObjectsResultsToProto(res, time.Now())
+	require.Len(t, out.Results, 2)
+	assert.Equal(t, "uuid-0", out.Results[0].Uuid)
+	assert.Equal(t, "", out.Results[0].Error)
+	assert.Equal(t, "uuid-1", out.Results[1].Uuid)
+	assert.Equal(t, "invalid object", out.Results[1].Error)
+}
@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+var filterOperators = map[pb.Filters_Operator]string{
+	pb.Filters_OPERATOR_EQUAL:              models.WhereFilterOperatorEqual,
+	pb.Filters_OPERATOR_NOT_EQUAL:          models.WhereFilterOperatorNotEqual,
+	pb.Filters_OPERATOR_GREATER_THAN:       models.WhereFilterOperatorGreaterThan,
+	pb.Filters_OPERATOR_GREATER_THAN_EQUAL: models.WhereFilterOperatorGreaterThanEqual,
+	pb.Filters_OPERATOR_LESS_THAN:          models.WhereFilterOperatorLessThan,
+	pb.Filters_OPERATOR_LESS_THAN_EQUAL:    models.WhereFilterOperatorLessThanEqual,
+	pb.Filters_OPERATOR_AND:                models.WhereFilterOperatorAnd,
+	pb.Filters_OPERATOR_OR:                 models.WhereFilterOperatorOr,
+	pb.Filters_OPERATOR_NOT:                models.WhereFilterOperatorNot,
+	pb.Filters_OPERATOR_WITHIN_GEO_RANGE:   models.WhereFilterOperatorWithinGeoRange,
+	pb.Filters_OPERATOR_LIKE:               models.WhereFilterOperatorLike,
+	pb.Filters_OPERATOR_IS_NULL:            models.WhereFilterOperatorIsNull,
+}
+
+// extractFilters converts the gRPC filters into a local filter. The filters
+// are translated into the REST representation first, so that gRPC, REST and
+// GraphQL share the same parsing and validation logic.
+func extractFilters(in *pb.Filters, className string) (*filters.LocalFilter, error) {
+	where, err := whereFilterFromProto(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterext.Parse(where, className)
+}
+
+func whereFilterFromProto(in *pb.Filters) (*models.WhereFilter, error) {
+	operator, ok := filterOperators[in.Operator]
+	if !ok {
+		return nil, fmt.Errorf("unknown filter operator %q", in.Operator.String())
+	}
+
+	out := &models.WhereFilter{Operator: operator}
+	if len(in.On) > 0 {
+		out.Path = in.On
+	}
+
+	for i, operand := range in.Filters {
+		where, err := whereFilterFromProto(operand)
+		if err != nil {
+			return nil, fmt.Errorf("operand %d: %w", i, err)
+		}
+		out.Operands = append(out.Operands, where)
+	}
+
+	switch value := in.TestValue.(type) {
+	case nil:
+	case *pb.Filters_ValueText:
+		out.ValueText = &value.ValueText
+	case *pb.Filters_ValueInt:
+		out.ValueInt = &value.ValueInt
+	case *pb.Filters_ValueBoolean:
+		out.ValueBoolean = &value.ValueBoolean
+	case *pb.Filters_ValueNumber:
+		out.ValueNumber = &value.ValueNumber
+	case *pb.Filters_ValueDate:
+		out.ValueDate = &value.ValueDate
+	case *pb.Filters_ValueGeo:
+		if value.ValueGeo == nil {
+			return nil, fmt.Errorf("value_geo must not be empty")
+		}
+		out.ValueGeoRange = &models.WhereFilterGeoRange{
+			GeoCoordinates: &models.GeoCoordinates{
+				Latitude:  &value.ValueGeo.Latitude,
+				Longitude: &value.ValueGeo.Longitude,
+			},
+			Distance: &models.WhereFilterGeoRangeDistance{
+				Max: float64(value.ValueGeo.Distance),
+			},
+		}
+	default:
+		return nil, fmt.Errorf("unknown filter value type %T", value)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+func TestExtractFilters(t *testing.T) {
+	lat, lon := float32(51.5), float32(-0.12)
+
+	tests := []struct {
+		name        string
+		in          *pb.Filters
+		expected    *filters.Clause
+		expectedErr string
+	}{
+		{
+			name: "equal on text",
+			in: &pb.Filters{
+				Operator:  pb.Filters_OPERATOR_EQUAL,
+				On:        []string{"name"},
+				TestValue: &pb.Filters_ValueText{ValueText: "foo"},
+			},
+			expected: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "Article", Property: "name"},
+				Value:    &filters.Value{Value: "foo", Type: schema.DataTypeText},
+			},
+		},
+		{
+			name: "like on text",
+			in: &pb.Filters{
+				Operator:  pb.Filters_OPERATOR_LIKE,
+				On:        []string{"name"},
+				TestValue: &pb.Filters_ValueText{ValueText: "fo*"},
+			},
+			expected: &filters.Clause{
+				Operator: filters.OperatorLike,
+				On:       &filters.Path{Class: "Article", Property: "name"},
+				Value:    &filters.Value{Value: "fo*", Type: schema.DataTypeText},
+			},
+		},
+		{
+			name: "is null",
+			in: &pb.Filters{
+				Operator:  pb.Filters_OPERATOR_IS_NULL,
+				On:        []string{"name"},
+				TestValue: &pb.Filters_ValueBoolean{ValueBoolean: true},
+			},
+			expected: &filters.Clause{
+				Operator: filters.OperatorIsNull,
+				On:       &filters.Path{Class: "Article", Property: "name"},
+				Value:    &filters.Value{Value: true, Type: schema.DataTypeBoolean},
+			},
+		},
+		{
+			name: "within geo range",
+			in: &pb.Filters{
+				Operator: pb.Filters_OPERATOR_WITHIN_GEO_RANGE,
+				On:       []string{"location"},
+				TestValue: &pb.Filters_ValueGeo{ValueGeo: &pb.GeoCoordinatesFilter{
+					Latitude: lat, Longitude: lon, Distance: 2000,
+				}},
+			},
+			expected: &filters.Clause{
+				Operator: filters.OperatorWithinGeoRange,
+				On:       &filters.Path{Class: "Article", Property: "location"},
+				Value: &filters.Value{
+					Value: filters.GeoRange{
+						GeoCoordinates: &models.GeoCoordinates{Latitude: &lat, Longitude: &lon},
+						Distance:       2000,
+					},
+					Type: schema.DataTypeGeoCoordinates,
+				},
+			},
+		},
+		{
+			name: "reference path",
+			in: &pb.Filters{
+				Operator:  pb.Filters_OPERATOR_GREATER_THAN,
+				On:        []string{"hasAuthor", "Author", "age"},
+				TestValue: &pb.Filters_ValueInt{ValueInt: 30},
+			},
+			expected: &filters.Clause{
+				Operator: filters.OperatorGreaterThan,
+				On: &filters.Path{
+					Class:    "Article",
+					Property: "hasAuthor",
+					Child:    &filters.Path{Class: "Author", Property: "age"},
+				},
+				Value: &filters.Value{Value: 30, Type: schema.DataTypeInt},
+			},
+		},
+		{
+			name: "nested and/or",
+			in: &pb.Filters{
+				Operator: pb.Filters_OPERATOR_AND,
+				Filters: []*pb.Filters{
+					{
+						Operator:  pb.Filters_OPERATOR_LESS_THAN_EQUAL,
+						On:        []string{"price"},
+						TestValue: &pb.Filters_ValueNumber{ValueNumber: 9.5},
+					},
+					{
+						Operator: pb.Filters_OPERATOR_OR,
+						Filters: []*pb.Filters{
+							{
+								Operator:  pb.Filters_OPERATOR_NOT_EQUAL,
+								On:        []string{"published"},
+								TestValue: &pb.Filters_ValueDate{ValueDate: "2023-01-01T00:00:00Z"},
+							},
+						},
+					},
+				},
+			},
+			expected: &filters.Clause{
+				Operator: filters.OperatorAnd,
+				Operands: []filters.Clause{
+					{
+						Operator: filters.OperatorLessThanEqual,
+						On:       &filters.Path{Class: "Article", Property: "price"},
+						Value:    &filters.Value{Value: 9.5, Type: schema.DataTypeNumber},
+					},
+					{
+						Operator: filters.OperatorOr,
+						Operands: []filters.Clause{
+							{
+								Operator: filters.OperatorNotEqual,
+								On:       &filters.Path{Class: "Article", Property: "published"},
+								Value:    &filters.Value{Value: "2023-01-01T00:00:00Z", Type: schema.DataTypeDate},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "unspecified operator",
+			in:          &pb.Filters{On: []string{"name"}},
+			expectedErr: "unknown filter operator \"OPERATOR_UNSPECIFIED\"",
+		},
+		{
+			name: "value on nested operator",
+			in: &pb.Filters{
+				Operator:  pb.Filters_OPERATOR_AND,
+				TestValue: &pb.Filters_ValueText{ValueText: "foo"},
+			},
+			expectedErr: "invalid where filter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := extractFilters(tt.in, "Article")
+			if tt.expectedErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, out.Root)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	pb "github.com/weaviate/weaviate/grpc"
+)
+
+func extractSorting(sortIn []*pb.SortBy) []filters.Sort {
+	sortOut := make([]filters.Sort, len(sortIn))
+	for i := range sortIn {
+		order := "asc"
+		if !sortIn[i].Ascending {
+			order = "desc"
+		}
+		sortOut[i] = filters.Sort{Order: order, Path: sortIn[i].Path}
+	}
+	return sortOut
+}
+
+func extractGroupBy(groupIn *pb.GroupBy) (*searchparams.GroupBy, error) {
+	// same as in the GraphQL API, only grouping by a single property is supported
+	if len(groupIn.Path) != 1 {
+		return nil, fmt.Errorf("groupBy path can only have one entry, received %v", groupIn.Path)
+	}
+
+	return &searchparams.GroupBy{
+		Property:        groupIn.Path[0],
+		Groups:          int(groupIn.NumberOfGroups),
+		ObjectsPerGroup: int(groupIn.ObjectsPerGroup),
+	}, nil
+}
+
+func groupByResultsToProto(res []any, searchParams dto.GetParams) ([]*pb.GroupByResult, error) {
+	out := make([]*pb.GroupByResult, 0, len(res))
+	for _, raw := range res {
+		asMap, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		addProps, ok := asMap["_additional"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		group, ok := addProps["group"].(*additional.Group)
+		if !ok {
+			continue
+		}
+
+		hits := make([]*pb.SearchResult, 0, len(group.Hits))
+		for _, hit := range group.Hits {
+			props, err := extractPropertiesAnswer(hit, searchParams.Properties, searchParams.ClassName)
+			if err != nil {
+				return nil, err
+			}
+			hits = append(hits, &pb.SearchResult{
+				Properties:           props,
+				AdditionalProperties: extractGroupHitAdditionalProps(hit, searchParams.AdditionalProperties),
+			})
+		}
+
+		result := &pb.GroupByResult{
+			Id:          int64(group.ID),
+			MinDistance: group.MinDistance,
+			MaxDistance: group.MaxDistance,
+			Count:       int64(group.Count),
+			Hits:        hits,
+		}
+		if group.GroupedBy != nil {
+			result.Value = group.GroupedBy.Value
+			result.Path = group.GroupedBy.Path
+		}
+		out = append(out, result)
+	}
+
+	return out, nil
+}
+
+func extractGroupHitAdditionalProps(hit map[string]interface{}, props additional.Properties) *pb.ResultAdditionalProps {
+	out := &pb.ResultAdditionalProps{}
+	hitAdditional, ok := hit["_additional"].(*additional.GroupHitAdditional)
+	if !ok {
+		return out
+	}
+
+	if props.ID {
+		out.Id = hitAdditional.ID
+	}
+	if props.Vector {
+		out.Vector = hitAdditional.Vector
+	}
+	if props.Distance {
+		out.Distance = hitAdditional.Distance
+		out.DistancePresent = true
+	}
+
+	return out
+}
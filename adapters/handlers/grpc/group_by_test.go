@@ -0,0 +1,238 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	pb "github.com/weaviate/weaviate/grpc"
+	"github.com/weaviate/weaviate/usecases/config"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+func TestGroupByResultsToProto(t *testing.T) {
+	res := []any{
+		map[string]any{
+			"name": "first",
+			"_additional": map[string]interface{}{
+				"group": &additional.Group{
+					ID:          0,
+					GroupedBy:   &additional.GroupedBy{Value: "a", Path: []string{"category"}},
+					MinDistance: 0.1,
+					MaxDistance: 0.2,
+					Count:       2,
+					Hits: []map[string]interface{}{
+						{
+							"name":        "first",
+							"_additional": &additional.GroupHitAdditional{ID: "id-1", Distance: 0.1},
+						},
+						{
+							"name":        "second",
+							"_additional": &additional.GroupHitAdditional{ID: "id-2", Distance: 0.2},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	params := dto.GetParams{
+		ClassName:            "Foo",
+		Properties:           search.SelectProperties{{Name: "name", IsPrimitive: true}},
+		GroupBy:              &searchparams.GroupBy{Property: "category", Groups: 1, ObjectsPerGroup: 2},
+		AdditionalProperties: additional.Properties{ID: true, Distance: true},
+	}
+
+	groups, err := groupByResultsToProto(res, params)
+	require.Nil(t, err)
+	require.Len(t, groups, 1)
+
+	group := groups[0]
+	assert.Equal(t, "a", group.Value)
+	assert.Equal(t, []string{"category"}, group.Path)
+	assert.Equal(t, float32(0.1), group.MinDistance)
+	assert.Equal(t, float32(0.2), group.MaxDistance)
+	assert.Equal(t, int64(2), group.Count)
+	require.Len(t, group.Hits, 2)
+	assert.Equal(t, "id-1", group.Hits[0].AdditionalProperties.Id)
+	assert.Equal(t, float32(0.1), group.Hits[0].AdditionalProperties.Distance)
+	assert.True(t, group.Hits[0].AdditionalProperties.DistancePresent)
+	assert.Equal(t, "second", group.Hits[1].Properties.NonRefProperties.AsMap()["name"])
+	assert.Equal(t, "Foo", group.Hits[1].Properties.ClassName)
+}
+
+// fakeGroupBySearcher returns a grouped result the same way the DB does,
+// the group is only part of the result if it is requested as additional
+// property
+type fakeGroupBySearcher struct{}
+
+func (f *fakeGroupBySearcher) VectorSearch(ctx context.Context,
+	params dto.GetParams,
+) ([]search.Result, error) {
+	obj := storobj.FromObject(&models.Object{
+		Class:      params.ClassName,
+		ID:         "8a9c1e3b-5d7f-4b2a-9c1e-3b5d7f4b2a01",
+		Properties: map[string]interface{}{"name": "first"},
+		Additional: models.AdditionalProperties{
+			"group": &additional.Group{
+				ID:        0,
+				GroupedBy: &additional.GroupedBy{Value: "a", Path: []string{"category"}},
+				Count:     1,
+				Hits: []map[string]interface{}{
+					{
+						"name":        "first",
+						"_additional": &additional.GroupHitAdditional{ID: "8a9c1e3b-5d7f-4b2a-9c1e-3b5d7f4b2a01"},
+					},
+				},
+			},
+		},
+	}, []float32{1, 2, 3})
+	return []search.Result{obj.SearchResultWithDist(params.AdditionalProperties, 0.1)}, nil
+}
+
+func (f *fakeGroupBySearcher) Search(ctx context.Context, params dto.GetParams) ([]search.Result, error) {
+	return nil, nil
+}
+
+func (f *fakeGroupBySearcher) CrossClassVectorSearch(ctx context.Context, vector []float32,
+	offset, limit int, filters *filters.LocalFilter,
+) ([]search.Result, error) {
+	return nil, nil
+}
+
+func (f *fakeGroupBySearcher) Object(ctx context.Context, className string, id strfmt.UUID,
+	props search.SelectProperties, additional additional.Properties,
+	properties *additional.ReplicationProperties, tenant string,
+) (*search.Result, error) {
+	return nil, nil
+}
+
+func (f *fakeGroupBySearcher) ObjectsByID(ctx context.Context, id strfmt.UUID,
+	props search.SelectProperties, additional additional.Properties, tenant string,
+) (search.Results, error) {
+	return nil, nil
+}
+
+func (f *fakeGroupBySearcher) Aggregate(ctx context.Context,
+	params aggregation.Params,
+) (*aggregation.Result, error) {
+	return nil, nil
+}
+
+func (f *fakeGroupBySearcher) SparseObjectSearch(ctx context.Context,
+	params dto.GetParams,
+) ([]*storobj.Object, []float32, error) {
+	return nil, nil, nil
+}
+
+func (f *fakeGroupBySearcher) DenseObjectSearch(context.Context, string, []float32, string,
+	int, int, *filters.LocalFilter, additional.Properties, string,
+) ([]*storobj.Object, []float32, error) {
+	return nil, nil, nil
+}
+
+func (f *fakeGroupBySearcher) ResolveReferences(ctx context.Context, objs search.Results,
+	props search.SelectProperties, groupBy *searchparams.GroupBy,
+	additional additional.Properties, tenant string,
+) (search.Results, error) {
+	return objs, nil
+}
+
+type fakeGroupBySchemaGetter struct {
+	schemaUC.SchemaGetter
+	schema  schema.Schema
+	aliases map[string]string
+}
+
+func (f *fakeGroupBySchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
+func (f *fakeGroupBySchemaGetter) ResolveAlias(name string) string {
+	if class, ok := f.aliases[name]; ok {
+		return class
+	}
+	return name
+}
+
+type fakeGroupByLocks struct{}
+
+func (f *fakeGroupByLocks) LockConnector() (func() error, error) {
+	return func() error { return nil }, nil
+}
+
+func (f *fakeGroupByLocks) LockSchema() (func() error, error) {
+	return func() error { return nil }, nil
+}
+
+type fakeGroupByAuthorizer struct{}
+
+func (f *fakeGroupByAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return nil
+}
+
+type fakeGroupByMetrics struct{}
+
+func (f *fakeGroupByMetrics) AddUsageDimensions(className, queryType, operation string, dims int) {}
+
+func TestSearchGroupBy(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeGroupBySchemaGetter{schema: schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{{
+			Class:             "Foo",
+			VectorIndexConfig: hnsw.UserConfig{Distance: hnsw.DistanceCosine},
+			Properties: []*models.Property{
+				{Name: "name", DataType: schema.DataTypeText.PropString()},
+				{Name: "category", DataType: schema.DataTypeText.PropString()},
+			},
+		}},
+	}}}
+
+	searcher := &fakeGroupBySearcher{}
+	explorer := traverser.NewExplorer(searcher, logger, nil, &fakeGroupByMetrics{})
+	explorer.SetSchemaGetter(schemaGetter)
+	s := &Server{
+		traverser: traverser.NewTraverser(&config.WeaviateConfig{}, &fakeGroupByLocks{},
+			logger, &fakeGroupByAuthorizer{}, searcher, explorer, schemaGetter, nil, nil, 0),
+		schemaManager:        schemaGetter,
+		allowAnonymousAccess: true,
+	}
+
+	reply, err := s.Search(context.Background(), &pb.SearchRequest{
+		ClassName:  "Foo",
+		NearVector: &pb.NearVectorParams{Vector: []float32{1, 2, 3}},
+		Properties: &pb.Properties{NonRefProperties: []string{"name"}},
+		GroupBy:    &pb.GroupBy{Path: []string{"category"}, NumberOfGroups: 1, ObjectsPerGroup: 1},
+	})
+	require.Nil(t, err)
+	require.Len(t, reply.GroupByResults, 1)
+	assert.Equal(t, "a", reply.GroupByResults[0].Value)
+	require.Len(t, reply.GroupByResults[0].Hits, 1)
+	assert.Equal(t, "first",
+		reply.GroupByResults[0].Hits[0].Properties.NonRefProperties.AsMap()["name"])
+}
@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	pb "github.com/weaviate/weaviate/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type moduleArgumentsProvider interface {
+	ExtractSearchParamsFromValues(arguments map[string]interface{}, className string) (map[string]interface{}, error)
+	ExtractAdditionalFieldFromValues(className, name string, arguments map[string]interface{}) (interface{}, error)
+}
+
+// moduleParamsFromProto routes the generic module arguments through the
+// modules provider, so they are extracted exactly like their GraphQL
+// counterparts
+func moduleParamsFromProto(req *pb.SearchRequest, provider moduleArgumentsProvider, out *dto.GetParams) error {
+	if len(req.ModuleSearch) == 0 && len(req.ModuleAdditional) == 0 {
+		return nil
+	}
+	if provider == nil {
+		return fmt.Errorf("no modules enabled")
+	}
+
+	if len(req.ModuleSearch) > 0 {
+		params, err := moduleSearchParamsFromProto(req.ModuleSearch, provider, out.ClassName)
+		if err != nil {
+			return err
+		}
+		out.ModuleParams = params
+	}
+
+	for _, arg := range req.ModuleAdditional {
+		extracted, err := provider.ExtractAdditionalFieldFromValues(out.ClassName, arg.Name, arg.Arguments.AsMap())
+		if err != nil {
+			return err
+		}
+		if out.AdditionalProperties.ModuleParams == nil {
+			out.AdditionalProperties.ModuleParams = map[string]interface{}{}
+		}
+		out.AdditionalProperties.ModuleParams[arg.Name] = extracted
+	}
+
+	return nil
+}
+
+func moduleSearchParamsFromProto(args []*pb.ModuleArgument, provider moduleArgumentsProvider,
+	className string,
+) (map[string]interface{}, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("no modules enabled")
+	}
+
+	arguments := make(map[string]interface{}, len(args))
+	for _, arg := range args {
+		if _, ok := arguments[arg.Name]; ok {
+			return nil, fmt.Errorf("search argument %q provided more than once", arg.Name)
+		}
+		arguments[arg.Name] = arg.Arguments.AsMap()
+	}
+
+	return provider.ExtractSearchParamsFromValues(arguments, className)
+}
+
+// moduleAdditionalToProto converts the module additional results, which are
+// module specific types, through their JSON representation as in the REST
+// and GraphQL APIs
+func moduleAdditionalToProto(additionalProps map[string]interface{},
+	moduleParams map[string]interface{},
+) (*structpb.Struct, error) {
+	out := make(map[string]interface{}, len(moduleParams))
+	for name := range moduleParams {
+		value, ok := additionalProps[name]
+		if !ok || value == nil {
+			continue
+		}
+
+		asJSON, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("marshal %s: %w", name, err)
+		}
+		var generic interface{}
+		if err := json.Unmarshal(asJSON, &generic); err != nil {
+			return nil, fmt.Errorf("unmarshal %s: %w", name, err)
+		}
+		out[name] = generic
+	}
+
+	return structpb.NewStruct(out)
+}
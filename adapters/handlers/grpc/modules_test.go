@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	pb "github.com/weaviate/weaviate/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type fakeModuleArgumentsProvider struct{}
+
+func (f *fakeModuleArgumentsProvider) ExtractSearchParamsFromValues(arguments map[string]interface{},
+	className string,
+) (map[string]interface{}, error) {
+	out := map[string]interface{}{}
+	for name, args := range arguments {
+		if name != "nearText" {
+			return nil, fmt.Errorf("unknown search argument %q for class %q", name, className)
+		}
+		out[name] = args
+	}
+	return out, nil
+}
+
+func (f *fakeModuleArgumentsProvider) ExtractAdditionalFieldFromValues(className, name string,
+	arguments map[string]interface{},
+) (interface{}, error) {
+	return arguments, nil
+}
+
+func TestModuleParamsFromProto(t *testing.T) {
+	nearText, err := structpb.NewStruct(map[string]interface{}{
+		"concepts": []interface{}{"foo"},
+	})
+	require.Nil(t, err)
+	generate, err := structpb.NewStruct(map[string]interface{}{
+		"singleResult": map[string]interface{}{"prompt": "summarize {text}"},
+	})
+	require.Nil(t, err)
+
+	t.Run("search and additional arguments", func(t *testing.T) {
+		req := &pb.SearchRequest{
+			ClassName:        "Foo",
+			ModuleSearch:     []*pb.ModuleArgument{{Name: "nearText", Arguments: nearText}},
+			ModuleAdditional: []*pb.ModuleArgument{{Name: "generate", Arguments: generate}},
+		}
+		out := dto.GetParams{ClassName: "Foo"}
+		require.Nil(t, moduleParamsFromProto(req, &fakeModuleArgumentsProvider{}, &out))
+		assert.Equal(t, map[string]interface{}{"nearText": nearText.AsMap()}, out.ModuleParams)
+		assert.Equal(t, map[string]interface{}{"generate": generate.AsMap()}, out.AdditionalProperties.ModuleParams)
+	})
+
+	t.Run("no module arguments", func(t *testing.T) {
+		out := dto.GetParams{ClassName: "Foo"}
+		require.Nil(t, moduleParamsFromProto(&pb.SearchRequest{ClassName: "Foo"}, nil, &out))
+		assert.Nil(t, out.ModuleParams)
+		assert.Nil(t, out.AdditionalProperties.ModuleParams)
+	})
+
+	t.Run("duplicate search argument", func(t *testing.T) {
+		req := &pb.SearchRequest{
+			ClassName: "Foo",
+			ModuleSearch: []*pb.ModuleArgument{
+				{Name: "nearText", Arguments: nearText},
+				{Name: "nearText", Arguments: nearText},
+			},
+		}
+		out := dto.GetParams{ClassName: "Foo"}
+		err := moduleParamsFromProto(req, &fakeModuleArgumentsProvider{}, &out)
+		require.NotNil(t, err)
+		assert.Equal(t, "search argument \"nearText\" provided more than once", err.Error())
+	})
+
+	t.Run("error from provider", func(t *testing.T) {
+		req := &pb.SearchRequest{
+			ClassName:    "Foo",
+			ModuleSearch: []*pb.ModuleArgument{{Name: "nearImage"}},
+		}
+		out := dto.GetParams{ClassName: "Foo"}
+		err := moduleParamsFromProto(req, &fakeModuleArgumentsProvider{}, &out)
+		require.NotNil(t, err)
+		assert.Equal(t, "unknown search argument \"nearImage\" for class \"Foo\"", err.Error())
+	})
+
+	t.Run("modules disabled", func(t *testing.T) {
+		req := &pb.SearchRequest{
+			ClassName:    "Foo",
+			ModuleSearch: []*pb.ModuleArgument{{Name: "nearText", Arguments: nearText}},
+		}
+		out := dto.GetParams{ClassName: "Foo"}
+		err := moduleParamsFromProto(req, nil, &out)
+		require.NotNil(t, err)
+		assert.Equal(t, "no modules enabled", err.Error())
+	})
+}
+
+func TestModuleAdditionalToProto(t *testing.T) {
+	type generateResult struct {
+		SingleResult *string `json:"singleResult"`
+		Error        error   `json:"error"`
+	}
+	result := "a summary"
+
+	out, err := moduleAdditionalToProto(map[string]interface{}{
+		"generate": &generateResult{SingleResult: &result},
+		"distance": float32(0.1),
+	}, map[string]interface{}{"generate": nil, "answer": nil})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"generate": map[string]interface{}{"singleResult": "a summary", "error": nil},
+	}, out.AsMap())
+}
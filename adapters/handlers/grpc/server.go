@@ -15,10 +15,11 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"runtime"
+	"runtime/debug"
 	"time"
 
 	"github.com/weaviate/weaviate/entities/schema"
-	schemaManager "github.com/weaviate/weaviate/usecases/schema"
 
 	"github.com/pkg/errors"
 
@@ -28,18 +29,30 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	pb "github.com/weaviate/weaviate/grpc"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
-	"github.com/weaviate/weaviate/usecases/traverser"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/objects"
 	"google.golang.org/grpc"
 )
 
 func CreateGRPCServer(state *state.State) *GRPCServer {
 	s := grpc.NewServer()
 
+	var memtables memtableMonitor
+	if state.DB != nil {
+		memtables = state.DB
+	}
+	var modulesProvider moduleArgumentsProvider
+	if state.Modules != nil {
+		modulesProvider = state.Modules
+	}
+
 	pb.RegisterWeaviateServer(s, &Server{
 		traverser: state.Traverser,
 		authComposer: composer.New(
@@ -47,6 +60,11 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 			state.APIKey, state.OIDC),
 		allowAnonymousAccess: state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 		schemaManager:        state.SchemaManager,
+		batchManager:         state.BatchManager,
+		modulesProvider:      modulesProvider,
+		backpressure: newBackpressure(
+			memwatch.NewMonitor(runtime.MemProfile, debug.SetMemoryLimit, runtime.MemProfileRate),
+			memtables, state.ServerConfig.Config.ResourceUsage.MemUse.WarningPercentage),
 	})
 
 	return &GRPCServer{s}
@@ -71,12 +89,27 @@ type GRPCServer struct {
 	*grpc.Server
 }
 
+type traverserProvider interface {
+	GetClass(ctx context.Context, principal *models.Principal,
+		params dto.GetParams) ([]interface{}, error)
+	Aggregate(ctx context.Context, principal *models.Principal,
+		params *aggregation.Params) (interface{}, error)
+}
+
+type schemaProvider interface {
+	GetSchemaSkipAuth() schema.Schema
+	ResolveAlias(name string) string
+}
+
 type Server struct {
 	pb.UnimplementedWeaviateServer
-	traverser            *traverser.Traverser
+	traverser            traverserProvider
 	authComposer         composer.TokenFunc
 	allowAnonymousAccess bool
-	schemaManager        *schemaManager.Manager
+	schemaManager        schemaProvider
+	batchManager         *objects.BatchManager
+	backpressure         *backpressure
+	modulesProvider      moduleArgumentsProvider
 }
 
 func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
@@ -87,11 +120,17 @@ func (s *Server) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchR
 		return nil, fmt.Errorf("extract auth: %w", err)
 	}
 
+	// resolve the alias first, the class name is used to extract the params
+	req.ClassName = s.schemaManager.ResolveAlias(req.ClassName)
 	searchParams, err := searchParamsFromProto(req)
 	if err != nil {
 		return nil, fmt.Errorf("extract params: %w", err)
 	}
 
+	if err := moduleParamsFromProto(req, s.modulesProvider, &searchParams); err != nil {
+		return nil, fmt.Errorf("extract module params: %w", err)
+	}
+
 	if err := s.validateClassAndProperty(searchParams); err != nil {
 		return nil, err
 	}
@@ -123,6 +162,14 @@ func (s *Server) validateClassAndProperty(searchParams dto.GetParams) error {
 
 func searchResultsToProto(res []any, start time.Time, searchParams dto.GetParams) (*pb.SearchReply, error) {
 	tookSeconds := float64(time.Since(start)) / float64(time.Second)
+	if searchParams.GroupBy != nil {
+		groups, err := groupByResultsToProto(res, searchParams)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.SearchReply{Took: float32(tookSeconds), GroupByResults: groups}, nil
+	}
+
 	out := &pb.SearchReply{
 		Took:    float32(tookSeconds),
 		Results: make([]*pb.SearchResult, len(res)),
@@ -260,6 +307,15 @@ func extractAdditionalProps(asMap map[string]any, searchParams dto.GetParams) (*
 		}
 	}
 
+	if len(searchParams.AdditionalProperties.ModuleParams) > 0 {
+		moduleAdditional, err := moduleAdditionalToProto(additionalPropertiesMap,
+			searchParams.AdditionalProperties.ModuleParams)
+		if err != nil {
+			return nil, err
+		}
+		additionalProps.ModuleAdditional = moduleAdditional
+	}
+
 	return additionalProps, nil
 }
 
@@ -367,58 +423,46 @@ func searchParamsFromProto(req *pb.SearchRequest) (dto.GetParams, error) {
 	}
 
 	if hs := req.HybridSearch; hs != nil {
-		out.HybridSearch = &searchparams.HybridSearch{Query: hs.Query, Properties: hs.Properties, Vector: hs.Vector, Alpha: float64(hs.Alpha)}
+		out.HybridSearch = hybridParamsFromProto(hs)
 	}
 
 	if bm25 := req.Bm25Search; bm25 != nil {
 		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: bm25.Properties, Type: "bm25", AdditionalExplanations: explainScore}
 	}
 
-	if nv := req.NearVector; nv != nil {
-		out.NearVector = &searchparams.NearVector{
-			Vector: nv.Vector,
-		}
-
-		// The following business logic should not sit in the API. However, it is
-		// also part of the GraphQL API, so we need to duplicate it in order to get
-		// the same behavior
-		if nv.Distance != nil && nv.Certainty != nil {
-			return out, fmt.Errorf("near_vector: cannot provide distance and certainty")
-		}
-
-		if nv.Certainty != nil {
-			out.NearVector.Certainty = *nv.Certainty
+	if req.Filters != nil {
+		clause, err := extractFilters(req.Filters, out.ClassName)
+		if err != nil {
+			return out, fmt.Errorf("filters: %w", err)
 		}
+		out.Filters = clause
+	}
 
-		if nv.Distance != nil {
-			out.NearVector.Distance = *nv.Distance
-			out.NearVector.WithDistance = true
+	if nv := req.NearVector; nv != nil {
+		nearVector, err := nearVectorParamsFromProto(nv)
+		if err != nil {
+			return out, err
 		}
+		out.NearVector = nearVector
 	}
 
 	if no := req.NearObject; no != nil {
-		out.NearObject = &searchparams.NearObject{
-			ID: req.NearObject.Id,
-		}
-
-		// The following business logic should not sit in the API. However, it is
-		// also part of the GraphQL API, so we need to duplicate it in order to get
-		// the same behavior
-		if no.Distance != nil && no.Certainty != nil {
-			return out, fmt.Errorf("near_object: cannot provide distance and certainty")
+		nearObject, err := nearObjectParamsFromProto(no)
+		if err != nil {
+			return out, err
 		}
+		out.NearObject = nearObject
+	}
 
-		if no.Certainty != nil {
-			out.NearObject.Certainty = *no.Certainty
-		}
+	out.Tenant = req.Tenant
 
-		if no.Distance != nil {
-			out.NearObject.Distance = *no.Distance
-			out.NearObject.WithDistance = true
-		}
+	repl, err := replicationPropertiesFromProto(req.ConsistencyLevel)
+	if err != nil {
+		return out, err
 	}
+	out.ReplicationProperties = repl
 
-	out.Pagination = &filters.Pagination{}
+	out.Pagination = &filters.Pagination{Offset: int(req.Offset), Autocut: int(req.Autocut)}
 	if req.Limit > 0 {
 		out.Pagination.Limit = int(req.Limit)
 	} else {
@@ -426,5 +470,87 @@ func searchParamsFromProto(req *pb.SearchRequest) (dto.GetParams, error) {
 		out.Pagination.Limit = 10
 	}
 
+	if req.After != "" {
+		out.Cursor = &filters.Cursor{After: req.After, Limit: out.Pagination.Limit}
+	}
+
+	if len(req.SortBy) > 0 {
+		if out.KeywordRanking != nil {
+			return out, fmt.Errorf("bm25 search is not compatible with sort")
+		}
+		if out.HybridSearch != nil {
+			return out, fmt.Errorf("hybrid search is not compatible with sort")
+		}
+		out.Sort = extractSorting(req.SortBy)
+	}
+
+	if req.GroupBy != nil {
+		groupBy, err := extractGroupBy(req.GroupBy)
+		if err != nil {
+			return out, err
+		}
+		out.GroupBy = groupBy
+		// the group is only returned as additional property if requested, the
+		// same as in the GraphQL API
+		out.AdditionalProperties.Group = true
+	}
+
+	return out, nil
+}
+
+func hybridParamsFromProto(hs *pb.HybridSearchParams) *searchparams.HybridSearch {
+	return &searchparams.HybridSearch{
+		Query: hs.Query, Properties: hs.Properties, Vector: hs.Vector, Alpha: float64(hs.Alpha),
+		TargetVectors: hs.TargetVectors,
+	}
+}
+
+func nearVectorParamsFromProto(nv *pb.NearVectorParams) (*searchparams.NearVector, error) {
+	out := &searchparams.NearVector{
+		Vector:        nv.Vector,
+		TargetVectors: nv.TargetVectors,
+	}
+
+	// The following business logic should not sit in the API. However, it is
+	// also part of the GraphQL API, so we need to duplicate it in order to get
+	// the same behavior
+	if nv.Distance != nil && nv.Certainty != nil {
+		return nil, fmt.Errorf("near_vector: cannot provide distance and certainty")
+	}
+
+	if nv.Certainty != nil {
+		out.Certainty = *nv.Certainty
+	}
+
+	if nv.Distance != nil {
+		out.Distance = *nv.Distance
+		out.WithDistance = true
+	}
+
+	return out, nil
+}
+
+func nearObjectParamsFromProto(no *pb.NearObjectParams) (*searchparams.NearObject, error) {
+	out := &searchparams.NearObject{
+		ID:            no.Id,
+		TargetVectors: no.TargetVectors,
+	}
+
+	// The following business logic should not sit in the API. However, it is
+	// also part of the GraphQL API, so we need to duplicate it in order to get
+	// the same behavior
+	if no.Distance != nil && no.Certainty != nil {
+		return nil, fmt.Errorf("near_object: cannot provide distance and certainty")
+	}
+
+	if no.Certainty != nil {
+		out.Certainty = *no.Certainty
+	}
+
+	if no.Distance != nil {
+		out.Distance = *no.Distance
+		out.WithDistance = true
+	}
+
 	return out, nil
 }
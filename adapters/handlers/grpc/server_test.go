@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	pb "github.com/weaviate/weaviate/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestSearchParamsFromProto(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *pb.SearchRequest
+		check       func(t *testing.T, pagination *filters.Pagination, cursor *filters.Cursor, sort []filters.Sort, groupBy *searchparams.GroupBy)
+		expectedErr string
+	}{
+		{
+			name: "offset and autocut",
+			req:  &pb.SearchRequest{ClassName: "Foo", Limit: 20, Offset: 5, Autocut: 2},
+			check: func(t *testing.T, pagination *filters.Pagination, cursor *filters.Cursor, sort []filters.Sort, groupBy *searchparams.GroupBy) {
+				assert.Equal(t, &filters.Pagination{Limit: 20, Offset: 5, Autocut: 2}, pagination)
+				assert.Nil(t, cursor)
+			},
+		},
+		{
+			name: "cursor",
+			req:  &pb.SearchRequest{ClassName: "Foo", Limit: 20, After: "6e3c1c9b-8d6b-4c1f-9e3a-6c9d3c1e7a01"},
+			check: func(t *testing.T, pagination *filters.Pagination, cursor *filters.Cursor, sort []filters.Sort, groupBy *searchparams.GroupBy) {
+				assert.Equal(t, &filters.Cursor{After: "6e3c1c9b-8d6b-4c1f-9e3a-6c9d3c1e7a01", Limit: 20}, cursor)
+			},
+		},
+		{
+			name: "sort",
+			req: &pb.SearchRequest{ClassName: "Foo", SortBy: []*pb.SortBy{
+				{Path: []string{"name"}, Ascending: true},
+				{Path: []string{"age"}},
+			}},
+			check: func(t *testing.T, pagination *filters.Pagination, cursor *filters.Cursor, sort []filters.Sort, groupBy *searchparams.GroupBy) {
+				assert.Equal(t, []filters.Sort{
+					{Path: []string{"name"}, Order: "asc"},
+					{Path: []string{"age"}, Order: "desc"},
+				}, sort)
+			},
+		},
+		{
+			name: "sort with bm25",
+			req: &pb.SearchRequest{
+				ClassName:  "Foo",
+				Bm25Search: &pb.BM25SearchParams{Query: "foo"},
+				SortBy:     []*pb.SortBy{{Path: []string{"name"}}},
+			},
+			expectedErr: "bm25 search is not compatible with sort",
+		},
+		{
+			name: "sort with hybrid",
+			req: &pb.SearchRequest{
+				ClassName:    "Foo",
+				HybridSearch: &pb.HybridSearchParams{Query: "foo"},
+				SortBy:       []*pb.SortBy{{Path: []string{"name"}}},
+			},
+			expectedErr: "hybrid search is not compatible with sort",
+		},
+		{
+			name: "group by",
+			req: &pb.SearchRequest{
+				ClassName:  "Foo",
+				NearVector: &pb.NearVectorParams{Vector: []float32{1, 2}},
+				GroupBy:    &pb.GroupBy{Path: []string{"category"}, NumberOfGroups: 3, ObjectsPerGroup: 4},
+			},
+			check: func(t *testing.T, pagination *filters.Pagination, cursor *filters.Cursor, sort []filters.Sort, groupBy *searchparams.GroupBy) {
+				assert.Equal(t, &searchparams.GroupBy{Property: "category", Groups: 3, ObjectsPerGroup: 4}, groupBy)
+			},
+		},
+		{
+			name: "group by with nested path",
+			req: &pb.SearchRequest{
+				ClassName: "Foo",
+				GroupBy:   &pb.GroupBy{Path: []string{"ref", "Bar", "name"}},
+			},
+			expectedErr: "groupBy path can only have one entry, received [ref Bar name]",
+		},
+	}
+
+	t.Run("tenant and consistency level", func(t *testing.T) {
+		lvl := pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM
+		out, err := searchParamsFromProto(&pb.SearchRequest{
+			ClassName:        "Foo",
+			Tenant:           "tenant1",
+			ConsistencyLevel: &lvl,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "tenant1", out.Tenant)
+		assert.Equal(t, &additional.ReplicationProperties{ConsistencyLevel: "QUORUM"}, out.ReplicationProperties)
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := searchParamsFromProto(tt.req)
+			if tt.expectedErr != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.expectedErr, err.Error())
+				return
+			}
+			require.Nil(t, err)
+			tt.check(t, out.Pagination, out.Cursor, out.Sort, out.GroupBy)
+		})
+	}
+}
+
+type fakeAliasTraverser struct {
+	getParams       dto.GetParams
+	aggregateParams *aggregation.Params
+}
+
+func (f *fakeAliasTraverser) GetClass(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) ([]interface{}, error) {
+	f.getParams = params
+	return nil, nil
+}
+
+func (f *fakeAliasTraverser) Aggregate(ctx context.Context, principal *models.Principal,
+	params *aggregation.Params,
+) (interface{}, error) {
+	f.aggregateParams = params
+	return &aggregation.Result{}, nil
+}
+
+type fakeAliasModuleProvider struct {
+	fakeModuleArgumentsProvider
+	classNames []string
+}
+
+func (f *fakeAliasModuleProvider) ExtractSearchParamsFromValues(arguments map[string]interface{},
+	className string,
+) (map[string]interface{}, error) {
+	f.classNames = append(f.classNames, className)
+	return f.fakeModuleArgumentsProvider.ExtractSearchParamsFromValues(arguments, className)
+}
+
+func TestSearchResolvesAlias(t *testing.T) {
+	nearText, err := structpb.NewStruct(map[string]interface{}{
+		"concepts": []interface{}{"foo"},
+	})
+	require.Nil(t, err)
+
+	newServer := func() (*Server, *fakeAliasTraverser, *fakeAliasModuleProvider) {
+		traverser := &fakeAliasTraverser{}
+		modules := &fakeAliasModuleProvider{}
+		return &Server{
+			traverser: traverser,
+			schemaManager: &fakeGroupBySchemaGetter{
+				schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{{
+					Class: "Foo",
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+					},
+				}}}},
+				aliases: map[string]string{"Bar": "Foo"},
+			},
+			modulesProvider:      modules,
+			allowAnonymousAccess: true,
+		}, traverser, modules
+	}
+
+	t.Run("search", func(t *testing.T) {
+		s, traverser, modules := newServer()
+		_, err := s.Search(context.Background(), &pb.SearchRequest{
+			ClassName:    "Bar",
+			Properties:   &pb.Properties{NonRefProperties: []string{"name"}},
+			ModuleSearch: []*pb.ModuleArgument{{Name: "nearText", Arguments: nearText}},
+			Filters: &pb.Filters{
+				Operator:  pb.Filters_OPERATOR_EQUAL,
+				On:        []string{"name"},
+				TestValue: &pb.Filters_ValueText{ValueText: "foo"},
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "Foo", traverser.getParams.ClassName)
+		assert.Equal(t, []string{"Foo"}, modules.classNames)
+		assert.Equal(t, schema.ClassName("Foo"), traverser.getParams.Filters.Root.On.Class)
+	})
+
+	t.Run("aggregate", func(t *testing.T) {
+		s, traverser, modules := newServer()
+		_, err := s.Aggregate(context.Background(), &pb.AggregateRequest{
+			ClassName:    "Bar",
+			MetaCount:    true,
+			ModuleSearch: []*pb.ModuleArgument{{Name: "nearText", Arguments: nearText}},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, schema.ClassName("Foo"), traverser.aggregateParams.ClassName)
+		assert.Equal(t, []string{"Foo"}, modules.classNames)
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	schemaent "github.com/weaviate/weaviate/entities/schema"
 	ucs "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
@@ -56,10 +57,18 @@ func (f *fakeRepo) NewShards(ctx context.Context, class string, shards []ucs.Key
 	return nil
 }
 
+func (f *fakeRepo) UpdateShards(ctx context.Context, class string, shards []ucs.KeyValuePair) error {
+	return nil
+}
+
 func (f *fakeRepo) DeleteShards(ctx context.Context, class string, shards []string) error {
 	return nil
 }
 
+func (f *fakeRepo) SaveAliases(ctx context.Context, aliases map[string]string) error {
+	return nil
+}
+
 type fakeAuthorizer struct{}
 
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
@@ -72,7 +81,11 @@ func (f fakeVectorConfig) IndexType() string {
 	return "fake"
 }
 
-func dummyParseVectorConfig(in interface{}) (schemaent.VectorIndexConfig, error) {
+func (f fakeVectorConfig) DistanceName() string {
+	return "fake"
+}
+
+func dummyParseVectorConfig(in interface{}, vectorIndexType string) (schemaent.VectorIndexConfig, error) {
 	return fakeVectorConfig(in.(map[string]interface{})), nil
 }
 
@@ -209,10 +222,22 @@ func (n *NilMigrator) NewTenants(ctx context.Context, class *models.Class, parti
 	return nil, nil
 }
 
+func (n *NilMigrator) UpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) (commit func(success bool), err error) {
+	return nil, nil
+}
+
 func (n *NilMigrator) DeleteTenants(ctx context.Context, class *models.Class, partitions []string) (commit func(success bool), err error) {
 	return nil, nil
 }
 
+func (n *NilMigrator) OffloadTenant(ctx context.Context, class *models.Class, tenant string) error {
+	return nil
+}
+
+func (n *NilMigrator) OnloadTenant(ctx context.Context, class *models.Class, tenant string) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
@@ -221,7 +246,11 @@ func (n *NilMigrator) UpdatePropertyAddDataType(ctx context.Context, className s
 	return nil
 }
 
-func (n *NilMigrator) DropProperty(ctx context.Context, className string, propName string) error {
+func (n *NilMigrator) DropProperty(ctx context.Context, className string, propName string, purge bool) error {
+	return nil
+}
+
+func (n *NilMigrator) UpdatePropertyIndexes(ctx context.Context, className string, old, updated *models.Property) error {
 	return nil
 }
 
@@ -233,6 +262,10 @@ func (n *NilMigrator) UpdateVectorIndexConfig(ctx context.Context, className str
 	return nil
 }
 
+func (n *NilMigrator) UpdateVectorIndexConfigs(ctx context.Context, className string, updated map[string]schemaent.VectorIndexConfig) error {
+	return nil
+}
+
 func (n *NilMigrator) ValidateInvertedIndexConfigUpdate(ctx context.Context, old, updated *models.InvertedIndexConfig) error {
 	return nil
 }
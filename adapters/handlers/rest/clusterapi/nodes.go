@@ -24,6 +24,7 @@ import (
 
 type nodesManager interface {
 	GetNodeStatus(ctx context.Context, className string) (*models.NodeStatus, error)
+	GetShardsStatus(ctx context.Context, className string, shards []string) ([]*models.NodeShardStatus, error)
 }
 
 type nodes struct {
@@ -37,12 +38,22 @@ func NewNodes(manager nodesManager) *nodes {
 var (
 	regxNodes      = regexp.MustCompile(`/status`)
 	regxNodesClass = regexp.MustCompile(`/status/(` + entschema.ClassNameRegexCore + `)`)
+	regxShards     = regexp.MustCompile(`/shards/(` + entschema.ClassNameRegexCore + `)`)
 )
 
 func (s *nodes) Nodes() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		path := r.URL.Path
 		switch {
+		case regxShards.MatchString(path):
+			if r.Method != http.MethodPost {
+				msg := fmt.Sprintf("/nodes api path %q not found", path)
+				http.Error(w, msg, http.StatusMethodNotAllowed)
+				return
+			}
+
+			s.incomingShardsStatus().ServeHTTP(w, r)
+			return
 		case regxNodes.MatchString(path) || regxNodesClass.MatchString(path):
 			if r.Method != http.MethodGet {
 				msg := fmt.Sprintf("/nodes api path %q not found", path)
@@ -91,3 +102,39 @@ func (s *nodes) incomingNodeStatus() http.Handler {
 		w.Write(nodeStatusBytes)
 	})
 }
+
+func (s *nodes) incomingShardsStatus() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		args := regxShards.FindStringSubmatch(r.URL.Path)
+		if len(args) < 2 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+		className := args[1]
+
+		var shards []string
+		if err := json.NewDecoder(r.Body).Decode(&shards); err != nil {
+			http.Error(w, "/nodes unmarshal shard names: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		statuses, err := s.nodesManager.GetShardsStatus(r.Context(), className, shards)
+		if err != nil {
+			http.Error(w, "/nodes fulfill request: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		statusesBytes, err := json.Marshal(statuses)
+		if err != nil {
+			http.Error(w, "/nodes marshal response: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(statusesBytes)
+	})
+}
@@ -36,10 +36,12 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
-	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	modstgazure "github.com/weaviate/weaviate/modules/backup-azure"
 	modstgfs "github.com/weaviate/weaviate/modules/backup-filesystem"
 	modstggcs "github.com/weaviate/weaviate/modules/backup-gcs"
@@ -133,6 +135,13 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			Fatal("invalid config")
 	}
 
+	// custom stopword presets need to be known before the indexes are loaded
+	if err := stopwords.RegisterPresets(appState.ServerConfig.Config.StopwordPresets); err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("invalid stopword presets")
+	}
+
 	api.ServeError = openapierrors.ServeError
 
 	api.JSONConsumer = runtime.JSONConsumer()
@@ -217,7 +226,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	schemaTxClient := clients.NewClusterSchema(clusterHttpClient)
 	schemaManager, err := schemaUC.NewManager(migrator, schemaRepo,
 		appState.Logger, appState.Authorizer, appState.ServerConfig.Config,
-		enthnsw.ParseAndValidateConfig, appState.Modules, inverted.ValidateConfig,
+		vectorindex.ParseAndValidateConfig, appState.Modules, inverted.ValidateConfig,
 		appState.Modules, appState.Cluster, schemaTxClient, scaler,
 	)
 	if err != nil {
@@ -266,6 +275,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	batchObjectsManager := objects.NewBatchManager(vectorRepo, appState.Modules,
 		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.Metrics)
+	appState.BatchManager = batchObjectsManager
 
 	objectsTraverser := traverser.NewTraverser(appState.ServerConfig, appState.Locks,
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
@@ -355,6 +365,22 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 			Fatal("modules didn't initialize")
 	}
 
+	if name := appState.ServerConfig.Config.TenantOffloadBackend; name != "" {
+		backend, err := appState.Modules.BackupBackend(name)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("invalid tenant offload backend")
+		}
+		offloadBackend, ok := backend.(modulecapabilities.OffloadBackend)
+		if !ok {
+			appState.Logger.
+				WithField("action", "startup").
+				Fatalf("backend %q cannot be used to offload tenants", name)
+		}
+		repo.SetOffloadBackend(offloadBackend)
+	}
+
 	// manually update schema once
 	schema := schemaManager.GetSchemaSkipAuth()
 	updateSchemaCallback(schema)
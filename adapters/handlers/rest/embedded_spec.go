@@ -977,6 +977,73 @@ func init() {
         ]
       }
     },
+    "/nodes/{className}/tenants": {
+      "get": {
+        "description": "Returns the status, node placement and statistics of the tenants of a multi-tenant class. The tenants are sorted by name and paginated.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "nodes.get.class.tenants",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Only return the status of the given tenant.",
+            "name": "tenant",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/CommonOffsetParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonLimitParameterQuery"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Tenants status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/NodesTenantsStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or tenant not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid query, e.g. the class is not multi-tenant or the maximum number of results is exceeded",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.nodes.status.get.class.tenants"
+        ]
+      }
+    },
     "/objects": {
       "get": {
         "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
@@ -2380,6 +2447,145 @@ func init() {
         ]
       }
     },
+    "/schema/aliases": {
+      "get": {
+        "description": "Returns all aliases and the classes they point to.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "List the aliases of classes.",
+        "operationId": "schema.aliases.get",
+        "responses": {
+          "200": {
+            "description": "The aliases sorted by name.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Alias"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/aliases/{aliasName}": {
+      "put": {
+        "description": "Objects and queries which use the alias as class name are served by the class it points to. Repointing an existing alias takes effect atomically, so that a class can be rebuilt under a new name and swapped in without downtime.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create an alias or point it to another class.",
+        "operationId": "schema.aliases.put",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The alias points to the class.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias, e.g. the class does not exist or the alias conflicts with a class name.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "description": "Removes the alias, the class it pointed to is not affected.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Delete an alias.",
+        "operationId": "schema.aliases.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the alias."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The alias does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/schema/cluster-status": {
       "get": {
         "tags": [
@@ -2611,26 +2817,41 @@ func init() {
         ]
       }
     },
-    "/schema/{className}/shards": {
-      "get": {
+    "/schema/{className}/properties/{propertyName}": {
+      "put": {
+        "description": "Changes indexFilterable, indexSearchable and tokenization of an existing property. The affected inverted indexes are rebuilt in the background on every shard, the progress is visible through the nodes API.",
         "tags": [
           "schema"
         ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
+        "summary": "Update the index settings of a property.",
+        "operationId": "schema.objects.properties.update",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Found the status of the shards, returned as body",
+            "description": "Updated the property, reindexing was started.",
             "schema": {
-              "$ref": "#/definitions/ShardStatusList"
+              "$ref": "#/definitions/Property"
             }
           },
           "401": {
@@ -2642,8 +2863,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "This class does not exist",
+          "422": {
+            "description": "Invalid property, e.g. the property does not exist or the settings are not valid for its data type.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2656,17 +2877,16 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.manipulate.meta"
         ]
-      }
-    },
-    "/schema/{className}/shards/{shardName}": {
-      "put": {
-        "description": "Update shard status of an Object Class",
+      },
+      "delete": {
+        "description": "Removes the property from the schema and drops its indexes. The stored values are no longer returned, but remain on disk unless they are purged.",
         "tags": [
           "schema"
         ],
-        "operationId": "schema.objects.shards.update",
+        "summary": "Delete a property from an Object class.",
+        "operationId": "schema.objects.properties.delete",
         "parameters": [
           {
             "type": "string",
@@ -2676,25 +2896,21 @@ func init() {
           },
           {
             "type": "string",
-            "name": "shardName",
+            "name": "propertyName",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ShardStatus"
-            }
+            "type": "boolean",
+            "default": false,
+            "description": "Remove the stored values of the property from all objects before responding.",
+            "name": "purge",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/ShardStatus"
-            }
+            "description": "Removed the property."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2705,14 +2921,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Shard to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Invalid property, e.g. the property does not exist.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2729,13 +2939,13 @@ func init() {
         ]
       }
     },
-    "/schema/{className}/tenants": {
+    "/schema/{className}/shards": {
       "get": {
-        "description": "get all tenants from a specific class",
         "tags": [
           "schema"
         ],
-        "operationId": "tenants.get",
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
         "parameters": [
           {
             "type": "string",
@@ -2746,12 +2956,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "tenants from specified class.",
+            "description": "Found the status of the shards, returned as body",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/ShardStatusList"
             }
           },
           "401": {
@@ -2763,8 +2970,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Tenant class",
+          "404": {
+            "description": "This class does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2775,14 +2982,193 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "post": {
-        "description": "Create a new tenant for a specific class",
-        "tags": [
-          "schema"
-        ],
-        "operationId": "tenants.create",
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update shard status of an Object Class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "schema.objects.shards.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/tenants": {
+      "get": {
+        "description": "get all tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "tenants from specified class.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update tenant of a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated tenants of the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a new tenant for a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.create",
         "parameters": [
           {
             "type": "string",
@@ -2897,6 +3283,20 @@ func init() {
         "type": "object"
       }
     },
+    "Alias": {
+      "description": "An alternative name which resolves to a class.",
+      "type": "object",
+      "properties": {
+        "alias": {
+          "description": "The name of the alias. Optional when creating an alias, as it is part of the path.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The name of the class the alias points to.",
+          "type": "string"
+        }
+      }
+    },
     "BM25Config": {
       "description": "tuning parameters for the BM25 algorithm",
       "type": "object",
@@ -3449,6 +3849,13 @@ func init() {
           "description": "Manage how the index should be sharded and distributed in the cluster",
           "type": "object"
         },
+        "vectorConfig": {
+          "description": "Named vectors of this class. Each named vector has its own vectorizer and vector index and can be selected as the target vector of a search by its name.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
+        },
         "vectorIndexConfig": {
           "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
           "type": "object"
@@ -3829,6 +4236,10 @@ func init() {
     "MultiTenancyConfig": {
       "description": "Configuration related to multi-tenancy within a class",
       "properties": {
+        "autoTenantCreation": {
+          "description": "Nonexistent tenants should (not) be created implicitly on batch import. Creating them requires the permission to update the tenants of the schema",
+          "type": "boolean"
+        },
         "enabled": {
           "description": "Whether or not multi-tenancy is enabled for this class",
           "type": "boolean",
@@ -3843,6 +4254,64 @@ func init() {
         "$ref": "#/definitions/SingleRef"
       }
     },
+    "NestedProperty": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "description": "Data type of the nested property. Can be any primitive data type except for geoCoordinates, phoneNumber and blob, or \"object\" and \"object[]\" for deeper nesting.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the nested property.",
+          "type": "string"
+        },
+        "indexFilterable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexPositions": {
+          "description": "Optional. Should the positions of the tokens be stored in the searchable index. Defaults to false. Applicable only to properties of data type text and text[] with indexSearchable enabled. Token positions are required to use quoted phrases (e.g. \"exact phrase\" or \"exact phrase\"~2 with slop) in bm25 or hybrid search",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the nested property.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to nested properties of data type object and object[].",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims), ` + "`" + `ascii_folding` + "`" + ` (like ` + "`" + `word` + "`" + `, additionally removes diacritics, e.g. ` + "`" + `café` + "`" + ` becomes ` + "`" + `cafe` + "`" + `), ` + "`" + `snowball_english` + "`" + `, ` + "`" + `snowball_german` + "`" + `, ` + "`" + `snowball_french` + "`" + ` (like ` + "`" + `ascii_folding` + "`" + `, additionally reduces words to their stem using the Snowball stemmer of the language), ` + "`" + `cjk_bigram` + "`" + ` (like ` + "`" + `word` + "`" + `, but splits Chinese, Japanese and Korean text into overlapping pairs of characters). Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "ascii_folding",
+            "snowball_english",
+            "snowball_german",
+            "snowball_french",
+            "cjk_bigram"
+          ]
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
@@ -3861,6 +4330,18 @@ func init() {
           "type": "number",
           "format": "int64",
           "x-omitempty": false
+        },
+        "reindexStatus": {
+          "description": "The status of the most recent reindexing of property settings on this shard.",
+          "$ref": "#/definitions/ShardReindexStatus"
+        },
+        "storageStatus": {
+          "description": "The storage status of the shard, READY or READONLY.",
+          "type": "string"
+        },
+        "vectorIndexingStatus": {
+          "description": "The status of the shard's vector indexing, READY, INDEXING while a vector index is built in the background, or READONLY.",
+          "type": "string"
         }
       }
     },
@@ -3920,17 +4401,85 @@ func init() {
         }
       }
     },
-    "NodesStatusResponse": {
-      "description": "The status of all of the Weaviate nodes",
-      "type": "object",
+    "NodeTenantReplicaStatus": {
+      "description": "The status of a single replica of a tenant",
       "properties": {
-        "nodes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NodeStatus"
-          }
-        }
-      }
+        "node": {
+          "description": "The name of the node the replica is placed on.",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "The number of objects in the replica.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "storageStatus": {
+          "description": "The storage status of the replica, READY or READONLY. Empty if the replica is not loaded or its node is unavailable.",
+          "type": "string"
+        },
+        "vectorIndexingStatus": {
+          "description": "The status of the replica's vector indexing, READY, INDEXING or READONLY. Empty if the replica is not loaded or its node is unavailable.",
+          "type": "string"
+        }
+      }
+    },
+    "NodeTenantStatus": {
+      "description": "The status and placement of a tenant's shard in the cluster",
+      "properties": {
+        "activityStatus": {
+          "description": "The activity status of the tenant.",
+          "type": "string"
+        },
+        "belongsToNodes": {
+          "description": "The nodes holding a replica of the tenant.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "The name of the tenant.",
+          "type": "string"
+        },
+        "replicas": {
+          "description": "The statistics of each of the tenant's replicas.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeTenantReplicaStatus"
+          }
+        }
+      }
+    },
+    "NodesStatusResponse": {
+      "description": "The status of all of the Weaviate nodes",
+      "type": "object",
+      "properties": {
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeStatus"
+          }
+        }
+      }
+    },
+    "NodesTenantsStatusResponse": {
+      "description": "The status of a page of a class's tenants",
+      "type": "object",
+      "properties": {
+        "tenants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeTenantStatus"
+          }
+        },
+        "totalResults": {
+          "description": "The total number of tenants matching the query.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
     },
     "Object": {
       "type": "object",
@@ -3970,6 +4519,10 @@ func init() {
         },
         "vectorWeights": {
           "$ref": "#/definitions/VectorWeights"
+        },
+        "vectors": {
+          "description": "This object's named vectors, keyed by the names configured in the class' vectorConfig. Vectors of named vectors without a vectorizer must be imported explicitly.",
+          "$ref": "#/definitions/Vectors"
         }
       }
     },
@@ -4192,7 +4745,7 @@ func init() {
       "type": "object",
       "properties": {
         "dataType": {
-          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
+          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\". Properties of data type \"object\" or \"object[]\" are structured by their nested properties.",
           "type": "array",
           "items": {
             "type": "string"
@@ -4212,6 +4765,11 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "indexPositions": {
+          "description": "Optional. Should the positions of the tokens be stored in the searchable index. Defaults to false. Applicable only to properties of data type text and text[] with indexSearchable enabled. Token positions are required to use quoted phrases (e.g. \"exact phrase\" or \"exact phrase\"~2 with slop) in bm25 or hybrid search",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "indexSearchable": {
           "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
           "type": "boolean",
@@ -4225,14 +4783,27 @@ func init() {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
         },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to properties of data type object and object[].",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
         "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims), ` + "`" + `ascii_folding` + "`" + ` (like ` + "`" + `word` + "`" + `, additionally removes diacritics, e.g. ` + "`" + `café` + "`" + ` becomes ` + "`" + `cafe` + "`" + `), ` + "`" + `snowball_english` + "`" + `, ` + "`" + `snowball_german` + "`" + `, ` + "`" + `snowball_french` + "`" + ` (like ` + "`" + `ascii_folding` + "`" + `, additionally reduces words to their stem using the Snowball stemmer of the language), ` + "`" + `cjk_bigram` + "`" + ` (like ` + "`" + `word` + "`" + `, but splits Chinese, Japanese and Korean text into overlapping pairs of characters). Not supported for remaining data types",
           "type": "string",
           "enum": [
             "word",
             "lowercase",
             "whitespace",
-            "field"
+            "field",
+            "ascii_folding",
+            "snowball_english",
+            "snowball_german",
+            "snowball_french",
+            "cjk_bigram"
           ]
         }
       }
@@ -4365,6 +4936,41 @@ func init() {
       "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
       "type": "object"
     },
+    "ShardReindexStatus": {
+      "description": "The progress of rebuilding the inverted indexes of a shard after property index settings were changed.",
+      "properties": {
+        "error": {
+          "description": "The reason why the reindexing failed.",
+          "type": "string"
+        },
+        "objectsProcessed": {
+          "description": "The number of objects processed so far.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsTotal": {
+          "description": "The number of objects in the shard when the reindexing started.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "description": "The names of the properties being reindexed.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "description": "The state of the reindexing.",
+          "type": "string",
+          "enum": [
+            "INDEXING",
+            "FINISHED",
+            "FAILED"
+          ]
+        }
+      }
+    },
     "ShardStatus": {
       "description": "The status of a single shard",
       "properties": {
@@ -4434,7 +5040,7 @@ func init() {
           }
         },
         "preset": {
-          "description": "pre-existing list of common words by language",
+          "description": "pre-existing list of common words by language. Built-in presets are ` + "`" + `en` + "`" + ` (default), ` + "`" + `de` + "`" + `, ` + "`" + `fr` + "`" + `, ` + "`" + `es` + "`" + `, ` + "`" + `it` + "`" + `, ` + "`" + `nl` + "`" + `, ` + "`" + `pt` + "`" + ` and ` + "`" + `none` + "`" + `. Additional presets can be registered in the server config under ` + "`" + `stopword_presets` + "`" + `",
           "type": "string"
         },
         "removals": {
@@ -4450,16 +5056,51 @@ func init() {
       "description": "attributes representing a single tenant within weaviate",
       "type": "object",
       "properties": {
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `OFFLOADED` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are uploaded to the configured offload backend and removed from local disk. The transfer to and from the offload backend happens in the background, meanwhile the tenant is inactive and reported as ` + "`" + `OFFLOADING` + "`" + ` or ` + "`" + `ONLOADING` + "`" + `. These statuses cannot be requested",
+          "type": "string",
+          "enum": [
+            "HOT",
+            "COLD",
+            "OFFLOADED",
+            "OFFLOADING",
+            "ONLOADING"
+          ]
+        },
         "name": {
           "description": "name of the tenant",
           "type": "string"
         }
       }
     },
+    "VectorConfig": {
+      "type": "object",
+      "properties": {
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Configuration of the vectorizer of this named vector, keyed by the name of the module, e.g. {\"text2vec-contextionary\": {\"properties\": [\"title\"]}}. The optional 'properties' setting restricts vectorization to the listed source properties. Use {\"none\": {}} to import the vectors yourself.",
+          "type": "object"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
     },
+    "Vectors": {
+      "description": "A map of named vectors, keyed by the name of the vector as configured in the class' vectorConfig.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/C11yVector"
+      }
+    },
     "WhereFilter": {
       "description": "Filter search results using a where filter",
       "type": "object",
@@ -4486,7 +5127,9 @@ func init() {
             "LessThan",
             "LessThanEqual",
             "WithinGeoRange",
-            "IsNull"
+            "IsNull",
+            "ContainsAny",
+            "ContainsAll"
           ],
           "example": "GreaterThanEqual"
         },
@@ -4508,12 +5151,35 @@ func init() {
           "x-nullable": true,
           "example": false
         },
+        "valueBooleanArray": {
+          "description": "value as boolean array (used with ContainsAny/ContainsAll)",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-omitempty": true,
+          "example": [
+            true,
+            false
+          ]
+        },
         "valueDate": {
           "description": "value as date (as string)",
           "type": "string",
           "x-nullable": true,
           "example": "TODO"
         },
+        "valueDateArray": {
+          "description": "value as date array (as string, used with ContainsAny/ContainsAll)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true,
+          "example": [
+            "TODO"
+          ]
+        },
         "valueGeoRange": {
           "description": "value as geo coordinates and distance",
           "type": "object",
@@ -4527,6 +5193,19 @@ func init() {
           "x-nullable": true,
           "example": 2000
         },
+        "valueIntArray": {
+          "description": "value as integer array (used with ContainsAny/ContainsAll)",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-omitempty": true,
+          "example": [
+            100,
+            200
+          ]
+        },
         "valueNumber": {
           "description": "value as number/float",
           "type": "number",
@@ -4534,17 +5213,51 @@ func init() {
           "x-nullable": true,
           "example": 3.14
         },
+        "valueNumberArray": {
+          "description": "value as number/float array (used with ContainsAny/ContainsAll)",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-omitempty": true,
+          "example": [
+            3.14
+          ]
+        },
         "valueString": {
           "description": "value as text (deprecated as of v1.19; alias for valueText)",
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
         },
+        "valueStringArray": {
+          "description": "value as text array (deprecated as of v1.19; alias for valueTextArray)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        },
         "valueText": {
           "description": "value as text",
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
+        },
+        "valueTextArray": {
+          "description": "value as text array (used with ContainsAny/ContainsAll)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
         }
       }
     },
@@ -5638,6 +6351,82 @@ func init() {
         ]
       }
     },
+    "/nodes/{className}/tenants": {
+      "get": {
+        "description": "Returns the status, node placement and statistics of the tenants of a multi-tenant class. The tenants are sorted by name and paginated.",
+        "tags": [
+          "nodes"
+        ],
+        "operationId": "nodes.get.class.tenants",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Only return the status of the given tenant.",
+            "name": "tenant",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The starting index of the result window. Default value is 0.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of items to be returned per page. Default value is set in Weaviate config.",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Tenants status successfully returned",
+            "schema": {
+              "$ref": "#/definitions/NodesTenantsStatusResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Class or tenant not found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid query, e.g. the class is not multi-tenant or the maximum number of results is exceeded",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.nodes.status.get.class.tenants"
+        ]
+      }
+    },
     "/objects": {
       "get": {
         "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
@@ -7143,6 +7932,145 @@ func init() {
         ]
       }
     },
+    "/schema/aliases": {
+      "get": {
+        "description": "Returns all aliases and the classes they point to.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "List the aliases of classes.",
+        "operationId": "schema.aliases.get",
+        "responses": {
+          "200": {
+            "description": "The aliases sorted by name.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Alias"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/schema/aliases/{aliasName}": {
+      "put": {
+        "description": "Objects and queries which use the alias as class name are served by the class it points to. Repointing an existing alias takes effect atomically, so that a class can be rebuilt under a new name and swapped in without downtime.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create an alias or point it to another class.",
+        "operationId": "schema.aliases.put",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The alias points to the class.",
+            "schema": {
+              "$ref": "#/definitions/Alias"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid alias, e.g. the class does not exist or the alias conflicts with a class name.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "description": "Removes the alias, the class it pointed to is not affected.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Delete an alias.",
+        "operationId": "schema.aliases.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "aliasName",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the alias."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The alias does not exist."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/schema/cluster-status": {
       "get": {
         "tags": [
@@ -7374,6 +8302,128 @@ func init() {
         ]
       }
     },
+    "/schema/{className}/properties/{propertyName}": {
+      "put": {
+        "description": "Changes indexFilterable, indexSearchable and tokenization of an existing property. The affected inverted indexes are rebuilt in the background on every shard, the progress is visible through the nodes API.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update the index settings of a property.",
+        "operationId": "schema.objects.properties.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated the property, reindexing was started.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid property, e.g. the property does not exist or the settings are not valid for its data type.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      },
+      "delete": {
+        "description": "Removes the property from the schema and drops its indexes. The stored values are no longer returned, but remain on disk unless they are purged.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Delete a property from an Object class.",
+        "operationId": "schema.objects.properties.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Remove the stored values of the property from all objects before responding.",
+            "name": "purge",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Removed the property."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid property, e.g. the property does not exist.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/schema/{className}/shards": {
       "get": {
         "tags": [
@@ -7439,24 +8489,82 @@ func init() {
           },
           {
             "type": "string",
-            "name": "shardName",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid update attempt",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/tenants": {
+      "get": {
+        "description": "get all tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/ShardStatus"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status was updated successfully",
+            "description": "tenants from specified class.",
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
             }
           },
           "401": {
@@ -7468,14 +8576,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Shard to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Invalid Tenant class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7486,30 +8588,36 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
-        ]
-      }
-    },
-    "/schema/{className}/tenants": {
-      "get": {
-        "description": "get all tenants from a specific class",
+        }
+      },
+      "put": {
+        "description": "Update tenant of a specific class",
         "tags": [
           "schema"
         ],
-        "operationId": "tenants.get",
+        "operationId": "tenants.update",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "tenants from specified class.",
+            "description": "Updated tenants of the specified class",
             "schema": {
               "type": "array",
               "items": {
@@ -7660,6 +8768,20 @@ func init() {
         "type": "object"
       }
     },
+    "Alias": {
+      "description": "An alternative name which resolves to a class.",
+      "type": "object",
+      "properties": {
+        "alias": {
+          "description": "The name of the alias. Optional when creating an alias, as it is part of the path.",
+          "type": "string"
+        },
+        "class": {
+          "description": "The name of the class the alias points to.",
+          "type": "string"
+        }
+      }
+    },
     "BM25Config": {
       "description": "tuning parameters for the BM25 algorithm",
       "type": "object",
@@ -8345,6 +9467,13 @@ func init() {
           "description": "Manage how the index should be sharded and distributed in the cluster",
           "type": "object"
         },
+        "vectorConfig": {
+          "description": "Named vectors of this class. Each named vector has its own vectorizer and vector index and can be selected as the target vector of a search by its name.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
+          }
+        },
         "vectorIndexConfig": {
           "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
           "type": "object"
@@ -8751,6 +9880,10 @@ func init() {
     "MultiTenancyConfig": {
       "description": "Configuration related to multi-tenancy within a class",
       "properties": {
+        "autoTenantCreation": {
+          "description": "Nonexistent tenants should (not) be created implicitly on batch import. Creating them requires the permission to update the tenants of the schema",
+          "type": "boolean"
+        },
         "enabled": {
           "description": "Whether or not multi-tenancy is enabled for this class",
           "type": "boolean",
@@ -8765,6 +9898,64 @@ func init() {
         "$ref": "#/definitions/SingleRef"
       }
     },
+    "NestedProperty": {
+      "type": "object",
+      "properties": {
+        "dataType": {
+          "description": "Data type of the nested property. Can be any primitive data type except for geoCoordinates, phoneNumber and blob, or \"object\" and \"object[]\" for deeper nesting.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "description": "Description of the nested property.",
+          "type": "string"
+        },
+        "indexFilterable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexPositions": {
+          "description": "Optional. Should the positions of the tokens be stored in the searchable index. Defaults to false. Applicable only to properties of data type text and text[] with indexSearchable enabled. Token positions are required to use quoted phrases (e.g. \"exact phrase\" or \"exact phrase\"~2 with slop) in bm25 or hybrid search",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexSearchable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "name": {
+          "description": "Name of the nested property.",
+          "type": "string"
+        },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to nested properties of data type object and object[].",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
+        "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims), ` + "`" + `ascii_folding` + "`" + ` (like ` + "`" + `word` + "`" + `, additionally removes diacritics, e.g. ` + "`" + `café` + "`" + ` becomes ` + "`" + `cafe` + "`" + `), ` + "`" + `snowball_english` + "`" + `, ` + "`" + `snowball_german` + "`" + `, ` + "`" + `snowball_french` + "`" + ` (like ` + "`" + `ascii_folding` + "`" + `, additionally reduces words to their stem using the Snowball stemmer of the language), ` + "`" + `cjk_bigram` + "`" + ` (like ` + "`" + `word` + "`" + `, but splits Chinese, Japanese and Korean text into overlapping pairs of characters). Not supported for remaining data types",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "ascii_folding",
+            "snowball_english",
+            "snowball_german",
+            "snowball_french",
+            "cjk_bigram"
+          ]
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
@@ -8783,6 +9974,18 @@ func init() {
           "type": "number",
           "format": "int64",
           "x-omitempty": false
+        },
+        "reindexStatus": {
+          "description": "The status of the most recent reindexing of property settings on this shard.",
+          "$ref": "#/definitions/ShardReindexStatus"
+        },
+        "storageStatus": {
+          "description": "The storage status of the shard, READY or READONLY.",
+          "type": "string"
+        },
+        "vectorIndexingStatus": {
+          "description": "The status of the shard's vector indexing, READY, INDEXING while a vector index is built in the background, or READONLY.",
+          "type": "string"
         }
       }
     },
@@ -8842,6 +10045,56 @@ func init() {
         }
       }
     },
+    "NodeTenantReplicaStatus": {
+      "description": "The status of a single replica of a tenant",
+      "properties": {
+        "node": {
+          "description": "The name of the node the replica is placed on.",
+          "type": "string"
+        },
+        "objectCount": {
+          "description": "The number of objects in the replica.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "storageStatus": {
+          "description": "The storage status of the replica, READY or READONLY. Empty if the replica is not loaded or its node is unavailable.",
+          "type": "string"
+        },
+        "vectorIndexingStatus": {
+          "description": "The status of the replica's vector indexing, READY, INDEXING or READONLY. Empty if the replica is not loaded or its node is unavailable.",
+          "type": "string"
+        }
+      }
+    },
+    "NodeTenantStatus": {
+      "description": "The status and placement of a tenant's shard in the cluster",
+      "properties": {
+        "activityStatus": {
+          "description": "The activity status of the tenant.",
+          "type": "string"
+        },
+        "belongsToNodes": {
+          "description": "The nodes holding a replica of the tenant.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "The name of the tenant.",
+          "type": "string"
+        },
+        "replicas": {
+          "description": "The statistics of each of the tenant's replicas.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeTenantReplicaStatus"
+          }
+        }
+      }
+    },
     "NodesStatusResponse": {
       "description": "The status of all of the Weaviate nodes",
       "type": "object",
@@ -8854,6 +10107,24 @@ func init() {
         }
       }
     },
+    "NodesTenantsStatusResponse": {
+      "description": "The status of a page of a class's tenants",
+      "type": "object",
+      "properties": {
+        "tenants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeTenantStatus"
+          }
+        },
+        "totalResults": {
+          "description": "The total number of tenants matching the query.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "Object": {
       "type": "object",
       "properties": {
@@ -8892,6 +10163,10 @@ func init() {
         },
         "vectorWeights": {
           "$ref": "#/definitions/VectorWeights"
+        },
+        "vectors": {
+          "description": "This object's named vectors, keyed by the names configured in the class' vectorConfig. Vectors of named vectors without a vectorizer must be imported explicitly.",
+          "$ref": "#/definitions/Vectors"
         }
       }
     },
@@ -9132,7 +10407,7 @@ func init() {
       "type": "object",
       "properties": {
         "dataType": {
-          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\".",
+          "description": "Can be a reference to another type when it starts with a capital (for example Person), otherwise \"string\" or \"int\". Properties of data type \"object\" or \"object[]\" are structured by their nested properties.",
           "type": "array",
           "items": {
             "type": "string"
@@ -9152,6 +10427,11 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "indexPositions": {
+          "description": "Optional. Should the positions of the tokens be stored in the searchable index. Defaults to false. Applicable only to properties of data type text and text[] with indexSearchable enabled. Token positions are required to use quoted phrases (e.g. \"exact phrase\" or \"exact phrase\"~2 with slop) in bm25 or hybrid search",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "indexSearchable": {
           "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
           "type": "boolean",
@@ -9165,14 +10445,27 @@ func init() {
           "description": "Name of the property as URI relative to the schema URL.",
           "type": "string"
         },
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to properties of data type object and object[].",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
+        },
         "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims), ` + "`" + `ascii_folding` + "`" + ` (like ` + "`" + `word` + "`" + `, additionally removes diacritics, e.g. ` + "`" + `café` + "`" + ` becomes ` + "`" + `cafe` + "`" + `), ` + "`" + `snowball_english` + "`" + `, ` + "`" + `snowball_german` + "`" + `, ` + "`" + `snowball_french` + "`" + ` (like ` + "`" + `ascii_folding` + "`" + `, additionally reduces words to their stem using the Snowball stemmer of the language), ` + "`" + `cjk_bigram` + "`" + ` (like ` + "`" + `word` + "`" + `, but splits Chinese, Japanese and Korean text into overlapping pairs of characters). Not supported for remaining data types",
           "type": "string",
           "enum": [
             "word",
             "lowercase",
             "whitespace",
-            "field"
+            "field",
+            "ascii_folding",
+            "snowball_english",
+            "snowball_german",
+            "snowball_french",
+            "cjk_bigram"
           ]
         }
       }
@@ -9305,6 +10598,41 @@ func init() {
       "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
       "type": "object"
     },
+    "ShardReindexStatus": {
+      "description": "The progress of rebuilding the inverted indexes of a shard after property index settings were changed.",
+      "properties": {
+        "error": {
+          "description": "The reason why the reindexing failed.",
+          "type": "string"
+        },
+        "objectsProcessed": {
+          "description": "The number of objects processed so far.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsTotal": {
+          "description": "The number of objects in the shard when the reindexing started.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "description": "The names of the properties being reindexed.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "description": "The state of the reindexing.",
+          "type": "string",
+          "enum": [
+            "INDEXING",
+            "FINISHED",
+            "FAILED"
+          ]
+        }
+      }
+    },
     "ShardStatus": {
       "description": "The status of a single shard",
       "properties": {
@@ -9374,7 +10702,7 @@ func init() {
           }
         },
         "preset": {
-          "description": "pre-existing list of common words by language",
+          "description": "pre-existing list of common words by language. Built-in presets are ` + "`" + `en` + "`" + ` (default), ` + "`" + `de` + "`" + `, ` + "`" + `fr` + "`" + `, ` + "`" + `es` + "`" + `, ` + "`" + `it` + "`" + `, ` + "`" + `nl` + "`" + `, ` + "`" + `pt` + "`" + ` and ` + "`" + `none` + "`" + `. Additional presets can be registered in the server config under ` + "`" + `stopword_presets` + "`" + `",
           "type": "string"
         },
         "removals": {
@@ -9390,16 +10718,51 @@ func init() {
       "description": "attributes representing a single tenant within weaviate",
       "type": "object",
       "properties": {
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `HOT` + "`" + `) and required for updating tenant. Allowed values are ` + "`" + `HOT` + "`" + ` - tenant is fully active, ` + "`" + `COLD` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, ` + "`" + `OFFLOADED` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are uploaded to the configured offload backend and removed from local disk. The transfer to and from the offload backend happens in the background, meanwhile the tenant is inactive and reported as ` + "`" + `OFFLOADING` + "`" + ` or ` + "`" + `ONLOADING` + "`" + `. These statuses cannot be requested",
+          "type": "string",
+          "enum": [
+            "HOT",
+            "COLD",
+            "OFFLOADED",
+            "OFFLOADING",
+            "ONLOADING"
+          ]
+        },
         "name": {
           "description": "name of the tenant",
           "type": "string"
         }
       }
     },
+    "VectorConfig": {
+      "type": "object",
+      "properties": {
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Configuration of the vectorizer of this named vector, keyed by the name of the module, e.g. {\"text2vec-contextionary\": {\"properties\": [\"title\"]}}. The optional 'properties' setting restricts vectorization to the listed source properties. Use {\"none\": {}} to import the vectors yourself.",
+          "type": "object"
+        }
+      }
+    },
     "VectorWeights": {
       "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
       "type": "object"
     },
+    "Vectors": {
+      "description": "A map of named vectors, keyed by the name of the vector as configured in the class' vectorConfig.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/C11yVector"
+      }
+    },
     "WhereFilter": {
       "description": "Filter search results using a where filter",
       "type": "object",
@@ -9426,7 +10789,9 @@ func init() {
             "LessThan",
             "LessThanEqual",
             "WithinGeoRange",
-            "IsNull"
+            "IsNull",
+            "ContainsAny",
+            "ContainsAll"
           ],
           "example": "GreaterThanEqual"
         },
@@ -9448,12 +10813,35 @@ func init() {
           "x-nullable": true,
           "example": false
         },
+        "valueBooleanArray": {
+          "description": "value as boolean array (used with ContainsAny/ContainsAll)",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-omitempty": true,
+          "example": [
+            true,
+            false
+          ]
+        },
         "valueDate": {
           "description": "value as date (as string)",
           "type": "string",
           "x-nullable": true,
           "example": "TODO"
         },
+        "valueDateArray": {
+          "description": "value as date array (as string, used with ContainsAny/ContainsAll)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true,
+          "example": [
+            "TODO"
+          ]
+        },
         "valueGeoRange": {
           "description": "value as geo coordinates and distance",
           "type": "object",
@@ -9467,6 +10855,19 @@ func init() {
           "x-nullable": true,
           "example": 2000
         },
+        "valueIntArray": {
+          "description": "value as integer array (used with ContainsAny/ContainsAll)",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-omitempty": true,
+          "example": [
+            100,
+            200
+          ]
+        },
         "valueNumber": {
           "description": "value as number/float",
           "type": "number",
@@ -9474,17 +10875,51 @@ func init() {
           "x-nullable": true,
           "example": 3.14
         },
+        "valueNumberArray": {
+          "description": "value as number/float array (used with ContainsAny/ContainsAll)",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-omitempty": true,
+          "example": [
+            3.14
+          ]
+        },
         "valueString": {
           "description": "value as text (deprecated as of v1.19; alias for valueText)",
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
         },
+        "valueStringArray": {
+          "description": "value as text array (deprecated as of v1.19; alias for valueTextArray)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
+        },
         "valueText": {
           "description": "value as text",
           "type": "string",
           "x-nullable": true,
           "example": "my search term"
+        },
+        "valueTextArray": {
+          "description": "value as text array (used with ContainsAny/ContainsAll)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
         }
       }
     },
@@ -175,6 +175,44 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				}},
 			},
+			{
+				name: "valid text array filter",
+				input: &models.WhereFilter{
+					Operator:       "ContainsAny",
+					ValueTextArray: []string{"foo", "bar"},
+					Path:           []string{"textArrayField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAny,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("textArrayField"),
+					},
+					Value: &filters.Value{
+						Value: []string{"foo", "bar"},
+						Type:  schema.DataTypeTextArray,
+					},
+				}},
+			},
+			{
+				name: "valid int array filter",
+				input: &models.WhereFilter{
+					Operator:      "ContainsAll",
+					ValueIntArray: []int64{1, 2},
+					Path:          []string{"intArrayField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAll,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("intArrayField"),
+					},
+					Value: &filters.Value{
+						Value: []int{1, 2},
+						Type:  schema.DataTypeIntArray,
+					},
+				}},
+			},
 		}
 
 		for _, test := range tests {
@@ -330,6 +368,16 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				input:          inputIntFilterWithOp("LessThanEqual"),
 				expectedFilter: intFilterWithOp(filters.OperatorLessThanEqual),
 			},
+			{
+				name:           "contains any",
+				input:          inputIntFilterWithOp("ContainsAny"),
+				expectedFilter: intFilterWithOp(filters.OperatorContainsAny),
+			},
+			{
+				name:           "contains all",
+				input:          inputIntFilterWithOp("ContainsAll"),
+				expectedFilter: intFilterWithOp(filters.OperatorContainsAll),
+			},
 		}
 
 		for _, test := range tests {
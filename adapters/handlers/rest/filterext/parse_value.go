@@ -124,6 +124,58 @@ var valueExtractors = []valueExtractorFunc{
 
 		return valueFilter(*in.ValueString, schema.DataTypeString), nil
 	},
+	// int array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueIntArray == nil {
+			return nil, nil
+		}
+
+		asInts := make([]int, len(in.ValueIntArray))
+		for i := range in.ValueIntArray {
+			asInts[i] = int(in.ValueIntArray[i])
+		}
+		return valueFilter(asInts, schema.DataTypeIntArray), nil
+	},
+	// number array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueNumberArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueNumberArray, schema.DataTypeNumberArray), nil
+	},
+	// text array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueTextArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueTextArray, schema.DataTypeTextArray), nil
+	},
+	// date array (as strings)
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueDateArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueDateArray, schema.DataTypeDateArray), nil
+	},
+	// boolean array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueBooleanArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueBooleanArray, schema.DataTypeBooleanArray), nil
+	},
+	// deprecated string array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueStringArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(in.ValueStringArray, schema.DataTypeStringArray), nil
+	},
 }
 
 func valueFilter(value interface{}, dt schema.DataType) *filters.Value {
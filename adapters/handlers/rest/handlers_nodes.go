@@ -61,6 +61,38 @@ func (s *nodesHandlers) getNodesStatusByClass(params nodes.NodesGetClassParams,
 	return nodes.NewNodesGetOK().WithPayload(status)
 }
 
+func (s *nodesHandlers) getTenantsStatus(params nodes.NodesGetClassTenantsParams, principal *models.Principal) middleware.Responder {
+	var tenant string
+	if params.Tenant != nil {
+		tenant = *params.Tenant
+	}
+	tenants, total, err := s.manager.GetTenantsStatus(params.HTTPRequest.Context(), principal,
+		params.ClassName, tenant, params.Offset, params.Limit)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return nodes.NewNodesGetClassTenantsNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return nodes.NewNodesGetClassTenantsForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return nodes.NewNodesGetClassTenantsUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesGetClassTenantsInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return nodes.NewNodesGetClassTenantsOK().WithPayload(&models.NodesTenantsStatusResponse{
+		Tenants:      tenants,
+		TotalResults: total,
+	})
+}
+
 func (s *nodesHandlers) handleGetNodesError(err error) middleware.Responder {
 	s.metricRequestsTotal.logError("", err)
 	if errors.As(err, &enterrors.ErrNotFound{}) {
@@ -90,6 +122,8 @@ func setupNodesHandlers(api *operations.WeaviateAPI,
 		NodesGetHandlerFunc(h.getNodesStatus)
 	api.NodesNodesGetClassHandler = nodes.
 		NodesGetClassHandlerFunc(h.getNodesStatusByClass)
+	api.NodesNodesGetClassTenantsHandler = nodes.
+		NodesGetClassTenantsHandlerFunc(h.getTenantsStatus)
 }
 
 type nodesRequestsTotal struct {
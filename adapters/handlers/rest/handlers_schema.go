@@ -135,6 +135,49 @@ func (s *schemaHandlers) addClassProperty(params schema.SchemaObjectsPropertiesA
 	return schema.NewSchemaObjectsPropertiesAddOK().WithPayload(params.Body)
 }
 
+func (s *schemaHandlers) updateClassProperty(params schema.SchemaObjectsPropertiesUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	prop, err := s.manager.UpdateClassProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName, params.Body)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsPropertiesUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsPropertiesUpdateOK().WithPayload(prop)
+}
+
+func (s *schemaHandlers) deleteClassProperty(params schema.SchemaObjectsPropertiesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	purge := params.Purge != nil && *params.Purge
+	err := s.manager.DeleteClassProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName, purge)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsPropertiesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsPropertiesDeleteOK()
+}
+
 func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 	dbSchema, err := s.manager.GetSchema(principal)
 	if err != nil {
@@ -233,6 +276,29 @@ func (s *schemaHandlers) createTenants(params schema.TenantsCreateParams,
 	return schema.NewTenantsCreateOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) updateTenants(params schema.TenantsUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.UpdateTenants(
+		params.HTTPRequest.Context(), principal, params.ClassName, params.Body)
+	if err != nil {
+		s.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewTenantsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := params.Body
+
+	s.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewTenantsUpdateOK().WithPayload(payload)
+}
+
 func (s *schemaHandlers) deleteTenants(params schema.TenantsDeleteParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -274,6 +340,77 @@ func (s *schemaHandlers) getTenants(params schema.TenantsGetParams,
 	return schema.NewTenantsGetOK().WithPayload(tenants)
 }
 
+func (s *schemaHandlers) getAliases(params schema.SchemaAliasesGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	aliases, err := s.manager.GetClassAliases(principal)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaAliasesGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaAliasesGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaAliasesGetOK().WithPayload(aliases)
+}
+
+func (s *schemaHandlers) putAlias(params schema.SchemaAliasesPutParams,
+	principal *models.Principal,
+) middleware.Responder {
+	className := ""
+	if params.Body != nil {
+		className = params.Body.Class
+	}
+	err := s.manager.SetClassAlias(params.HTTPRequest.Context(), principal,
+		params.AliasName, className)
+	if err != nil {
+		s.metricRequestsTotal.logError(className, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaAliasesPutForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaAliasesPutUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(className)
+	return schema.NewSchemaAliasesPutOK().WithPayload(&models.Alias{
+		Alias: params.AliasName,
+		Class: className,
+	})
+}
+
+func (s *schemaHandlers) deleteAlias(params schema.SchemaAliasesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.DeleteClassAlias(params.HTTPRequest.Context(), principal, params.AliasName)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaAliasesDeleteNotFound()
+		}
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaAliasesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaAliasesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaAliasesDeleteOK()
+}
+
 func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) {
 	h := &schemaHandlers{manager, newSchemaRequestsTotal(metrics, logger)}
 
@@ -283,6 +420,10 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 		SchemaObjectsDeleteHandlerFunc(h.deleteClass)
 	api.SchemaSchemaObjectsPropertiesAddHandler = schema.
 		SchemaObjectsPropertiesAddHandlerFunc(h.addClassProperty)
+	api.SchemaSchemaObjectsPropertiesDeleteHandler = schema.
+		SchemaObjectsPropertiesDeleteHandlerFunc(h.deleteClassProperty)
+	api.SchemaSchemaObjectsPropertiesUpdateHandler = schema.
+		SchemaObjectsPropertiesUpdateHandlerFunc(h.updateClassProperty)
 
 	api.SchemaSchemaObjectsUpdateHandler = schema.
 		SchemaObjectsUpdateHandlerFunc(h.updateClass)
@@ -301,10 +442,19 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 
 	api.SchemaTenantsCreateHandler = schema.
 		TenantsCreateHandlerFunc(h.createTenants)
+	api.SchemaTenantsUpdateHandler = schema.
+		TenantsUpdateHandlerFunc(h.updateTenants)
 	api.SchemaTenantsDeleteHandler = schema.
 		TenantsDeleteHandlerFunc(h.deleteTenants)
 
 	api.SchemaTenantsGetHandler = schema.TenantsGetHandlerFunc(h.getTenants)
+
+	api.SchemaSchemaAliasesGetHandler = schema.
+		SchemaAliasesGetHandlerFunc(h.getAliases)
+	api.SchemaSchemaAliasesPutHandler = schema.
+		SchemaAliasesPutHandlerFunc(h.putAlias)
+	api.SchemaSchemaAliasesDeleteHandler = schema.
+		SchemaAliasesDeleteHandlerFunc(h.deleteAlias)
 }
 
 type schemaRequestsTotal struct {
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesGetClassTenantsHandlerFunc turns a function with the right signature into a nodes get class tenants handler
+type NodesGetClassTenantsHandlerFunc func(NodesGetClassTenantsParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn NodesGetClassTenantsHandlerFunc) Handle(params NodesGetClassTenantsParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// NodesGetClassTenantsHandler interface for that can handle valid nodes get class tenants params
+type NodesGetClassTenantsHandler interface {
+	Handle(NodesGetClassTenantsParams, *models.Principal) middleware.Responder
+}
+
+// NewNodesGetClassTenants creates a new http.Handler for the nodes get class tenants operation
+func NewNodesGetClassTenants(ctx *middleware.Context, handler NodesGetClassTenantsHandler) *NodesGetClassTenants {
+	return &NodesGetClassTenants{Context: ctx, Handler: handler}
+}
+
+/*
+	NodesGetClassTenants swagger:route GET /nodes/{className}/tenants nodes nodesGetClassTenants
+
+Returns the status, node placement and statistics of the tenants of a multi-tenant class. The tenants are sorted by name and paginated.
+*/
+type NodesGetClassTenants struct {
+	Context *middleware.Context
+	Handler NodesGetClassTenantsHandler
+}
+
+func (o *NodesGetClassTenants) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewNodesGetClassTenantsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
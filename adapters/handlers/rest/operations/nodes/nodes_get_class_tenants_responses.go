@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesGetClassTenantsOKCode is the HTTP code returned for type NodesGetClassTenantsOK
+const NodesGetClassTenantsOKCode int = 200
+
+/*
+NodesGetClassTenantsOK Tenants status successfully returned
+
+swagger:response nodesGetClassTenantsOK
+*/
+type NodesGetClassTenantsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NodesTenantsStatusResponse `json:"body,omitempty"`
+}
+
+// NewNodesGetClassTenantsOK creates NodesGetClassTenantsOK with default headers values
+func NewNodesGetClassTenantsOK() *NodesGetClassTenantsOK {
+
+	return &NodesGetClassTenantsOK{}
+}
+
+// WithPayload adds the payload to the nodes get class tenants o k response
+func (o *NodesGetClassTenantsOK) WithPayload(payload *models.NodesTenantsStatusResponse) *NodesGetClassTenantsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes get class tenants o k response
+func (o *NodesGetClassTenantsOK) SetPayload(payload *models.NodesTenantsStatusResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesGetClassTenantsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesGetClassTenantsUnauthorizedCode is the HTTP code returned for type NodesGetClassTenantsUnauthorized
+const NodesGetClassTenantsUnauthorizedCode int = 401
+
+/*
+NodesGetClassTenantsUnauthorized Unauthorized or invalid credentials.
+
+swagger:response nodesGetClassTenantsUnauthorized
+*/
+type NodesGetClassTenantsUnauthorized struct {
+}
+
+// NewNodesGetClassTenantsUnauthorized creates NodesGetClassTenantsUnauthorized with default headers values
+func NewNodesGetClassTenantsUnauthorized() *NodesGetClassTenantsUnauthorized {
+
+	return &NodesGetClassTenantsUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *NodesGetClassTenantsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// NodesGetClassTenantsForbiddenCode is the HTTP code returned for type NodesGetClassTenantsForbidden
+const NodesGetClassTenantsForbiddenCode int = 403
+
+/*
+NodesGetClassTenantsForbidden Forbidden
+
+swagger:response nodesGetClassTenantsForbidden
+*/
+type NodesGetClassTenantsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesGetClassTenantsForbidden creates NodesGetClassTenantsForbidden with default headers values
+func NewNodesGetClassTenantsForbidden() *NodesGetClassTenantsForbidden {
+
+	return &NodesGetClassTenantsForbidden{}
+}
+
+// WithPayload adds the payload to the nodes get class tenants forbidden response
+func (o *NodesGetClassTenantsForbidden) WithPayload(payload *models.ErrorResponse) *NodesGetClassTenantsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes get class tenants forbidden response
+func (o *NodesGetClassTenantsForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesGetClassTenantsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesGetClassTenantsNotFoundCode is the HTTP code returned for type NodesGetClassTenantsNotFound
+const NodesGetClassTenantsNotFoundCode int = 404
+
+/*
+NodesGetClassTenantsNotFound Class or tenant not found
+
+swagger:response nodesGetClassTenantsNotFound
+*/
+type NodesGetClassTenantsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesGetClassTenantsNotFound creates NodesGetClassTenantsNotFound with default headers values
+func NewNodesGetClassTenantsNotFound() *NodesGetClassTenantsNotFound {
+
+	return &NodesGetClassTenantsNotFound{}
+}
+
+// WithPayload adds the payload to the nodes get class tenants not found response
+func (o *NodesGetClassTenantsNotFound) WithPayload(payload *models.ErrorResponse) *NodesGetClassTenantsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes get class tenants not found response
+func (o *NodesGetClassTenantsNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesGetClassTenantsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesGetClassTenantsUnprocessableEntityCode is the HTTP code returned for type NodesGetClassTenantsUnprocessableEntity
+const NodesGetClassTenantsUnprocessableEntityCode int = 422
+
+/*
+NodesGetClassTenantsUnprocessableEntity Invalid query, e.g. the class is not multi-tenant or the maximum number of results is exceeded
+
+swagger:response nodesGetClassTenantsUnprocessableEntity
+*/
+type NodesGetClassTenantsUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesGetClassTenantsUnprocessableEntity creates NodesGetClassTenantsUnprocessableEntity with default headers values
+func NewNodesGetClassTenantsUnprocessableEntity() *NodesGetClassTenantsUnprocessableEntity {
+
+	return &NodesGetClassTenantsUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the nodes get class tenants unprocessable entity response
+func (o *NodesGetClassTenantsUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *NodesGetClassTenantsUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes get class tenants unprocessable entity response
+func (o *NodesGetClassTenantsUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesGetClassTenantsUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesGetClassTenantsInternalServerErrorCode is the HTTP code returned for type NodesGetClassTenantsInternalServerError
+const NodesGetClassTenantsInternalServerErrorCode int = 500
+
+/*
+NodesGetClassTenantsInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response nodesGetClassTenantsInternalServerError
+*/
+type NodesGetClassTenantsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesGetClassTenantsInternalServerError creates NodesGetClassTenantsInternalServerError with default headers values
+func NewNodesGetClassTenantsInternalServerError() *NodesGetClassTenantsInternalServerError {
+
+	return &NodesGetClassTenantsInternalServerError{}
+}
+
+// WithPayload adds the payload to the nodes get class tenants internal server error response
+func (o *NodesGetClassTenantsInternalServerError) WithPayload(payload *models.ErrorResponse) *NodesGetClassTenantsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes get class tenants internal server error response
+func (o *NodesGetClassTenantsInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesGetClassTenantsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
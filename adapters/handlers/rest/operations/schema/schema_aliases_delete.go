@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaAliasesDeleteHandlerFunc turns a function with the right signature into a schema aliases delete handler
+type SchemaAliasesDeleteHandlerFunc func(SchemaAliasesDeleteParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaAliasesDeleteHandlerFunc) Handle(params SchemaAliasesDeleteParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaAliasesDeleteHandler interface for that can handle valid schema aliases delete params
+type SchemaAliasesDeleteHandler interface {
+	Handle(SchemaAliasesDeleteParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaAliasesDelete creates a new http.Handler for the schema aliases delete operation
+func NewSchemaAliasesDelete(ctx *middleware.Context, handler SchemaAliasesDeleteHandler) *SchemaAliasesDelete {
+	return &SchemaAliasesDelete{Context: ctx, Handler: handler}
+}
+
+/*
+	SchemaAliasesDelete swagger:route DELETE /schema/aliases/{aliasName} schema schemaAliasesDelete
+
+Delete an alias.
+
+Removes the alias, the class it pointed to is not affected.
+*/
+type SchemaAliasesDelete struct {
+	Context *middleware.Context
+	Handler SchemaAliasesDeleteHandler
+}
+
+func (o *SchemaAliasesDelete) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSchemaAliasesDeleteParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaAliasesDeleteOKCode is the HTTP code returned for type SchemaAliasesDeleteOK
+const SchemaAliasesDeleteOKCode int = 200
+
+/*
+SchemaAliasesDeleteOK Removed the alias.
+
+swagger:response schemaAliasesDeleteOK
+*/
+type SchemaAliasesDeleteOK struct {
+}
+
+// NewSchemaAliasesDeleteOK creates SchemaAliasesDeleteOK with default headers values
+func NewSchemaAliasesDeleteOK() *SchemaAliasesDeleteOK {
+
+	return &SchemaAliasesDeleteOK{}
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesDeleteOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// SchemaAliasesDeleteUnauthorizedCode is the HTTP code returned for type SchemaAliasesDeleteUnauthorized
+const SchemaAliasesDeleteUnauthorizedCode int = 401
+
+/*
+SchemaAliasesDeleteUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaAliasesDeleteUnauthorized
+*/
+type SchemaAliasesDeleteUnauthorized struct {
+}
+
+// NewSchemaAliasesDeleteUnauthorized creates SchemaAliasesDeleteUnauthorized with default headers values
+func NewSchemaAliasesDeleteUnauthorized() *SchemaAliasesDeleteUnauthorized {
+
+	return &SchemaAliasesDeleteUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesDeleteUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaAliasesDeleteForbiddenCode is the HTTP code returned for type SchemaAliasesDeleteForbidden
+const SchemaAliasesDeleteForbiddenCode int = 403
+
+/*
+SchemaAliasesDeleteForbidden Forbidden
+
+swagger:response schemaAliasesDeleteForbidden
+*/
+type SchemaAliasesDeleteForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaAliasesDeleteForbidden creates SchemaAliasesDeleteForbidden with default headers values
+func NewSchemaAliasesDeleteForbidden() *SchemaAliasesDeleteForbidden {
+
+	return &SchemaAliasesDeleteForbidden{}
+}
+
+// WithPayload adds the payload to the schema aliases delete forbidden response
+func (o *SchemaAliasesDeleteForbidden) WithPayload(payload *models.ErrorResponse) *SchemaAliasesDeleteForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema aliases delete forbidden response
+func (o *SchemaAliasesDeleteForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesDeleteForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaAliasesDeleteNotFoundCode is the HTTP code returned for type SchemaAliasesDeleteNotFound
+const SchemaAliasesDeleteNotFoundCode int = 404
+
+/*
+SchemaAliasesDeleteNotFound The alias does not exist.
+
+swagger:response schemaAliasesDeleteNotFound
+*/
+type SchemaAliasesDeleteNotFound struct {
+}
+
+// NewSchemaAliasesDeleteNotFound creates SchemaAliasesDeleteNotFound with default headers values
+func NewSchemaAliasesDeleteNotFound() *SchemaAliasesDeleteNotFound {
+
+	return &SchemaAliasesDeleteNotFound{}
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesDeleteNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// SchemaAliasesDeleteInternalServerErrorCode is the HTTP code returned for type SchemaAliasesDeleteInternalServerError
+const SchemaAliasesDeleteInternalServerErrorCode int = 500
+
+/*
+SchemaAliasesDeleteInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaAliasesDeleteInternalServerError
+*/
+type SchemaAliasesDeleteInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaAliasesDeleteInternalServerError creates SchemaAliasesDeleteInternalServerError with default headers values
+func NewSchemaAliasesDeleteInternalServerError() *SchemaAliasesDeleteInternalServerError {
+
+	return &SchemaAliasesDeleteInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema aliases delete internal server error response
+func (o *SchemaAliasesDeleteInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaAliasesDeleteInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema aliases delete internal server error response
+func (o *SchemaAliasesDeleteInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesDeleteInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
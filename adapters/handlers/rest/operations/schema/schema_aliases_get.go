@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaAliasesGetHandlerFunc turns a function with the right signature into a schema aliases get handler
+type SchemaAliasesGetHandlerFunc func(SchemaAliasesGetParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaAliasesGetHandlerFunc) Handle(params SchemaAliasesGetParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaAliasesGetHandler interface for that can handle valid schema aliases get params
+type SchemaAliasesGetHandler interface {
+	Handle(SchemaAliasesGetParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaAliasesGet creates a new http.Handler for the schema aliases get operation
+func NewSchemaAliasesGet(ctx *middleware.Context, handler SchemaAliasesGetHandler) *SchemaAliasesGet {
+	return &SchemaAliasesGet{Context: ctx, Handler: handler}
+}
+
+/*
+	SchemaAliasesGet swagger:route GET /schema/aliases schema schemaAliasesGet
+
+List the aliases of classes.
+
+Returns all aliases and the classes they point to.
+*/
+type SchemaAliasesGet struct {
+	Context *middleware.Context
+	Handler SchemaAliasesGetHandler
+}
+
+func (o *SchemaAliasesGet) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSchemaAliasesGetParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaAliasesGetOKCode is the HTTP code returned for type SchemaAliasesGetOK
+const SchemaAliasesGetOKCode int = 200
+
+/*
+SchemaAliasesGetOK The aliases sorted by name.
+
+swagger:response schemaAliasesGetOK
+*/
+type SchemaAliasesGetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Alias `json:"body,omitempty"`
+}
+
+// NewSchemaAliasesGetOK creates SchemaAliasesGetOK with default headers values
+func NewSchemaAliasesGetOK() *SchemaAliasesGetOK {
+
+	return &SchemaAliasesGetOK{}
+}
+
+// WithPayload adds the payload to the schema aliases get o k response
+func (o *SchemaAliasesGetOK) WithPayload(payload []*models.Alias) *SchemaAliasesGetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema aliases get o k response
+func (o *SchemaAliasesGetOK) SetPayload(payload []*models.Alias) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesGetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.Alias, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// SchemaAliasesGetUnauthorizedCode is the HTTP code returned for type SchemaAliasesGetUnauthorized
+const SchemaAliasesGetUnauthorizedCode int = 401
+
+/*
+SchemaAliasesGetUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaAliasesGetUnauthorized
+*/
+type SchemaAliasesGetUnauthorized struct {
+}
+
+// NewSchemaAliasesGetUnauthorized creates SchemaAliasesGetUnauthorized with default headers values
+func NewSchemaAliasesGetUnauthorized() *SchemaAliasesGetUnauthorized {
+
+	return &SchemaAliasesGetUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesGetUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaAliasesGetForbiddenCode is the HTTP code returned for type SchemaAliasesGetForbidden
+const SchemaAliasesGetForbiddenCode int = 403
+
+/*
+SchemaAliasesGetForbidden Forbidden
+
+swagger:response schemaAliasesGetForbidden
+*/
+type SchemaAliasesGetForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaAliasesGetForbidden creates SchemaAliasesGetForbidden with default headers values
+func NewSchemaAliasesGetForbidden() *SchemaAliasesGetForbidden {
+
+	return &SchemaAliasesGetForbidden{}
+}
+
+// WithPayload adds the payload to the schema aliases get forbidden response
+func (o *SchemaAliasesGetForbidden) WithPayload(payload *models.ErrorResponse) *SchemaAliasesGetForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema aliases get forbidden response
+func (o *SchemaAliasesGetForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesGetForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaAliasesGetInternalServerErrorCode is the HTTP code returned for type SchemaAliasesGetInternalServerError
+const SchemaAliasesGetInternalServerErrorCode int = 500
+
+/*
+SchemaAliasesGetInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaAliasesGetInternalServerError
+*/
+type SchemaAliasesGetInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaAliasesGetInternalServerError creates SchemaAliasesGetInternalServerError with default headers values
+func NewSchemaAliasesGetInternalServerError() *SchemaAliasesGetInternalServerError {
+
+	return &SchemaAliasesGetInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema aliases get internal server error response
+func (o *SchemaAliasesGetInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaAliasesGetInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema aliases get internal server error response
+func (o *SchemaAliasesGetInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesGetInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaAliasesPutHandlerFunc turns a function with the right signature into a schema aliases put handler
+type SchemaAliasesPutHandlerFunc func(SchemaAliasesPutParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaAliasesPutHandlerFunc) Handle(params SchemaAliasesPutParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaAliasesPutHandler interface for that can handle valid schema aliases put params
+type SchemaAliasesPutHandler interface {
+	Handle(SchemaAliasesPutParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaAliasesPut creates a new http.Handler for the schema aliases put operation
+func NewSchemaAliasesPut(ctx *middleware.Context, handler SchemaAliasesPutHandler) *SchemaAliasesPut {
+	return &SchemaAliasesPut{Context: ctx, Handler: handler}
+}
+
+/*
+	SchemaAliasesPut swagger:route PUT /schema/aliases/{aliasName} schema schemaAliasesPut
+
+Create an alias or point it to another class.
+
+Objects and queries which use the alias as class name are served by the class it points to. Repointing an existing alias takes effect atomically, so that a class can be rebuilt under a new name and swapped in without downtime.
+*/
+type SchemaAliasesPut struct {
+	Context *middleware.Context
+	Handler SchemaAliasesPutHandler
+}
+
+func (o *SchemaAliasesPut) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSchemaAliasesPutParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaAliasesPutOKCode is the HTTP code returned for type SchemaAliasesPutOK
+const SchemaAliasesPutOKCode int = 200
+
+/*
+SchemaAliasesPutOK The alias points to the class.
+
+swagger:response schemaAliasesPutOK
+*/
+type SchemaAliasesPutOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Alias `json:"body,omitempty"`
+}
+
+// NewSchemaAliasesPutOK creates SchemaAliasesPutOK with default headers values
+func NewSchemaAliasesPutOK() *SchemaAliasesPutOK {
+
+	return &SchemaAliasesPutOK{}
+}
+
+// WithPayload adds the payload to the schema aliases put o k response
+func (o *SchemaAliasesPutOK) WithPayload(payload *models.Alias) *SchemaAliasesPutOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema aliases put o k response
+func (o *SchemaAliasesPutOK) SetPayload(payload *models.Alias) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesPutOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaAliasesPutUnauthorizedCode is the HTTP code returned for type SchemaAliasesPutUnauthorized
+const SchemaAliasesPutUnauthorizedCode int = 401
+
+/*
+SchemaAliasesPutUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaAliasesPutUnauthorized
+*/
+type SchemaAliasesPutUnauthorized struct {
+}
+
+// NewSchemaAliasesPutUnauthorized creates SchemaAliasesPutUnauthorized with default headers values
+func NewSchemaAliasesPutUnauthorized() *SchemaAliasesPutUnauthorized {
+
+	return &SchemaAliasesPutUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesPutUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaAliasesPutForbiddenCode is the HTTP code returned for type SchemaAliasesPutForbidden
+const SchemaAliasesPutForbiddenCode int = 403
+
+/*
+SchemaAliasesPutForbidden Forbidden
+
+swagger:response schemaAliasesPutForbidden
+*/
+type SchemaAliasesPutForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaAliasesPutForbidden creates SchemaAliasesPutForbidden with default headers values
+func NewSchemaAliasesPutForbidden() *SchemaAliasesPutForbidden {
+
+	return &SchemaAliasesPutForbidden{}
+}
+
+// WithPayload adds the payload to the schema aliases put forbidden response
+func (o *SchemaAliasesPutForbidden) WithPayload(payload *models.ErrorResponse) *SchemaAliasesPutForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema aliases put forbidden response
+func (o *SchemaAliasesPutForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesPutForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaAliasesPutUnprocessableEntityCode is the HTTP code returned for type SchemaAliasesPutUnprocessableEntity
+const SchemaAliasesPutUnprocessableEntityCode int = 422
+
+/*
+SchemaAliasesPutUnprocessableEntity Invalid alias, e.g. the class does not exist or the alias conflicts with a class name.
+
+swagger:response schemaAliasesPutUnprocessableEntity
+*/
+type SchemaAliasesPutUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaAliasesPutUnprocessableEntity creates SchemaAliasesPutUnprocessableEntity with default headers values
+func NewSchemaAliasesPutUnprocessableEntity() *SchemaAliasesPutUnprocessableEntity {
+
+	return &SchemaAliasesPutUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the schema aliases put unprocessable entity response
+func (o *SchemaAliasesPutUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *SchemaAliasesPutUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema aliases put unprocessable entity response
+func (o *SchemaAliasesPutUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesPutUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaAliasesPutInternalServerErrorCode is the HTTP code returned for type SchemaAliasesPutInternalServerError
+const SchemaAliasesPutInternalServerErrorCode int = 500
+
+/*
+SchemaAliasesPutInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaAliasesPutInternalServerError
+*/
+type SchemaAliasesPutInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaAliasesPutInternalServerError creates SchemaAliasesPutInternalServerError with default headers values
+func NewSchemaAliasesPutInternalServerError() *SchemaAliasesPutInternalServerError {
+
+	return &SchemaAliasesPutInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema aliases put internal server error response
+func (o *SchemaAliasesPutInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaAliasesPutInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema aliases put internal server error response
+func (o *SchemaAliasesPutInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaAliasesPutInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
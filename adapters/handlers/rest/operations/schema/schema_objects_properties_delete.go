@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsPropertiesDeleteHandlerFunc turns a function with the right signature into a schema objects properties delete handler
+type SchemaObjectsPropertiesDeleteHandlerFunc func(SchemaObjectsPropertiesDeleteParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaObjectsPropertiesDeleteHandlerFunc) Handle(params SchemaObjectsPropertiesDeleteParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaObjectsPropertiesDeleteHandler interface for that can handle valid schema objects properties delete params
+type SchemaObjectsPropertiesDeleteHandler interface {
+	Handle(SchemaObjectsPropertiesDeleteParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaObjectsPropertiesDelete creates a new http.Handler for the schema objects properties delete operation
+func NewSchemaObjectsPropertiesDelete(ctx *middleware.Context, handler SchemaObjectsPropertiesDeleteHandler) *SchemaObjectsPropertiesDelete {
+	return &SchemaObjectsPropertiesDelete{Context: ctx, Handler: handler}
+}
+
+/*
+	SchemaObjectsPropertiesDelete swagger:route DELETE /schema/{className}/properties/{propertyName} schema schemaObjectsPropertiesDelete
+
+Delete a property from an Object class.
+
+Removes the property from the schema and drops its indexes. The stored values are no longer returned, but remain on disk unless they are purged.
+*/
+type SchemaObjectsPropertiesDelete struct {
+	Context *middleware.Context
+	Handler SchemaObjectsPropertiesDeleteHandler
+}
+
+func (o *SchemaObjectsPropertiesDelete) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSchemaObjectsPropertiesDeleteParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
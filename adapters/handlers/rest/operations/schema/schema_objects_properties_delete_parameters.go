@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewSchemaObjectsPropertiesDeleteParams creates a new SchemaObjectsPropertiesDeleteParams object
+// with the default values initialized.
+func NewSchemaObjectsPropertiesDeleteParams() SchemaObjectsPropertiesDeleteParams {
+
+	var (
+		// initialize parameters with default values
+
+		purgeDefault = bool(false)
+	)
+
+	return SchemaObjectsPropertiesDeleteParams{
+		Purge: &purgeDefault,
+	}
+}
+
+// SchemaObjectsPropertiesDeleteParams contains all the bound params for the schema objects properties delete operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters schema.objects.properties.delete
+type SchemaObjectsPropertiesDeleteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	ClassName string
+	/*
+	  Required: true
+	  In: path
+	*/
+	PropertyName string
+	/*Remove the stored values of the property from all objects before responding.
+	  In: query
+	  Default: false
+	*/
+	Purge *bool
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewSchemaObjectsPropertiesDeleteParams() beforehand.
+func (o *SchemaObjectsPropertiesDeleteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	rClassName, rhkClassName, _ := route.Params.GetOK("className")
+	if err := o.bindClassName(rClassName, rhkClassName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rPropertyName, rhkPropertyName, _ := route.Params.GetOK("propertyName")
+	if err := o.bindPropertyName(rPropertyName, rhkPropertyName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPurge, qhkPurge, _ := qs.GetOK("purge")
+	if err := o.bindPurge(qPurge, qhkPurge, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindClassName binds and validates parameter ClassName from path.
+func (o *SchemaObjectsPropertiesDeleteParams) bindClassName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.ClassName = raw
+
+	return nil
+}
+
+// bindPropertyName binds and validates parameter PropertyName from path.
+func (o *SchemaObjectsPropertiesDeleteParams) bindPropertyName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.PropertyName = raw
+
+	return nil
+}
+
+// bindPurge binds and validates parameter Purge from query.
+func (o *SchemaObjectsPropertiesDeleteParams) bindPurge(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewSchemaObjectsPropertiesDeleteParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("purge", "query", "bool", raw)
+	}
+	o.Purge = &value
+
+	return nil
+}
@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsPropertiesDeleteOKCode is the HTTP code returned for type SchemaObjectsPropertiesDeleteOK
+const SchemaObjectsPropertiesDeleteOKCode int = 200
+
+/*
+SchemaObjectsPropertiesDeleteOK Removed the property.
+
+swagger:response schemaObjectsPropertiesDeleteOK
+*/
+type SchemaObjectsPropertiesDeleteOK struct {
+}
+
+// NewSchemaObjectsPropertiesDeleteOK creates SchemaObjectsPropertiesDeleteOK with default headers values
+func NewSchemaObjectsPropertiesDeleteOK() *SchemaObjectsPropertiesDeleteOK {
+
+	return &SchemaObjectsPropertiesDeleteOK{}
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsPropertiesDeleteOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// SchemaObjectsPropertiesDeleteUnauthorizedCode is the HTTP code returned for type SchemaObjectsPropertiesDeleteUnauthorized
+const SchemaObjectsPropertiesDeleteUnauthorizedCode int = 401
+
+/*
+SchemaObjectsPropertiesDeleteUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaObjectsPropertiesDeleteUnauthorized
+*/
+type SchemaObjectsPropertiesDeleteUnauthorized struct {
+}
+
+// NewSchemaObjectsPropertiesDeleteUnauthorized creates SchemaObjectsPropertiesDeleteUnauthorized with default headers values
+func NewSchemaObjectsPropertiesDeleteUnauthorized() *SchemaObjectsPropertiesDeleteUnauthorized {
+
+	return &SchemaObjectsPropertiesDeleteUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsPropertiesDeleteUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaObjectsPropertiesDeleteForbiddenCode is the HTTP code returned for type SchemaObjectsPropertiesDeleteForbidden
+const SchemaObjectsPropertiesDeleteForbiddenCode int = 403
+
+/*
+SchemaObjectsPropertiesDeleteForbidden Forbidden
+
+swagger:response schemaObjectsPropertiesDeleteForbidden
+*/
+type SchemaObjectsPropertiesDeleteForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsPropertiesDeleteForbidden creates SchemaObjectsPropertiesDeleteForbidden with default headers values
+func NewSchemaObjectsPropertiesDeleteForbidden() *SchemaObjectsPropertiesDeleteForbidden {
+
+	return &SchemaObjectsPropertiesDeleteForbidden{}
+}
+
+// WithPayload adds the payload to the schema objects properties delete forbidden response
+func (o *SchemaObjectsPropertiesDeleteForbidden) WithPayload(payload *models.ErrorResponse) *SchemaObjectsPropertiesDeleteForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects properties delete forbidden response
+func (o *SchemaObjectsPropertiesDeleteForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsPropertiesDeleteForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsPropertiesDeleteUnprocessableEntityCode is the HTTP code returned for type SchemaObjectsPropertiesDeleteUnprocessableEntity
+const SchemaObjectsPropertiesDeleteUnprocessableEntityCode int = 422
+
+/*
+SchemaObjectsPropertiesDeleteUnprocessableEntity Invalid property, e.g. the property does not exist.
+
+swagger:response schemaObjectsPropertiesDeleteUnprocessableEntity
+*/
+type SchemaObjectsPropertiesDeleteUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsPropertiesDeleteUnprocessableEntity creates SchemaObjectsPropertiesDeleteUnprocessableEntity with default headers values
+func NewSchemaObjectsPropertiesDeleteUnprocessableEntity() *SchemaObjectsPropertiesDeleteUnprocessableEntity {
+
+	return &SchemaObjectsPropertiesDeleteUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the schema objects properties delete unprocessable entity response
+func (o *SchemaObjectsPropertiesDeleteUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *SchemaObjectsPropertiesDeleteUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects properties delete unprocessable entity response
+func (o *SchemaObjectsPropertiesDeleteUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsPropertiesDeleteUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsPropertiesDeleteInternalServerErrorCode is the HTTP code returned for type SchemaObjectsPropertiesDeleteInternalServerError
+const SchemaObjectsPropertiesDeleteInternalServerErrorCode int = 500
+
+/*
+SchemaObjectsPropertiesDeleteInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaObjectsPropertiesDeleteInternalServerError
+*/
+type SchemaObjectsPropertiesDeleteInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsPropertiesDeleteInternalServerError creates SchemaObjectsPropertiesDeleteInternalServerError with default headers values
+func NewSchemaObjectsPropertiesDeleteInternalServerError() *SchemaObjectsPropertiesDeleteInternalServerError {
+
+	return &SchemaObjectsPropertiesDeleteInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema objects properties delete internal server error response
+func (o *SchemaObjectsPropertiesDeleteInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaObjectsPropertiesDeleteInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects properties delete internal server error response
+func (o *SchemaObjectsPropertiesDeleteInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsPropertiesDeleteInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
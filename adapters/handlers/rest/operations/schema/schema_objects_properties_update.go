@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsPropertiesUpdateHandlerFunc turns a function with the right signature into a schema objects properties update handler
+type SchemaObjectsPropertiesUpdateHandlerFunc func(SchemaObjectsPropertiesUpdateParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaObjectsPropertiesUpdateHandlerFunc) Handle(params SchemaObjectsPropertiesUpdateParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaObjectsPropertiesUpdateHandler interface for that can handle valid schema objects properties update params
+type SchemaObjectsPropertiesUpdateHandler interface {
+	Handle(SchemaObjectsPropertiesUpdateParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaObjectsPropertiesUpdate creates a new http.Handler for the schema objects properties update operation
+func NewSchemaObjectsPropertiesUpdate(ctx *middleware.Context, handler SchemaObjectsPropertiesUpdateHandler) *SchemaObjectsPropertiesUpdate {
+	return &SchemaObjectsPropertiesUpdate{Context: ctx, Handler: handler}
+}
+
+/*
+	SchemaObjectsPropertiesUpdate swagger:route PUT /schema/{className}/properties/{propertyName} schema schemaObjectsPropertiesUpdate
+
+Update the index settings of a property.
+
+Changes indexFilterable, indexSearchable and tokenization of an existing property. The affected inverted indexes are rebuilt in the background on every shard, the progress is visible through the nodes API.
+*/
+type SchemaObjectsPropertiesUpdate struct {
+	Context *middleware.Context
+	Handler SchemaObjectsPropertiesUpdateHandler
+}
+
+func (o *SchemaObjectsPropertiesUpdate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSchemaObjectsPropertiesUpdateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsPropertiesUpdateOKCode is the HTTP code returned for type SchemaObjectsPropertiesUpdateOK
+const SchemaObjectsPropertiesUpdateOKCode int = 200
+
+/*
+SchemaObjectsPropertiesUpdateOK Updated the property, reindexing was started.
+
+swagger:response schemaObjectsPropertiesUpdateOK
+*/
+type SchemaObjectsPropertiesUpdateOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Property `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsPropertiesUpdateOK creates SchemaObjectsPropertiesUpdateOK with default headers values
+func NewSchemaObjectsPropertiesUpdateOK() *SchemaObjectsPropertiesUpdateOK {
+
+	return &SchemaObjectsPropertiesUpdateOK{}
+}
+
+// WithPayload adds the payload to the schema objects properties update o k response
+func (o *SchemaObjectsPropertiesUpdateOK) WithPayload(payload *models.Property) *SchemaObjectsPropertiesUpdateOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects properties update o k response
+func (o *SchemaObjectsPropertiesUpdateOK) SetPayload(payload *models.Property) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsPropertiesUpdateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsPropertiesUpdateUnauthorizedCode is the HTTP code returned for type SchemaObjectsPropertiesUpdateUnauthorized
+const SchemaObjectsPropertiesUpdateUnauthorizedCode int = 401
+
+/*
+SchemaObjectsPropertiesUpdateUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaObjectsPropertiesUpdateUnauthorized
+*/
+type SchemaObjectsPropertiesUpdateUnauthorized struct {
+}
+
+// NewSchemaObjectsPropertiesUpdateUnauthorized creates SchemaObjectsPropertiesUpdateUnauthorized with default headers values
+func NewSchemaObjectsPropertiesUpdateUnauthorized() *SchemaObjectsPropertiesUpdateUnauthorized {
+
+	return &SchemaObjectsPropertiesUpdateUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsPropertiesUpdateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaObjectsPropertiesUpdateForbiddenCode is the HTTP code returned for type SchemaObjectsPropertiesUpdateForbidden
+const SchemaObjectsPropertiesUpdateForbiddenCode int = 403
+
+/*
+SchemaObjectsPropertiesUpdateForbidden Forbidden
+
+swagger:response schemaObjectsPropertiesUpdateForbidden
+*/
+type SchemaObjectsPropertiesUpdateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsPropertiesUpdateForbidden creates SchemaObjectsPropertiesUpdateForbidden with default headers values
+func NewSchemaObjectsPropertiesUpdateForbidden() *SchemaObjectsPropertiesUpdateForbidden {
+
+	return &SchemaObjectsPropertiesUpdateForbidden{}
+}
+
+// WithPayload adds the payload to the schema objects properties update forbidden response
+func (o *SchemaObjectsPropertiesUpdateForbidden) WithPayload(payload *models.ErrorResponse) *SchemaObjectsPropertiesUpdateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects properties update forbidden response
+func (o *SchemaObjectsPropertiesUpdateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsPropertiesUpdateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsPropertiesUpdateUnprocessableEntityCode is the HTTP code returned for type SchemaObjectsPropertiesUpdateUnprocessableEntity
+const SchemaObjectsPropertiesUpdateUnprocessableEntityCode int = 422
+
+/*
+SchemaObjectsPropertiesUpdateUnprocessableEntity Invalid property, e.g. the property does not exist or the settings are not valid for its data type.
+
+swagger:response schemaObjectsPropertiesUpdateUnprocessableEntity
+*/
+type SchemaObjectsPropertiesUpdateUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsPropertiesUpdateUnprocessableEntity creates SchemaObjectsPropertiesUpdateUnprocessableEntity with default headers values
+func NewSchemaObjectsPropertiesUpdateUnprocessableEntity() *SchemaObjectsPropertiesUpdateUnprocessableEntity {
+
+	return &SchemaObjectsPropertiesUpdateUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the schema objects properties update unprocessable entity response
+func (o *SchemaObjectsPropertiesUpdateUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *SchemaObjectsPropertiesUpdateUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects properties update unprocessable entity response
+func (o *SchemaObjectsPropertiesUpdateUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsPropertiesUpdateUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsPropertiesUpdateInternalServerErrorCode is the HTTP code returned for type SchemaObjectsPropertiesUpdateInternalServerError
+const SchemaObjectsPropertiesUpdateInternalServerErrorCode int = 500
+
+/*
+SchemaObjectsPropertiesUpdateInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaObjectsPropertiesUpdateInternalServerError
+*/
+type SchemaObjectsPropertiesUpdateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsPropertiesUpdateInternalServerError creates SchemaObjectsPropertiesUpdateInternalServerError with default headers values
+func NewSchemaObjectsPropertiesUpdateInternalServerError() *SchemaObjectsPropertiesUpdateInternalServerError {
+
+	return &SchemaObjectsPropertiesUpdateInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema objects properties update internal server error response
+func (o *SchemaObjectsPropertiesUpdateInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaObjectsPropertiesUpdateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects properties update internal server error response
+func (o *SchemaObjectsPropertiesUpdateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsPropertiesUpdateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
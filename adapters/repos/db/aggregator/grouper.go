@@ -57,7 +57,9 @@ func (g *grouper) Do(ctx context.Context) ([]group, error) {
 }
 
 func (g *grouper) groupAll(ctx context.Context) ([]group, error) {
-	err := ScanAllLSM(g.store, func(prop *models.PropertySchema, docID uint64) (bool, error) {
+	sch := g.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(g.params.ClassName)
+	err := ScanAllLSM(g.store, class, func(prop *models.PropertySchema, docID uint64) (bool, error) {
 		return true, g.addElementById(prop, docID)
 	})
 	if err != nil {
@@ -283,8 +285,9 @@ func ScanAll(tx *bolt.Tx, scan docid.ObjectScanFn) error {
 	return nil
 }
 
-// ScanAllLSM iterates over every row in the object buckets
-func ScanAllLSM(store *lsmkv.Store, scan docid.ObjectScanFn) error {
+// ScanAllLSM iterates over every row in the object buckets, the class is
+// used to unmarshal the objects, see storobj.FromBinaryWithClass
+func ScanAllLSM(store *lsmkv.Store, class *models.Class, scan docid.ObjectScanFn) error {
 	b := store.Bucket(helpers.ObjectsBucketLSM)
 	if b == nil {
 		return fmt.Errorf("objects bucket not found")
@@ -294,7 +297,7 @@ func ScanAllLSM(store *lsmkv.Store, scan docid.ObjectScanFn) error {
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
-		elem, err := storobj.FromBinary(v)
+		elem, err := storobj.FromBinaryWithClass(v, class)
 		if err != nil {
 			return errors.Wrapf(err, "unmarshal data object")
 		}
@@ -192,7 +192,7 @@ func (i *Index) descriptor(ctx context.Context, backupID string, desc *backup.Cl
 		}
 	}()
 
-	if err = i.ForEachShard(func(name string, s *Shard) error {
+	if err = i.forEachBackupShard(ctx, func(name string, s *Shard) error {
 		if err = s.beginBackup(ctx); err != nil {
 			return fmt.Errorf("pause compaction and flush: %w", err)
 		}
@@ -216,6 +216,49 @@ func (i *Index) descriptor(ctx context.Context, backupID string, desc *backup.Cl
 	return nil
 }
 
+// forEachBackupShard calls f for all local shards of the index. Shards of cold
+// tenants are not loaded, they are opened for the call and shut down
+// afterwards, so their files remain unchanged until they have been copied. As
+// the files of offloaded tenants are not available locally, the backup fails
+// if the index contains one.
+func (i *Index) forEachBackupShard(ctx context.Context, f func(name string, shard *Shard) error) error {
+	className := i.Config.ClassName.String()
+	ss := i.getSchema.CopyShardingState(className)
+	if ss == nil {
+		return fmt.Errorf("cannot find sharding state for %q", className)
+	}
+
+	for _, name := range ss.AllLocalPhysicalShards() {
+		if shard := i.shards.Load(name); shard != nil {
+			if err := f(name, shard); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if ss.Physical[name].IsOffloaded() {
+			return fmt.Errorf("tenant %q is offloaded, it needs to be reloaded to be backed up", name)
+		}
+		sch := i.getSchema.GetSchemaSkipAuth()
+		class := sch.GetClass(i.Config.ClassName)
+		if class == nil {
+			return fmt.Errorf("cannot find class %q", className)
+		}
+		shard, err := NewShard(ctx, nil, name, i, class, i.centralJobQueue)
+		if err != nil {
+			return fmt.Errorf("cannot load partition %q: %w", name, err)
+		}
+		err = f(name, shard)
+		if err2 := shard.shutdown(ctx); err2 != nil && err == nil {
+			err = fmt.Errorf("cannot shutdown partition %q: %w", name, err2)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ReleaseBackup marks the specified backup as inactive and restarts all
 // async background and maintenance processes. It errors if the backup does not exist
 // or is already inactive.
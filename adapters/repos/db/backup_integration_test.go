@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -31,6 +32,8 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 func TestBackup_DBLevel(t *testing.T) {
@@ -175,6 +178,88 @@ func TestBackup_DBLevel(t *testing.T) {
 	})
 }
 
+func TestBackup_DBLevelColdTenant(t *testing.T) {
+	ctx := testCtx()
+	dirName := t.TempDir()
+	backupID := "backup1"
+	tenants := []string{"hot", "cold"}
+	logger, _ := test.NewNullLogger()
+
+	class := makeTestClass("ColdTenantBackupClass")
+	class.MultiTenancyConfig = &models.MultiTenancyConfig{Enabled: true}
+	config, err := sharding.ParseConfig(nil, 1)
+	require.Nil(t, err)
+	shardState, err := sharding.InitState(class.Class, config,
+		fakeNodes{[]string{"node1"}}, 1, true)
+	require.Nil(t, err)
+	for _, tenant := range tenants {
+		shardState.AddPartition(tenant, []string{"node1"}, models.TenantActivityStatusHOT)
+	}
+
+	db, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	db.SetSchemaGetter(&fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: shardState,
+	})
+	require.Nil(t, db.WaitForStartup(ctx))
+	defer func() {
+		require.Nil(t, db.Shutdown(context.Background()))
+	}()
+	migrator := NewMigrator(db, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, shardState))
+
+	for i, tenant := range tenants {
+		require.Nil(t, db.PutObject(ctx, &models.Object{
+			Class:  class.Class,
+			ID:     strfmt.UUID(fmt.Sprintf("ff9fcae5-57b8-431c-b8e2-986fd78f580%d", i)),
+			Tenant: tenant,
+			Properties: map[string]interface{}{
+				"stringProp": tenant,
+			},
+		}, []float32{1, 2, float32(i)}, nil))
+	}
+
+	commit, err := migrator.UpdateTenants(ctx, class,
+		[]*migrate.UpdateTenantPayload{{Name: "cold", Status: models.TenantActivityStatusCOLD}})
+	require.Nil(t, err)
+	commit(true)
+	p := shardState.Physical["cold"]
+	p.Status = models.TenantActivityStatusCOLD
+	shardState.Physical["cold"] = p
+
+	idx := db.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+	require.Nil(t, idx.shards.Load("cold"))
+
+	t.Run("create backup", func(t *testing.T) {
+		require.Nil(t, db.Backupable(ctx, []string{class.Class}))
+
+		ch := db.BackupDescriptors(ctx, backupID, []string{class.Class})
+		for d := range ch {
+			require.Nil(t, d.Error)
+			require.Len(t, d.Shards, len(tenants))
+			var names []string
+			for _, shd := range d.Shards {
+				names = append(names, shd.Name)
+				assert.NotEmpty(t, shd.Files)
+				assert.NotEmpty(t, shd.DocIDCounter)
+			}
+			assert.ElementsMatch(t, tenants, names)
+		}
+		assert.Nil(t, idx.shards.Load("cold"), "tenant remains unloaded")
+	})
+
+	t.Run("release backup", func(t *testing.T) {
+		assert.Nil(t, db.ReleaseBackup(ctx, backupID, class.Class))
+	})
+}
+
 func TestBackup_BucketLevel(t *testing.T) {
 	ctx := testCtx()
 	className := "BucketLevelBackup"
@@ -0,0 +1,172 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestBM25Phrases(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	vTrue := true
+	class := &models.Class{
+		Class:               "LegalDocument",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:           "body",
+				DataType:       schema.DataTypeText.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	adjacentID := strfmt.UUID("3c1e5a56-1f0e-4b6d-8a43-0f2d3c6b7a01")
+	apartID := strfmt.UUID("3c1e5a56-1f0e-4b6d-8a43-0f2d3c6b7a02")
+	unrelatedID := strfmt.UUID("3c1e5a56-1f0e-4b6d-8a43-0f2d3c6b7a03")
+	objects := []*models.Object{
+		{
+			Class: class.Class,
+			ID:    adjacentID,
+			Properties: map[string]interface{}{
+				"body":  "the party is in breach of contract and owes damages",
+				"title": "breach of contract",
+			},
+		},
+		{
+			Class: class.Class,
+			ID:    apartID,
+			Properties: map[string]interface{}{
+				"body":  "the contract was signed breach was alleged later",
+				"title": "alleged breach",
+			},
+		},
+		{
+			Class: class.Class,
+			ID:    unrelatedID,
+			Properties: map[string]interface{}{
+				"body":  "the lease ends in december",
+				"title": "lease",
+			},
+		},
+	}
+	for _, obj := range objects {
+		require.Nil(t, repo.PutObject(ctx, obj, []float32{1, 2, 3}, nil))
+	}
+
+	search := func(t *testing.T, query string, properties ...string) ([]strfmt.UUID, error) {
+		res, err := repo.Search(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type:       "bm25",
+				Query:      query,
+				Properties: properties,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids, nil
+	}
+
+	t.Run("without a phrase all documents with the terms match", func(t *testing.T) {
+		ids, err := search(t, "breach contract", "body")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{adjacentID, apartID}, ids)
+	})
+
+	t.Run("exact phrase", func(t *testing.T) {
+		ids, err := search(t, `"breach of contract"`, "body")
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{adjacentID}, ids)
+	})
+
+	t.Run("exact phrase together with other terms", func(t *testing.T) {
+		ids, err := search(t, `damages "breach of contract"`, "body")
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{adjacentID}, ids)
+	})
+
+	t.Run("phrase without any match", func(t *testing.T) {
+		ids, err := search(t, `"contract of breach"`, "body")
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("phrase with slop", func(t *testing.T) {
+		ids, err := search(t, `"contract breach"~2`, "body")
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{apartID}, ids)
+
+		ids, err = search(t, `"contract breach"~3`, "body")
+		require.Nil(t, err)
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		assert.Equal(t, []strfmt.UUID{adjacentID, apartID}, ids)
+	})
+
+	t.Run("phrase is only matched on properties with positions", func(t *testing.T) {
+		ids, err := search(t, `"alleged breach"`, "body", "title")
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("phrase on properties without positions", func(t *testing.T) {
+		_, err := search(t, `"breach of contract"`, "title")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "indexPositions")
+	})
+}
@@ -0,0 +1,189 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestBM25LanguageTokenizations(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	vTrue := true
+	invertedIndexConfig := invertedConfig()
+	invertedIndexConfig.Stopwords.Preset = "en"
+	class := &models.Class{
+		Class:               "MultilingualArticle",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedIndexConfig,
+		Properties: []*models.Property{
+			{
+				Name:           "english",
+				DataType:       schema.DataTypeText.PropString(),
+				Tokenization:   models.PropertyTokenizationSnowballEnglish,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:         "german",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationSnowballGerman,
+			},
+			{
+				Name:         "french",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationSnowballFrench,
+			},
+			{
+				Name:         "folded",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationASCIIFolding,
+			},
+			{
+				Name:         "japanese",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationCjkBigram,
+			},
+		},
+	}
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	firstID := strfmt.UUID("7d2b6c1e-5a4f-4e0b-9c3d-1f2e3a4b5c01")
+	secondID := strfmt.UUID("7d2b6c1e-5a4f-4e0b-9c3d-1f2e3a4b5c02")
+	objects := []*models.Object{
+		{
+			Class: class.Class,
+			ID:    firstID,
+			Properties: map[string]interface{}{
+				"english":  "the runners was running through the parks",
+				"german":   "Die Häuser der Katzen",
+				"french":   "Les élèves chantaient",
+				"folded":   "Crème brûlée",
+				"japanese": "東京都に住んでいます",
+			},
+		},
+		{
+			Class: class.Class,
+			ID:    secondID,
+			Properties: map[string]interface{}{
+				"english":  "a quiet afternoon",
+				"german":   "ein Hund im Garten",
+				"french":   "une maison",
+				"folded":   "naïve art",
+				"japanese": "京都の寺",
+			},
+		},
+	}
+	for _, obj := range objects {
+		require.Nil(t, repo.PutObject(ctx, obj, []float32{1, 2, 3}, nil))
+	}
+
+	search := func(t *testing.T, query string, properties ...string) []strfmt.UUID {
+		res, err := repo.Search(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type:       "bm25",
+				Query:      query,
+				Properties: properties,
+			},
+		})
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	t.Run("english stems", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{firstID}, search(t, "run park", "english"))
+	})
+
+	t.Run("english stopwords are removed before stemming", func(t *testing.T) {
+		assert.Empty(t, search(t, "the was", "english"))
+	})
+
+	t.Run("english phrase on stems", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{firstID}, search(t, `"runner is running"`, "english"))
+	})
+
+	t.Run("german stems and umlauts", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{firstID}, search(t, "haus katze", "german"))
+	})
+
+	t.Run("french stems and accents", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{firstID}, search(t, "eleve chanter", "french"))
+	})
+
+	t.Run("folded diacritics", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{firstID}, search(t, "creme brulee", "folded"))
+		assert.Equal(t, []strfmt.UUID{secondID}, search(t, "NAIVE", "folded"))
+	})
+
+	t.Run("cjk bigrams", func(t *testing.T) {
+		ids := search(t, "東京", "japanese")
+		assert.Equal(t, []strfmt.UUID{firstID}, ids)
+
+		ids = search(t, "京都", "japanese")
+		assert.ElementsMatch(t, []strfmt.UUID{firstID, secondID}, ids)
+	})
+
+	t.Run("equal filter on stemmed property", func(t *testing.T) {
+		res, err := repo.Search(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: "english",
+					},
+					Value: &filters.Value{Value: "runs", Type: schema.DataTypeText},
+				},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, firstID, res[0].ID)
+	})
+}
@@ -154,8 +154,8 @@ func (f *fakeSchemaManager) ShardOwner(class, shard string) (string, error) {
 	return ss.Physical[shard].BelongsToNodes[0], nil
 }
 
-func (f *fakeSchemaManager) TenantShard(class, tenant string) string {
-	return tenant
+func (f *fakeSchemaManager) TenantShard(class, tenant string) (string, string) {
+	return tenant, models.TenantActivityStatusHOT
 }
 
 func (f *fakeSchemaManager) ShardFromUUID(class string, uuid []byte) string {
@@ -163,6 +163,8 @@ func (f *fakeSchemaManager) ShardFromUUID(class string, uuid []byte) string {
 	return ss.Shard("", string(uuid))
 }
 
+func (f *fakeSchemaManager) ResolveAlias(name string) string { return name }
+
 func (f *fakeSchemaManager) RestoreClass(ctx context.Context, d *backup.ClassDescriptor) error {
 	return nil
 }
@@ -0,0 +1,321 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestDropProperty(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class:               "DropProperty",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+			{
+				Name:         "color",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+		},
+	}
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	ids := []strfmt.UUID{
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+		"86a380e9-cb60-4b2a-bc48-51f52acd72d6",
+	}
+	for _, id := range ids {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			Class: class.Class,
+			ID:    id,
+			Properties: map[string]interface{}{
+				"name":  "car",
+				"color": "red",
+			},
+		}, []float32{1, 2, 3}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+	shard := idx.shards.Load(schemaGetter.shardState.AllPhysicalShards()[0])
+	require.NotNil(t, shard)
+	require.NotNil(t, shard.store.Bucket(helpers.BucketSearchableFromPropNameLSM("color")))
+
+	// remove the property from the schema, as the schema manager would
+	class.Properties = class.Properties[:1]
+	require.Nil(t, migrator.DropProperty(ctx, class.Class, "color", false))
+
+	t.Run("indexes are dropped", func(t *testing.T) {
+		assert.Nil(t, shard.store.Bucket(helpers.BucketFromPropNameLSM("color")))
+		assert.Nil(t, shard.store.Bucket(helpers.BucketSearchableFromPropNameLSM("color")))
+		assert.NotNil(t, shard.store.Bucket(helpers.BucketSearchableFromPropNameLSM("name")))
+	})
+
+	t.Run("values are no longer returned", func(t *testing.T) {
+		res, err := repo.ObjectByID(ctx, ids[0], nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.NotContains(t, res.Schema, "color")
+		assert.Equal(t, "car", res.Schema.(map[string]interface{})["name"])
+
+		list, err := repo.ObjectSearch(ctx, 0, 10, nil, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.Len(t, list, 2)
+		for _, obj := range list {
+			assert.NotContains(t, obj.Schema, "color")
+			assert.Equal(t, "car", obj.Schema.(map[string]interface{})["name"])
+		}
+	})
+
+	t.Run("values are not returned in grouped results", func(t *testing.T) {
+		res, err := repo.VectorSearch(ctx, dto.GetParams{
+			ClassName:            class.Class,
+			SearchVector:         []float32{1, 2, 3},
+			Pagination:           &filters.Pagination{Limit: 10},
+			GroupBy:              &searchparams.GroupBy{Property: "name", Groups: 1, ObjectsPerGroup: 2},
+			AdditionalProperties: additional.Properties{Group: true},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		group, ok := res[0].AdditionalProperties["group"].(*additional.Group)
+		require.True(t, ok)
+		require.Len(t, group.Hits, 2)
+		for _, hit := range group.Hits {
+			assert.NotContains(t, hit, "color")
+			assert.Equal(t, "car", hit["name"])
+			assert.Contains(t, hit, "_additional")
+		}
+	})
+
+	storedProps := func(id strfmt.UUID) map[string]interface{} {
+		key, err := uuid.MustParse(id.String()).MarshalBinary()
+		require.Nil(t, err)
+		data, err := shard.store.Bucket(helpers.ObjectsBucketLSM).Get(key)
+		require.Nil(t, err)
+		obj, err := storobj.FromBinary(data)
+		require.Nil(t, err)
+		return obj.Properties().(map[string]interface{})
+	}
+
+	t.Run("values remain stored until purged", func(t *testing.T) {
+		assert.Equal(t, "red", storedProps(ids[0])["color"])
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		require.Nil(t, migrator.DropProperty(ctx, class.Class, "color", true))
+		// values are purged before returning
+		for _, id := range ids {
+			assert.NotContains(t, storedProps(id), "color")
+		}
+		assert.Equal(t, "car", storedProps(ids[1])["name"])
+
+		count, err := shard.purgeProperty(ctx, "color")
+		require.Nil(t, err)
+		assert.Equal(t, 0, count, "nothing left to purge")
+	})
+
+	t.Run("values of a property added again are kept", func(t *testing.T) {
+		color := &models.Property{
+			Name:         "color",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWhitespace,
+		}
+		class.Properties = append(class.Properties, color)
+		require.Nil(t, migrator.AddProperty(ctx, class.Class, color))
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			Class: class.Class,
+			ID:    ids[0],
+			Properties: map[string]interface{}{
+				"name":  "car",
+				"color": "blue",
+			},
+		}, []float32{1, 2, 3}, nil))
+
+		assert.Equal(t, "blue", storedProps(ids[0])["color"])
+	})
+}
+
+func TestDropPropertyColdTenant(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		dirName = t.TempDir()
+		tenants = []string{"hot", "cold"}
+		ids     = []strfmt.UUID{
+			"8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+			"86a380e9-cb60-4b2a-bc48-51f52acd72d6",
+		}
+	)
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "DropPropertyTenants",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+			{
+				Name:         "color",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+		},
+	}
+	config, err := sharding.ParseConfig(nil, 1)
+	require.Nil(t, err)
+	shardState, err := sharding.InitState(class.Class, config,
+		fakeNodes{[]string{"node1"}}, 1, true)
+	require.Nil(t, err)
+	for _, tenant := range tenants {
+		shardState.AddPartition(tenant, []string{"node1"}, models.TenantActivityStatusHOT)
+	}
+
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: shardState,
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, shardState))
+
+	for i, tenant := range tenants {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			Class:  class.Class,
+			ID:     ids[i],
+			Tenant: tenant,
+			Properties: map[string]interface{}{
+				"name":  "car",
+				"color": "red",
+			},
+		}, []float32{1, 2, 3}, nil))
+	}
+
+	updateStatus := func(t *testing.T, tenant, status string) {
+		commit, err := migrator.UpdateTenants(ctx, class,
+			[]*migrate.UpdateTenantPayload{{Name: tenant, Status: status}})
+		require.Nil(t, err)
+		commit(true)
+		p := shardState.Physical[tenant]
+		p.Status = status
+		shardState.Physical[tenant] = p
+	}
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+	lsmPath := path.Join(dirName, idx.ID()+"_cold_lsm")
+
+	propertyDirs := func(t *testing.T) []string {
+		entries, err := os.ReadDir(lsmPath)
+		require.Nil(t, err)
+		var dirs []string
+		for _, entry := range entries {
+			if strings.HasPrefix(entry.Name(), helpers.BucketFromPropNameLSM("color")) {
+				dirs = append(dirs, entry.Name())
+			}
+		}
+		return dirs
+	}
+
+	updateStatus(t, "cold", models.TenantActivityStatusCOLD)
+	require.Nil(t, idx.shards.Load("cold"))
+	require.NotEmpty(t, propertyDirs(t))
+
+	// remove the property from the schema, as the schema manager would
+	class.Properties = class.Properties[:1]
+	require.Nil(t, migrator.DropProperty(ctx, class.Class, "color", true))
+
+	t.Run("indexes of the cold tenant are dropped", func(t *testing.T) {
+		assert.Nil(t, idx.shards.Load("cold"), "tenant remains unloaded")
+		assert.Empty(t, propertyDirs(t))
+	})
+
+	t.Run("values of the cold tenant are purged", func(t *testing.T) {
+		updateStatus(t, "cold", models.TenantActivityStatusHOT)
+		shard := idx.shards.Load("cold")
+		require.NotNil(t, shard)
+		assert.Nil(t, shard.store.Bucket(helpers.BucketSearchableFromPropNameLSM("color")))
+
+		key, err := uuid.MustParse(ids[1].String()).MarshalBinary()
+		require.Nil(t, err)
+		data, err := shard.store.Bucket(helpers.ObjectsBucketLSM).Get(key)
+		require.Nil(t, err)
+		obj, err := storobj.FromBinary(data)
+		require.Nil(t, err)
+		props := obj.Properties().(map[string]interface{})
+		assert.NotContains(t, props, "color")
+		assert.Equal(t, "car", props["name"])
+	})
+
+	t.Run("indexes of the hot tenant are dropped", func(t *testing.T) {
+		shard := idx.shards.Load("hot")
+		require.NotNil(t, shard)
+		assert.Nil(t, shard.store.Bucket(helpers.BucketSearchableFromPropNameLSM("color")))
+	})
+}
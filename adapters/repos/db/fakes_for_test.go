@@ -59,8 +59,11 @@ func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error) {
 	return ss.Physical[shard].BelongsToNodes[0], nil
 }
 
-func (f *fakeSchemaGetter) TenantShard(class, tenant string) string {
-	return tenant
+func (f *fakeSchemaGetter) TenantShard(class, tenant string) (string, string) {
+	if f.shardState == nil {
+		return tenant, models.TenantActivityStatusHOT
+	}
+	return tenant, f.shardState.Physical[tenant].ActivityStatus()
 }
 
 func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string {
@@ -68,6 +71,8 @@ func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string {
 	return ss.Shard("", string(uuid))
 }
 
+func (f *fakeSchemaGetter) ResolveAlias(name string) string { return name }
+
 func (f *fakeSchemaGetter) Nodes() []string {
 	return []string{"node1"}
 }
@@ -187,7 +192,7 @@ func (f *fakeRemoteClient) MultiGetObjects(ctx context.Context, hostName, indexN
 }
 
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []float32, limit int,
+	shardName string, vector []float32, targetVector string, limit int,
 	filters *filters.LocalFilter, _ *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
@@ -248,6 +253,12 @@ func (f *fakeRemoteNodeClient) GetNodeStatus(ctx context.Context, hostName strin
 	return &models.NodeStatus{}, nil
 }
 
+func (f *fakeRemoteNodeClient) GetShardsStatus(ctx context.Context, hostName string, className string,
+	shards []string,
+) ([]*models.NodeShardStatus, error) {
+	return nil, nil
+}
+
 type fakeReplicationClient struct{}
 
 func (f *fakeReplicationClient) PutObject(ctx context.Context, host, index, shard, requestID string,
@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestFiltersContains(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class:               "ContainsShirt",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "colors",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{
+				Name:     "sizes",
+				DataType: schema.DataTypeIntArray.PropString(),
+			},
+			{
+				Name:     "ref",
+				DataType: schema.DataTypeUUID.PropString(),
+			},
+		},
+	}
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	redID := strfmt.UUID("6a2a2a8e-3f9d-4d5e-8c3b-8b1c6a2b3c01")
+	blueID := strfmt.UUID("6a2a2a8e-3f9d-4d5e-8c3b-8b1c6a2b3c02")
+	mixedID := strfmt.UUID("6a2a2a8e-3f9d-4d5e-8c3b-8b1c6a2b3c03")
+	objects := []*models.Object{
+		{
+			Class: class.Class,
+			ID:    redID,
+			Properties: map[string]interface{}{
+				"colors": []string{"red"},
+				"sizes":  []float64{38, 40},
+				"ref":    "a8c5d2ea-91b7-4b42-9e2b-59b0e7ea0001",
+			},
+		},
+		{
+			Class: class.Class,
+			ID:    blueID,
+			Properties: map[string]interface{}{
+				"colors": []string{"blue", "navy blue"},
+				"sizes":  []float64{42},
+				"ref":    "a8c5d2ea-91b7-4b42-9e2b-59b0e7ea0002",
+			},
+		},
+		{
+			Class: class.Class,
+			ID:    mixedID,
+			Properties: map[string]interface{}{
+				"colors": []string{"red", "navy blue"},
+				"sizes":  []float64{40, 42},
+				"ref":    "a8c5d2ea-91b7-4b42-9e2b-59b0e7ea0003",
+			},
+		},
+	}
+	for _, obj := range objects {
+		require.Nil(t, repo.PutObject(ctx, obj, []float32{1, 2, 3}, nil))
+	}
+
+	filterIDs := func(t *testing.T, operator filters.Operator, propName string,
+		value interface{}, dataType schema.DataType,
+	) []strfmt.UUID {
+		res, err := repo.Search(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: operator,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: schema.PropertyName(propName),
+					},
+					Value: &filters.Value{Value: value, Type: dataType},
+				},
+			},
+		})
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		return ids
+	}
+
+	t.Run("ContainsAny on text[]", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{blueID, mixedID}, filterIDs(t, filters.OperatorContainsAny,
+			"colors", []string{"navy blue", "green"}, schema.DataTypeTextArray))
+		assert.Empty(t, filterIDs(t, filters.OperatorContainsAny,
+			"colors", []string{"green", "yellow"}, schema.DataTypeTextArray))
+	})
+
+	t.Run("ContainsAll on text[]", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{mixedID}, filterIDs(t, filters.OperatorContainsAll,
+			"colors", []string{"red", "navy blue"}, schema.DataTypeTextArray))
+	})
+
+	t.Run("ContainsAny on int[]", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{redID, blueID, mixedID}, filterIDs(t, filters.OperatorContainsAny,
+			"sizes", []int{38, 42}, schema.DataTypeIntArray))
+	})
+
+	t.Run("ContainsAll on int[]", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{mixedID}, filterIDs(t, filters.OperatorContainsAll,
+			"sizes", []int{40, 42}, schema.DataTypeIntArray))
+	})
+
+	t.Run("ContainsAny on uuid", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{redID, mixedID}, filterIDs(t, filters.OperatorContainsAny,
+			"ref", []string{
+				"a8c5d2ea-91b7-4b42-9e2b-59b0e7ea0001",
+				"a8c5d2ea-91b7-4b42-9e2b-59b0e7ea0003",
+			}, schema.DataTypeTextArray))
+	})
+
+	t.Run("ContainsAny on the internal id", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{blueID}, filterIDs(t, filters.OperatorContainsAny,
+			filters.InternalPropID, []string{blueID.String()}, schema.DataTypeTextArray))
+	})
+}
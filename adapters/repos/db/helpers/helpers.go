@@ -22,9 +22,20 @@ var (
 	ObjectsBucketLSM           = "objects"
 	CompressedObjectsBucketLSM = "compressed_objects"
 	DimensionsBucketLSM        = "dimensions"
+	VectorsBucketLSM           = "vectors"
 	DocIDBucket                = []byte("doc_ids")
 )
 
+// VectorsBucketName returns the name of the bucket holding the vectors of
+// the given target vector. An empty target vector refers to the class level
+// vector.
+func VectorsBucketName(targetVector string) string {
+	if targetVector == "" {
+		return VectorsBucketLSM
+	}
+	return fmt.Sprintf("%s_%s", VectorsBucketLSM, targetVector)
+}
+
 // BucketFromPropName creates the byte-representation used as the bucket name
 // for a partiular prop in the inverted index
 func BucketFromPropName(propName string) []byte {
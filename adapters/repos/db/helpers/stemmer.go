@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import "unicode/utf8"
+
+// The stemmers implement the Snowball algorithms as described on
+// https://snowballstem.org/algorithms/. They expect lowercased words as
+// produced by the word tokenization and operate on runes, suffixes are
+// matched from the end of the word.
+
+type stemmerWord []rune
+
+func (w stemmerWord) hasSuffix(suffix string) bool {
+	n := utf8.RuneCountInString(suffix)
+	if n > len(w) {
+		return false
+	}
+	return string(w[len(w)-n:]) == suffix
+}
+
+// longestSuffix returns the start of the longest of the given suffixes the
+// word ends with, together with the suffix. If none matches, the start is -1.
+func (w stemmerWord) longestSuffix(suffixes ...string) (int, string) {
+	start, match := -1, ""
+	for _, suffix := range suffixes {
+		n := utf8.RuneCountInString(suffix)
+		if (start == -1 || n > len(w)-start) && w.hasSuffix(suffix) {
+			start, match = len(w)-n, suffix
+		}
+	}
+	return start, match
+}
+
+// replaceFrom replaces everything starting at pos with the replacement
+func (w stemmerWord) replaceFrom(pos int, replacement string) stemmerWord {
+	return append(w[:pos:pos], []rune(replacement)...)
+}
+
+func (w stemmerWord) containsAny(isVowel func(rune) bool) bool {
+	for _, r := range w {
+		if isVowel(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// regionAfter returns the start of the region after the first non-vowel
+// following a vowel, searching from start. This is R1 if start is 0 and R2 if
+// start is R1.
+func (w stemmerWord) regionAfter(start int, isVowel func(rune) bool) int {
+	for i := start + 1; i < len(w); i++ {
+		if isVowel(w[i-1]) && !isVowel(w[i]) {
+			return i + 1
+		}
+	}
+	return len(w)
+}
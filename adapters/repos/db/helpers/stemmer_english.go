@@ -0,0 +1,234 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var englishExceptions = map[string]string{
+	"skis":   "ski",
+	"skies":  "sky",
+	"dying":  "die",
+	"lying":  "lie",
+	"tying":  "tie",
+	"idly":   "idl",
+	"gently": "gentl",
+	"ugly":   "ugli",
+	"early":  "earli",
+	"only":   "onli",
+	"singly": "singl",
+	"sky":    "sky",
+	"news":   "news",
+	"howe":   "howe",
+	"atlas":  "atlas",
+	"cosmos": "cosmos",
+	"bias":   "bias",
+	"andes":  "andes",
+}
+
+// englishInvariants are left as they are once the plural is removed
+var englishInvariants = map[string]struct{}{
+	"inning":  {},
+	"outing":  {},
+	"canning": {},
+	"herring": {},
+	"earring": {},
+	"proceed": {},
+	"exceed":  {},
+	"succeed": {},
+}
+
+var englishStep2 = map[string]string{
+	"tional": "tion", "enci": "ence", "anci": "ance", "abli": "able",
+	"entli": "ent", "izer": "ize", "ization": "ize", "ational": "ate",
+	"ation": "ate", "ator": "ate", "alism": "al", "aliti": "al", "alli": "al",
+	"fulness": "ful", "ousli": "ous", "ousness": "ous", "iveness": "ive",
+	"iviti": "ive", "biliti": "ble", "bli": "ble", "ogi": "og", "fulli": "ful",
+	"lessli": "less", "li": "",
+}
+
+var englishStep3 = map[string]string{
+	"tional": "tion", "ational": "ate", "alize": "al", "icate": "ic",
+	"iciti": "ic", "ical": "ic", "ful": "", "ness": "", "ative": "",
+}
+
+var englishStep4 = []string{
+	"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment",
+	"ent", "ism", "ate", "iti", "ous", "ive", "ize", "ion",
+}
+
+func isEnglishVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	default:
+		return false
+	}
+}
+
+// endsWithEnglishShortSyllable is true for a vowel followed by a non-vowel
+// other than w, x or Y and preceded by a non-vowel, or a vowel at the
+// beginning of the word followed by a non-vowel
+func endsWithEnglishShortSyllable(w stemmerWord) bool {
+	n := len(w)
+	if n == 2 {
+		return isEnglishVowel(w[0]) && !isEnglishVowel(w[1])
+	}
+	if n < 3 {
+		return false
+	}
+	last := w[n-1]
+	return !isEnglishVowel(w[n-3]) && isEnglishVowel(w[n-2]) && !isEnglishVowel(last) &&
+		last != 'w' && last != 'x' && last != 'Y'
+}
+
+func mapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+var (
+	englishStep2Suffixes = mapKeys(englishStep2)
+	englishStep3Suffixes = mapKeys(englishStep3)
+)
+
+// stemEnglish implements the English (Porter2) Snowball stemmer. Words are
+// expected without apostrophes, as those are removed by the word tokenization.
+func stemEnglish(word string) string {
+	if utf8.RuneCountInString(word) <= 2 {
+		return word
+	}
+	if exception, ok := englishExceptions[word]; ok {
+		return exception
+	}
+
+	w := stemmerWord(word)
+	for i := range w {
+		if w[i] == 'y' && (i == 0 || isEnglishVowel(w[i-1])) {
+			w[i] = 'Y'
+		}
+	}
+
+	r1 := w.regionAfter(0, isEnglishVowel)
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if strings.HasPrefix(word, prefix) {
+			r1 = len(prefix)
+		}
+	}
+	r2 := w.regionAfter(r1, isEnglishVowel)
+
+	// step 1a: plurals
+	switch {
+	case w.hasSuffix("sses"):
+		w = w[:len(w)-2]
+	case w.hasSuffix("ied"), w.hasSuffix("ies"):
+		if len(w) > 4 {
+			w = w[:len(w)-2]
+		} else {
+			w = w[:len(w)-1]
+		}
+	case w.hasSuffix("us"), w.hasSuffix("ss"):
+	case w.hasSuffix("s"):
+		if w[:len(w)-2].containsAny(isEnglishVowel) {
+			w = w[:len(w)-1]
+		}
+	}
+	if _, ok := englishInvariants[string(w)]; ok {
+		return string(w)
+	}
+
+	// step 1b: past tense and gerund
+	if start, suffix := w.longestSuffix("eed", "eedly", "ed", "edly", "ing", "ingly"); start >= 0 {
+		switch suffix {
+		case "eed", "eedly":
+			if start >= r1 {
+				w = w.replaceFrom(start, "ee")
+			}
+		default:
+			if w[:start].containsAny(isEnglishVowel) {
+				w = w[:start]
+				switch {
+				case w.hasSuffix("at"), w.hasSuffix("bl"), w.hasSuffix("iz"):
+					w = append(w, 'e')
+				case endsWithEnglishDouble(w):
+					w = w[:len(w)-1]
+				case endsWithEnglishShortSyllable(w) && r1 >= len(w):
+					w = append(w, 'e')
+				}
+			}
+		}
+	}
+
+	// step 1c
+	if n := len(w); n > 2 && (w[n-1] == 'y' || w[n-1] == 'Y') && !isEnglishVowel(w[n-2]) {
+		w[n-1] = 'i'
+	}
+
+	// step 2
+	if start, suffix := w.longestSuffix(englishStep2Suffixes...); start >= r1 {
+		switch suffix {
+		case "ogi":
+			if start > 0 && w[start-1] == 'l' {
+				w = w.replaceFrom(start, englishStep2[suffix])
+			}
+		case "li":
+			if start > 0 && strings.ContainsRune("cdeghkmnrt", w[start-1]) {
+				w = w[:start]
+			}
+		default:
+			w = w.replaceFrom(start, englishStep2[suffix])
+		}
+	}
+
+	// step 3
+	if start, suffix := w.longestSuffix(englishStep3Suffixes...); start >= r1 {
+		if suffix != "ative" || start >= r2 {
+			w = w.replaceFrom(start, englishStep3[suffix])
+		}
+	}
+
+	// step 4
+	if start, suffix := w.longestSuffix(englishStep4...); start >= r2 {
+		if suffix != "ion" || (start > 0 && (w[start-1] == 's' || w[start-1] == 't')) {
+			w = w[:start]
+		}
+	}
+
+	// step 5
+	if n := len(w); n > 0 {
+		switch w[n-1] {
+		case 'e':
+			if n-1 >= r2 || (n-1 >= r1 && !endsWithEnglishShortSyllable(w[:n-1])) {
+				w = w[:n-1]
+			}
+		case 'l':
+			if n-1 >= r2 && n > 1 && w[n-2] == 'l' {
+				w = w[:n-1]
+			}
+		}
+	}
+
+	return strings.ReplaceAll(string(w), "Y", "y")
+}
+
+func endsWithEnglishDouble(w stemmerWord) bool {
+	n := len(w)
+	if n < 2 || w[n-1] != w[n-2] {
+		return false
+	}
+	return strings.ContainsRune("bdfgmnprt", w[n-1])
+}
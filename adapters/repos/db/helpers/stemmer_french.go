@@ -0,0 +1,347 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import "strings"
+
+var frenchStandardSuffixes = []string{
+	"ance", "iqUe", "isme", "able", "iste", "eux", "ances", "iqUes", "ismes",
+	"ables", "istes", "atrice", "ateur", "ation", "atrices", "ateurs", "ations",
+	"logie", "logies", "usion", "ution", "usions", "utions", "ence", "ences",
+	"ement", "ements", "ité", "ités", "if", "ive", "ifs", "ives", "eaux", "aux",
+	"euse", "euses", "issement", "issements", "amment", "emment", "ment", "ments",
+}
+
+var frenchIVerbSuffixes = []string{
+	"îmes", "ît", "îtes", "i", "ie", "ies", "ir", "ira", "irai", "iraIent",
+	"irais", "irait", "iras", "irent", "irez", "iriez", "irions", "irons",
+	"iront", "is", "issaIent", "issais", "issait", "issant", "issante",
+	"issantes", "issants", "isse", "issent", "isses", "issez", "issiez",
+	"issions", "issons", "it",
+}
+
+var frenchVerbSuffixes = []string{
+	"ions", "é", "ée", "ées", "és", "èrent", "er", "era", "erai", "eraIent",
+	"erais", "erait", "eras", "erez", "eriez", "erions", "erons", "eront", "ez",
+	"iez", "âmes", "ât", "âtes", "a", "ai", "aIent", "ais", "ait", "ant", "ante",
+	"antes", "ants", "as", "asse", "assent", "asses", "assiez", "assions",
+}
+
+func isFrenchVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'â', 'à', 'ë', 'é', 'ê', 'è', 'ï', 'î',
+		'ô', 'û', 'ù':
+		return true
+	default:
+		return false
+	}
+}
+
+type frenchStemmer struct {
+	w          stemmerWord
+	rv, r1, r2 int
+}
+
+// stemFrench implements the French Snowball stemmer
+func stemFrench(word string) string {
+	s := &frenchStemmer{w: stemmerWord(word)}
+	s.markNonVowels()
+	s.markRegions()
+
+	changed := s.standardSuffix() || s.iVerbSuffix() || s.verbSuffix()
+
+	if changed {
+		if n := len(s.w); n > 0 {
+			switch s.w[n-1] {
+			case 'Y':
+				s.w[n-1] = 'i'
+			case 'ç':
+				s.w[n-1] = 'c'
+			}
+		}
+	} else {
+		s.residualSuffix()
+	}
+
+	s.undouble()
+	s.unaccent()
+
+	return strings.NewReplacer("I", "i", "U", "u", "Y", "y").Replace(string(s.w))
+}
+
+// markNonVowels marks u and i between vowels, y next to a vowel and u after
+// q as non-vowels by upper casing them
+func (s *frenchStemmer) markNonVowels() {
+	w := s.w
+	for i := range w {
+		prevVowel := i > 0 && isFrenchVowel(w[i-1])
+		nextVowel := i < len(w)-1 && isFrenchVowel(w[i+1])
+		switch {
+		case (w[i] == 'u' || w[i] == 'i') && prevVowel && nextVowel:
+			w[i] = w[i] - 'a' + 'A'
+		case w[i] == 'y' && (prevVowel || nextVowel):
+			w[i] = 'Y'
+		case w[i] == 'u' && i > 0 && w[i-1] == 'q':
+			w[i] = 'U'
+		}
+	}
+}
+
+func (s *frenchStemmer) markRegions() {
+	w := s.w
+	s.rv = len(w)
+	switch {
+	case len(w) >= 3 && (strings.HasPrefix(string(w), "par") ||
+		strings.HasPrefix(string(w), "col") || strings.HasPrefix(string(w), "tap")):
+		s.rv = 3
+	case len(w) >= 3 && isFrenchVowel(w[0]) && isFrenchVowel(w[1]):
+		s.rv = 3
+	default:
+		for i := 1; i < len(w); i++ {
+			if isFrenchVowel(w[i]) {
+				s.rv = i + 1
+				break
+			}
+		}
+	}
+	s.r1 = w.regionAfter(0, isFrenchVowel)
+	s.r2 = w.regionAfter(s.r1, isFrenchVowel)
+}
+
+// precededBy returns the start of the suffix if the word part before end ends
+// with it, -1 otherwise
+func (s *frenchStemmer) precededBy(end int, suffix string) int {
+	start, _ := s.w[:end].longestSuffix(suffix)
+	return start
+}
+
+// standardSuffix removes noun and adjective suffixes. It reports whether a
+// suffix was removed, endings of adverbs ending in -ment are removed
+// nonetheless, but reported as not removed so that verb suffixes are
+// considered as well.
+func (s *frenchStemmer) standardSuffix() bool {
+	start, suffix := s.w.longestSuffix(frenchStandardSuffixes...)
+	if start < 0 {
+		return false
+	}
+
+	switch suffix {
+	case "ance", "iqUe", "isme", "able", "iste", "eux", "ances", "iqUes",
+		"ismes", "ables", "istes":
+		if start < s.r2 {
+			return false
+		}
+		s.w = s.w[:start]
+	case "atrice", "ateur", "ation", "atrices", "ateurs", "ations":
+		if start < s.r2 {
+			return false
+		}
+		s.w = s.w[:start]
+		if ic := s.precededBy(start, "ic"); ic >= 0 {
+			if ic >= s.r2 {
+				s.w = s.w[:ic]
+			} else {
+				s.w = s.w.replaceFrom(ic, "iqU")
+			}
+		}
+	case "logie", "logies":
+		if start < s.r2 {
+			return false
+		}
+		s.w = s.w.replaceFrom(start, "log")
+	case "usion", "ution", "usions", "utions":
+		if start < s.r2 {
+			return false
+		}
+		s.w = s.w.replaceFrom(start, "u")
+	case "ence", "ences":
+		if start < s.r2 {
+			return false
+		}
+		s.w = s.w.replaceFrom(start, "ent")
+	case "ement", "ements":
+		if start < s.rv {
+			return false
+		}
+		s.w = s.w[:start]
+		if iv := s.precededBy(start, "iv"); iv >= 0 {
+			if iv >= s.r2 {
+				s.w = s.w[:iv]
+				if at := s.precededBy(iv, "at"); at >= s.r2 {
+					s.w = s.w[:at]
+				}
+			}
+		} else if eus := s.precededBy(start, "eus"); eus >= 0 {
+			if eus >= s.r2 {
+				s.w = s.w[:eus]
+			} else if eus >= s.r1 {
+				s.w = s.w.replaceFrom(eus, "eux")
+			}
+		} else if abl, _ := s.w.longestSuffix("abl", "iqU"); abl >= 0 {
+			if abl >= s.r2 {
+				s.w = s.w[:abl]
+			}
+		} else if ier, _ := s.w.longestSuffix("ièr", "Ièr"); ier >= 0 {
+			if ier >= s.rv {
+				s.w = s.w.replaceFrom(ier, "i")
+			}
+		}
+	case "ité", "ités":
+		if start < s.r2 {
+			return false
+		}
+		s.w = s.w[:start]
+		if abil := s.precededBy(start, "abil"); abil >= 0 {
+			if abil >= s.r2 {
+				s.w = s.w[:abil]
+			} else {
+				s.w = s.w.replaceFrom(abil, "abl")
+			}
+		} else if ic := s.precededBy(start, "ic"); ic >= 0 {
+			if ic >= s.r2 {
+				s.w = s.w[:ic]
+			} else {
+				s.w = s.w.replaceFrom(ic, "iqU")
+			}
+		} else if iv := s.precededBy(start, "iv"); iv >= s.r2 {
+			s.w = s.w[:iv]
+		}
+	case "if", "ive", "ifs", "ives":
+		if start < s.r2 {
+			return false
+		}
+		s.w = s.w[:start]
+		if at := s.precededBy(start, "at"); at >= s.r2 {
+			s.w = s.w[:at]
+			if ic := s.precededBy(at, "ic"); ic >= 0 {
+				if ic >= s.r2 {
+					s.w = s.w[:ic]
+				} else {
+					s.w = s.w.replaceFrom(ic, "iqU")
+				}
+			}
+		}
+	case "eaux":
+		s.w = s.w.replaceFrom(start, "eau")
+	case "aux":
+		if start < s.r1 {
+			return false
+		}
+		s.w = s.w.replaceFrom(start, "al")
+	case "euse", "euses":
+		switch {
+		case start >= s.r2:
+			s.w = s.w[:start]
+		case start >= s.r1:
+			s.w = s.w.replaceFrom(start, "eux")
+		default:
+			return false
+		}
+	case "issement", "issements":
+		if start < s.r1 || start == 0 || isFrenchVowel(s.w[start-1]) {
+			return false
+		}
+		s.w = s.w[:start]
+	case "amment":
+		if start >= s.rv {
+			s.w = s.w.replaceFrom(start, "ant")
+		}
+		return false
+	case "emment":
+		if start >= s.rv {
+			s.w = s.w.replaceFrom(start, "ent")
+		}
+		return false
+	case "ment", "ments":
+		if start > 0 && start-1 >= s.rv && isFrenchVowel(s.w[start-1]) {
+			s.w = s.w[:start]
+		}
+		return false
+	}
+
+	return true
+}
+
+// iVerbSuffix removes endings of verbs ending in -ir
+func (s *frenchStemmer) iVerbSuffix() bool {
+	start, _ := s.w.longestSuffix(frenchIVerbSuffixes...)
+	if start < 0 || start-1 < s.rv || isFrenchVowel(s.w[start-1]) {
+		return false
+	}
+	s.w = s.w[:start]
+	return true
+}
+
+// verbSuffix removes the endings of all other verbs
+func (s *frenchStemmer) verbSuffix() bool {
+	start, suffix := s.w.longestSuffix(frenchVerbSuffixes...)
+	if start < s.rv {
+		return false
+	}
+
+	switch suffix {
+	case "ions":
+		if start < s.r2 {
+			return false
+		}
+		s.w = s.w[:start]
+	case "âmes", "ât", "âtes", "a", "ai", "aIent", "ais", "ait", "ant", "ante",
+		"antes", "ants", "as", "asse", "assent", "asses", "assiez", "assions":
+		s.w = s.w[:start]
+		if start-1 >= s.rv && s.w[start-1] == 'e' {
+			s.w = s.w[:start-1]
+		}
+	default:
+		s.w = s.w[:start]
+	}
+	return true
+}
+
+func (s *frenchStemmer) residualSuffix() {
+	if n := len(s.w); n > 1 && s.w[n-1] == 's' && !strings.ContainsRune("aiouès", s.w[n-2]) {
+		s.w = s.w[:n-1]
+	}
+
+	start, suffix := s.w.longestSuffix("ion", "ier", "ière", "Ier", "Ière", "e")
+	if start < s.rv {
+		return
+	}
+	switch suffix {
+	case "ion":
+		if start >= s.r2 && start-1 >= s.rv &&
+			(s.w[start-1] == 's' || s.w[start-1] == 't') {
+			s.w = s.w[:start]
+		}
+	case "e":
+		s.w = s.w[:start]
+	default:
+		s.w = s.w.replaceFrom(start, "i")
+	}
+}
+
+func (s *frenchStemmer) undouble() {
+	if start, _ := s.w.longestSuffix("enn", "onn", "ett", "ell", "eill"); start >= 0 {
+		s.w = s.w[:len(s.w)-1]
+	}
+}
+
+// unaccent removes the accent of é or è followed by at least one non-vowel at
+// the end of the word
+func (s *frenchStemmer) unaccent() {
+	i := len(s.w) - 1
+	for i >= 0 && !isFrenchVowel(s.w[i]) {
+		i--
+	}
+	if i >= 0 && i < len(s.w)-1 && (s.w[i] == 'é' || s.w[i] == 'è') {
+		s.w[i] = 'e'
+	}
+}
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import "strings"
+
+func isGermanVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'ä', 'ö', 'ü':
+		return true
+	default:
+		return false
+	}
+}
+
+// stemGerman implements the German Snowball stemmer
+func stemGerman(word string) string {
+	w := stemmerWord(strings.ReplaceAll(word, "ß", "ss"))
+
+	// u and y between vowels are treated as consonants
+	for i := 1; i < len(w)-1; i++ {
+		if (w[i] == 'u' || w[i] == 'y') && isGermanVowel(w[i-1]) && isGermanVowel(w[i+1]) {
+			w[i] = w[i] - 'a' + 'A'
+		}
+	}
+
+	r1 := w.regionAfter(0, isGermanVowel)
+	if r1 < 3 {
+		r1 = 3
+	}
+	r2 := w.regionAfter(r1, isGermanVowel)
+
+	// step 1
+	if start, suffix := w.longestSuffix("em", "ern", "er", "e", "en", "es", "s"); start >= r1 {
+		switch suffix {
+		case "s":
+			if start > 0 && strings.ContainsRune("bdfghklmnrt", w[start-1]) {
+				w = w[:start]
+			}
+		case "e", "en", "es":
+			w = w[:start]
+			if w.hasSuffix("niss") {
+				w = w[:len(w)-1]
+			}
+		default:
+			w = w[:start]
+		}
+	}
+
+	// step 2
+	if start, suffix := w.longestSuffix("en", "er", "est", "st"); start >= r1 {
+		if suffix != "st" ||
+			(start > 3 && strings.ContainsRune("bdfghklmnt", w[start-1])) {
+			w = w[:start]
+		}
+	}
+
+	// step 3: derivational suffixes
+	if start, suffix := w.longestSuffix("end", "ung", "ig", "ik", "isch",
+		"lich", "heit", "keit"); start >= r2 {
+		switch suffix {
+		case "end", "ung":
+			w = w[:start]
+			if s := start - 2; s >= r2 && w.hasSuffix("ig") && (s == 0 || w[s-1] != 'e') {
+				w = w[:s]
+			}
+		case "ig", "ik", "isch":
+			if start == 0 || w[start-1] != 'e' {
+				w = w[:start]
+			}
+		case "lich", "heit":
+			w = w[:start]
+			if s := start - 2; s >= r1 && (w.hasSuffix("er") || w.hasSuffix("en")) {
+				w = w[:s]
+			}
+		case "keit":
+			w = w[:start]
+			if s, _ := w.longestSuffix("lich", "ig"); s >= 0 && s >= r2 {
+				w = w[:s]
+			}
+		}
+	}
+
+	return strings.NewReplacer("U", "u", "Y", "y", "ä", "a", "ö", "o", "ü", "u").
+		Replace(string(w))
+}
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStemmers(t *testing.T) {
+	type testCase struct {
+		word     string
+		expected string
+	}
+
+	t.Run("english", func(t *testing.T) {
+		testCases := []testCase{
+			{word: "consign", expected: "consign"},
+			{word: "consigned", expected: "consign"},
+			{word: "consignment", expected: "consign"},
+			{word: "consistently", expected: "consist"},
+			{word: "consolatory", expected: "consolatori"},
+			{word: "consolidating", expected: "consolid"},
+			{word: "conspiracy", expected: "conspiraci"},
+			{word: "generously", expected: "generous"},
+			{word: "running", expected: "run"},
+			{word: "hoping", expected: "hope"},
+			{word: "cries", expected: "cri"},
+			{word: "ties", expected: "tie"},
+			{word: "agreed", expected: "agre"},
+			{word: "skies", expected: "sky"},
+			{word: "news", expected: "news"},
+			{word: "saying", expected: "say"},
+			{word: "by", expected: "by"},
+		}
+
+		for _, tc := range testCases {
+			assert.Equal(t, tc.expected, stemEnglish(tc.word), tc.word)
+		}
+	})
+
+	t.Run("german", func(t *testing.T) {
+		testCases := []testCase{
+			{word: "häuser", expected: "haus"},
+			{word: "katze", expected: "katz"},
+			{word: "katzen", expected: "katz"},
+			{word: "aufeinanderfolgenden", expected: "aufeinanderfolg"},
+			{word: "bedeutung", expected: "bedeut"},
+			{word: "freundlichkeit", expected: "freundlich"},
+			{word: "möglichkeiten", expected: "moglich"},
+			{word: "straße", expected: "strass"},
+			{word: "kindern", expected: "kind"},
+		}
+
+		for _, tc := range testCases {
+			assert.Equal(t, tc.expected, stemGerman(tc.word), tc.word)
+		}
+	})
+
+	t.Run("french", func(t *testing.T) {
+		testCases := []testCase{
+			{word: "continuellement", expected: "continuel"},
+			{word: "chantait", expected: "chant"},
+			{word: "chanter", expected: "chant"},
+			{word: "chanté", expected: "chant"},
+			{word: "finissons", expected: "fin"},
+			{word: "nationalité", expected: "national"},
+			{word: "nationaux", expected: "national"},
+			{word: "heureusement", expected: "heureux"},
+			{word: "constamment", expected: "const"},
+			{word: "informations", expected: "inform"},
+			{word: "beaux", expected: "beau"},
+		}
+
+		for _, tc := range testCases {
+			assert.Equal(t, tc.expected, stemFrench(tc.word), tc.word)
+		}
+	})
+}
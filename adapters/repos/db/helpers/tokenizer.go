@@ -16,6 +16,7 @@ import (
 	"unicode"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"golang.org/x/text/unicode/norm"
 )
 
 var Tokenizations []string = []string{
@@ -23,12 +24,21 @@ var Tokenizations []string = []string{
 	models.PropertyTokenizationLowercase,
 	models.PropertyTokenizationWhitespace,
 	models.PropertyTokenizationField,
+	models.PropertyTokenizationASCIIFolding,
+	models.PropertyTokenizationSnowballEnglish,
+	models.PropertyTokenizationSnowballGerman,
+	models.PropertyTokenizationSnowballFrench,
+	models.PropertyTokenizationCjkBigram,
 }
 
 func Tokenize(tokenization string, in string) []string {
 	switch tokenization {
-	case models.PropertyTokenizationWord:
-		return tokenizeWord(in)
+	case models.PropertyTokenizationWord, models.PropertyTokenizationASCIIFolding,
+		models.PropertyTokenizationSnowballEnglish, models.PropertyTokenizationSnowballGerman,
+		models.PropertyTokenizationSnowballFrench:
+		return TransformWords(tokenization, SplitWords(tokenization, in))
+	case models.PropertyTokenizationCjkBigram:
+		return tokenizeCJKBigram(in, false)
 	case models.PropertyTokenizationLowercase:
 		return tokenizeLowercase(in)
 	case models.PropertyTokenizationWhitespace:
@@ -44,6 +54,12 @@ func TokenizeWithWildcards(tokenization string, in string) []string {
 	switch tokenization {
 	case models.PropertyTokenizationWord:
 		return tokenizeWordWithWildcards(in)
+	case models.PropertyTokenizationASCIIFolding, models.PropertyTokenizationSnowballEnglish,
+		models.PropertyTokenizationSnowballGerman, models.PropertyTokenizationSnowballFrench:
+		// wildcard patterns are not stemmed, as their words are incomplete
+		return foldDiacritics(tokenizeWordWithWildcards(norm.NFC.String(in)))
+	case models.PropertyTokenizationCjkBigram:
+		return tokenizeCJKBigram(in, true)
 	case models.PropertyTokenizationLowercase:
 		return tokenizeLowercase(in)
 	case models.PropertyTokenizationWhitespace:
@@ -91,6 +107,114 @@ func tokenizeWordWithWildcards(in string) []string {
 	return lowercase(terms)
 }
 
+// IsWordTokenization returns whether the tokenization splits the input into
+// words like the word tokenization does and transforms each of the words on
+// its own. This allows to remove stopwords from the words before they are
+// transformed into terms.
+func IsWordTokenization(tokenization string) bool {
+	switch tokenization {
+	case models.PropertyTokenizationWord, models.PropertyTokenizationASCIIFolding,
+		models.PropertyTokenizationSnowballEnglish, models.PropertyTokenizationSnowballGerman,
+		models.PropertyTokenizationSnowballFrench:
+		return true
+	default:
+		return false
+	}
+}
+
+// SplitWords splits the input into lowercased words for one of the word
+// tokenizations, TransformWords turns them into the terms of the tokenization
+func SplitWords(tokenization string, in string) []string {
+	if tokenization == models.PropertyTokenizationWord {
+		return tokenizeWord(in)
+	}
+	// composing characters first keeps combining diacritics within the words
+	return tokenizeWord(norm.NFC.String(in))
+}
+
+// TransformWords turns words as returned by SplitWords into terms, e.g. by
+// stemming them. Each word is turned into exactly one term.
+func TransformWords(tokenization string, words []string) []string {
+	var stem func(string) string
+	switch tokenization {
+	case models.PropertyTokenizationASCIIFolding:
+		return foldDiacritics(words)
+	case models.PropertyTokenizationSnowballEnglish:
+		stem = stemEnglish
+	case models.PropertyTokenizationSnowballGerman:
+		stem = stemGerman
+	case models.PropertyTokenizationSnowballFrench:
+		stem = stemFrench
+	default:
+		return words
+	}
+
+	for i := range words {
+		words[i] = stem(words[i])
+	}
+	return foldDiacritics(words)
+}
+
+// letters which do not decompose into a base letter and a diacritic
+var diacriticsReplacer = strings.NewReplacer("ß", "ss", "æ", "ae", "œ", "oe",
+	"ø", "o", "đ", "d", "ð", "d", "ł", "l", "þ", "th", "ı", "i")
+
+// foldDiacritics removes diacritics from the lowercased terms, e.g. café
+// becomes cafe
+func foldDiacritics(terms []string) []string {
+	for i := range terms {
+		decomposed := norm.NFKD.String(diacriticsReplacer.Replace(terms[i]))
+		terms[i] = strings.ToLower(strings.Map(func(r rune) rune {
+			if unicode.Is(unicode.Mn, r) {
+				return -1
+			}
+			return r
+		}, decomposed))
+	}
+	return terms
+}
+
+func isCJK(r rune) bool {
+	// the prolonged sound mark is not part of the Katakana script
+	return r == 'ー' || unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// tokenizeCJKBigram splits on any non-alphanumerical like tokenizeWord.
+// Chinese, Japanese and Korean characters within the words are not separated
+// by white spaces, so runs of them are split into overlapping bigrams instead,
+// e.g. 東京都 into 東京 and 京都. A single character on its own is kept.
+func tokenizeCJKBigram(in string, withWildcards bool) []string {
+	words := strings.FieldsFunc(in, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) &&
+			(!withWildcards || (r != '?' && r != '*'))
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		runes := []rune(word)
+		for start := 0; start < len(runes); {
+			cjk := isCJK(runes[start])
+			end := start + 1
+			for end < len(runes) && isCJK(runes[end]) == cjk {
+				end++
+			}
+
+			switch {
+			case !cjk:
+				terms = append(terms, strings.ToLower(string(runes[start:end])))
+			case end-start == 1:
+				terms = append(terms, string(runes[start]))
+			default:
+				for i := start; i < end-1; i++ {
+					terms = append(terms, string(runes[i:i+2]))
+				}
+			}
+			start = end
+		}
+	}
+	return terms
+}
+
 func lowercase(terms []string) []string {
 	for i := range terms {
 		terms[i] = strings.ToLower(terms[i])
@@ -99,8 +223,14 @@ func lowercase(terms []string) []string {
 }
 
 func TokenizeAndCountDuplicates(tokenization string, in string) ([]string, []int) {
+	return CountDuplicates(Tokenize(tokenization, in))
+}
+
+// CountDuplicates returns the unique terms together with how often each of
+// them occurs
+func CountDuplicates(terms []string) ([]string, []int) {
 	counts := map[string]int{}
-	for _, term := range Tokenize(tokenization, in) {
+	for _, term := range terms {
 		counts[term]++
 	}
 
@@ -141,3 +141,85 @@ func TestTokenizeAndCountDuplicates(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenizeLanguages(t *testing.T) {
+	type testCase struct {
+		tokenization string
+		input        string
+		expected     []string
+	}
+
+	t.Run("tokenize", func(t *testing.T) {
+		testCases := []testCase{
+			{
+				tokenization: models.PropertyTokenizationASCIIFolding,
+				input:        "Crème brûlée in der Straße",
+				expected:     []string{"creme", "brulee", "in", "der", "strasse"},
+			},
+			{
+				tokenization: models.PropertyTokenizationASCIIFolding,
+				input:        "café naıve",
+				expected:     []string{"cafe", "naive"},
+			},
+			{
+				tokenization: models.PropertyTokenizationSnowballEnglish,
+				input:        "Running runners ran",
+				expected:     []string{"run", "runner", "ran"},
+			},
+			{
+				tokenization: models.PropertyTokenizationSnowballGerman,
+				input:        "Die Häuser der Katzen",
+				expected:     []string{"die", "haus", "der", "katz"},
+			},
+			{
+				tokenization: models.PropertyTokenizationSnowballFrench,
+				input:        "Les élèves chantaient",
+				expected:     []string{"le", "elev", "chant"},
+			},
+			{
+				tokenization: models.PropertyTokenizationCjkBigram,
+				input:        "東京都に住む Hello世界! 한",
+				expected:     []string{"東京", "京都", "都に", "に住", "住む", "hello", "世界", "한"},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.tokenization, func(t *testing.T) {
+				assert.Equal(t, tc.expected, Tokenize(tc.tokenization, tc.input))
+			})
+		}
+	})
+
+	t.Run("tokenize with wildcards", func(t *testing.T) {
+		testCases := []testCase{
+			{
+				tokenization: models.PropertyTokenizationASCIIFolding,
+				input:        "Crè* brûl?e",
+				expected:     []string{"cre*", "brul?e"},
+			},
+			{
+				tokenization: models.PropertyTokenizationSnowballEnglish,
+				input:        "Runn* runners",
+				expected:     []string{"runn*", "runners"},
+			},
+			{
+				tokenization: models.PropertyTokenizationCjkBigram,
+				input:        "東京* hel?o",
+				expected:     []string{"東京", "*", "hel?o"},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.tokenization, func(t *testing.T) {
+				assert.Equal(t, tc.expected, TokenizeWithWildcards(tc.tokenization, tc.input))
+			})
+		}
+	})
+
+	t.Run("split and transform words", func(t *testing.T) {
+		words := SplitWords(models.PropertyTokenizationSnowballEnglish, "The Running Dogs")
+		assert.Equal(t, []string{"the", "running", "dogs"}, words)
+		assert.Equal(t, []string{"the", "run", "dog"},
+			TransformWords(models.PropertyTokenizationSnowballEnglish, words))
+	})
+}
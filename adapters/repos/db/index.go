@@ -50,8 +50,9 @@ import (
 )
 
 var (
-	errTenantNotFound = errors.New("tenant not found")
-	_NUMCPU           = runtime.NumCPU()
+	errTenantNotFound  = errors.New("tenant not found")
+	errTenantNotActive = errors.New("tenant not active")
+	_NUMCPU            = runtime.NumCPU()
 )
 
 // shardMap is a syn.Map which specialized in storing shards
@@ -96,6 +97,11 @@ func (m *shardMap) CompareAndSwap(name string, old, new *Shard) bool {
 	return (*sync.Map)(m).CompareAndSwap(name, old, new)
 }
 
+// CompareAndDelete deletes the entry for key if its value is equal to old.
+func (m *shardMap) CompareAndDelete(name string, old *Shard) bool {
+	return (*sync.Map)(m).CompareAndDelete(name, old)
+}
+
 // LoadAndDelete deletes the value for a key, returning the previous value if any.
 // The loaded result reports whether the key was present.
 func (m *shardMap) LoadAndDelete(name string) (*Shard, bool) {
@@ -114,15 +120,22 @@ type Index struct {
 	shards                shardMap
 	Config                IndexConfig
 	vectorIndexUserConfig schema.VectorIndexConfig
-	getSchema             schemaUC.SchemaGetter
-	logger                logrus.FieldLogger
-	remote                *sharding.RemoteIndex
-	stopwords             *stopwords.Detector
-	replicator            *replica.Replicator
+	// vectorIndexUserConfigs holds the configs of the named vectors, keyed by
+	// target vector
+	vectorIndexUserConfigs map[string]schema.VectorIndexConfig
+	getSchema              schemaUC.SchemaGetter
+	logger                 logrus.FieldLogger
+	remote                 *sharding.RemoteIndex
+	stopwords              *stopwords.Detector
+	replicator             *replica.Replicator
 
 	backupState     BackupState
 	backupStateLock sync.RWMutex
 
+	// transfers holds the running uploads and downloads of offloaded shards
+	transfers     map[string]*shardTransfer
+	transfersLock sync.Mutex
+
 	invertedIndexConfig     schema.InvertedIndexConfig
 	invertedIndexConfigLock sync.Mutex
 
@@ -175,14 +188,15 @@ func NewIndex(ctx context.Context, config IndexConfig,
 		sg, nodeResolver, replicaClient, logger)
 
 	index := &Index{
-		Config:                config,
-		getSchema:             sg,
-		logger:                logger,
-		classSearcher:         cs,
-		vectorIndexUserConfig: vectorIndexUserConfig,
-		invertedIndexConfig:   invertedIndexConfig,
-		stopwords:             sd,
-		replicator:            repl,
+		Config:                 config,
+		getSchema:              sg,
+		logger:                 logger,
+		classSearcher:          cs,
+		vectorIndexUserConfig:  vectorIndexUserConfig,
+		vectorIndexUserConfigs: namedVectorIndexConfigs(class),
+		invertedIndexConfig:    invertedIndexConfig,
+		stopwords:              sd,
+		replicator:             repl,
 		remote: sharding.NewRemoteIndex(config.ClassName.String(), sg,
 			nodeResolver, remoteClient),
 		metrics:             NewMetrics(logger, promMetrics, config.ClassName.String(), "n/a"),
@@ -200,6 +214,10 @@ func NewIndex(ctx context.Context, config IndexConfig,
 			// do not create non-local shards
 			continue
 		}
+		if shardState.Physical[shardName].ActivityStatus() != models.TenantActivityStatusHOT {
+			// do not load shards of inactive tenants
+			continue
+		}
 
 		shard, err := NewShard(ctx, promMetrics, shardName, index, class, jobQueueCh)
 		if err != nil {
@@ -295,6 +313,27 @@ func (i *Index) updateVectorIndexConfig(ctx context.Context,
 	})
 }
 
+func (i *Index) updateVectorIndexConfigs(ctx context.Context,
+	updated map[string]schema.VectorIndexConfig,
+) error {
+	configs := make(map[string]schema.VectorIndexConfig, len(i.vectorIndexUserConfigs))
+	for targetVector, cfg := range i.vectorIndexUserConfigs {
+		configs[targetVector] = cfg
+	}
+	for targetVector, cfg := range updated {
+		configs[targetVector] = cfg
+	}
+	i.vectorIndexUserConfigs = configs
+
+	// an updated is not specific to one shard, but rather all
+	return i.ForEachShard(func(name string, shard *Shard) error {
+		if err := shard.updateVectorIndexConfigs(ctx, updated); err != nil {
+			return errors.Wrapf(err, "shard %s", name)
+		}
+		return nil
+	})
+}
+
 func (i *Index) getInvertedIndexConfig() schema.InvertedIndexConfig {
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
@@ -335,7 +374,10 @@ func indexID(class schema.ClassName) string {
 func (i *Index) determineObjectShard(id strfmt.UUID, tenant string) (string, error) {
 	className := i.Config.ClassName.String()
 	if tenant != "" {
-		if shard := i.getSchema.TenantShard(className, tenant); shard != "" {
+		if shard, status := i.getSchema.TenantShard(className, tenant); shard != "" {
+			if status != models.TenantActivityStatusHOT {
+				return "", objects.NewErrMultiTenancy(fmt.Errorf("%w: %q", errTenantNotActive, tenant))
+			}
 			return shard, nil
 		}
 		return "", objects.NewErrMultiTenancy(fmt.Errorf("%w: %q", errTenantNotFound, tenant))
@@ -679,7 +721,9 @@ func (i *Index) IncomingBatchAddReferences(ctx context.Context, shardName string
 func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 	props search.SelectProperties, addl additional.Properties,
 	replProps *additional.ReplicationProperties, tenant string,
-) (*storobj.Object, error) {
+) (obj *storobj.Object, err error) {
+	defer func() { i.removeDeletedProperties(obj) }()
+
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, err
 	}
@@ -694,8 +738,6 @@ func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
 		}
 	}
 
-	var obj *storobj.Object
-
 	if i.replicationEnabled() {
 		if replProps == nil {
 			replProps = defaultConsistency()
@@ -759,7 +801,9 @@ func (i *Index) IncomingMultiGetObjects(ctx context.Context, shardName string,
 
 func (i *Index) multiObjectByID(ctx context.Context,
 	query []multi.Identifier, tenant string,
-) ([]*storobj.Object, error) {
+) (out []*storobj.Object, err error) {
+	defer func() { i.removeDeletedProperties(out...) }()
+
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, err
 	}
@@ -782,7 +826,7 @@ func (i *Index) multiObjectByID(ctx context.Context,
 		byShard[shardName] = group
 	}
 
-	out := make([]*storobj.Object, len(query))
+	out = make([]*storobj.Object, len(query))
 
 	for shardName, group := range byShard {
 
@@ -889,7 +933,9 @@ func (i *Index) IncomingExists(ctx context.Context, shardName string,
 func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	addlProps additional.Properties, replProps *additional.ReplicationProperties, tenant string, autoCut int,
-) ([]*storobj.Object, []float32, error) {
+) (objs []*storobj.Object, scores []float32, err error) {
+	defer func() { i.removeDeletedProperties(objs...) }()
+
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, nil, err
 	}
@@ -1040,7 +1086,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 				}
 			} else {
 				objs, scores, err = i.remote.SearchShard(
-					ctx, shardName, nil, limit, filters, keywordRanking,
+					ctx, shardName, nil, "", limit, filters, keywordRanking,
 					sort, cursor, nil, addlProps, i.replicationEnabled())
 				if err != nil {
 					return fmt.Errorf(
@@ -1125,13 +1171,13 @@ func (i *Index) mergeGroups(objects []*storobj.Object, dists []float32,
 }
 
 func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVector []float32,
-	dist float32, limit int, filters *filters.LocalFilter,
+	targetVector string, dist float32, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
 	shardName string,
 ) ([]*storobj.Object, []float32, error) {
 	shard := i.shards.Load(shardName)
 	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+		ctx, searchVector, targetVector, dist, limit, filters, sort, groupBy, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1147,7 +1193,10 @@ func (i *Index) targetShardNames(tenant string) ([]string, error) {
 		return shardingState.AllPhysicalShards(), nil
 	}
 	if tenant != "" {
-		if shard := i.getSchema.TenantShard(className, tenant); shard != "" {
+		if shard, status := i.getSchema.TenantShard(className, tenant); shard != "" {
+			if status != models.TenantActivityStatusHOT {
+				return nil, objects.NewErrMultiTenancy(fmt.Errorf("%w: %q", errTenantNotActive, tenant))
+			}
 			return []string{shard}, nil
 		}
 	}
@@ -1155,10 +1204,12 @@ func (i *Index) targetShardNames(tenant string) ([]string, error) {
 }
 
 func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
-	dist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort,
+	targetVector string, dist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort,
 	groupBy *searchparams.GroupBy, additional additional.Properties,
 	replProps *additional.ReplicationProperties, tenant string,
-) ([]*storobj.Object, []float32, error) {
+) (objs []*storobj.Object, scores []float32, err error) {
+	defer func() { i.removeDeletedProperties(objs...) }()
+
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, nil, err
 	}
@@ -1169,7 +1220,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 
 	if len(shardNames) == 1 {
 		if i.localShard(shardNames[0]) != nil {
-			return i.singleLocalShardObjectVectorSearch(ctx, searchVector, dist, limit, filters,
+			return i.singleLocalShardObjectVectorSearch(ctx, searchVector, targetVector, dist, limit, filters,
 				sort, groupBy, additional, shardNames[0])
 		}
 	}
@@ -1198,7 +1249,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 
 			if shard := i.localShard(shardName); shard != nil {
 				res, resDists, err = shard.objectVectorSearch(
-					ctx, searchVector, dist, limit, filters, sort, groupBy, additional)
+					ctx, searchVector, targetVector, dist, limit, filters, sort, groupBy, additional)
 				if err != nil {
 					return errors.Wrapf(err, "shard %s", shard.ID())
 				}
@@ -1207,7 +1258,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 				}
 			} else {
 				res, resDists, err = i.remote.SearchShard(ctx,
-					shardName, searchVector, limit, filters,
+					shardName, searchVector, targetVector, limit, filters,
 					nil, sort, nil, groupBy, additional, i.replicationEnabled())
 				if err != nil {
 					return errors.Wrapf(err, "remote shard %s", shardName)
@@ -1261,7 +1312,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 }
 
 func (i *Index) IncomingSearch(ctx context.Context, shardName string,
-	searchVector []float32, distance float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, targetVector string, distance float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	additional additional.Properties,
@@ -1281,7 +1332,7 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	}
 
 	res, resDists, err := shard.objectVectorSearch(
-		ctx, searchVector, distance, limit, filters, sort, groupBy, additional)
+		ctx, searchVector, targetVector, distance, limit, filters, sort, groupBy, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1524,6 +1575,52 @@ func (i *Index) dropShards(names []string) (commit func(success bool), err error
 	return commit, eg.Wait()
 }
 
+// unloadShards shuts down the given shards and removes them from memory.
+// Contrary to dropShards the files of the shards are kept on disk.
+func (i *Index) unloadShards(names []string) {
+	i.backupStateLock.RLock()
+	defer i.backupStateLock.RUnlock()
+
+	var eg errgroup.Group
+	eg.SetLimit(_NUMCPU * 2)
+	for _, name := range names {
+		shard, _ := i.shards.LoadAndDelete(name)
+		if shard == nil {
+			continue
+		}
+		eg.Go(func() error {
+			if err := shard.shutdown(context.Background()); err != nil {
+				i.logger.WithField("action", "unload_shard").
+					WithField("shard", shard.ID()).Error(err)
+			}
+			return nil
+		})
+	}
+	eg.Wait()
+}
+
+// dropOffloadedShards removes the given shards from memory and deletes their
+// files from disk, as they are stored in the offload backend.
+func (i *Index) dropOffloadedShards(shards []*Shard) {
+	i.backupStateLock.RLock()
+	defer i.backupStateLock.RUnlock()
+
+	var eg errgroup.Group
+	eg.SetLimit(_NUMCPU * 2)
+	for _, shard := range shards {
+		shard := shard
+		i.shards.CompareAndDelete(shard.name, shard)
+		eg.Go(func() error {
+			if err := shard.drop(); err != nil {
+				i.logger.WithField("action", "drop_offloaded_shard").
+					WithField("shard", shard.ID()).Error(err)
+			}
+			return nil
+		})
+	}
+	eg.Wait()
+}
+
 func (i *Index) Shutdown(ctx context.Context) error {
 	i.backupStateLock.RLock()
 	defer i.backupStateLock.RUnlock()
@@ -1567,6 +1664,10 @@ func (i *Index) getShardsStatus(ctx context.Context) (map[string]string, error)
 	shardNames := shardState.AllPhysicalShards()
 
 	for _, shardName := range shardNames {
+		if shardState.Physical[shardName].ActivityStatus() != models.TenantActivityStatusHOT {
+			// shards of inactive tenants are not loaded
+			continue
+		}
 		var err error
 		var status string
 		if !shardState.IsLocalShard(shardName) {
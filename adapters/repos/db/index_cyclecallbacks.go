@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
@@ -43,8 +44,11 @@ type indexCycleCallbacks struct {
 
 func (index *Index) initCycleCallbacks() {
 	vectorTombstoneCleanupIntervalSeconds := hnsw.DefaultCleanupIntervalSeconds
-	if hnswUserConfig, ok := index.vectorIndexUserConfig.(hnsw.UserConfig); ok {
-		vectorTombstoneCleanupIntervalSeconds = hnswUserConfig.CleanupIntervalSeconds
+	switch vectorIndexUserConfig := index.vectorIndexUserConfig.(type) {
+	case hnsw.UserConfig:
+		vectorTombstoneCleanupIntervalSeconds = vectorIndexUserConfig.CleanupIntervalSeconds
+	case dynamic.UserConfig:
+		vectorTombstoneCleanupIntervalSeconds = vectorIndexUserConfig.HnswUC.CleanupIntervalSeconds
 	}
 
 	id := func(elems ...string) string {
@@ -31,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 func TestIndex_DropIndex(t *testing.T) {
@@ -51,6 +52,52 @@ func TestIndex_DropIndex(t *testing.T) {
 	assert.Equal(t, 0, len(indexFilesAfterDelete))
 }
 
+func TestIndex_TenantActivityStatus(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "TenantActivity",
+		InvertedIndexConfig: &models.InvertedIndexConfig{},
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+	}
+	fakeSchema := schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+	config, err := sharding.ParseConfig(nil, 1)
+	require.Nil(t, err)
+	shardState, err := sharding.InitState("tenant-activity", config,
+		fakeNodes{[]string{"node1"}}, 1, true)
+	require.Nil(t, err)
+	shardState.AddPartition("hot", []string{"node1"}, models.TenantActivityStatusHOT)
+	shardState.AddPartition("cold", []string{"node1"}, models.TenantActivityStatusCOLD)
+
+	index, err := NewIndex(testCtx(), IndexConfig{
+		RootPath:  dirName,
+		ClassName: schema.ClassName(class.Class),
+	}, shardState, inverted.ConfigFromModel(class.InvertedIndexConfig),
+		hnsw.NewDefaultUserConfig(), &fakeSchemaGetter{
+			schema: fakeSchema, shardState: shardState,
+		}, nil, logger, nil, nil, nil, nil, class, nil)
+	require.Nil(t, err)
+	defer index.Shutdown(context.Background())
+
+	assert.NotNil(t, index.shards.Load("hot"), "hot tenant is loaded")
+	assert.Nil(t, index.shards.Load("cold"), "cold tenant is not loaded")
+
+	id := strfmt.UUID("1295c052-263d-4aae-99dd-920c5a370d06")
+	shard, err := index.determineObjectShard(id, "hot")
+	require.Nil(t, err)
+	assert.Equal(t, "hot", shard)
+
+	_, err = index.determineObjectShard(id, "cold")
+	assert.ErrorContains(t, err, errTenantNotActive.Error())
+	_, err = index.targetShardNames("cold")
+	assert.ErrorContains(t, err, errTenantNotActive.Error())
+
+	index.unloadShards([]string{"hot"})
+	assert.Nil(t, index.shards.Load("hot"), "hot tenant is unloaded")
+}
+
 func TestIndex_DropEmptyAndRecreateEmptyIndex(t *testing.T) {
 	dirName := t.TempDir()
 	class := &models.Class{Class: "deletetest"}
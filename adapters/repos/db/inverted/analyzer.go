@@ -25,6 +25,9 @@ type IsFallbackToSearchable func() bool
 type Countable struct {
 	Data          []byte
 	TermFrequency float32
+	// Positions at which the term occurs in the tokenized input. Only set for
+	// properties which index token positions, see HasPositionsIndex
+	Positions []uint32
 }
 
 type Property struct {
@@ -70,6 +73,39 @@ func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
 	return countable
 }
 
+// positionGap is added between the positions of consecutive elements of a
+// text array, so that phrases can not match across elements
+const positionGap = 100
+
+// TextArrayWithPositions tokenizes given input according to selected
+// tokenization, then aggregates duplicates while keeping the position of
+// every occurrence of a term
+func (a *Analyzer) TextArrayWithPositions(tokenization string, inArr []string) []Countable {
+	positions := map[string][]uint32{}
+	position := uint32(0)
+	for i, in := range inArr {
+		if i > 0 {
+			position += positionGap
+		}
+		for _, term := range helpers.Tokenize(tokenization, in) {
+			positions[term] = append(positions[term], position)
+			position++
+		}
+	}
+
+	countable := make([]Countable, len(positions))
+	i := 0
+	for term, termPositions := range positions {
+		countable[i] = Countable{
+			Data:          []byte(term),
+			TermFrequency: float32(len(termPositions)),
+			Positions:     termPositions,
+		}
+		i++
+	}
+	return countable
+}
+
 // Int requires no analysis, so it's actually just a simple conversion to a
 // string-formatted byte slice of the int
 func (a *Analyzer) Int(in int64) ([]Countable, error) {
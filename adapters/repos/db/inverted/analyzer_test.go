@@ -317,6 +317,31 @@ func TestAnalyzer(t *testing.T) {
 	})
 }
 
+func TestAnalyzer_TextArrayWithPositions(t *testing.T) {
+	a := NewAnalyzer(nil)
+
+	t.Run("with a single text", func(t *testing.T) {
+		res := a.TextArrayWithPositions(models.PropertyTokenizationWord,
+			[]string{"Du. Du hast. Du hast mich gefragt."})
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("du"), TermFrequency: 3, Positions: []uint32{0, 1, 3}},
+			{Data: []byte("hast"), TermFrequency: 2, Positions: []uint32{2, 4}},
+			{Data: []byte("mich"), TermFrequency: 1, Positions: []uint32{5}},
+			{Data: []byte("gefragt"), TermFrequency: 1, Positions: []uint32{6}},
+		}, res)
+	})
+
+	t.Run("with a text array, elements are separated by a gap", func(t *testing.T) {
+		res := a.TextArrayWithPositions(models.PropertyTokenizationWhitespace,
+			[]string{"new york", "york city"})
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("new"), TermFrequency: 1, Positions: []uint32{0}},
+			{Data: []byte("york"), TermFrequency: 2, Positions: []uint32{1, 2 + positionGap}},
+			{Data: []byte("city"), TermFrequency: 1, Positions: []uint32{3 + positionGap}},
+		}, res)
+	})
+}
+
 func TestAnalyzer_DefaultEngPreset(t *testing.T) {
 	countable := func(data []string, freq []int) []Countable {
 		countable := make([]Countable, len(data))
@@ -0,0 +1,291 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+)
+
+// phrase is a quoted part of a bm25 query, e.g. "exact phrase" or
+// "exact phrase"~2. A document only matches if the terms of the phrase occur
+// in the same order next to each other, or with at most slop positions in
+// between.
+type phrase struct {
+	text string
+	slop int
+}
+
+var phraseRegexp = regexp.MustCompile(`"([^"]*)"(~(\d+))?`)
+
+// extractPhrases returns the phrases of the query and the query with the
+// phrase syntax removed. The terms of the phrases are kept in the returned
+// query, so that they are scored like every other term.
+func extractPhrases(query string) ([]phrase, string) {
+	var phrases []phrase
+	plain := phraseRegexp.ReplaceAllStringFunc(query, func(match string) string {
+		groups := phraseRegexp.FindStringSubmatch(match)
+		slop := 0
+		if groups[3] != "" {
+			slop, _ = strconv.Atoi(groups[3])
+		}
+		if strings.TrimSpace(groups[1]) != "" {
+			phrases = append(phrases, phrase{text: groups[1], slop: slop})
+		}
+		return " " + groups[1] + " "
+	})
+
+	return phrases, plain
+}
+
+// phraseToken is a term of a phrase together with its offset within the
+// phrase. Offsets of removed stopwords are skipped, so that the remaining
+// terms keep their distance to each other.
+type phraseToken struct {
+	term   string
+	offset int
+}
+
+func tokenizePhrase(tokenization string, text string,
+	detector *stopwords.Detector,
+) []phraseToken {
+	if !helpers.IsWordTokenization(tokenization) {
+		terms := helpers.Tokenize(tokenization, text)
+		tokens := make([]phraseToken, len(terms))
+		for i, term := range terms {
+			tokens[i] = phraseToken{term: term, offset: i}
+		}
+		return tokens
+	}
+
+	// stopwords are removed before the words are stemmed or folded, every
+	// word is turned into exactly one term
+	words := helpers.SplitWords(tokenization, text)
+	kept := make([]string, 0, len(words))
+	tokens := make([]phraseToken, 0, len(words))
+	for i, word := range words {
+		if detector != nil && detector.IsStopword(word) {
+			continue
+		}
+		kept = append(kept, word)
+		tokens = append(tokens, phraseToken{offset: i})
+	}
+	for i, term := range helpers.TransformWords(tokenization, kept) {
+		tokens[i].term = term
+	}
+	return tokens
+}
+
+type termPositions struct {
+	positions  []uint32
+	propLength float32
+}
+
+// phraseTerm matches the phrase on all given properties and returns it as a
+// term which is scored alongside the regular query terms. Its frequency in a
+// document is the sum of the proximity of each match, so that closer matches
+// rank higher. Only documents contained in filterDocIds are kept, the
+// document frequency used for the idf includes the filtered ones.
+func (b *BM25Searcher) phraseTerm(N float64, filterDocIds helpers.AllowList,
+	p phrase, propNamesByTokenization map[string][]string,
+	propertyBoosts map[string]float32, detector *stopwords.Detector,
+) (term, map[uint64]int, error) {
+	termResult := term{queryTerm: "\"" + p.text + "\""}
+	indices := map[uint64]int{}
+	matchedDocIDs := sroar.NewBitmap()
+
+	for tokenization, propNames := range propNamesByTokenization {
+		tokens := tokenizePhrase(tokenization, p.text, detector)
+		if len(tokens) == 0 {
+			continue
+		}
+
+		for _, propName := range propNames {
+			matches, err := b.matchPhrase(propName, tokens, p.slop)
+			if err != nil {
+				return termResult, nil, err
+			}
+
+			for docID, match := range matches {
+				matchedDocIDs.Set(docID)
+				if filterDocIds != nil && !filterDocIds.Contains(docID) {
+					continue
+				}
+				if ind, ok := indices[docID]; ok {
+					termResult.data[ind].frequency += match.frequency * propertyBoosts[propName]
+					termResult.data[ind].propLength += match.propLength
+					continue
+				}
+				indices[docID] = len(termResult.data)
+				termResult.data = append(termResult.data, docPointerWithScore{
+					id:         docID,
+					frequency:  match.frequency * propertyBoosts[propName],
+					propLength: match.propLength,
+				})
+			}
+		}
+	}
+
+	if len(termResult.data) == 0 {
+		termResult.exhausted = true
+		return termResult, indices, nil
+	}
+
+	sort.Slice(termResult.data, func(i, j int) bool {
+		return termResult.data[i].id < termResult.data[j].id
+	})
+	for i := range termResult.data {
+		indices[termResult.data[i].id] = i
+	}
+
+	n := float64(matchedDocIDs.GetCardinality())
+	termResult.idf = math.Log(float64(1) + (N-n+0.5)/(n+0.5))
+	termResult.posPointer = 0
+	termResult.idPointer = termResult.data[0].id
+	return termResult, indices, nil
+}
+
+type phraseMatch struct {
+	frequency  float32
+	propLength float32
+}
+
+// matchPhrase returns the documents in which the phrase occurs within the
+// given property
+func (b *BM25Searcher) matchPhrase(propName string, tokens []phraseToken,
+	slop int,
+) (map[uint64]phraseMatch, error) {
+	bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+	if bucket == nil {
+		return nil, fmt.Errorf("could not find bucket for property %v", propName)
+	}
+
+	positionsByTerm := map[string]map[uint64]termPositions{}
+	for _, token := range tokens {
+		if _, ok := positionsByTerm[token.term]; ok {
+			continue
+		}
+
+		pairs, err := bucket.MapList([]byte(token.term))
+		if err != nil {
+			return nil, err
+		}
+		positions := make(map[uint64]termPositions, len(pairs))
+		for _, pair := range pairs {
+			if len(pair.Value) <= 8 {
+				// no positions stored, e.g. if the object was imported before
+				// positions were indexed
+				continue
+			}
+			docPositions := termPositions{
+				positions:  make([]uint32, (len(pair.Value)-8)/4),
+				propLength: math.Float32frombits(binary.LittleEndian.Uint32(pair.Value[4:8])),
+			}
+			for i := range docPositions.positions {
+				docPositions.positions[i] = binary.LittleEndian.Uint32(pair.Value[8+4*i : 12+4*i])
+			}
+			positions[binary.BigEndian.Uint64(pair.Key)] = docPositions
+		}
+		positionsByTerm[token.term] = positions
+	}
+
+	// candidates are the documents which contain every term of the phrase,
+	// starting with the rarest term keeps the number of lookups low
+	rarest := positionsByTerm[tokens[0].term]
+	for _, positions := range positionsByTerm {
+		if len(positions) < len(rarest) {
+			rarest = positions
+		}
+	}
+
+	matches := map[uint64]phraseMatch{}
+	docPositions := make([][]uint32, len(tokens))
+	offsets := make([]int, len(tokens))
+	for i, token := range tokens {
+		offsets[i] = token.offset
+	}
+
+Candidates:
+	for docID, candidate := range rarest {
+		for i, token := range tokens {
+			positions, ok := positionsByTerm[token.term][docID]
+			if !ok {
+				continue Candidates
+			}
+			docPositions[i] = positions.positions
+		}
+
+		if frequency := phraseFrequency(docPositions, offsets, slop); frequency > 0 {
+			matches[docID] = phraseMatch{
+				frequency:  frequency,
+				propLength: candidate.propLength,
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+// phraseFrequency returns how often the phrase occurs in a document given the
+// positions of each of its terms. A match requires the terms to be at their
+// offset within the phrase, relative to each other, allowing them to be off
+// by at most slop positions in total. Each match adds 1/(1+distance), where
+// distance is how far the terms are off, so exact matches count the most.
+func phraseFrequency(positions [][]uint32, offsets []int, slop int) float32 {
+	frequency := float32(0)
+
+Anchors:
+	for _, anchor := range positions[0] {
+		start := int(anchor) - offsets[0]
+		minDelta, maxDelta := 0, 0
+		for i := 1; i < len(positions); i++ {
+			expected := start + offsets[i]
+			delta := closestPosition(positions[i], expected) - expected
+			if delta < minDelta {
+				minDelta = delta
+			}
+			if delta > maxDelta {
+				maxDelta = delta
+			}
+			if maxDelta-minDelta > slop {
+				continue Anchors
+			}
+		}
+		frequency += 1 / float32(1+maxDelta-minDelta)
+	}
+
+	return frequency
+}
+
+// closestPosition returns the position of the sorted positions which is
+// closest to the expected one
+func closestPosition(positions []uint32, expected int) int {
+	i := sort.Search(len(positions), func(i int) bool {
+		return int(positions[i]) >= expected
+	})
+	if i == len(positions) {
+		return int(positions[i-1])
+	}
+	if i > 0 && expected-int(positions[i-1]) < int(positions[i])-expected {
+		return int(positions[i-1])
+	}
+	return int(positions[i])
+}
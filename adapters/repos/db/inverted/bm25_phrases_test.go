@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestExtractPhrases(t *testing.T) {
+	type testCase struct {
+		name            string
+		query           string
+		expectedPhrases []phrase
+		expectedQuery   string
+	}
+
+	testCases := []testCase{
+		{
+			name:          "without phrases",
+			query:         "breach of contract",
+			expectedQuery: "breach of contract",
+		},
+		{
+			name:            "with an exact phrase",
+			query:           `"breach of contract" damages`,
+			expectedPhrases: []phrase{{text: "breach of contract"}},
+			expectedQuery:   " breach of contract  damages",
+		},
+		{
+			name:  "with slop",
+			query: `"breach contract"~2 and "force majeure"`,
+			expectedPhrases: []phrase{
+				{text: "breach contract", slop: 2},
+				{text: "force majeure"},
+			},
+			expectedQuery: " breach contract  and  force majeure ",
+		},
+		{
+			name:          "with an empty phrase",
+			query:         `"" damages`,
+			expectedQuery: "   damages",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			phrases, query := extractPhrases(tc.query)
+			assert.Equal(t, tc.expectedPhrases, phrases)
+			assert.Equal(t, tc.expectedQuery, query)
+		})
+	}
+}
+
+func TestTokenizePhrase(t *testing.T) {
+	detector, err := stopwords.NewDetectorFromPreset(stopwords.EnglishPreset)
+	assert.Nil(t, err)
+
+	tokens := tokenizePhrase(models.PropertyTokenizationWord, "Breach of the Contract", detector)
+	assert.Equal(t, []phraseToken{
+		{term: "breach", offset: 0},
+		{term: "contract", offset: 3},
+	}, tokens)
+
+	tokens = tokenizePhrase(models.PropertyTokenizationWhitespace, "Breach of Contract", detector)
+	assert.Equal(t, []phraseToken{
+		{term: "Breach", offset: 0},
+		{term: "of", offset: 1},
+		{term: "Contract", offset: 2},
+	}, tokens)
+}
+
+func TestPhraseFrequency(t *testing.T) {
+	type testCase struct {
+		name      string
+		positions [][]uint32
+		offsets   []int
+		slop      int
+		expected  float32
+	}
+
+	testCases := []testCase{
+		{
+			name:      "exact match",
+			positions: [][]uint32{{3}, {4}},
+			offsets:   []int{0, 1},
+			expected:  1,
+		},
+		{
+			name:      "multiple exact matches",
+			positions: [][]uint32{{3, 10}, {4, 11}},
+			offsets:   []int{0, 1},
+			expected:  2,
+		},
+		{
+			name:      "terms too far apart without slop",
+			positions: [][]uint32{{3}, {5}},
+			offsets:   []int{0, 1},
+			expected:  0,
+		},
+		{
+			name:      "terms within slop",
+			positions: [][]uint32{{3}, {5}},
+			offsets:   []int{0, 1},
+			slop:      1,
+			expected:  0.5,
+		},
+		{
+			name:      "reversed terms need a slop of two",
+			positions: [][]uint32{{4}, {3}},
+			offsets:   []int{0, 1},
+			slop:      2,
+			expected:  1.0 / 3,
+		},
+		{
+			name:      "with a gap for a removed stopword",
+			positions: [][]uint32{{3}, {5}},
+			offsets:   []int{0, 2},
+			expected:  1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, phraseFrequency(tc.positions, tc.offsets, tc.slop), 0.0001)
+		})
+	}
+}
@@ -109,16 +109,14 @@ func (b *BM25Searcher) wand(
 		}
 	}
 
-	// There are currently cases, for different tokenization:
-	// word, lowercase, whitespace and field.
-	// Query is tokenized and respective properties are then searched for the search terms,
-	// results at the end are combined using WAND
-	tokenizationsOrdered := []string{
-		models.PropertyTokenizationWord,
-		models.PropertyTokenizationLowercase,
-		models.PropertyTokenizationWhitespace,
-		models.PropertyTokenizationField,
-	}
+	// Query is tokenized once for every tokenization and respective properties
+	// are then searched for the search terms, results at the end are combined
+	// using WAND
+	tokenizationsOrdered := helpers.Tokenizations
+
+	// quoted phrases restrict the results to the documents containing them,
+	// their terms are scored as part of the regular query nonetheless
+	phrases, query := extractPhrases(params.Query)
 
 	queryTermsByTokenization := map[string][]string{}
 	duplicateBoostsByTokenization := map[string][]int{}
@@ -126,16 +124,21 @@ func (b *BM25Searcher) wand(
 	propertyBoosts := make(map[string]float32, len(params.Properties))
 
 	for _, tokenization := range tokenizationsOrdered {
-		queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = helpers.TokenizeAndCountDuplicates(tokenization, params.Query)
-
-		// stopword filtering for word tokenization
-		if tokenization == models.PropertyTokenizationWord {
-			queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = b.removeStopwordsFromQueryTerms(queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization], stopWordDetector)
+		if helpers.IsWordTokenization(tokenization) {
+			// stopword filtering for word tokenizations, stopwords are removed
+			// before the words are stemmed or folded
+			words := b.removeStopwords(helpers.SplitWords(tokenization, query), stopWordDetector)
+			queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = helpers.CountDuplicates(helpers.TransformWords(tokenization, words))
+		} else {
+			queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization] = helpers.TokenizeAndCountDuplicates(tokenization, query)
 		}
 
 		propNamesByTokenization[tokenization] = make([]string, 0)
 	}
 
+	// phrases can only be matched on properties which index token positions
+	phrasePropNamesByTokenization := map[string][]string{}
+
 	averagePropLength := 0.
 	for _, propertyWithBoost := range params.Properties {
 		property := propertyWithBoost
@@ -153,7 +156,7 @@ func (b *BM25Searcher) wand(
 		}
 		averagePropLength += float64(propMean)
 
-		prop, err := schema.GetPropertyByName(class, property)
+		prop, err := schema.GetNestedPropertyByPath(class, property)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -165,6 +168,9 @@ func (b *BM25Searcher) wand(
 					prop.Tokenization, prop.Name)
 			}
 			propNamesByTokenization[prop.Tokenization] = append(propNamesByTokenization[prop.Tokenization], property)
+			if HasPositionsIndex(prop) {
+				phrasePropNamesByTokenization[prop.Tokenization] = append(phrasePropNamesByTokenization[prop.Tokenization], property)
+			}
 		default:
 			return nil, nil, fmt.Errorf("cannot handle datatype '%v' of property '%s'", dt, prop.Name)
 		}
@@ -172,6 +178,55 @@ func (b *BM25Searcher) wand(
 
 	averagePropLength = averagePropLength / float64(len(params.Properties))
 
+	phraseResults := make(terms, 0, len(phrases))
+	phraseIndices := make([]map[uint64]int, 0, len(phrases))
+	if len(phrases) > 0 {
+		if len(phrasePropNamesByTokenization) == 0 {
+			return nil, nil, fmt.Errorf("phrase queries require token positions, " +
+				"set `indexPositions: true` on at least one of the searched properties")
+		}
+
+		for _, p := range phrases {
+			phraseResult, docIndices, err := b.phraseTerm(N, filterDocIds, p,
+				phrasePropNamesByTokenization, propertyBoosts, stopWordDetector)
+			if err != nil {
+				return nil, nil, err
+			}
+			if phraseResult.exhausted {
+				// every phrase is required, there is nothing left to score
+				return []*storobj.Object{}, []float32{}, nil
+			}
+			phraseResults = append(phraseResults, phraseResult)
+			phraseIndices = append(phraseIndices, docIndices)
+		}
+
+		// only documents containing every phrase are considered for the
+		// remaining terms
+		allowed := sroar.NewBitmap()
+		for _, data := range phraseResults[0].data {
+			allowed.Set(data.id)
+		}
+		for _, phraseResult := range phraseResults[1:] {
+			matched := sroar.NewBitmap()
+			for _, data := range phraseResult.data {
+				matched.Set(data.id)
+			}
+			allowed.And(matched)
+		}
+		if allowed.IsEmpty() {
+			return []*storobj.Object{}, []float32{}, nil
+		}
+		filterDocIds = helpers.NewAllowListFromBitmap(allowed)
+		for i := range phraseResults {
+			phraseResults[i].data = keepAllowed(phraseResults[i].data, filterDocIds)
+			phraseResults[i].idPointer = phraseResults[i].data[0].id
+			phraseIndices[i] = make(map[uint64]int, len(phraseResults[i].data))
+			for j := range phraseResults[i].data {
+				phraseIndices[i][phraseResults[i].data[j].id] = j
+			}
+		}
+	}
+
 	// preallocate the results
 	lengthAllResults := 0
 	for tokenization, propNames := range propNamesByTokenization {
@@ -214,6 +269,9 @@ func (b *BM25Searcher) wand(
 	if err := eg.Wait(); err != nil {
 		return nil, nil, err
 	}
+	results = append(results, phraseResults...)
+	indices = append(indices, phraseIndices...)
+
 	// all results. Sum up the length of the results from all terms to get an upper bound of how many results there are
 	if limit == 0 {
 		for _, ind := range indices {
@@ -226,35 +284,24 @@ func (b *BM25Searcher) wand(
 	copy(resultsOriginalOrder, results)
 
 	topKHeap := b.getTopKHeap(limit, results, averagePropLength)
-	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations)
+	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, class, params.AdditionalExplanations)
 }
 
-func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string, duplicateBoost []int, detector *stopwords.Detector) ([]string, []int) {
-	if detector == nil || len(queryTerms) == 0 {
-		return queryTerms, duplicateBoost
+func (b *BM25Searcher) removeStopwords(words []string, detector *stopwords.Detector) []string {
+	if detector == nil || len(words) == 0 {
+		return words
 	}
 
-	i := 0
-WordLoop:
-	for {
-		if i == len(queryTerms) {
-			return queryTerms, duplicateBoost
-		}
-		queryTerm := queryTerms[i]
-		if detector.IsStopword(queryTerm) {
-			queryTerms[i] = queryTerms[len(queryTerms)-1]
-			queryTerms = queryTerms[:len(queryTerms)-1]
-			duplicateBoost[i] = duplicateBoost[len(duplicateBoost)-1]
-			duplicateBoost = duplicateBoost[:len(duplicateBoost)-1]
-
-			continue WordLoop
+	filtered := words[:0]
+	for _, word := range words {
+		if !detector.IsStopword(word) {
+			filtered = append(filtered, word)
 		}
-
-		i++
 	}
+	return filtered
 }
 
-func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results terms, indices []map[uint64]int, additionalExplanations bool) ([]*storobj.Object, []float32, error) {
+func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results terms, indices []map[uint64]int, class *models.Class, additionalExplanations bool) ([]*storobj.Object, []float32, error) {
 	objectsBucket := b.store.Bucket(helpers.ObjectsBucketLSM)
 	if objectsBucket == nil {
 		return nil, nil, errors.Errorf("objects bucket not found")
@@ -277,7 +324,7 @@ func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue, results ter
 			continue
 		}
 
-		obj, err := storobj.FromBinary(objectByte)
+		obj, err := storobj.FromBinaryWithClass(objectByte, class)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -449,6 +496,18 @@ func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, que
 	return termResult, docMapPairsIndices, nil
 }
 
+// keepAllowed returns the entries of the sorted data whose doc ids are
+// contained in the allow list
+func keepAllowed(data []docPointerWithScore, allowList helpers.AllowList) []docPointerWithScore {
+	out := data[:0]
+	for _, entry := range data {
+		if allowList.Contains(entry.id) {
+			out = append(out, entry)
+		}
+	}
+	return out
+}
+
 type term struct {
 	// doubles as max impact (with tf=1, the max impact would be 1*idf), if there
 	// is a boost for a queryTerm, simply apply it here once
@@ -607,7 +666,7 @@ func PropertyHasSearchableIndex(schemaDefinition *models.Schema, className, tent
 	if err != nil {
 		return false
 	}
-	p, err := schema.GetPropertyByName(c, propertyName)
+	p, err := schema.GetNestedPropertyByPath(c, propertyName)
 	if err != nil {
 		return false
 	}
@@ -94,7 +94,7 @@ func validateStopwordConfig(conf *models.StopwordConfig) error {
 		conf.Preset = stopwords.EnglishPreset
 	}
 
-	if _, ok := stopwords.Presets[conf.Preset]; !ok {
+	if _, ok := stopwords.Preset(conf.Preset); !ok {
 		return errors.Errorf("stopwordPreset '%s' does not exist", conf.Preset)
 	}
 
@@ -139,7 +139,7 @@ func validateStopwordAdditionsRemovals(conf *models.StopwordConfig) error {
 }
 
 func removeStopwordAdditionsIfInPreset(conf *models.StopwordConfig, foundAdditions map[string]int) {
-	presets := stopwords.Presets[conf.Preset]
+	presets, _ := stopwords.Preset(conf.Preset)
 
 	// if any of the elements in stopwords.additions
 	// already exist in the preset, mark it as to
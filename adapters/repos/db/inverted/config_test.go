@@ -16,6 +16,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -80,6 +82,23 @@ func TestValidateConfig(t *testing.T) {
 		assert.EqualError(t, err, "stopwordPreset 'DNE' does not exist")
 	})
 
+	t.Run("with registered custom stopword preset", func(t *testing.T) {
+		require.Nil(t, stopwords.RegisterPresets(map[string][]string{
+			"legal": {"hereby", "whereas"},
+		}))
+
+		in := &models.InvertedIndexConfig{
+			Stopwords: &models.StopwordConfig{
+				Preset:    "legal",
+				Additions: []string{"hereby", "thereof"},
+			},
+		}
+
+		err := ValidateConfig(in)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"thereof"}, in.Stopwords.Additions)
+	})
+
 	t.Run("with whitespace stopword additions", func(t *testing.T) {
 		additions := [][]string{
 			{"bats", " "},
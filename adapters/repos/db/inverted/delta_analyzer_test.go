@@ -276,4 +276,34 @@ func TestDeltaAnalyzer(t *testing.T) {
 		assert.Equal(t, expectedAdd, res.ToAdd)
 		assert.Equal(t, expectedDelete, res.ToDelete)
 	})
+	t.Run("with previous indexing - changed positions only", func(t *testing.T) {
+		previous := []Property{
+			{
+				Name: "prop1",
+				Items: []Countable{
+					{
+						Data:          []byte("value1"),
+						TermFrequency: 1,
+						Positions:     []uint32{0},
+					},
+				},
+			},
+		}
+		next := []Property{
+			{
+				Name: "prop1",
+				Items: []Countable{
+					{
+						Data:          []byte("value1"),
+						TermFrequency: 1,
+						Positions:     []uint32{1},
+					},
+				},
+			},
+		}
+
+		res := Delta(previous, next)
+		assert.Equal(t, next, res.ToAdd)
+		assert.Equal(t, previous, res.ToDelete)
+	})
 }
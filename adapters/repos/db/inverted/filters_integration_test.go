@@ -616,6 +616,137 @@ func Test_Filters_String_DuplicateEntriesInAnd(t *testing.T) {
 	}
 }
 
+func Test_Filters_Contains(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dirName, dirName, logger, nil,
+		cyclemanager.NewCycleCallbacksNoop(), cyclemanager.NewCycleCallbacksNoop())
+	require.Nil(t, err)
+
+	propName := "inverted-with-frequency"
+	bucketName := helpers.BucketSearchableFromPropNameLSM(propName)
+	require.Nil(t, store.CreateOrLoadBucket(context.Background(),
+		bucketName, lsmkv.WithStrategy(lsmkv.StrategyMapCollection)))
+	bWithFrequency := store.Bucket(bucketName)
+
+	defer store.Shutdown(context.Background())
+
+	fakeInvertedIndex := map[string][]uint64{
+		"modulo-2": {2, 4, 6, 8, 10, 12, 14, 16},
+		"modulo-3": {3, 6, 9, 12, 15},
+		"modulo-7": {7, 14},
+		"modulo-8": {8, 16},
+	}
+
+	t.Run("import data", func(t *testing.T) {
+		for value, ids := range fakeInvertedIndex {
+			idsMapValues := idsToBinaryMapValues(ids)
+			for _, pair := range idsMapValues {
+				require.Nil(t, bWithFrequency.MapSet([]byte(value), pair))
+			}
+		}
+		require.Nil(t, bWithFrequency.FlushAndSwitch())
+	})
+
+	searcher := NewSearcher(logger, store, createSchema(),
+		nil, nil, nil, fakeStopwordDetector{}, 2, func() bool { return false }, "")
+
+	containsClause := func(operator filters.Operator, values ...string) *filters.Clause {
+		return &filters.Clause{
+			Operator: operator,
+			On: &filters.Path{
+				Class:    "foo",
+				Property: schema.PropertyName(propName),
+			},
+			Value: &filters.Value{
+				Value: values,
+				Type:  schema.DataTypeTextArray,
+			},
+		}
+	}
+
+	t.Run("values are folded into a single pair", func(t *testing.T) {
+		pv, err := searcher.extractPropValuePair(
+			containsClause(filters.OperatorContainsAny, "modulo-7", "modulo-2 modulo-3"), className)
+		require.Nil(t, err)
+
+		assert.Equal(t, filters.OperatorContainsAny, pv.operator)
+		assert.Equal(t, propName, pv.prop)
+		assert.Empty(t, pv.children)
+		assert.Equal(t, [][][]byte{
+			{[]byte("modulo-7")},
+			{[]byte("modulo-2"), []byte("modulo-3")},
+		}, pv.containsTerms)
+	})
+
+	type test struct {
+		name         string
+		filter       *filters.LocalFilter
+		expectedList helpers.AllowList
+	}
+
+	tests := []test{
+		{
+			name: "contains any",
+			filter: &filters.LocalFilter{
+				Root: containsClause(filters.OperatorContainsAny, "modulo-7", "modulo-8"),
+			},
+			expectedList: helpers.NewAllowList(7, 8, 14, 16),
+		},
+		{
+			name: "contains any - value with multiple terms",
+			filter: &filters.LocalFilter{
+				Root: containsClause(filters.OperatorContainsAny, "modulo-7", "modulo-2 modulo-3"),
+			},
+			expectedList: helpers.NewAllowList(6, 7, 12, 14),
+		},
+		{
+			name: "contains any - unknown value",
+			filter: &filters.LocalFilter{
+				Root: containsClause(filters.OperatorContainsAny, "modulo-7", "modulo-7000000"),
+			},
+			expectedList: helpers.NewAllowList(7, 14),
+		},
+		{
+			name: "contains all",
+			filter: &filters.LocalFilter{
+				Root: containsClause(filters.OperatorContainsAll, "modulo-2", "modulo-3"),
+			},
+			expectedList: helpers.NewAllowList(6, 12),
+		},
+		{
+			name: "contains all - unknown value",
+			filter: &filters.LocalFilter{
+				Root: containsClause(filters.OperatorContainsAll, "modulo-2", "modulo-7000000"),
+			},
+			expectedList: helpers.NewAllowList(),
+		},
+		{
+			name: "contains all - nested in or filter",
+			filter: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorOr,
+					Operands: []filters.Clause{
+						*containsClause(filters.OperatorContainsAll, "modulo-2", "modulo-7"),
+						*containsClause(filters.OperatorContainsAll, "modulo-3", "modulo-8"),
+					},
+				},
+			},
+			expectedList: helpers.NewAllowList(14),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := searcher.DocIDs(context.Background(), test.filter,
+				additional.Properties{}, className)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expectedList.Slice(), res.Slice())
+		})
+	}
+}
+
 func idsToBinaryList(ids []uint64) [][]byte {
 	out := make([][]byte, len(ids))
 	for i, id := range ids {
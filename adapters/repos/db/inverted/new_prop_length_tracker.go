@@ -155,6 +155,31 @@ func (t *JsonPropertyLengthTracker) Clear() {
 	t.data = &PropLenData{make(map[string]map[int]int), make(map[string]int), make(map[string]int)}
 }
 
+// DropProperty removes all tracked lengths of the given property
+func (t *JsonPropertyLengthTracker) DropProperty(propName string) {
+	t.Lock()
+	defer t.Unlock()
+
+	delete(t.data.BucketedData, propName)
+	delete(t.data.SumData, propName)
+	delete(t.data.CountData, propName)
+}
+
+// RenameProperty moves the tracked lengths of a property to its new name
+func (t *JsonPropertyLengthTracker) RenameProperty(propName, newName string) {
+	t.Lock()
+	defer t.Unlock()
+
+	if bucketed, ok := t.data.BucketedData[propName]; ok {
+		t.data.BucketedData[newName] = bucketed
+		t.data.SumData[newName] = t.data.SumData[propName]
+		t.data.CountData[newName] = t.data.CountData[propName]
+	}
+	delete(t.data.BucketedData, propName)
+	delete(t.data.SumData, propName)
+	delete(t.data.CountData, propName)
+}
+
 // Path to the file on disk
 func (t *JsonPropertyLengthTracker) FileName() string {
 	return t.path
@@ -14,6 +14,7 @@ package inverted
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -80,6 +81,10 @@ func (a *Analyzer) analyzeProps(propsMap map[string]*models.Property,
 			if err := a.extendPropertiesWithReference(&out, prop, input, key); err != nil {
 				return nil, err
 			}
+		} else if _, ok := schema.AsNested(prop.DataType); ok {
+			if err := a.extendPropertiesWithNested(&out, prop, input, key); err != nil {
+				return nil, err
+			}
 		} else if schema.IsArrayDataType(prop.DataType) {
 			if err := a.extendPropertiesWithArrayType(&out, prop, input, key); err != nil {
 				return nil, err
@@ -203,6 +208,88 @@ func (a *Analyzer) extendPropertiesWithPrimitive(properties *[]Property,
 	return nil
 }
 
+// extendPropertiesWithNested mutates the passed in properties, by extending
+// it with one property per nested property, named by its path, e.g.
+// "address.city". Values of nested properties within object[] are collected
+// from all objects, as such nested properties are of an array data type.
+func (a *Analyzer) extendPropertiesWithNested(properties *[]Property,
+	prop *models.Property, input map[string]any, propName string,
+) error {
+	value, ok := input[propName]
+	if !ok {
+		// skip any nested prop that's not set
+		return nil
+	}
+
+	for _, leaf := range schema.NestedLeafProperties(prop) {
+		if !HasInvertedIndex(leaf) {
+			continue
+		}
+
+		path := strings.Split(leaf.Name, schema.NestedPropertySeparator)[1:]
+		values, err := nestedValues(value, path)
+		if err != nil {
+			return fmt.Errorf("analyze nested prop %s: %w", leaf.Name, err)
+		}
+		if values == nil {
+			// skip any nested prop that's not set
+			continue
+		}
+
+		var property *Property
+		if schema.IsArrayDataType(leaf.DataType) {
+			property, err = a.analyzeArrayProp(leaf, values)
+			if err != nil {
+				return fmt.Errorf("analyze array prop: %w", err)
+			}
+		} else {
+			property, err = a.analyzePrimitiveProp(leaf, values[0])
+			if err != nil {
+				return fmt.Errorf("analyze primitive prop: %w", err)
+			}
+		}
+		if property == nil {
+			continue
+		}
+
+		*properties = append(*properties, *property)
+	}
+
+	return nil
+}
+
+// nestedValues collects the values found at the path within a nested object
+// or array of nested objects. Values of array properties are flattened. nil
+// is returned if the path is not set in any of the objects.
+func nestedValues(value any, path []string) ([]any, error) {
+	switch typed := value.(type) {
+	case map[string]any:
+		child, ok := typed[path[0]]
+		if !ok || child == nil {
+			return nil, nil
+		}
+		if len(path) > 1 {
+			return nestedValues(child, path[1:])
+		}
+		if asSlice, err := typedSliceToUntyped(child); err == nil {
+			return asSlice, nil
+		}
+		return []any{child}, nil
+	case []any:
+		var values []any
+		for _, elem := range typed {
+			elemValues, err := nestedValues(elem, path)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, elemValues...)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("expected nested object, but got %T", value)
+	}
+}
+
 func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Property, error) {
 	var items []Countable
 	hasFilterableIndex := HasFilterableIndex(prop)
@@ -215,7 +302,11 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 		if err != nil {
 			return nil, err
 		}
-		items = a.TextArray(prop.Tokenization, in)
+		if HasPositionsIndex(prop) {
+			items = a.TextArrayWithPositions(prop.Tokenization, in)
+		} else {
+			items = a.TextArray(prop.Tokenization, in)
+		}
 	case schema.DataTypeIntArray:
 		in := make([]int64, len(values))
 		for i, value := range values {
@@ -355,7 +446,11 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.Text(prop.Tokenization, asString)
+		if HasPositionsIndex(prop) {
+			items = a.TextArrayWithPositions(prop.Tokenization, []string{asString})
+		} else {
+			items = a.Text(prop.Tokenization, asString)
+		}
 		propertyLength = utf8.RuneCountInString(asString)
 	case schema.DataTypeInt:
 		if asFloat, ok := value.(float64); ok {
@@ -582,6 +677,16 @@ func HasSearchableIndex(prop *models.Property) bool {
 	}
 }
 
+// Indicates whether the searchable index of the property additionally holds
+// the positions of the tokens within the property, which are required for
+// phrase queries
+func HasPositionsIndex(prop *models.Property) bool {
+	if prop.IndexPositions == nil || !*prop.IndexPositions {
+		return false
+	}
+	return HasSearchableIndex(prop)
+}
+
 // Indicates whether property should be indexed
 // Index holds document ids with property of/containing particular value
 // (index created using bucket of StrategyRoaringSet)
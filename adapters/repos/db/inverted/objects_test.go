@@ -652,6 +652,88 @@ func TestAnalyzeObject(t *testing.T) {
 		})
 	})
 
+	t.Run("with nested properties", func(t *testing.T) {
+		sch := map[string]interface{}{
+			"address": map[string]interface{}{
+				"city": "Amsterdam",
+				"zip":  float64(1012),
+			},
+			"pets": []interface{}{
+				map[string]interface{}{"name": "Bowser"},
+				map[string]interface{}{"name": "Mittens", "tags": []interface{}{"cat"}},
+			},
+		}
+
+		uuid := "2609f1bc-7693-48f3-b531-6ddc52cd2501"
+		props := []*models.Property{
+			{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{
+						Name:         "city",
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationWord,
+					},
+					{
+						Name:     "zip",
+						DataType: schema.DataTypeInt.PropString(),
+					},
+				},
+			},
+			{
+				Name:     "pets",
+				DataType: schema.DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{
+						Name:         "name",
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationField,
+					},
+					{
+						Name:         "tags",
+						DataType:     schema.DataTypeTextArray.PropString(),
+						Tokenization: models.PropertyTokenizationField,
+					},
+				},
+			},
+		}
+		res, err := a.Object(sch, props, strfmt.UUID(uuid))
+		require.Nil(t, err)
+
+		expectedZip, err := a.Int(1012)
+		require.Nil(t, err)
+
+		byName := map[string]Property{}
+		for _, prop := range res {
+			byName[prop.Name] = prop
+		}
+		assert.NotContains(t, byName, "address")
+		assert.NotContains(t, byName, "pets")
+
+		require.Contains(t, byName, "address.city")
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("amsterdam"), TermFrequency: 1},
+		}, byName["address.city"].Items)
+		assert.Equal(t, 9, byName["address.city"].Length)
+
+		require.Contains(t, byName, "address.zip")
+		assert.ElementsMatch(t, expectedZip, byName["address.zip"].Items)
+
+		require.Contains(t, byName, "pets.name")
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("Bowser"), TermFrequency: 1},
+			{Data: []byte("Mittens"), TermFrequency: 1},
+		}, byName["pets.name"].Items)
+		assert.Equal(t, 2, byName["pets.name"].Length)
+
+		require.Contains(t, byName, "pets.tags")
+		assert.ElementsMatch(t, []Countable{
+			{Data: []byte("cat"), TermFrequency: 1},
+		}, byName["pets.tags"].Items)
+		assert.Equal(t, 1, byName["pets.tags"].Length)
+	})
+
 	t.Run("when objects are indexed by timestamps", func(t *testing.T) {
 		sch := map[string]interface{}{
 			"description":         "pretty ok if you ask me",
@@ -31,6 +31,10 @@ type propValuePair struct {
 	// that's not a geoRange
 	value []byte
 
+	// only set if operator=OperatorContainsAny/OperatorContainsAll, holds the
+	// terms of each of the values
+	containsTerms [][][]byte
+
 	// only set if operator=OperatorWithinGeoRange, as that cannot be served by a
 	// byte value from an inverted index
 	valueGeoRange      *filters.GeoRange
@@ -95,7 +95,7 @@ func (s *Searcher) Objects(ctx context.Context, limit int,
 		it = allowList.LimitedIterator(limit)
 	}
 
-	return s.objectsByDocID(it, additional)
+	return s.objectsByDocID(it, additional, s.schema.GetClass(className))
 }
 
 func (s *Searcher) sort(ctx context.Context, limit int, sort []filters.Sort, docIDs helpers.AllowList,
@@ -109,7 +109,7 @@ func (s *Searcher) sort(ctx context.Context, limit int, sort []filters.Sort, doc
 }
 
 func (s *Searcher) objectsByDocID(it docIDsIterator,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) ([]*storobj.Object, error) {
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	if bucket == nil {
@@ -135,7 +135,7 @@ func (s *Searcher) objectsByDocID(it docIDsIterator,
 		if additional.ReferenceQuery {
 			unmarshalled, err = storobj.FromBinaryUUIDOnly(res)
 		} else {
-			unmarshalled, err = storobj.FromBinaryOptional(res, additional)
+			unmarshalled, err = storobj.FromBinaryOptional(res, additional, class)
 		}
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarshal data object at position %d", i)
@@ -217,6 +217,10 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 		return &out, nil
 	}
 
+	if filter.Operator.IsContains() {
+		return s.extractContains(filter, className)
+	}
+
 	// on value or non-nested filter
 	props := filter.On.Slice()
 	propName := props[0]
@@ -271,6 +275,138 @@ func (s *Searcher) extractPropValuePair(filter *filters.Clause,
 		filter.Operator)
 }
 
+// extractContains resolves ContainsAny/ContainsAll by extracting an Equal
+// pair for each of the values. If all of them are served by the same bucket
+// they are folded into a single pair, whose value bitmaps are fetched in one
+// pass and merged directly, see docBitmapContains. Otherwise (e.g. for
+// reference paths) the pairs are merged as an Or/And tree.
+func (s *Searcher) extractContains(filter *filters.Clause,
+	className schema.ClassName,
+) (*propValuePair, error) {
+	baseType, ok := schema.IsArrayType(filter.Value.Type)
+	if !ok {
+		return nil, fmt.Errorf("operator %s requires an array value, got %q",
+			filter.Operator.Name(), filter.Value.Type)
+	}
+
+	values, err := containsValues(filter.Value.Value, baseType)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("operator %s requires at least one value",
+			filter.Operator.Name())
+	}
+
+	children := make([]*propValuePair, len(values))
+	for i, value := range values {
+		child, err := s.extractPropValuePair(&filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       filter.On,
+			Value:    &filters.Value{Value: value, Type: baseType},
+		}, className)
+		if err != nil {
+			return nil, errors.Wrapf(err, "value at pos %d", i)
+		}
+		children[i] = child
+	}
+
+	if folded, ok := foldContains(filter.Operator, children); ok {
+		return folded, nil
+	}
+
+	out := newPropValuePair()
+	out.operator = filters.OperatorOr
+	if filter.Operator == filters.OperatorContainsAll {
+		out.operator = filters.OperatorAnd
+	}
+	out.children = children
+	return &out, nil
+}
+
+// foldContains folds the Equal pairs of a ContainsAny/ContainsAll filter
+// into a single pair holding the terms of every value. A value tokenized into
+// multiple terms is an And of Equal pairs, all of its terms need to match.
+func foldContains(operator filters.Operator, children []*propValuePair) (*propValuePair, bool) {
+	out := newPropValuePair()
+	out.operator = operator
+	out.containsTerms = make([][][]byte, len(children))
+
+	for i, child := range children {
+		leaves := []*propValuePair{child}
+		if child.operator == filters.OperatorAnd {
+			leaves = child.children
+		}
+
+		terms := make([][]byte, len(leaves))
+		for j, leaf := range leaves {
+			if leaf.operator != filters.OperatorEqual {
+				return nil, false
+			}
+			if i == 0 && j == 0 {
+				out.prop = leaf.prop
+				out.hasFilterableIndex = leaf.hasFilterableIndex
+				out.hasSearchableIndex = leaf.hasSearchableIndex
+			} else if leaf.prop != out.prop ||
+				leaf.hasFilterableIndex != out.hasFilterableIndex ||
+				leaf.hasSearchableIndex != out.hasSearchableIndex {
+				return nil, false
+			}
+			terms[j] = leaf.value
+		}
+		out.containsTerms[i] = terms
+	}
+
+	return &out, true
+}
+
+// containsValues flattens the array value of a ContainsAny/ContainsAll
+// filter. Filters which went through json (e.g. from a remote node) hold a
+// []interface{}, anything else holds a typed slice.
+func containsValues(in interface{}, baseType schema.DataType) ([]interface{}, error) {
+	switch typed := in.(type) {
+	case []string:
+		out := make([]interface{}, len(typed))
+		for i := range typed {
+			out[i] = typed[i]
+		}
+		return out, nil
+	case []int:
+		out := make([]interface{}, len(typed))
+		for i := range typed {
+			out[i] = typed[i]
+		}
+		return out, nil
+	case []float64:
+		out := make([]interface{}, len(typed))
+		for i := range typed {
+			out[i] = typed[i]
+		}
+		return out, nil
+	case []bool:
+		out := make([]interface{}, len(typed))
+		for i := range typed {
+			out[i] = typed[i]
+		}
+		return out, nil
+	case []interface{}:
+		if baseType != schema.DataTypeInt {
+			return typed, nil
+		}
+		out := make([]interface{}, len(typed))
+		for i := range typed {
+			asFloat, ok := typed[i].(float64)
+			if !ok {
+				return nil, fmt.Errorf("expected int value, got %T", typed[i])
+			}
+			out[i] = int(asFloat)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("expected array value, got %T", in)
+	}
+}
+
 func (s *Searcher) extractReferenceFilter(prop *models.Property,
 	filter *filters.Clause,
 ) (*propValuePair, error) {
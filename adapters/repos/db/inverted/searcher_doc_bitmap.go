@@ -19,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/roaringset"
 	"github.com/weaviate/weaviate/entities/filters"
 )
 
@@ -34,6 +35,10 @@ func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
 	// all other operators perform operations on the inverted index which we
 	// can serve directly
 
+	if pv.operator.IsContains() {
+		return s.docBitmapContains(ctx, b, limit, pv)
+	}
+
 	if pv.hasFilterableIndex {
 		// bucket with strategy roaring set serves bitmaps directly
 		if b.Strategy() == lsmkv.StrategyRoaringSet {
@@ -53,6 +58,75 @@ func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
 	return docBitmap{}, fmt.Errorf("property '%s' is neither filterable nor searchable", pv.prop)
 }
 
+// docBitmapContains reads the bitmaps of all values of a ContainsAny or
+// ContainsAll pair from the bucket and merges them as they come in, rather
+// than fetching and merging a child pair per value.
+func (s *Searcher) docBitmapContains(ctx context.Context, b *lsmkv.Bucket,
+	limit int, pv *propValuePair,
+) (docBitmap, error) {
+	var out *sroar.Bitmap
+	for i, terms := range pv.containsTerms {
+		valueIDs, err := s.docBitmapTerms(ctx, b, pv, terms)
+		if err != nil {
+			return docBitmap{}, errors.Wrapf(err, "value at pos %d", i)
+		}
+
+		if out == nil {
+			out = valueIDs
+		} else if pv.operator == filters.OperatorContainsAll {
+			out.And(valueIDs)
+		} else {
+			out.Or(valueIDs)
+		}
+
+		if pv.operator == filters.OperatorContainsAll && out.IsEmpty() {
+			break
+		}
+		if pv.operator == filters.OperatorContainsAny &&
+			limit > 0 && out.GetCardinality() >= limit {
+			break
+		}
+	}
+
+	if out == nil {
+		return newDocBitmap(), nil
+	}
+	return docBitmap{docIDs: roaringset.Condense(out)}, nil
+}
+
+// docBitmapTerms returns the doc ids matching all of the given terms
+func (s *Searcher) docBitmapTerms(ctx context.Context, b *lsmkv.Bucket,
+	pv *propValuePair, terms [][]byte,
+) (*sroar.Bitmap, error) {
+	var out *sroar.Bitmap
+	for _, term := range terms {
+		termPv := &propValuePair{
+			prop:               pv.prop,
+			operator:           filters.OperatorEqual,
+			value:              term,
+			hasFilterableIndex: pv.hasFilterableIndex,
+			hasSearchableIndex: pv.hasSearchableIndex,
+		}
+		dbm, err := s.docBitmap(ctx, b, 0, termPv)
+		if err != nil {
+			return nil, err
+		}
+
+		if out == nil {
+			out = dbm.docIDs
+		} else {
+			out.And(dbm.docIDs)
+		}
+		if out.IsEmpty() {
+			break
+		}
+	}
+	if out == nil {
+		return sroar.NewBitmap(), nil
+	}
+	return out, nil
+}
+
 func (s *Searcher) docBitmapInvertedRoaringSet(ctx context.Context, b *lsmkv.Bucket,
 	limit int, pv *propValuePair,
 ) (docBitmap, error) {
@@ -45,7 +45,7 @@ func NewDetectorFromPreset(preset string) (*Detector, error) {
 	var ok bool
 
 	if preset != "" {
-		list, ok = Presets[preset]
+		list, ok = Preset(preset)
 		if !ok {
 			return nil, errors.Errorf("preset %q not known to stopword detector", preset)
 		}
@@ -14,6 +14,7 @@ package stopwords
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -156,4 +157,80 @@ func TestStopwordDetector(t *testing.T) {
 
 		runTest(t, tests)
 	})
+	t.Run("with language presets", func(t *testing.T) {
+		tests := []testcase{
+			{
+				cfg:               models.StopwordConfig{Preset: "de"},
+				input:             []string{"der", "hund", "und", "die", "katze", "für", "über"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "fr"},
+				input:             []string{"l", "élève", "est", "à", "la", "maison"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "es"},
+				input:             []string{"el", "perro", "y", "el", "gato"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "it"},
+				input:             []string{"il", "cane", "e", "il", "gatto"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "nl"},
+				input:             []string{"de", "hond", "en", "de", "kat"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "pt"},
+				input:             []string{"o", "cão", "e", "o", "gato"},
+				expectedCountable: 2,
+			},
+		}
+
+		runTest(t, tests)
+	})
+
+	t.Run("with registered custom preset", func(t *testing.T) {
+		require.Nil(t, RegisterPresets(map[string][]string{
+			"legal": {"hereby", "whereas"},
+		}))
+
+		tests := []testcase{
+			{
+				cfg: models.StopwordConfig{
+					Preset:    "legal",
+					Additions: []string{"thereof"},
+				},
+				input:             []string{"whereas", "the", "party", "hereby", "thereof"},
+				expectedCountable: 2,
+			},
+		}
+
+		runTest(t, tests)
+	})
+
+	t.Run("registering invalid custom presets", func(t *testing.T) {
+		err := RegisterPresets(map[string][]string{"en": {"dog"}})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "built-in")
+
+		err = RegisterPresets(map[string][]string{"": {"dog"}})
+		require.NotNil(t, err)
+
+		err = RegisterPresets(map[string][]string{"blank": {" "}})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "empty word")
+
+		_, ok := Preset("blank")
+		assert.False(t, ok)
+	})
+
+	t.Run("with unknown preset", func(t *testing.T) {
+		_, err := NewDetectorFromPreset("unknown")
+		require.NotNil(t, err)
+	})
 }
@@ -11,9 +11,22 @@
 
 package stopwords
 
+import (
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
 const (
-	EnglishPreset = "en"
-	NoPreset      = "none"
+	EnglishPreset    = "en"
+	GermanPreset     = "de"
+	FrenchPreset     = "fr"
+	SpanishPreset    = "es"
+	ItalianPreset    = "it"
+	DutchPreset      = "nl"
+	PortuguesePreset = "pt"
+	NoPreset         = "none"
 )
 
 var Presets = map[string][]string{
@@ -23,5 +36,103 @@ var Presets = map[string][]string{
 		"the", "their", "then", "there", "these", "they", "this", "to", "was", "will",
 		"with",
 	},
+	GermanPreset: {
+		"aber", "alle", "als", "also", "am", "an", "auch", "auf", "aus", "bei",
+		"bin", "bis", "bist", "da", "damit", "dann", "das", "dass", "dein", "dem",
+		"den", "denn", "der", "des", "dich", "die", "dir", "doch", "du", "durch",
+		"ein", "eine", "einem", "einen", "einer", "eines", "er", "es", "euch",
+		"euer", "für", "habe", "haben", "hat", "hatte", "ich", "ihm", "ihn", "ihr",
+		"im", "in", "ist", "ja", "kann", "kein", "man", "mich", "mir", "mit",
+		"nach", "nicht", "noch", "nun", "nur", "ob", "oder", "ohne", "sein",
+		"sich", "sie", "sind", "so", "über", "um", "und", "uns", "unter", "vom",
+		"von", "vor", "war", "was", "weil", "wenn", "wer", "wie", "wir", "wird",
+		"wo", "zu", "zum", "zur",
+	},
+	FrenchPreset: {
+		"à", "au", "aux", "avec", "c", "ce", "ces", "d", "dans", "de", "des",
+		"du", "elle", "en", "est", "et", "été", "était", "eux", "il", "ils", "j",
+		"je", "l", "la", "le", "les", "leur", "lui", "m", "ma", "mais", "me",
+		"même", "mes", "moi", "mon", "n", "ne", "nos", "notre", "nous", "on",
+		"ou", "par", "pas", "pour", "qu", "que", "qui", "s", "sa", "se", "ses",
+		"son", "sont", "sur", "t", "ta", "te", "tes", "toi", "ton", "tu", "un",
+		"une", "vos", "votre", "vous", "y",
+	},
+	SpanishPreset: {
+		"a", "al", "algo", "como", "con", "cuando", "de", "del", "desde",
+		"donde", "el", "ella", "ellos", "en", "entre", "era", "es", "esta",
+		"este", "esto", "fue", "ha", "hay", "la", "las", "le", "les", "lo",
+		"los", "más", "me", "mi", "muy", "no", "nos", "o", "para", "pero", "por",
+		"porque", "que", "qué", "se", "sin", "sobre", "su", "sus", "también",
+		"te", "tu", "un", "una", "uno", "y", "ya", "yo",
+	},
+	ItalianPreset: {
+		"a", "ad", "al", "alla", "alle", "anche", "che", "chi", "ci", "come",
+		"con", "da", "dal", "dalla", "degli", "dei", "del", "della", "delle",
+		"di", "e", "è", "gli", "ha", "ho", "i", "il", "in", "io", "la", "le",
+		"lei", "lo", "lui", "ma", "mi", "ne", "nel", "nella", "noi", "non", "o",
+		"per", "più", "questo", "se", "si", "sono", "su", "sua", "suo", "tra",
+		"un", "una", "uno", "voi",
+	},
+	DutchPreset: {
+		"aan", "al", "als", "bij", "dan", "dat", "de", "die", "dit", "door",
+		"een", "en", "er", "had", "heb", "hebben", "het", "hij", "hoe", "ik",
+		"in", "is", "je", "kan", "maar", "me", "men", "met", "mij", "na", "naar",
+		"niet", "nog", "nu", "of", "om", "omdat", "ook", "op", "over", "te",
+		"tot", "u", "uit", "van", "voor", "want", "was", "wat", "we", "wel",
+		"werd", "wie", "wij", "zal", "ze", "zich", "zij", "zijn", "zo", "zou",
+	},
+	PortuguesePreset: {
+		"a", "ao", "aos", "as", "com", "como", "da", "das", "de", "do", "dos",
+		"e", "é", "ela", "ele", "eles", "em", "entre", "era", "eu", "foi", "já",
+		"lhe", "mais", "mas", "me", "muito", "na", "nas", "no", "nos", "não",
+		"o", "os", "ou", "para", "pela", "pelo", "por", "que", "se", "sem",
+		"seu", "sua", "são", "também", "te", "um", "uma", "você",
+	},
 	NoPreset: {},
 }
+
+var (
+	customPresetsLock sync.RWMutex
+	customPresets     = map[string][]string{}
+)
+
+// RegisterPresets makes custom presets, e.g. from the server config,
+// available in addition to the built-in ones. The built-in presets cannot be
+// overwritten.
+func RegisterPresets(presets map[string][]string) error {
+	for name, words := range presets {
+		if strings.TrimSpace(name) == "" {
+			return errors.Errorf("stopword preset name cannot be empty")
+		}
+		if _, ok := Presets[name]; ok {
+			return errors.Errorf("stopword preset %q is built-in and cannot be overwritten", name)
+		}
+		for _, word := range words {
+			if strings.TrimSpace(word) == "" {
+				return errors.Errorf("stopword preset %q cannot contain an empty word", name)
+			}
+		}
+	}
+
+	customPresetsLock.Lock()
+	defer customPresetsLock.Unlock()
+
+	for name, words := range presets {
+		customPresets[name] = words
+	}
+
+	return nil
+}
+
+// Preset returns the words of the built-in or registered custom preset
+func Preset(name string) ([]string, bool) {
+	if words, ok := Presets[name]; ok {
+		return words, true
+	}
+
+	customPresetsLock.RLock()
+	defer customPresetsLock.RUnlock()
+
+	words, ok := customPresets[name]
+	return words, ok
+}
@@ -12,6 +12,7 @@
 package db
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -35,6 +36,26 @@ type ShardInvertedReindexTask interface {
 	OnPostResumeStore(ctx context.Context, shard *Shard) error
 }
 
+// ShardInvertedReindexTaskObjectHook can optionally be implemented by a task
+// to be notified about every object whose properties were reindexed, e.g. to
+// report progress
+type ShardInvertedReindexTaskObjectHook interface {
+	OnObjectReindexed(ctx context.Context, shard *Shard, properties []inverted.Property) error
+}
+
+// ShardInvertedReindexTaskLive can optionally be implemented by a task which
+// reindexes while the shard keeps serving writes. The store is not paused for
+// live tasks, instead writes to the reindexed buckets go to the temporary
+// buckets as well until those replace the current buckets.
+type ShardInvertedReindexTaskLive interface {
+	IsLive() bool
+}
+
+func isLiveReindexTask(task ShardInvertedReindexTask) bool {
+	live, ok := task.(ShardInvertedReindexTaskLive)
+	return ok && live.IsLive()
+}
+
 type ReindexableProperty struct {
 	PropertyName    string
 	IndexType       PropertyIndexType
@@ -98,9 +119,12 @@ func (r *ShardInvertedReindexer) doTask(ctx context.Context, task ShardInvertedR
 		return err
 	}
 
-	if err := r.pauseStoreActivity(ctx); err != nil {
-		r.logError(err, "failed pausing store activity")
-		return err
+	live := isLiveReindexTask(task)
+	if !live {
+		if err := r.pauseStoreActivity(ctx); err != nil {
+			r.logError(err, "failed pausing store activity")
+			return err
+		}
 	}
 
 	bucketsToReindex := make([]string, len(reindexProperties))
@@ -133,11 +157,59 @@ func (r *ShardInvertedReindexer) doTask(ctx context.Context, task ShardInvertedR
 			Debug("created temporary bucket")
 	}
 
-	if err := r.reindexProperties(ctx, reindexProperties); err != nil {
+	if live {
+		if err := r.shard.startDoubleWrites(bucketsToReindex); err != nil {
+			r.logError(err, "failed starting double writes")
+			return err
+		}
+		if err := r.reindexPropertiesLive(ctx, task, reindexProperties); err != nil {
+			r.logError(err, "failed reindexing properties")
+			return errors.Wrapf(err, "failed reindexing properties on shard '%s'", r.shard.name)
+		}
+		if err := r.shard.stopDoubleWrites(func() error {
+			return r.replaceBuckets(ctx, reindexProperties, bucketsToReindex)
+		}); err != nil {
+			return err
+		}
+		if !r.shard.isReadOnly() {
+			// the replaced buckets were made read-only to be flushed
+			for _, name := range bucketsToReindex {
+				r.shard.store.Bucket(name).UpdateStatus(storagestate.StatusReady)
+			}
+		}
+		if err := task.OnPostResumeStore(ctx, r.shard); err != nil {
+			r.logError(err, "failed OnPostResumeStore")
+			return errors.Wrap(err, "failed OnPostResumeStore")
+		}
+		return nil
+	}
+
+	if err := r.reindexProperties(ctx, task, reindexProperties); err != nil {
 		r.logError(err, "failed reindexing properties")
 		return errors.Wrapf(err, "failed reindexing properties on shard '%s'", r.shard.name)
 	}
 
+	if err := r.replaceBuckets(ctx, reindexProperties, bucketsToReindex); err != nil {
+		return err
+	}
+
+	if err := r.checkContextExpired(ctx, "resuming store stopped due to context canceled"); err != nil {
+		return err
+	}
+
+	if err := r.resumeStoreActivity(ctx, task); err != nil {
+		r.logError(err, "failed resuming store activity")
+		return err
+	}
+
+	return nil
+}
+
+// replaceBuckets replaces the reindexed buckets with the populated temporary
+// buckets
+func (r *ShardInvertedReindexer) replaceBuckets(ctx context.Context,
+	reindexProperties []ReindexableProperty, bucketsToReindex []string,
+) error {
 	for i := range bucketsToReindex {
 		if err := r.checkContextExpired(ctx, "replacing buckets stopped due to context canceled"); err != nil {
 			return err
@@ -174,15 +246,6 @@ func (r *ShardInvertedReindexer) doTask(ctx context.Context, task ShardInvertedR
 		}
 	}
 
-	if err := r.checkContextExpired(ctx, "resuming store stopped due to context canceled"); err != nil {
-		return err
-	}
-
-	if err := r.resumeStoreActivity(ctx, task); err != nil {
-		r.logError(err, "failed resuming store activity")
-		return err
-	}
-
 	return nil
 }
 
@@ -232,8 +295,11 @@ func (r *ShardInvertedReindexer) createTempBucket(ctx context.Context, name stri
 	return nil
 }
 
-func (r *ShardInvertedReindexer) reindexProperties(ctx context.Context, reindexableProperties []ReindexableProperty) error {
+func (r *ShardInvertedReindexer) reindexProperties(ctx context.Context, task ShardInvertedReindexTask,
+	reindexableProperties []ReindexableProperty,
+) error {
 	checker := newReindexablePropertyChecker(reindexableProperties, r.class)
+	hook, _ := task.(ShardInvertedReindexTaskObjectHook)
 	objectsBucket := r.shard.store.Bucket(helpers.ObjectsBucketLSM)
 
 	r.logger.
@@ -253,21 +319,8 @@ func (r *ShardInvertedReindexer) reindexProperties(ctx context.Context, reindexa
 				WithField("shard", r.shard.name).
 				Debugf("iterating through objects: %d done", i)
 		}
-		docID := object.DocID()
-		properties, nilProperties, err := r.shard.analyzeObject(object)
-		if err != nil {
-			return errors.Wrapf(err, "failed analyzying object")
-		}
-
-		for _, property := range properties {
-			if err := r.handleProperty(ctx, checker, docID, property); err != nil {
-				return errors.Wrapf(err, "failed reindexing property '%s' of object '%d'", property.Name, docID)
-			}
-		}
-		for _, nilProperty := range nilProperties {
-			if err := r.handleNilProperty(ctx, checker, docID, nilProperty); err != nil {
-				return errors.Wrapf(err, "failed reindexing property '%s' of object '%d'", nilProperty.Name, docID)
-			}
+		if err := r.reindexObject(ctx, checker, hook, r.shard.analyzeObject, object); err != nil {
+			return err
 		}
 
 		i++
@@ -284,6 +337,121 @@ func (r *ShardInvertedReindexer) reindexProperties(ctx context.Context, reindexa
 	return nil
 }
 
+// reindexPropertiesLive populates the temporary buckets like reindexProperties
+// while the shard is written to. The keys of the objects are read in batches,
+// so that the objects bucket is not locked for the whole reindexing. Writes to
+// the inverted buckets are blocked while an object is reindexed, so that the
+// current version of the object is indexed and later writes to it are
+// contained in the temporary buckets as well.
+func (r *ShardInvertedReindexer) reindexPropertiesLive(ctx context.Context,
+	task ShardInvertedReindexTask, reindexableProperties []ReindexableProperty,
+) error {
+	// the temporary buckets are populated with the definitions the properties
+	// are rebuilt for, the schema may already contain a more recent one
+	class := *r.class
+	r.shard.doubleWriteLock.RLock()
+	class.Properties = r.shard.reindexTargetProperties(r.class.Properties)
+	r.shard.doubleWriteLock.RUnlock()
+
+	checker := newReindexablePropertyChecker(reindexableProperties, &class)
+	hook, _ := task.(ShardInvertedReindexTaskObjectHook)
+	objectsBucket := r.shard.store.Bucket(helpers.ObjectsBucketLSM)
+
+	r.logger.
+		WithField("action", "inverted reindex").
+		WithField("shard", r.shard.name).
+		Debug("starting populating indexes")
+
+	i := 0
+	var lastKey []byte
+	for {
+		if err := r.checkContextExpired(ctx, "iterating through objects stopped due to context canceled"); err != nil {
+			return err
+		}
+
+		keys := nextKeysBatch(objectsBucket, lastKey, reindexLiveBatchSize)
+		if len(keys) == 0 {
+			break
+		}
+		for _, key := range keys {
+			if err := r.reindexCurrentObject(ctx, checker, hook, objectsBucket, key); err != nil {
+				return err
+			}
+		}
+
+		i += len(keys)
+		lastKey = keys[len(keys)-1]
+		r.logger.
+			WithField("action", "inverted reindex").
+			WithField("shard", r.shard.name).
+			Debugf("iterating through objects: %d done", i)
+	}
+
+	return nil
+}
+
+// reindexLiveBatchSize is the number of object keys read at once by a live
+// reindexing
+const reindexLiveBatchSize = 1000
+
+// reindexCurrentObject reindexes the object with the given key while no
+// writes to the inverted buckets are in progress. Objects which were deleted
+// in the meantime are skipped.
+func (r *ShardInvertedReindexer) reindexCurrentObject(ctx context.Context,
+	checker *reindexablePropertyChecker, hook ShardInvertedReindexTaskObjectHook,
+	objectsBucket *lsmkv.Bucket, key []byte,
+) error {
+	r.shard.doubleWriteLock.Lock()
+	defer r.shard.doubleWriteLock.Unlock()
+
+	data, err := objectsBucket.Get(key)
+	if err != nil {
+		return errors.Wrap(err, "failed getting object")
+	}
+	if data == nil {
+		return nil
+	}
+	object, err := storobj.FromBinaryWithClass(data, r.shard.class())
+	if err != nil {
+		return errors.Wrap(err, "failed unmarshalling object")
+	}
+
+	if err := r.reindexObject(ctx, checker, hook, r.shard.analyzeObjectForReindex, object); err != nil {
+		return err
+	}
+	r.shard.doubleWriteCursor = key
+	return nil
+}
+
+func (r *ShardInvertedReindexer) reindexObject(ctx context.Context,
+	checker *reindexablePropertyChecker, hook ShardInvertedReindexTaskObjectHook,
+	analyze func(object *storobj.Object) ([]inverted.Property, []nilProp, error),
+	object *storobj.Object,
+) error {
+	docID := object.DocID()
+	properties, nilProperties, err := analyze(object)
+	if err != nil {
+		return errors.Wrapf(err, "failed analyzying object")
+	}
+
+	for _, property := range properties {
+		if err := r.handleProperty(ctx, checker, docID, property); err != nil {
+			return errors.Wrapf(err, "failed reindexing property '%s' of object '%d'", property.Name, docID)
+		}
+	}
+	for _, nilProperty := range nilProperties {
+		if err := r.handleNilProperty(ctx, checker, docID, nilProperty); err != nil {
+			return errors.Wrapf(err, "failed reindexing property '%s' of object '%d'", nilProperty.Name, docID)
+		}
+	}
+	if hook != nil {
+		if err := hook.OnObjectReindexed(ctx, r.shard, properties); err != nil {
+			return errors.Wrapf(err, "failed OnObjectReindexed of object '%d'", docID)
+		}
+	}
+	return nil
+}
+
 func (r *ShardInvertedReindexer) handleProperty(ctx context.Context, checker *reindexablePropertyChecker,
 	docID uint64, property inverted.Property,
 ) error {
@@ -312,7 +480,8 @@ func (r *ShardInvertedReindexer) handleProperty(ctx context.Context, checker *re
 		for _, item := range property.Items {
 			key := item.Data
 			if reindexablePropSearchableValue && inverted.HasSearchableIndex(schemaProp) {
-				pair := r.shard.pairPropertyWithFrequency(docID, item.TermFrequency, propLen)
+				pair := r.shard.pairPropertyWithFrequency(docID, item.TermFrequency, propLen,
+					item.Positions)
 				if err := r.shard.addToPropertyMapBucket(bucketSearchableValue, pair, key); err != nil {
 					return errors.Wrapf(err, "failed adding to prop '%s' value bucket", property.Name)
 				}
@@ -442,3 +611,27 @@ func (r *ShardInvertedReindexer) logError(err error, msg string, args ...interfa
 		WithError(err).
 		Errorf(msg, args...)
 }
+
+// nextKeysBatch returns up to limit keys following the given key, or starting
+// with the first key if the given one is nil. The cursor is closed before
+// returning, so the returned keys are copies.
+func nextKeysBatch(bucket *lsmkv.Bucket, after []byte, limit int) [][]byte {
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	var k []byte
+	if after == nil {
+		k, _ = cursor.First()
+	} else {
+		k, _ = cursor.Seek(after)
+		if bytes.Equal(k, after) {
+			k, _ = cursor.Next()
+		}
+	}
+
+	keys := make([][]byte, 0, limit)
+	for ; k != nil && len(keys) < limit; k, _ = cursor.Next() {
+		keys = append(keys, append([]byte{}, k...))
+	}
+	return keys
+}
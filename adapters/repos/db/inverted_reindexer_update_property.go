@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+)
+
+// reindex progress is reported every n objects
+const reindexProgressInterval = 1000
+
+// shardInvertedReindexTaskUpdateProperty rebuilds the inverted indexes of a
+// single property after its index settings were changed. The indexes to be
+// rebuilt are determined up front by Shard.prepareReindex from the settings
+// the current buckets were built with.
+type shardInvertedReindexTaskUpdateProperty struct {
+	propName         string
+	properties       []ReindexableProperty
+	trackPropLengths bool
+	objectsProcessed int64
+	onProgress       func(objectsProcessed int64)
+}
+
+// IsLive makes the reindexing run while the shard keeps serving writes
+func (t *shardInvertedReindexTaskUpdateProperty) IsLive() bool {
+	return true
+}
+
+func (t *shardInvertedReindexTaskUpdateProperty) GetPropertiesToReindex(ctx context.Context,
+	shard *Shard,
+) ([]ReindexableProperty, error) {
+	if t.trackPropLengths {
+		// lengths depend on the tokenization, they are tracked again while
+		// iterating the objects. The current lengths are kept for the current
+		// bucket until it is replaced.
+		shard.propLengths.DropProperty(reindexedPropLengthsName(t.propName))
+	}
+	return t.properties, nil
+}
+
+func (t *shardInvertedReindexTaskUpdateProperty) OnObjectReindexed(ctx context.Context,
+	shard *Shard, properties []inverted.Property,
+) error {
+	if t.trackPropLengths {
+		for _, prop := range properties {
+			if prop.Name != t.propName || !prop.HasSearchableIndex {
+				continue
+			}
+			if err := shard.propLengths.TrackProperty(reindexedPropLengthsName(prop.Name),
+				float32(len(prop.Items))); err != nil {
+				return err
+			}
+		}
+	}
+
+	t.objectsProcessed++
+	if t.onProgress != nil && t.objectsProcessed%reindexProgressInterval == 0 {
+		t.onProgress(t.objectsProcessed)
+	}
+	return nil
+}
+
+func (t *shardInvertedReindexTaskUpdateProperty) OnPostResumeStore(ctx context.Context, shard *Shard) error {
+	if t.onProgress != nil {
+		t.onProgress(t.objectsProcessed)
+	}
+	if t.trackPropLengths {
+		return shard.propLengths.Flush(false)
+	}
+	return nil
+}
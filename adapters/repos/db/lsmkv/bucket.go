@@ -753,6 +753,16 @@ func (b *Bucket) Shutdown(ctx context.Context) error {
 	}
 }
 
+// MemtablePressure indicates that writes are outpacing flushes: the active
+// memtable has already exceeded its threshold while the previous one is
+// still being flushed to disk.
+func (b *Bucket) MemtablePressure() bool {
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	return b.flushing != nil && b.active.Size() >= b.memtableThreshold
+}
+
 func (b *Bucket) flushAndSwitchIfThresholdsMet(shouldAbort cyclemanager.ShouldAbortCallback) bool {
 	b.flushLock.RLock()
 	commitLogSize := b.active.commitlog.Size()
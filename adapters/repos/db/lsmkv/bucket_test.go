@@ -126,6 +126,49 @@ func TestBucket_MemtableCountWithFlushing(t *testing.T) {
 	}
 }
 
+func TestBucket_MemtablePressure(t *testing.T) {
+	tests := []struct {
+		name     string
+		active   uint64
+		flushing *Memtable
+		expected bool
+	}{
+		{
+			name:     "active below threshold, nothing flushing",
+			active:   10,
+			expected: false,
+		},
+		{
+			name:     "active above threshold, nothing flushing",
+			active:   200,
+			expected: false,
+		},
+		{
+			name:     "active below threshold while flushing",
+			active:   10,
+			flushing: &Memtable{size: 100},
+			expected: false,
+		},
+		{
+			name:     "active above threshold while flushing",
+			active:   200,
+			flushing: &Memtable{size: 100},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := Bucket{
+				active:            &Memtable{size: tt.active},
+				flushing:          tt.flushing,
+				memtableThreshold: 100,
+			}
+			assert.Equal(t, tt.expected, b.MemtablePressure())
+		})
+	}
+}
+
 func TestBucketReadsIntoMemory(t *testing.T) {
 	ctx := context.Background()
 	dirName := t.TempDir()
@@ -89,6 +89,21 @@ func (s *Store) UpdateBucketsStatus(targetStatus storagestate.Status) {
 	}
 }
 
+// MemtablePressure returns true if any of the store's buckets reports
+// memtable pressure, see [Bucket.MemtablePressure]
+func (s *Store) MemtablePressure() bool {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
+	for _, b := range s.bucketsByName {
+		if b != nil && b.MemtablePressure() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (s *Store) init() error {
 	if err := os.MkdirAll(s.dir, 0o700); err != nil {
 		return err
@@ -338,6 +353,28 @@ func (s *Store) ReplaceBuckets(ctx context.Context, bucketName, replacementBucke
 	return nil
 }
 
+// DropBucket shuts down the bucket with the given name and removes all of its
+// files from disk
+func (s *Store) DropBucket(ctx context.Context, bucketName string) error {
+	s.bucketAccessLock.Lock()
+	defer s.bucketAccessLock.Unlock()
+
+	bucket := s.bucketsByName[bucketName]
+	if bucket == nil {
+		return fmt.Errorf("bucket '%s' not found", bucketName)
+	}
+	delete(s.bucketsByName, bucketName)
+
+	if err := bucket.Shutdown(ctx); err != nil {
+		return errors.Wrapf(err, "failed shutting down bucket '%s'", bucketName)
+	}
+	if err := os.RemoveAll(bucket.dir); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", bucket.dir)
+	}
+
+	return nil
+}
+
 func (s *Store) RenameBucket(ctx context.Context, bucketName, newBucketName string) error {
 	s.bucketAccessLock.Lock()
 	defer s.bucketAccessLock.Unlock()
@@ -19,12 +19,17 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"golang.org/x/sync/errgroup"
 )
@@ -97,11 +102,11 @@ func (m *Migrator) DropClass(ctx context.Context, className string) error {
 }
 
 func (m *Migrator) UpdateClass(ctx context.Context, className string, newClassName *string) error {
-	if newClassName != nil {
-		return errors.New("weaviate does not support renaming of classes")
+	if newClassName == nil {
+		return nil
 	}
 
-	return nil
+	return m.renameIndex(ctx, className, *newClassName)
 }
 
 func (m *Migrator) AddProperty(ctx context.Context, className string, prop *models.Property) error {
@@ -113,18 +118,45 @@ func (m *Migrator) AddProperty(ctx context.Context, className string, prop *mode
 	return idx.addProperty(ctx, prop)
 }
 
-// DropProperty is ignored, API compliant change
-func (m *Migrator) DropProperty(ctx context.Context, className string, propertyName string) error {
-	// ignore but don't error
-	return nil
+// DropProperty drops the indexes of the property on all local shards. If
+// purge is set, the values of the property are removed from the stored
+// objects as well.
+func (m *Migrator) DropProperty(ctx context.Context, className string,
+	propertyName string, purge bool,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot drop property from a non-existing index for %s", className)
+	}
+
+	return m.dropProperty(ctx, idx, propertyName, purge)
+}
+
+// UpdatePropertyIndexes applies the changed index settings of a property to
+// all local shards. The affected inverted indexes of loaded shards are
+// rebuilt in the background, those of unloaded shards right away.
+func (m *Migrator) UpdatePropertyIndexes(ctx context.Context, className string,
+	old, updated *models.Property,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update property of a non-existing index for %s", className)
+	}
+
+	return m.updatePropertyIndexes(ctx, idx, old, updated)
 }
 
 func (m *Migrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
-	if newName != nil {
-		return errors.New("weaviate does not support renaming of properties")
+	if newName == nil {
+		return nil
 	}
 
-	return nil
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot rename property of a non-existing index for %s", className)
+	}
+
+	return m.renameProperty(ctx, idx, propName, *newName)
 }
 
 func (m *Migrator) GetShardsStatus(ctx context.Context, className string) (map[string]string, error) {
@@ -193,14 +225,230 @@ func (m *Migrator) NewTenants(ctx context.Context, class *models.Class, tenants
 	return commit, nil
 }
 
+// UpdateTenants activates or deactivates tenants and returns a commit func
+// that can be used to either commit or rollback the changes.
+// Shards of HOT tenants are loaded, shards of COLD tenants are shut down and
+// released from memory while their files are kept on disk. Shards of
+// OFFLOADING tenants are shut down as well, they are uploaded by
+// OffloadTenant and removed from disk once the tenant is OFFLOADED. Shards of
+// ONLOADING tenants are downloaded by OnloadTenant and removed from the
+// offload backend once the tenant is active again.
+func (m *Migrator) UpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) (commit func(success bool), err error) {
+	idx := m.db.GetIndex(schema.ClassName(class.Class))
+	if idx == nil {
+		return nil, fmt.Errorf("cannot find index for %q", class.Class)
+	}
+	ss := idx.getSchema.CopyShardingState(class.Class)
+	if ss == nil {
+		return nil, fmt.Errorf("cannot find sharding state for %q", class.Class)
+	}
+
+	var (
+		loaded    = make(map[string]*Shard, len(updates))
+		unloaded  = make([]string, 0, len(updates))
+		offloaded = make([]*Shard, 0, len(updates)) // opened only to be dropped
+		onloaded  = make([]string, 0, len(updates)) // to be removed from the offload backend
+	)
+	rollback := func() {
+		for _, shard := range loaded {
+			offloaded = append(offloaded, shard)
+		}
+		for _, shard := range offloaded {
+			if err := shard.shutdown(context.Background()); err != nil {
+				m.logger.WithField("action", "shutdown_shard").
+					WithField("class", class.Class).
+					Errorf("cannot shutdown self loaded shard %s: %v", shard.name, err)
+			}
+		}
+	}
+	commit = func(success bool) {
+		if !success {
+			rollback()
+			return
+		}
+		for name, shard := range loaded {
+			idx.shards.Store(name, shard)
+		}
+		idx.unloadShards(unloaded)
+		idx.dropOffloadedShards(offloaded)
+		idx.deleteOffloaded(m.db.offloadBackend, onloaded)
+	}
+	defer func() {
+		if err != nil {
+			rollback()
+		}
+	}()
+
+	for _, update := range updates {
+		previous := ss.Physical[update.Name].ActivityStatus()
+		if previous == update.Status {
+			continue
+		}
+		invalid := fmt.Errorf("cannot change activity status of partition %q from %q to %q",
+			update.Name, previous, update.Status)
+
+		// a transfer is only waited for if it is over once the status changes,
+		// otherwise it is cancelled
+		switch previous {
+		case models.TenantActivityStatusOFFLOADING:
+			abort := update.Status != models.TenantActivityStatusOFFLOADED
+			if err := idx.stopTransfer(ctx, update.Name, abort); err != nil {
+				return nil, err
+			}
+		case models.TenantActivityStatusONLOADING:
+			abort := update.Status == models.TenantActivityStatusOFFLOADED
+			if err := idx.stopTransfer(ctx, update.Name, abort); err != nil {
+				return nil, err
+			}
+			if !abort {
+				onloaded = append(onloaded, update.Name)
+			}
+		}
+
+		switch update.Status {
+		case models.TenantActivityStatusHOT:
+			if previous == models.TenantActivityStatusOFFLOADED {
+				return nil, invalid
+			}
+			if shard := idx.shards.Load(update.Name); shard != nil {
+				continue
+			}
+			shard, err := NewShard(ctx, m.db.promMetrics, update.Name, idx, class, idx.centralJobQueue)
+			if err != nil {
+				return nil, fmt.Errorf("cannot load partition %q: %w", update.Name, err)
+			}
+			loaded[update.Name] = shard
+		case models.TenantActivityStatusCOLD:
+			if previous == models.TenantActivityStatusOFFLOADED {
+				return nil, invalid
+			}
+			unloaded = append(unloaded, update.Name)
+		case models.TenantActivityStatusOFFLOADING:
+			if previous != models.TenantActivityStatusHOT && previous != models.TenantActivityStatusCOLD {
+				return nil, invalid
+			}
+			if m.db.offloadBackend == nil {
+				return nil, fmt.Errorf("cannot offload partition %q: no offload backend configured", update.Name)
+			}
+			// no more writes are accepted, as they would be lost once offloaded
+			unloaded = append(unloaded, update.Name)
+		case models.TenantActivityStatusOFFLOADED:
+			if previous != models.TenantActivityStatusOFFLOADING && previous != models.TenantActivityStatusONLOADING {
+				return nil, invalid
+			}
+			// local files are dropped, they are kept in the offload backend.
+			// The shard needs to be opened to find all of them.
+			shard, err := NewShard(ctx, m.db.promMetrics, update.Name, idx, class, idx.centralJobQueue)
+			if err != nil {
+				return nil, fmt.Errorf("cannot load partition %q: %w", update.Name, err)
+			}
+			offloaded = append(offloaded, shard)
+		case models.TenantActivityStatusONLOADING:
+			if previous != models.TenantActivityStatusOFFLOADED {
+				return nil, invalid
+			}
+			if m.db.offloadBackend == nil {
+				return nil, fmt.Errorf("cannot reload partition %q: no offload backend configured", update.Name)
+			}
+		default:
+			return nil, fmt.Errorf("invalid activity status %q of partition %q", update.Status, update.Name)
+		}
+	}
+
+	return commit, nil
+}
+
+// OffloadTenant uploads the shard of an OFFLOADING tenant to the offload
+// backend. The shard has been shut down when the tenant became OFFLOADING,
+// so it is opened only for the time of the upload.
+func (m *Migrator) OffloadTenant(ctx context.Context, class *models.Class, tenant string) (err error) {
+	idx := m.db.GetIndex(schema.ClassName(class.Class))
+	if idx == nil {
+		return fmt.Errorf("cannot find index for %q", class.Class)
+	}
+	backend := m.db.offloadBackend
+	if backend == nil {
+		return fmt.Errorf("cannot offload partition %q: no offload backend configured", tenant)
+	}
+	ctx, finish, err := idx.startTransfer(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	defer finish()
+
+	shard, err := NewShard(ctx, m.db.promMetrics, tenant, idx, class, idx.centralJobQueue)
+	if err != nil {
+		return fmt.Errorf("cannot load partition %q: %w", tenant, err)
+	}
+	defer func() {
+		if err := shard.shutdown(context.Background()); err != nil {
+			m.logger.WithField("action", "shutdown_shard").
+				WithField("class", class.Class).
+				Errorf("cannot shutdown offloaded shard %s: %v", tenant, err)
+		}
+	}()
+
+	if err := shard.offload(ctx, backend); err != nil {
+		// files uploaded so far are of no use anymore
+		idx.deleteOffloaded(backend, []string{tenant})
+		return fmt.Errorf("cannot offload partition %q: %w", tenant, err)
+	}
+	return nil
+}
+
+// OnloadTenant downloads the shard of an ONLOADING tenant from the offload
+// backend. The shard is loaded once the tenant is activated.
+func (m *Migrator) OnloadTenant(ctx context.Context, class *models.Class, tenant string) error {
+	idx := m.db.GetIndex(schema.ClassName(class.Class))
+	if idx == nil {
+		return fmt.Errorf("cannot find index for %q", class.Class)
+	}
+	backend := m.db.offloadBackend
+	if backend == nil {
+		return fmt.Errorf("cannot reload partition %q: no offload backend configured", tenant)
+	}
+	ctx, finish, err := idx.startTransfer(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	defer finish()
+
+	if err := idx.reloadOffloaded(ctx, backend, tenant); err != nil {
+		return fmt.Errorf("cannot reload partition %q: %w", tenant, err)
+	}
+	return nil
+}
+
 // DeleteTenants deletes tenants and returns a commit func
-// that can be used to either commit or rollback deletion
+// that can be used to either commit or rollback deletion.
+// Offloaded tenants are removed from the offload backend on commit.
 func (m *Migrator) DeleteTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error) {
 	idx := m.db.GetIndex(schema.ClassName(class.Class))
 	if idx == nil {
 		return func(bool) {}, nil
 	}
-	return idx.dropShards(tenants)
+
+	var offloaded []string
+	if ss := idx.getSchema.CopyShardingState(class.Class); ss != nil {
+		for _, name := range tenants {
+			if p, ok := ss.Physical[name]; ok && p.IsOffloaded() {
+				// a running transfer would leave files behind
+				if err := idx.stopTransfer(ctx, name, true); err != nil {
+					m.logger.WithField("action", "delete_tenants").
+						WithField("class", class.Class).Error(err)
+				}
+				offloaded = append(offloaded, name)
+			}
+		}
+	}
+
+	drop, err := idx.dropShards(tenants)
+	return func(success bool) {
+		drop(success)
+		if success {
+			idx.deleteOffloaded(m.db.offloadBackend, offloaded)
+		}
+	}, err
 }
 
 func NewMigrator(db *DB, logger logrus.FieldLogger) *Migrator {
@@ -218,13 +466,28 @@ func (m *Migrator) UpdateVectorIndexConfig(ctx context.Context,
 	return idx.updateVectorIndexConfig(ctx, updated)
 }
 
+func (m *Migrator) UpdateVectorIndexConfigs(ctx context.Context,
+	className string, updated map[string]schema.VectorIndexConfig,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update vector index configs of non-existing index for %s", className)
+	}
+
+	return idx.updateVectorIndexConfigs(ctx, updated)
+}
+
 func (m *Migrator) ValidateVectorIndexConfigUpdate(ctx context.Context,
 	old, updated schema.VectorIndexConfig,
 ) error {
-	// hnsw is the only supported vector index type at the moment, so no need
-	// to check, we can always use that an hnsw-specific validation should be
-	// used for now.
-	return hnsw.ValidateUserConfigUpdate(old, updated)
+	switch old.(type) {
+	case flatent.UserConfig:
+		return flat.ValidateUserConfigUpdate(old, updated)
+	case dynament.UserConfig:
+		return dynamic.ValidateUserConfigUpdate(old, updated)
+	default:
+		return hnsw.ValidateUserConfigUpdate(old, updated)
+	}
 }
 
 func (m *Migrator) ValidateInvertedIndexConfigUpdate(ctx context.Context,
@@ -295,7 +558,9 @@ func (m *Migrator) RecountProperties(ctx context.Context) error {
 		// Iterate over all shards
 		index.IterateObjects(ctx, func(index *Index, shard *Shard, object *storobj.Object) error {
 			count = count + 1
+			shard.doubleWriteLock.RLock()
 			props, _, err := shard.analyzeObject(object)
+			shard.doubleWriteLock.RUnlock()
 			if err != nil {
 				m.logger.WithField("error", err).Error("could not analyze object")
 				return nil
@@ -0,0 +1,329 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestNamedVectorsJourney(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	className := "NamedVectorsClass"
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {
+				Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+			},
+			"image": {
+				Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType:   "flat",
+				VectorIndexConfig: flatent.NewDefaultUserConfig(),
+			},
+		},
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+		},
+	}
+
+	t.Run("add schema", func(t *testing.T) {
+		err := migrator.AddClass(context.Background(), class, schemaGetter.shardState)
+		require.Nil(t, err)
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{Classes: []*models.Class{class}},
+		}
+	})
+
+	idA := strfmt.UUID("8f2a1f3c-2ef5-4a0e-9b64-7a1c0a6e8b01")
+	idB := strfmt.UUID("8f2a1f3c-2ef5-4a0e-9b64-7a1c0a6e8b02")
+
+	t.Run("import objects with named vectors", func(t *testing.T) {
+		objA := &models.Object{
+			Class:      className,
+			ID:         idA,
+			Properties: map[string]interface{}{"name": "a"},
+			Vectors: models.Vectors{
+				"title": []float32{1, 0},
+				"image": []float32{0, 1, 0, 0},
+			},
+		}
+		objB := &models.Object{
+			Class:      className,
+			ID:         idB,
+			Properties: map[string]interface{}{"name": "b"},
+			Vectors: models.Vectors{
+				"title": []float32{0, 1},
+				"image": []float32{1, 0, 0, 0},
+			},
+		}
+		require.Nil(t, repo.PutObject(context.Background(), objA, []float32{1, 0, 0}, nil))
+		require.Nil(t, repo.PutObject(context.Background(), objB, []float32{0, 1, 0}, nil))
+	})
+
+	search := func(t *testing.T, vector []float32, targetVector string) []interface{} {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    className,
+			SearchVector: vector,
+			TargetVector: targetVector,
+			Pagination:   &filters.Pagination{Limit: 10},
+		})
+		require.Nil(t, err)
+		return extractPropValues(res, "name")
+	}
+
+	t.Run("search each vector space", func(t *testing.T) {
+		assert.Equal(t, []interface{}{"b", "a"}, search(t, []float32{0, 1, 0}, ""))
+		assert.Equal(t, []interface{}{"a", "b"}, search(t, []float32{1, 0}, "title"))
+		assert.Equal(t, []interface{}{"b", "a"}, search(t, []float32{1, 0, 0, 0}, "image"))
+	})
+
+	t.Run("search a missing target vector", func(t *testing.T) {
+		_, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    className,
+			SearchVector: []float32{1, 0},
+			TargetVector: "body",
+			Pagination:   &filters.Pagination{Limit: 10},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "target vector \"body\" does not exist")
+	})
+
+	t.Run("named vectors are returned with the object", func(t *testing.T) {
+		res, err := repo.Object(context.Background(), className, idA, nil,
+			additional.Properties{Vector: true}, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, models.Vectors{
+			"title": []float32{1, 0},
+			"image": []float32{0, 1, 0, 0},
+		}, res.Vectors)
+	})
+
+	t.Run("merge a single named vector", func(t *testing.T) {
+		err := repo.Merge(context.Background(), objects.MergeDocument{
+			Class:           className,
+			ID:              idA,
+			PrimitiveSchema: map[string]interface{}{},
+			Vectors:         map[string][]float32{"image": {1, 0, 0, 0}},
+			UpdateTime:      time.Now().UnixMilli(),
+		}, nil, "")
+		require.Nil(t, err)
+
+		res, err := repo.Object(context.Background(), className, idA, nil,
+			additional.Properties{Vector: true}, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, models.Vectors{
+			"title": []float32{1, 0},
+			"image": []float32{1, 0, 0, 0},
+		}, res.Vectors)
+		assert.Equal(t, []interface{}{"a", "b"}, search(t, []float32{1, 0}, "title"))
+	})
+
+	t.Run("deleted objects are removed from all vector spaces", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), className, idA, nil, ""))
+
+		assert.Equal(t, []interface{}{"b"}, search(t, []float32{0, 1, 0}, ""))
+		assert.Equal(t, []interface{}{"b"}, search(t, []float32{1, 0}, "title"))
+		assert.Equal(t, []interface{}{"b"}, search(t, []float32{1, 0, 0, 0}, "image"))
+	})
+
+}
+
+func TestNamedVectorsCompressed(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer func() {
+		if repo != nil {
+			repo.Shutdown(context.Background())
+		}
+	}()
+	migrator := NewMigrator(repo, logger)
+
+	bqConfig := func() enthnsw.UserConfig {
+		uc := enthnsw.NewDefaultUserConfig()
+		uc.BQ = enthnsw.BQConfig{Enabled: true}
+		return uc
+	}
+
+	// the vector spaces have different dimensions, so that codes of one index
+	// cannot be mistaken for the codes of the other
+	className := "CompressedNamedVectors"
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {
+				Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: bqConfig(),
+			},
+			"body": {
+				Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: bqConfig(),
+			},
+		},
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+		},
+	}
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}
+
+	titleVector := func(i int) []float32 {
+		return []float32{float32(i), -float32(i), float32(i % 3), 1}
+	}
+	bodyVector := func(i int) []float32 {
+		return []float32{-float32(i), 1, float32(i % 5), float32(i), 0, float32(i * i), 1, -1}
+	}
+
+	for i := 0; i < 20; i++ {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			Class:      className,
+			ID:         strfmt.UUID(fmt.Sprintf("8f2a1f3c-2ef5-4a0e-9b64-7a1c0a6e8b%02d", i)),
+			Properties: map[string]interface{}{"name": fmt.Sprintf("obj-%d", i)},
+			Vectors: models.Vectors{
+				"title": titleVector(i),
+				"body":  bodyVector(i),
+			},
+		}, []float32{float32(i), 1, 0}, nil))
+	}
+
+	search := func(t *testing.T, vector []float32, targetVector string) []interface{} {
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    className,
+			SearchVector: vector,
+			TargetVector: targetVector,
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+		return extractPropValues(res, "name")
+	}
+
+	t.Run("search each compressed vector space", func(t *testing.T) {
+		for _, i := range []int{3, 11, 17} {
+			name := fmt.Sprintf("obj-%d", i)
+			assert.Equal(t, []interface{}{name}, search(t, titleVector(i), "title"))
+			assert.Equal(t, []interface{}{name}, search(t, bodyVector(i), "body"))
+		}
+	})
+
+	t.Run("search each compressed vector space after a restart", func(t *testing.T) {
+		shutdownErr := repo.Shutdown(context.Background())
+		repo = nil
+		require.Nil(t, shutdownErr)
+
+		restarted, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		restarted.SetSchemaGetter(schemaGetter)
+		require.Nil(t, restarted.WaitForStartup(testCtx()))
+		repo = restarted
+
+		// the compressed vectors cache is prefilled in the background
+		require.Eventually(t, func() bool {
+			searchable := func(vector []float32, targetVector string) bool {
+				_, err := repo.VectorSearch(context.Background(), dto.GetParams{
+					ClassName:    className,
+					SearchVector: vector,
+					TargetVector: targetVector,
+					Pagination:   &filters.Pagination{Limit: 1},
+				})
+				return err == nil
+			}
+			return searchable(titleVector(0), "title") && searchable(bodyVector(0), "body")
+		}, 10*time.Second, 50*time.Millisecond)
+
+		for _, i := range []int{3, 11, 17} {
+			name := fmt.Sprintf("obj-%d", i)
+			assert.Equal(t, []interface{}{name}, search(t, titleVector(i), "title"))
+			assert.Equal(t, []interface{}{name}, search(t, bodyVector(i), "body"))
+		}
+	})
+
+	t.Run("each index has its own compressed store", func(t *testing.T) {
+		require.NotNil(t, repo)
+		idx := repo.GetIndex(schema.ClassName(className))
+		require.NotNil(t, idx)
+		idx.ForEachShard(func(_ string, shard *Shard) error {
+			for _, targetVector := range []string{"title", "body"} {
+				assert.DirExists(t, hnsw.CompressedStorePath(dirName, shard.vectorIndexID(targetVector)))
+			}
+			return nil
+		})
+	})
+}
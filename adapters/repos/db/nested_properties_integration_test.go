@@ -0,0 +1,202 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestNestedProperties(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class:               "NestedPerson",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{
+						Name:         "city",
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationWord,
+					},
+					{
+						Name:     "zip",
+						DataType: schema.DataTypeInt.PropString(),
+					},
+				},
+			},
+			{
+				Name:     "pets",
+				DataType: schema.DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{
+						Name:         "name",
+						DataType:     schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationWord,
+					},
+				},
+			},
+		},
+	}
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	aliceID := strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506970")
+	bobID := strfmt.UUID("86a380e9-cb60-4b2a-bc48-51f52acd72d6")
+	require.Nil(t, repo.PutObject(ctx, &models.Object{
+		Class: class.Class,
+		ID:    aliceID,
+		Properties: map[string]interface{}{
+			"address": map[string]interface{}{
+				"city": "New Amsterdam",
+				"zip":  int64(1012),
+			},
+			"pets": []interface{}{
+				map[string]interface{}{"name": "Bowser"},
+				map[string]interface{}{"name": "Mittens"},
+			},
+		},
+	}, []float32{1, 2, 3}, nil))
+	require.Nil(t, repo.PutObject(ctx, &models.Object{
+		Class: class.Class,
+		ID:    bobID,
+		Properties: map[string]interface{}{
+			"address": map[string]interface{}{
+				"city": "Rotterdam",
+				"zip":  int64(3011),
+			},
+		},
+	}, []float32{1, 2, 3}, nil))
+
+	filterIDs := func(t *testing.T, propName string, value interface{}, dataType schema.DataType) []strfmt.UUID {
+		res, err := repo.Search(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: schema.PropertyName(propName),
+					},
+					Value: &filters.Value{Value: value, Type: dataType},
+				},
+			},
+		})
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	t.Run("nested values are stored", func(t *testing.T) {
+		res, err := repo.ObjectByID(ctx, aliceID, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		props := res.Object().Properties.(map[string]interface{})
+		address := props["address"].(map[string]interface{})
+		assert.Equal(t, "New Amsterdam", address["city"])
+		assert.Equal(t, float64(1012), address["zip"])
+		assert.Len(t, props["pets"], 2)
+	})
+
+	t.Run("filter on a nested text property", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{aliceID}, filterIDs(t, "address.city", "amsterdam", schema.DataTypeText))
+	})
+
+	t.Run("filter on a nested int property", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{bobID}, filterIDs(t, "address.zip", 3011, schema.DataTypeInt))
+	})
+
+	t.Run("filter on a property within an object array", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{aliceID}, filterIDs(t, "pets.name", "mittens", schema.DataTypeText))
+		assert.Empty(t, filterIDs(t, "pets.name", "rotterdam", schema.DataTypeText))
+	})
+
+	t.Run("bm25 on a nested property", func(t *testing.T) {
+		res, err := repo.Search(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type:       "bm25",
+				Query:      "rotterdam",
+				Properties: []string{"address.city"},
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, bobID, res[0].ID)
+	})
+
+	t.Run("merge a nested property into an existing property", func(t *testing.T) {
+		merged := *class.Properties[0]
+		merged.NestedProperties = append(merged.NestedProperties, &models.NestedProperty{
+			Name:         "country",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWord,
+		})
+		class.Properties[0] = &merged
+		require.Nil(t, migrator.AddProperty(ctx, class.Class, &merged))
+
+		carolID := strfmt.UUID("c6f85bf5-c3b7-4c1d-bd51-e899f9605336")
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			Class: class.Class,
+			ID:    carolID,
+			Properties: map[string]interface{}{
+				"address": map[string]interface{}{
+					"city":    "Utrecht",
+					"country": "Netherlands",
+				},
+			},
+		}, []float32{3, 2, 1}, nil))
+
+		assert.Equal(t, []strfmt.UUID{carolID}, filterIDs(t, "address.country", "netherlands", schema.DataTypeText))
+		assert.Equal(t, []strfmt.UUID{aliceID}, filterIDs(t, "address.city", "amsterdam", schema.DataTypeText),
+			"indexes of existing nested properties are kept")
+	})
+}
@@ -129,9 +129,12 @@ func (i *Index) getShardsNodeStatus(status *[]*models.NodeShardStatus) (totalCou
 	i.ForEachShard(func(name string, shard *Shard) error {
 		objectCount := int64(shard.objectCount())
 		shardStatus := &models.NodeShardStatus{
-			Name:        name,
-			Class:       shard.index.Config.ClassName.String(),
-			ObjectCount: objectCount,
+			Name:                 name,
+			Class:                shard.index.Config.ClassName.String(),
+			ObjectCount:          objectCount,
+			StorageStatus:        shard.getStatus().String(),
+			VectorIndexingStatus: shard.getVectorIndexingStatus().String(),
+			ReindexStatus:        shard.getReindexStatus(),
 		}
 		totalCount += objectCount
 		*status = append(*status, shardStatus)
@@ -139,3 +142,162 @@ func (i *Index) getShardsNodeStatus(status *[]*models.NodeShardStatus) (totalCou
 	})
 	return
 }
+
+// GetTenantsStatus returns the status and placement of a page of the tenants
+// of a multi-tenant class sorted by name, as well as the total number of
+// tenants. If tenant is set, only the status of this tenant is returned.
+func (db *DB) GetTenantsStatus(ctx context.Context, className, tenant string,
+	offset, limit *int64,
+) ([]*models.NodeTenantStatus, int64, error) {
+	from, to, err := db.tenantsPageBounds(offset, limit)
+	if err != nil {
+		return nil, 0, enterrors.NewErrUnprocessable(err)
+	}
+
+	if db.GetIndex(schema.ClassName(className)) == nil {
+		return nil, 0, enterrors.NewErrNotFound(
+			fmt.Errorf("class %q not found", className))
+	}
+	ss := db.schemaGetter.CopyShardingState(className)
+	if ss == nil || !ss.PartitioningEnabled {
+		return nil, 0, enterrors.NewErrUnprocessable(
+			fmt.Errorf("multi-tenancy is not enabled for class %q", className))
+	}
+
+	var names []string
+	if tenant != "" {
+		if _, ok := ss.Physical[tenant]; !ok {
+			return nil, 0, enterrors.NewErrNotFound(
+				fmt.Errorf("tenant %q not found", tenant))
+		}
+		names = []string{tenant}
+	} else {
+		names = make([]string, 0, len(ss.Physical))
+		for name := range ss.Physical {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	total := int64(len(names))
+	if from > len(names) {
+		from = len(names)
+	}
+	if to > len(names) {
+		to = len(names)
+	}
+	names = names[from:to]
+
+	tenants := make([]*models.NodeTenantStatus, len(names))
+	shardsPerNode := map[string][]string{}
+	for i, name := range names {
+		physical := ss.Physical[name]
+		tenants[i] = &models.NodeTenantStatus{
+			Name:           name,
+			ActivityStatus: physical.ActivityStatus(),
+			BelongsToNodes: physical.BelongsToNodes,
+		}
+		for _, node := range physical.BelongsToNodes {
+			shardsPerNode[node] = append(shardsPerNode[node], name)
+		}
+	}
+
+	// statuses of the loaded shards per node and shard name
+	statuses := make(map[string]map[string]*models.NodeShardStatus, len(shardsPerNode))
+	for node, shards := range shardsPerNode {
+		nodeStatuses, err := db.getShardsStatus(ctx, node, className, shards)
+		if err != nil {
+			return nil, 0, fmt.Errorf("node: %v: %w", node, err)
+		}
+		statuses[node] = make(map[string]*models.NodeShardStatus, len(nodeStatuses))
+		for _, status := range nodeStatuses {
+			statuses[node][status.Name] = status
+		}
+	}
+
+	for _, t := range tenants {
+		t.Replicas = make([]*models.NodeTenantReplicaStatus, len(t.BelongsToNodes))
+		for i, node := range t.BelongsToNodes {
+			replica := &models.NodeTenantReplicaStatus{Node: node}
+			if status := statuses[node][t.Name]; status != nil {
+				replica.ObjectCount = status.ObjectCount
+				replica.StorageStatus = status.StorageStatus
+				replica.VectorIndexingStatus = status.VectorIndexingStatus
+			}
+			t.Replicas[i] = replica
+		}
+	}
+	return tenants, total, nil
+}
+
+// tenantsPageBounds turns the optional offset and limit into the bounds of
+// the requested page, falling back to the configured query limit. Only the
+// page size is capped by the maximum number of results, the offset is not, as
+// the tenants are listed from the sharding state and not from a search.
+func (db *DB) tenantsPageBounds(offset, limit *int64) (from, to int, err error) {
+	o, l := int64(0), db.config.QueryLimit
+	if offset != nil {
+		o = *offset
+	}
+	if limit != nil {
+		l = *limit
+	}
+	if o < 0 || l < 0 {
+		return 0, 0, fmt.Errorf("offset and limit must not be negative")
+	}
+	if db.config.QueryMaximumResults > 0 && l > db.config.QueryMaximumResults {
+		return 0, 0, fmt.Errorf("limit must not exceed the query maximum results of %d",
+			db.config.QueryMaximumResults)
+	}
+	return int(o), int(o + l), nil
+}
+
+func (db *DB) getShardsStatus(ctx context.Context, nodeName, className string,
+	shards []string,
+) ([]*models.NodeShardStatus, error) {
+	if db.schemaGetter.NodeName() == nodeName {
+		return db.localShardsStatus(className, shards), nil
+	}
+	statuses, err := db.remoteNode.GetShardsStatus(ctx, nodeName, className, shards)
+	if err != nil {
+		switch err.(type) {
+		case enterrors.ErrOpenHttpRequest, enterrors.ErrSendHttpRequest:
+			// the replicas of unavailable nodes are reported without statistics
+			return nil, nil
+		default:
+			return nil, err
+		}
+	}
+	return statuses, nil
+}
+
+// IncomingGetShardsStatus returns the status of the given shards which are
+// loaded on this node
+func (db *DB) IncomingGetShardsStatus(ctx context.Context, className string,
+	shards []string,
+) ([]*models.NodeShardStatus, error) {
+	return db.localShardsStatus(className, shards), nil
+}
+
+func (db *DB) localShardsStatus(className string, shards []string) []*models.NodeShardStatus {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil
+	}
+	statuses := make([]*models.NodeShardStatus, 0, len(shards))
+	for _, name := range shards {
+		shard := idx.shards.Load(name)
+		if shard == nil {
+			// shards of inactive tenants are not loaded
+			continue
+		}
+		statuses = append(statuses, &models.NodeShardStatus{
+			Name:                 name,
+			Class:                className,
+			ObjectCount:          int64(shard.objectCount()),
+			StorageStatus:        shard.getStatus().String(),
+			VectorIndexingStatus: shard.getVectorIndexingStatus().String(),
+			ReindexStatus:        shard.getReindexStatus(),
+		})
+	}
+	return statuses
+}
@@ -21,10 +21,12 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 func TestNodesAPI_Journey(t *testing.T) {
@@ -131,6 +133,139 @@ func TestNodesAPI_Journey(t *testing.T) {
 	assert.Equal(t, "ClassNodesAPI", nodeStatus.Shards[0].Class)
 	assert.True(t, len(nodeStatus.Shards[0].Name) > 0)
 	assert.Equal(t, int64(2), nodeStatus.Shards[0].ObjectCount)
+	assert.Equal(t, "READY", nodeStatus.Shards[0].StorageStatus)
+	assert.Equal(t, "READY", nodeStatus.Shards[0].VectorIndexingStatus)
 	assert.Equal(t, int64(2), nodeStatus.Stats.ObjectCount)
 	assert.Equal(t, int64(1), nodeStatus.Stats.ShardCount)
 }
+
+func TestNodesAPI_TenantsStatus(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	config, err := sharding.ParseConfig(nil, 1)
+	require.Nil(t, err)
+	shardState, err := sharding.InitState("tenants-status", config,
+		fakeNodes{[]string{"node1"}}, 1, true)
+	require.Nil(t, err)
+	shardState.AddPartition("tenant3", []string{"node1"}, models.TenantActivityStatusCOLD)
+	shardState.AddPartition("tenant1", []string{"node1"}, models.TenantActivityStatusHOT)
+	shardState.AddPartition("tenant2", []string{"node1"}, models.TenantActivityStatusHOT)
+
+	schemaGetter := &fakeSchemaGetter{shardState: shardState}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryLimit:                20,
+		QueryMaximumResults:       100,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class:               "TenantsStatus",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+	}
+	require.Nil(t, migrator.AddClass(context.Background(), class, shardState))
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	batch := objects.BatchObjects{
+		objects.BatchObject{
+			OriginalIndex: 0,
+			Object: &models.Object{
+				Class:  class.Class,
+				ID:     "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+				Tenant: "tenant1",
+			},
+			UUID: "8d5a3aa2-3c8d-4589-9ae1-3f638f506970",
+		},
+		objects.BatchObject{
+			OriginalIndex: 1,
+			Object: &models.Object{
+				Class:  class.Class,
+				ID:     "86a380e9-cb60-4b2a-bc48-51f52acd72d6",
+				Tenant: "tenant1",
+			},
+			UUID: "86a380e9-cb60-4b2a-bc48-51f52acd72d6",
+		},
+	}
+	batchRes, err := repo.BatchPutObjects(context.Background(), batch, nil)
+	require.Nil(t, err)
+	for _, res := range batchRes {
+		require.Nil(t, res.Err)
+	}
+
+	ptr := func(i int64) *int64 { return &i }
+
+	t.Run("all tenants", func(t *testing.T) {
+		tenants, total, err := repo.GetTenantsStatus(context.Background(), class.Class, "", nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, tenants, 3)
+
+		assert.Equal(t, &models.NodeTenantStatus{
+			Name:           "tenant1",
+			ActivityStatus: models.TenantActivityStatusHOT,
+			BelongsToNodes: []string{"node1"},
+			Replicas: []*models.NodeTenantReplicaStatus{{
+				Node:                 "node1",
+				ObjectCount:          2,
+				StorageStatus:        "READY",
+				VectorIndexingStatus: "READY",
+			}},
+		}, tenants[0])
+		assert.Equal(t, "tenant2", tenants[1].Name)
+		assert.Equal(t, int64(0), tenants[1].Replicas[0].ObjectCount)
+		assert.Equal(t, "READY", tenants[1].Replicas[0].StorageStatus)
+		assert.Equal(t, "READY", tenants[1].Replicas[0].VectorIndexingStatus)
+		assert.Equal(t, &models.NodeTenantStatus{
+			Name:           "tenant3",
+			ActivityStatus: models.TenantActivityStatusCOLD,
+			BelongsToNodes: []string{"node1"},
+			Replicas:       []*models.NodeTenantReplicaStatus{{Node: "node1"}},
+		}, tenants[2], "cold tenants have no statistics")
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		tenants, total, err := repo.GetTenantsStatus(context.Background(), class.Class, "", ptr(1), ptr(1))
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, tenants, 1)
+		assert.Equal(t, "tenant2", tenants[0].Name)
+
+		tenants, _, err = repo.GetTenantsStatus(context.Background(), class.Class, "", ptr(5), ptr(1))
+		require.Nil(t, err)
+		assert.Len(t, tenants, 0)
+
+		// only the limit is capped by the query maximum results
+		tenants, total, err = repo.GetTenantsStatus(context.Background(), class.Class, "", ptr(90), ptr(20))
+		require.Nil(t, err)
+		assert.Equal(t, int64(3), total)
+		assert.Len(t, tenants, 0)
+	})
+
+	t.Run("single tenant", func(t *testing.T) {
+		tenants, total, err := repo.GetTenantsStatus(context.Background(), class.Class, "tenant1", nil, nil)
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, tenants, 1)
+		assert.Equal(t, int64(2), tenants[0].Replicas[0].ObjectCount)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, _, err := repo.GetTenantsStatus(context.Background(), class.Class, "unknown", nil, nil)
+		assert.ErrorAs(t, err, &enterrors.ErrNotFound{})
+
+		_, _, err = repo.GetTenantsStatus(context.Background(), "Unknown", "", nil, nil)
+		assert.ErrorAs(t, err, &enterrors.ErrNotFound{})
+
+		_, _, err = repo.GetTenantsStatus(context.Background(), class.Class, "", nil, ptr(101))
+		assert.ErrorAs(t, err, &enterrors.ErrUnprocessable{})
+	})
+}
@@ -40,19 +40,31 @@ func (i Indices) ByProp(propName string) (Index, bool) {
 }
 
 func (i Indices) DropAll(ctx context.Context) error {
-	for propName, index := range i {
-		if index.Type != schema.DataTypeGeoCoordinates {
-			return errors.Errorf("no implementation to delete property %s index of type %v",
-				propName, index.Type)
+	for propName := range i {
+		if err := i.Drop(ctx, propName); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		if err := index.GeoIndex.Drop(ctx); err != nil {
-			return errors.Wrapf(err, "drop property %s", propName)
-		}
+// Drop removes the property-specific index of the given prop, if it exists
+func (i Indices) Drop(ctx context.Context, propName string) error {
+	index, ok := i[propName]
+	if !ok {
+		return nil
+	}
 
-		index.GeoIndex = nil
-		delete(i, propName)
+	if index.Type != schema.DataTypeGeoCoordinates {
+		return errors.Errorf("no implementation to delete property %s index of type %v",
+			propName, index.Type)
+	}
 
+	if err := index.GeoIndex.Drop(ctx); err != nil {
+		return errors.Wrapf(err, "drop property %s", propName)
 	}
+
+	index.GeoIndex = nil
+	delete(i, propName)
 	return nil
 }
@@ -0,0 +1,383 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// renameIndex moves the index of a renamed class to the new name. The index
+// is shut down, the files of all local shards are renamed on disk and the
+// index is loaded again under the new name. Stored objects and beacons
+// pointing to the class are rewritten afterwards.
+func (m *Migrator) renameIndex(ctx context.Context, className, newName string) error {
+	sch := m.db.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(newName))
+	if class == nil {
+		return fmt.Errorf("cannot find class %q", newName)
+	}
+	ss := m.db.schemaGetter.CopyShardingState(newName)
+	if ss == nil {
+		return fmt.Errorf("cannot find sharding state for %q", newName)
+	}
+
+	if err := m.db.shutdownIndex(ctx, schema.ClassName(className)); err != nil {
+		return err
+	}
+	if err := renameShardFiles(m.db.config.RootPath, indexID(schema.ClassName(className)),
+		indexID(schema.ClassName(newName)), ss.AllLocalPhysicalShards()); err != nil {
+		return err
+	}
+	if err := m.AddClass(ctx, class, ss); err != nil {
+		return errors.Wrapf(err, "load index of renamed class %q", newName)
+	}
+
+	idx := m.db.GetIndex(schema.ClassName(newName))
+	if idx == nil {
+		return fmt.Errorf("cannot find index for %q", newName)
+	}
+	err := m.forEachLocalShard(ctx, idx, class, nil, func(name string, shard *Shard) error {
+		if _, err := shard.renameClassOfObjects(ctx, newName); err != nil {
+			return errors.Wrapf(err, "rename class of objects on shard %q", name)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.renameBeacons(ctx, className, newName)
+}
+
+// shutdownIndex shuts the index down and removes it from the database
+// without deleting its files
+func (db *DB) shutdownIndex(ctx context.Context, className schema.ClassName) error {
+	db.indexLock.Lock()
+	defer db.indexLock.Unlock()
+
+	id := indexID(className)
+	index := db.indices[id]
+	if index == nil {
+		return fmt.Errorf("cannot find index for %q", className)
+	}
+
+	index.dropIndex.Lock()
+	defer index.dropIndex.Unlock()
+	if err := index.Shutdown(ctx); err != nil {
+		return errors.Wrapf(err, "shutdown index %q", id)
+	}
+	delete(db.indices, id)
+	return nil
+}
+
+// renameShardFiles renames all files and folders in the root path which
+// belong to the given shards of an index. All of them are prefixed with the
+// shard id, which is made up of the index id and the shard name.
+func renameShardFiles(rootPath, indexID, newIndexID string, shardNames []string) error {
+	entries, err := os.ReadDir(rootPath)
+	if err != nil {
+		return errors.Wrapf(err, "read dir %q", rootPath)
+	}
+
+	for _, entry := range entries {
+		for _, shardName := range shardNames {
+			suffix, ok := strings.CutPrefix(entry.Name(), indexID+"_"+shardName)
+			if !ok || (suffix != "" && suffix[0] != '.' && suffix[0] != '_') {
+				continue
+			}
+			oldPath := path.Join(rootPath, entry.Name())
+			newPath := path.Join(rootPath, newIndexID+"_"+shardName+suffix)
+			if err := os.Rename(oldPath, newPath); err != nil {
+				return errors.Wrapf(err, "rename %q to %q", oldPath, newPath)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// renameBeacons rewrites the beacons pointing to a renamed class in all
+// reference properties which point to the class
+func (m *Migrator) renameBeacons(ctx context.Context, className, newName string) error {
+	sch := m.db.schemaGetter.GetSchemaSkipAuth()
+	if sch.Objects == nil {
+		return nil
+	}
+
+	for _, class := range sch.Objects.Classes {
+		var propNames []string
+		for _, prop := range class.Properties {
+			for _, dataType := range prop.DataType {
+				if dataType == newName {
+					propNames = append(propNames, prop.Name)
+					break
+				}
+			}
+		}
+		if len(propNames) == 0 {
+			continue
+		}
+
+		idx := m.db.GetIndex(schema.ClassName(class.Class))
+		if idx == nil {
+			return fmt.Errorf("cannot find index for %q", class.Class)
+		}
+		err := m.forEachLocalShard(ctx, idx, class, nil, func(name string, shard *Shard) error {
+			if _, err := shard.renameBeacons(ctx, propNames, className, newName); err != nil {
+				return errors.Wrapf(err, "rename beacons of class %q on shard %q", class.Class, name)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renameProperty renames the buckets and the stored values of the property
+// on all local shards of the index
+func (m *Migrator) renameProperty(ctx context.Context, idx *Index, propName, newName string) error {
+	sch := idx.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(idx.Config.ClassName)
+	if class == nil {
+		return fmt.Errorf("cannot find class %q", idx.Config.ClassName)
+	}
+
+	// the buckets of shards which are not loaded are renamed on disk, so that
+	// they are loaded with the new name once the shard is opened
+	beforeOpen := func(name string) error {
+		lsmPath := fmt.Sprintf("%s/%s_%s_lsm", idx.Config.RootPath, idx.ID(), name)
+		for i, bucket := range propertyBuckets(propName) {
+			oldPath := path.Join(lsmPath, bucket)
+			newPath := path.Join(lsmPath, propertyBuckets(newName)[i])
+			if _, err := os.Stat(oldPath); os.IsNotExist(err) {
+				continue
+			}
+			// empty buckets of the new name might exist, as they would have been
+			// created when the shard was loaded last
+			if err := os.RemoveAll(newPath); err != nil {
+				return errors.Wrapf(err, "remove %q", newPath)
+			}
+			if err := os.Rename(oldPath, newPath); err != nil {
+				return errors.Wrapf(err, "rename %q to %q", oldPath, newPath)
+			}
+		}
+		return nil
+	}
+
+	return m.forEachLocalShard(ctx, idx, class, beforeOpen, func(name string, shard *Shard) error {
+		if err := shard.renameProperty(ctx, propName, newName); err != nil {
+			return errors.Wrapf(err, "rename property %q on shard %q", propName, name)
+		}
+		return nil
+	})
+}
+
+// forEachLocalShard calls f for all local shards of the index, except for
+// shards of offloaded tenants whose files are not available locally. Shards
+// which are not loaded are opened for the call and shut down afterwards,
+// beforeOpen is called before such a shard is opened.
+func (m *Migrator) forEachLocalShard(ctx context.Context, idx *Index, class *models.Class,
+	beforeOpen func(name string) error, f func(name string, shard *Shard) error,
+) error {
+	ss := idx.getSchema.CopyShardingState(class.Class)
+	if ss == nil {
+		return fmt.Errorf("cannot find sharding state for %q", class.Class)
+	}
+
+	for _, name := range ss.AllLocalPhysicalShards() {
+		if ss.Physical[name].IsOffloaded() {
+			continue
+		}
+		if shard := idx.shards.Load(name); shard != nil {
+			if err := f(name, shard); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if beforeOpen != nil {
+			if err := beforeOpen(name); err != nil {
+				return err
+			}
+		}
+		shard, err := NewShard(ctx, m.db.promMetrics, name, idx, class, idx.centralJobQueue)
+		if err != nil {
+			return fmt.Errorf("cannot load partition %q: %w", name, err)
+		}
+		err = f(name, shard)
+		if err2 := shard.shutdown(ctx); err2 != nil && err == nil {
+			err = fmt.Errorf("cannot shutdown partition %q: %w", name, err2)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renameProperty renames the buckets of the property and the property in all
+// stored objects
+func (s *Shard) renameProperty(ctx context.Context, propName, newName string) error {
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+
+	newBuckets := propertyBuckets(newName)
+	for i, bucket := range propertyBuckets(propName) {
+		if s.store.Bucket(bucket) == nil {
+			continue
+		}
+		if err := s.store.RenameBucket(ctx, bucket, newBuckets[i]); err != nil {
+			return errors.Wrapf(err, "rename bucket %q", bucket)
+		}
+	}
+
+	s.propLengths.RenameProperty(propName, newName)
+	if err := s.propLengths.Flush(false); err != nil {
+		return err
+	}
+
+	hasProperty := func(obj *storobj.Object) bool {
+		_, ok := obj.Properties().(map[string]interface{})[propName]
+		return ok
+	}
+	_, err := s.rewriteObjects(ctx, hasProperty, func(obj *storobj.Object) error {
+		props := obj.Properties().(map[string]interface{})
+		props[newName] = props[propName]
+		delete(props, propName)
+		return nil
+	})
+	return err
+}
+
+// renameClassOfObjects sets the class of all stored objects which are not
+// of the given class yet
+func (s *Shard) renameClassOfObjects(ctx context.Context, className string) (int, error) {
+	return s.rewriteObjects(ctx,
+		func(obj *storobj.Object) bool { return obj.Class().String() != className },
+		func(obj *storobj.Object) error {
+			obj.SetClass(className)
+			return nil
+		})
+}
+
+// renameBeacons rewrites the class of beacons pointing to a renamed class in
+// the given reference properties of all stored objects. The inverted index
+// of the properties is updated accordingly, as it contains the beacons.
+func (s *Shard) renameBeacons(ctx context.Context, propNames []string,
+	className, newName string,
+) (int, error) {
+	hasBeacons := func(obj *storobj.Object) bool {
+		for _, ref := range refsOfProps(obj, propNames) {
+			if beaconClass(ref) == className {
+				return true
+			}
+		}
+		return false
+	}
+
+	return s.rewriteObjects(ctx, hasBeacons, func(obj *storobj.Object) error {
+		s.doubleWriteLock.RLock()
+		defer s.doubleWriteLock.RUnlock()
+
+		previous, _, err := s.analyzeObject(obj)
+		if err != nil {
+			return errors.Wrap(err, "analyze previous object")
+		}
+		if err := s.deleteFromReindexedBucketsLSM(obj, obj.DocID()); err != nil {
+			return errors.Wrap(err, "delete previous beacons from reindexed inverted index")
+		}
+
+		props := obj.Properties().(map[string]interface{})
+		for _, propName := range propNames {
+			refs, ok := props[propName].(models.MultipleRef)
+			if !ok {
+				continue
+			}
+			renamed := make(models.MultipleRef, len(refs))
+			for i, ref := range refs {
+				renamed[i] = ref
+				if beaconClass(ref) != className {
+					continue
+				}
+				parsed, _ := crossref.ParseSingleRef(ref)
+				copied := *ref
+				copied.Beacon = strfmt.URI(crossref.New(parsed.PeerName, newName, parsed.TargetID).String())
+				if copied.Class == strfmt.URI(className) {
+					copied.Class = strfmt.URI(newName)
+				}
+				renamed[i] = &copied
+			}
+			props[propName] = renamed
+		}
+
+		next, _, err := s.analyzeObject(obj)
+		if err != nil {
+			return errors.Wrap(err, "analyze next object")
+		}
+		delta := inverted.Delta(previous, next)
+		if err := s.deleteFromInvertedIndicesLSM(delta.ToDelete, obj.DocID()); err != nil {
+			return errors.Wrap(err, "delete previous beacons from inverted index")
+		}
+		for _, prop := range delta.ToAdd {
+			if err := s.addToPropertyValueIndex(obj.DocID(), prop); err != nil {
+				return errors.Wrap(err, "add beacons to inverted index")
+			}
+		}
+		if err := s.extendReindexedBucketsLSM(obj, obj.DocID()); err != nil {
+			return errors.Wrap(err, "add beacons to reindexed inverted index")
+		}
+		return nil
+	})
+}
+
+func refsOfProps(obj *storobj.Object, propNames []string) models.MultipleRef {
+	props, ok := obj.Properties().(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	var refs models.MultipleRef
+	for _, propName := range propNames {
+		if propRefs, ok := props[propName].(models.MultipleRef); ok {
+			refs = append(refs, propRefs...)
+		}
+	}
+	return refs
+}
+
+// beaconClass returns the class segment of the beacon of the reference or an
+// empty string if the beacon does not contain a class
+func beaconClass(ref *models.SingleRef) string {
+	if ref == nil {
+		return ""
+	}
+	parsed, err := crossref.ParseSingleRef(ref)
+	if err != nil {
+		return ""
+	}
+	return parsed.Class
+}
@@ -0,0 +1,321 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestRenameClassAndProperty(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	rootPath := t.TempDir()
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	newRepo := func(t *testing.T) (*DB, *Migrator) {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  rootPath,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo, NewMigrator(repo, logger)
+	}
+	repo, migrator := newRepo(t)
+	defer func() { repo.Shutdown(context.Background()) }()
+
+	author := &models.Class{
+		Class:               "RenameAuthor",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	article := &models.Class{
+		Class:               "RenameArticle",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:     "writtenBy",
+				DataType: []string{"RenameAuthor"},
+			},
+		},
+	}
+	for _, class := range []*models.Class{author, article} {
+		require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	}
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{author, article}}
+
+	authorID := strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506970")
+	articleID := strfmt.UUID("86a380e9-cb60-4b2a-bc48-51f52acd72d6")
+	require.Nil(t, repo.PutObject(ctx, &models.Object{
+		Class:      author.Class,
+		ID:         authorID,
+		Properties: map[string]interface{}{"name": "alice"},
+	}, []float32{1, 2, 3}, nil))
+	require.Nil(t, repo.PutObject(ctx, &models.Object{
+		Class: article.Class,
+		ID:    articleID,
+		Properties: map[string]interface{}{
+			"title": "renaming things is hard",
+			"writtenBy": models.MultipleRef{
+				crossref.NewLocalhost(author.Class, authorID).SingleRef(),
+			},
+		},
+	}, []float32{1, 2, 3}, nil))
+
+	searchByAuthorName := func(t *testing.T, authorClass, name string) int {
+		res, err := repo.Search(ctx, dto.GetParams{
+			ClassName:  article.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(article.Class),
+						Property: "writtenBy",
+						Child: &filters.Path{
+							Class:    schema.ClassName(authorClass),
+							Property: "name",
+						},
+					},
+					Value: &filters.Value{Value: name, Type: schema.DataTypeText},
+				},
+			},
+		})
+		require.Nil(t, err)
+		return len(res)
+	}
+	assertRenamedClass := func(t *testing.T) {
+		assert.Nil(t, repo.GetIndex("RenameAuthor"))
+
+		res, err := repo.ObjectByID(ctx, authorID, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "RenameWriter", res.ClassName)
+
+		res, err = repo.ObjectByID(ctx, articleID, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		refs := res.Object().Properties.(map[string]interface{})["writtenBy"].(models.MultipleRef)
+		require.Len(t, refs, 1)
+		assert.Equal(t, crossref.NewLocalhost("RenameWriter", authorID).String(), refs[0].Beacon.String())
+
+		assert.Equal(t, 1, searchByAuthorName(t, "RenameWriter", "alice"))
+	}
+
+	t.Run("rename the referenced class", func(t *testing.T) {
+		require.Equal(t, 1, searchByAuthorName(t, "RenameAuthor", "alice"))
+
+		// update the schema, as the schema manager would
+		author.Class = "RenameWriter"
+		article.Properties[1].DataType = []string{"RenameWriter"}
+		newName := "RenameWriter"
+		require.Nil(t, migrator.UpdateClass(ctx, "RenameAuthor", &newName))
+
+		assertRenamedClass(t)
+	})
+
+	t.Run("rename a property", func(t *testing.T) {
+		article.Properties[0].Name = "headline"
+		newName := "headline"
+		require.Nil(t, migrator.UpdateProperty(ctx, article.Class, "title", &newName))
+
+		res, err := repo.ObjectByID(ctx, articleID, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		props := res.Object().Properties.(map[string]interface{})
+		assert.Equal(t, "renaming things is hard", props["headline"])
+		assert.NotContains(t, props, "title")
+
+		res2, err := repo.Search(ctx, dto.GetParams{
+			ClassName:  article.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(article.Class),
+						Property: "headline",
+					},
+					Value: &filters.Value{Value: "hard", Type: schema.DataTypeText},
+				},
+			},
+		})
+		require.Nil(t, err)
+		assert.Len(t, res2, 1)
+
+		res2, err = repo.Search(ctx, dto.GetParams{
+			ClassName:  article.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type:       "bm25",
+				Query:      "renaming",
+				Properties: []string{"headline"},
+			},
+		})
+		require.Nil(t, err)
+		assert.Len(t, res2, 1)
+	})
+
+	t.Run("renames are kept after a restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(context.Background()))
+		repo, migrator = newRepo(t)
+
+		assertRenamedClass(t)
+		res, err := repo.ObjectByID(ctx, articleID, nil, additional.Properties{}, "")
+		require.Nil(t, err)
+		assert.Equal(t, "renaming things is hard",
+			res.Object().Properties.(map[string]interface{})["headline"])
+	})
+}
+
+func TestRenameClassWithCompressedIndex(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	rootPath := t.TempDir()
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	newRepo := func(t *testing.T) (*DB, *Migrator) {
+		repo, err := New(logger, Config{
+			MemtablesFlushIdleAfter:   60,
+			RootPath:                  rootPath,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo, NewMigrator(repo, logger)
+	}
+	repo, migrator := newRepo(t)
+	defer func() { repo.Shutdown(context.Background()) }()
+
+	vectorIndexConfig := enthnsw.NewDefaultUserConfig()
+	vectorIndexConfig.BQ = enthnsw.BQConfig{Enabled: true}
+	class := &models.Class{
+		Class:               "RenameCompressed",
+		VectorIndexConfig:   vectorIndexConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+		},
+	}
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	vector := func(i int) []float32 {
+		return []float32{float32(i), -float32(i), float32(i % 3), 1}
+	}
+	for i := 0; i < 20; i++ {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			Class:      class.Class,
+			ID:         strfmt.UUID(fmt.Sprintf("8f2a1f3c-2ef5-4a0e-9b64-7a1c0a6e8b%02d", i)),
+			Properties: map[string]interface{}{"name": fmt.Sprintf("obj-%d", i)},
+		}, vector(i), nil))
+	}
+
+	search := func(t *testing.T, i int) error {
+		res, err := repo.VectorSearch(ctx, dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: vector(i),
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, []interface{}{fmt.Sprintf("obj-%d", i)}, extractPropValues(res, "name"))
+		return nil
+	}
+	compressedStorePath := func(t *testing.T) string {
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		var storePath string
+		idx.ForEachShard(func(_ string, shard *Shard) error {
+			storePath = hnsw.CompressedStorePath(rootPath, shard.vectorIndexID(""))
+			return nil
+		})
+		return storePath
+	}
+
+	previousStorePath := compressedStorePath(t)
+	require.DirExists(t, previousStorePath)
+
+	t.Run("rename the class", func(t *testing.T) {
+		// update the schema, as the schema manager would
+		class.Class = "RenamedCompressed"
+		newName := class.Class
+		require.Nil(t, migrator.UpdateClass(ctx, "RenameCompressed", &newName))
+
+		assert.NoDirExists(t, previousStorePath)
+		assert.DirExists(t, compressedStorePath(t))
+		// the compressed vectors cache of the reloaded index is prefilled in
+		// the background
+		require.Eventually(t, func() bool {
+			return search(t, 0) == nil
+		}, 10*time.Second, 50*time.Millisecond)
+		for _, i := range []int{3, 11, 17} {
+			require.Nil(t, search(t, i))
+		}
+	})
+
+	t.Run("compressed vectors are kept after a restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(context.Background()))
+		repo, migrator = newRepo(t)
+
+		assert.DirExists(t, compressedStorePath(t))
+		// the compressed vectors cache is prefilled in the background
+		require.Eventually(t, func() bool {
+			return search(t, 0) == nil
+		}, 10*time.Second, 50*time.Millisecond)
+		for _, i := range []int{3, 11, 17} {
+			require.Nil(t, search(t, i))
+		}
+	})
+}
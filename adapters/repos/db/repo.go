@@ -22,6 +22,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -71,12 +72,20 @@ type DB struct {
 	jobQueueCh          chan job
 	shutDownWg          sync.WaitGroup
 	maxNumberGoroutines int
+
+	// offloadBackend stores the files of offloaded tenants
+	offloadBackend modulecapabilities.OffloadBackend
 }
 
 func (db *DB) SetSchemaGetter(sg schemaUC.SchemaGetter) {
 	db.schemaGetter = sg
 }
 
+// SetOffloadBackend sets the backend used to offload inactive tenants
+func (db *DB) SetOffloadBackend(backend modulecapabilities.OffloadBackend) {
+	db.offloadBackend = backend
+}
+
 func (db *DB) WaitForStartup(ctx context.Context) error {
 	err := db.init(ctx)
 	if err != nil {
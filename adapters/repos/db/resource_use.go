@@ -64,6 +64,28 @@ func (d *DB) scanResourceUsage() {
 	}()
 }
 
+// MemtablePressure returns true if any loaded shard has memtables that fill
+// up faster than they can be flushed. Importers can use this to slow down.
+func (d *DB) MemtablePressure() bool {
+	d.indexLock.RLock()
+	defer d.indexLock.RUnlock()
+
+	pressure := false
+	for _, index := range d.indices {
+		index.ForEachShard(func(name string, shard *Shard) error {
+			if !pressure && shard.store.MemtablePressure() {
+				pressure = true
+			}
+			return nil
+		})
+		if pressure {
+			return true
+		}
+	}
+
+	return false
+}
+
 type resourceScanState struct {
 	disk       *scanState
 	mem        *scanState
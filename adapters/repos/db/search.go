@@ -111,7 +111,7 @@ func (db *DB) VectorSearch(ctx context.Context,
 
 	targetDist := extractDistanceFromParams(params)
 	res, dists, err := idx.objectVectorSearch(ctx, params.SearchVector,
-		targetDist, totalLimit, params.Filters, params.Sort, params.GroupBy,
+		params.TargetVector, targetDist, totalLimit, params.Filters, params.Sort, params.GroupBy,
 		params.AdditionalProperties, params.ReplicationProperties, params.Tenant)
 	if err != nil {
 		return nil, errors.Wrapf(err, "object vector search at index %s", idx.ID())
@@ -143,7 +143,7 @@ func extractDistanceFromParams(params dto.GetParams) float32 {
 // Class VectorSearch method fit this need. Later on, other use cases presented the need
 // for the raw storage objects, such as hybrid search.
 func (db *DB) DenseObjectSearch(ctx context.Context, class string, vector []float32,
-	offset int, limit int, filters *filters.LocalFilter, addl additional.Properties,
+	targetVector string, offset int, limit int, filters *filters.LocalFilter, addl additional.Properties,
 	tenant string,
 ) ([]*storobj.Object, []float32, error) {
 	totalLimit := offset + limit
@@ -154,7 +154,7 @@ func (db *DB) DenseObjectSearch(ctx context.Context, class string, vector []floa
 	}
 
 	// TODO: groupBy think of this
-	objs, dist, err := index.objectVectorSearch(ctx, vector, 0,
+	objs, dist, err := index.objectVectorSearch(ctx, vector, targetVector, 0,
 		totalLimit, filters, nil, nil, addl, nil, tenant)
 	if err != nil {
 		return nil, nil, fmt.Errorf("search index %s: %w", index.ID(), err)
@@ -179,7 +179,7 @@ func (db *DB) CrossClassVectorSearch(ctx context.Context, vector []float32, offs
 		go func(index *Index, wg *sync.WaitGroup) {
 			defer wg.Done()
 
-			objs, dist, err := index.objectVectorSearch(ctx, vector,
+			objs, dist, err := index.objectVectorSearch(ctx, vector, "",
 				0, totalLimit, filters, nil, nil,
 				additional.Properties{}, nil, "")
 			if err != nil {
@@ -27,13 +27,18 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
+	dynament "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"golang.org/x/sync/errgroup"
@@ -50,6 +55,7 @@ type Shard struct {
 	store           *lsmkv.Store
 	counter         *indexcounter.Counter
 	vectorIndex     VectorIndex
+	vectorIndexes   map[string]VectorIndex // named vectors, keyed by target vector
 	metrics         *Metrics
 	promMetrics     *monitoring.PrometheusMetrics
 	propertyIndices propertyspecific.Indices
@@ -62,6 +68,25 @@ type Shard struct {
 	propertyIndicesLock sync.RWMutex
 	stopMetrics         chan struct{}
 
+	// reindexLock serializes background reindexing after property index
+	// settings were changed, reindexStatus holds the progress of the most
+	// recent one
+	reindexLock       sync.Mutex
+	reindexStatus     *models.ShardReindexStatus
+	reindexStatusLock sync.Mutex
+
+	// doubleWriteBuckets maps the names of buckets which are rebuilt by a
+	// running reindexing to the temporary buckets they are rebuilt in, writes
+	// to the inverted index go to both. reindexedProps holds the properties
+	// whose buckets do not match their definition in the schema yet,
+	// doubleWriteCursor is the key of the last object the running reindexing
+	// populated the temporary buckets with. doubleWriteLock is held for
+	// reading while writing to the inverted buckets.
+	doubleWriteBuckets map[string]*lsmkv.Bucket
+	reindexedProps     map[string]*reindexedProperty
+	doubleWriteCursor  []byte
+	doubleWriteLock    sync.RWMutex
+
 	centralJobQueue chan job // reference to queue used by all shards
 
 	docIdLock []sync.Mutex
@@ -104,78 +129,210 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 
 	defer s.metrics.ShardStartup(before)
 
-	hnswUserConfig, ok := index.vectorIndexUserConfig.(hnswent.UserConfig)
-	if !ok {
-		return nil, errors.Errorf("hnsw vector index: config is not hnsw.UserConfig: %T",
-			index.vectorIndexUserConfig)
+	if err := s.initNonVector(ctx, class); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q", s.ID())
 	}
 
-	if hnswUserConfig.Skip {
-		s.vectorIndex = noop.NewIndex()
-	} else {
-		if err := s.initVectorIndex(ctx, hnswUserConfig); err != nil {
-			return nil, fmt.Errorf("init vector index: %w", err)
-		}
+	if err := s.initVectorIndexes(ctx); err != nil {
+		return nil, fmt.Errorf("init vector index: %w", err)
+	}
+	defer s.postStartupVectorIndexes()
 
-		defer s.vectorIndex.PostStartup()
+	return s, nil
+}
+
+// initVectorIndexes creates the vector index of the class level vector as
+// well as one vector index per named vector
+func (s *Shard) initVectorIndexes(ctx context.Context) error {
+	vi, err := s.initVectorIndex(ctx, "", s.index.vectorIndexUserConfig)
+	if err != nil {
+		return err
 	}
+	s.vectorIndex = vi
 
-	if err := s.initNonVector(ctx, class); err != nil {
-		return nil, errors.Wrapf(err, "init shard %q", s.ID())
+	s.vectorIndexes = make(map[string]VectorIndex, len(s.index.vectorIndexUserConfigs))
+	for targetVector, vectorIndexUserConfig := range s.index.vectorIndexUserConfigs {
+		vi, err := s.initVectorIndex(ctx, targetVector, vectorIndexUserConfig)
+		if err != nil {
+			return fmt.Errorf("named vector %q: %w", targetVector, err)
+		}
+		s.vectorIndexes[targetVector] = vi
 	}
 
-	return s, nil
+	return nil
 }
 
-func (s *Shard) initVectorIndex(
-	ctx context.Context, hnswUserConfig hnswent.UserConfig,
-) error {
-	var distProv distancer.Provider
+// initVectorIndex creates the vector index matching the configured index
+// type. It requires the LSM store to be initialized, as some index types
+// persist their vectors in a bucket of the shard's store. An empty target
+// vector refers to the class level vector.
+func (s *Shard) initVectorIndex(ctx context.Context, targetVector string,
+	vectorIndexUserConfig schema.VectorIndexConfig,
+) (VectorIndex, error) {
+	switch vectorIndexUserConfig := vectorIndexUserConfig.(type) {
+	case hnswent.UserConfig:
+		if vectorIndexUserConfig.Skip {
+			return noop.NewIndex(), nil
+		}
+		return s.createHnswIndex(ctx, targetVector, vectorIndexUserConfig)
+	case flatent.UserConfig:
+		return s.createFlatIndex(ctx, targetVector, vectorIndexUserConfig)
+	case dynament.UserConfig:
+		return s.createDynamicIndex(ctx, targetVector, vectorIndexUserConfig)
+	default:
+		return nil, errors.Errorf("unsupported vector index config: %T",
+			vectorIndexUserConfig)
+	}
+}
+
+// vectorIndexID is the id of the vector index of the given target vector.
+// Named vectors are separated by a dot, which is neither allowed in shard
+// nor in vector names, so that ids can never clash.
+func (s *Shard) vectorIndexID(targetVector string) string {
+	if targetVector == "" {
+		return s.ID()
+	}
+	return fmt.Sprintf("%s.%s", s.ID(), targetVector)
+}
 
-	switch hnswUserConfig.Distance {
+func distancerProviderFromName(distance string) (distancer.Provider, error) {
+	switch distance {
 	case "", hnswent.DistanceCosine:
-		distProv = distancer.NewCosineDistanceProvider()
+		return distancer.NewCosineDistanceProvider(), nil
 	case hnswent.DistanceDot:
-		distProv = distancer.NewDotProductProvider()
+		return distancer.NewDotProductProvider(), nil
 	case hnswent.DistanceL2Squared:
-		distProv = distancer.NewL2SquaredProvider()
+		return distancer.NewL2SquaredProvider(), nil
 	case hnswent.DistanceManhattan:
-		distProv = distancer.NewManhattanProvider()
+		return distancer.NewManhattanProvider(), nil
 	case hnswent.DistanceHamming:
-		distProv = distancer.NewHammingProvider()
+		return distancer.NewHammingProvider(), nil
 	default:
-		return errors.Errorf("unrecognized distance metric %q,"+
-			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", hnswUserConfig.Distance)
+		return nil, errors.Errorf("unrecognized distance metric %q,"+
+			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", distance)
+	}
+}
+
+func (s *Shard) createHnswIndex(ctx context.Context, targetVector string,
+	hnswUserConfig hnswent.UserConfig,
+) (VectorIndex, error) {
+	distProv, err := distancerProviderFromName(hnswUserConfig.Distance)
+	if err != nil {
+		return nil, err
 	}
 
 	// starts vector cycles if vector is configured
 	s.index.cycleCallbacks.vectorCommitLoggerCycle.Start()
 	s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
 
+	id := s.vectorIndexID(targetVector)
+	if targetVector == "" {
+		if err := s.migrateLegacyCompressedStore(id); err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: hnsw index", id)
+		}
+	}
+
 	vi, err := hnsw.New(hnsw.Config{
-		Logger:               s.index.logger,
-		RootPath:             s.index.Config.RootPath,
-		ID:                   s.ID(),
-		ShardName:            s.name,
-		ClassName:            s.index.Config.ClassName.String(),
-		PrometheusMetrics:    s.promMetrics,
-		VectorForIDThunk:     s.vectorByIndexID,
-		TempVectorForIDThunk: s.readVectorByIndexIDIntoSlice,
-		DistanceProvider:     distProv,
+		Logger:            s.index.logger,
+		RootPath:          s.index.Config.RootPath,
+		ID:                id,
+		ShardName:         s.name,
+		ClassName:         s.index.Config.ClassName.String(),
+		PrometheusMetrics: s.promMetrics,
+		VectorForIDThunk: func(ctx context.Context, indexID uint64) ([]float32, error) {
+			return s.targetVectorByIndexID(ctx, indexID, targetVector)
+		},
+		TempVectorForIDThunk: func(ctx context.Context, indexID uint64, container *hnsw.VectorSlice) ([]float32, error) {
+			return s.readTargetVectorByIndexIDIntoSlice(ctx, indexID, container, targetVector)
+		},
+		DistanceProvider: distProv,
 		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-			return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(),
+			return hnsw.NewCommitLogger(s.index.Config.RootPath, id,
 				s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks)
 		},
 	}, hnswUserConfig,
 		s.cycleCallbacks.vectorTombstoneCleanupCallbacks, s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks)
 	if err != nil {
-		return errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
+		return nil, errors.Wrapf(err, "init shard %q: hnsw index", id)
 	}
-	s.vectorIndex = vi
 
+	return vi, nil
+}
+
+// migrateLegacyCompressedStore moves the compressed vectors of the default
+// vector index from <root>/<ClassName>/<shard>, which was shared by all vector
+// indexes of the shard, to the store derived from the index id
+func (s *Shard) migrateLegacyCompressedStore(id string) error {
+	classPath := path.Join(s.index.Config.RootPath, s.index.Config.ClassName.String())
+	legacyPath := path.Join(classPath, s.name)
+	if _, err := os.Stat(legacyPath); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	targetPath := hnsw.CompressedStorePath(s.index.Config.RootPath, id)
+	if _, err := os.Stat(targetPath); err == nil {
+		return nil
+	}
+	if err := os.Rename(legacyPath, targetPath); err != nil {
+		return errors.Wrap(err, "move legacy compressed vectors store")
+	}
+	// the class directory only held the stores of its shards
+	os.Remove(classPath)
 	return nil
 }
 
+func (s *Shard) createFlatIndex(ctx context.Context, targetVector string,
+	flatUserConfig flatent.UserConfig,
+) (VectorIndex, error) {
+	distProv, err := distancerProviderFromName(flatUserConfig.Distance)
+	if err != nil {
+		return nil, err
+	}
+
+	id := s.vectorIndexID(targetVector)
+	vi, err := flat.New(flat.Config{
+		ID:               id,
+		TargetVector:     targetVector,
+		Logger:           s.index.logger,
+		DistanceProvider: distProv,
+	}, flatUserConfig, s.store)
+	if err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: flat index", id)
+	}
+
+	return vi, nil
+}
+
+func (s *Shard) createDynamicIndex(ctx context.Context, targetVector string,
+	dynamicUserConfig dynament.UserConfig,
+) (VectorIndex, error) {
+	distProv, err := distancerProviderFromName(dynamicUserConfig.Distance)
+	if err != nil {
+		return nil, err
+	}
+
+	id := s.vectorIndexID(targetVector)
+	vi, err := dynamic.New(dynamic.Config{
+		RootPath:         s.index.Config.RootPath,
+		ID:               id,
+		TargetVector:     targetVector,
+		Logger:           s.index.logger,
+		DistanceProvider: distProv,
+		Store:            s.store,
+		MakeHnswIndex: func(uc hnswent.UserConfig) (dynamic.VectorIndex, error) {
+			return s.createHnswIndex(ctx, targetVector, uc)
+		},
+	}, dynamicUserConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: dynamic index", id)
+	}
+
+	return vi, nil
+}
+
 func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 	err := s.initLSMStore(ctx)
 	if err != nil {
@@ -282,6 +439,15 @@ func (s *Shard) drop() error {
 		return fmt.Errorf("drop shard '%s': %w", s.name, err)
 	}
 
+	// remove vector index before the store is shut down, this stops a running
+	// upgrade of a dynamic index, which still reads from and writes to the store
+	err := s.forEachVectorIndex(func(_ string, vi VectorIndex) error {
+		return vi.Drop(ctx)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "remove vector index at %s", s.DBPathLSM())
+	}
+
 	if err := s.store.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "stop lsmkv store")
 	}
@@ -293,7 +459,7 @@ func (s *Shard) drop() error {
 		}
 	}
 	// delete indexcount
-	err := s.counter.Drop()
+	err = s.counter.Drop()
 	if err != nil {
 		return errors.Wrapf(err, "remove indexcount at %s", s.DBPathLSM())
 	}
@@ -303,12 +469,6 @@ func (s *Shard) drop() error {
 	if err != nil {
 		return errors.Wrapf(err, "remove indexcount at %s", s.DBPathLSM())
 	}
-	// remove vector index
-	err = s.vectorIndex.Drop(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "remove vector index at %s", s.DBPathLSM())
-	}
-
 	// delete indexcount
 	err = s.propLengths.Drop()
 	if err != nil {
@@ -399,35 +559,44 @@ func (s *Shard) dynamicMemtableSizing() lsmkv.BucketOption {
 }
 
 func (s *Shard) createPropertyIndex(ctx context.Context, prop *models.Property, eg *errgroup.Group) {
+	if _, ok := schema.AsNested(prop.DataType); ok {
+		// nested properties are indexed by their path, e.g. "address.city"
+		for _, leaf := range schema.NestedLeafProperties(prop) {
+			s.createPropertyIndex(ctx, leaf, eg)
+		}
+		return
+	}
+
 	if !inverted.HasInvertedIndex(prop) {
 		return
 	}
 
+	// all indexes are scheduled from the caller's goroutine, calling eg.Go
+	// from within a running task deadlocks once the group's limit is reached
 	eg.Go(func() error {
 		if err := s.createPropertyValueIndex(ctx, prop); err != nil {
 			return errors.Wrapf(err, "create property '%s' value index on shard '%s'", prop.Name, s.ID())
 		}
-
-		if s.index.invertedIndexConfig.IndexNullState {
-			eg.Go(func() error {
-				if err := s.createPropertyNullIndex(ctx, prop); err != nil {
-					return errors.Wrapf(err, "create property '%s' null index on shard '%s'", prop.Name, s.ID())
-				}
-				return nil
-			})
-		}
-
-		if s.index.invertedIndexConfig.IndexPropertyLength {
-			eg.Go(func() error {
-				if err := s.createPropertyLengthIndex(ctx, prop); err != nil {
-					return errors.Wrapf(err, "create property '%s' length index on shard '%s'", prop.Name, s.ID())
-				}
-				return nil
-			})
-		}
-
 		return nil
 	})
+
+	if s.index.invertedIndexConfig.IndexNullState {
+		eg.Go(func() error {
+			if err := s.createPropertyNullIndex(ctx, prop); err != nil {
+				return errors.Wrapf(err, "create property '%s' null index on shard '%s'", prop.Name, s.ID())
+			}
+			return nil
+		})
+	}
+
+	if s.index.invertedIndexConfig.IndexPropertyLength {
+		eg.Go(func() error {
+			if err := s.createPropertyLengthIndex(ctx, prop); err != nil {
+				return errors.Wrapf(err, "create property '%s' length index on shard '%s'", prop.Name, s.ID())
+			}
+			return nil
+		})
+	}
 }
 
 func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Property) error {
@@ -523,6 +692,41 @@ func (s *Shard) updateVectorIndexConfig(ctx context.Context,
 	})
 }
 
+func (s *Shard) updateVectorIndexConfigs(ctx context.Context,
+	updated map[string]schema.VectorIndexConfig,
+) error {
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+
+	for targetVector := range updated {
+		if _, err := s.namedVectorIndex(targetVector); err != nil {
+			return err
+		}
+	}
+
+	err := s.updateStatus(storagestate.StatusReadOnly.String())
+	if err != nil {
+		return fmt.Errorf("attempt to mark read-only: %w", err)
+	}
+
+	// the shard is marked ready again once all vector indexes have applied
+	// their update, which may happen asynchronously
+	wg := &sync.WaitGroup{}
+	wg.Add(len(updated))
+	go func() {
+		wg.Wait()
+		s.updateStatus(storagestate.StatusReady.String())
+	}()
+
+	ec := &errorcompounder.ErrorCompounder{}
+	for targetVector, cfg := range updated {
+		err := s.vectorIndexes[targetVector].UpdateUserConfig(cfg, wg.Done)
+		ec.AddWrap(err, fmt.Sprintf("named vector %q", targetVector))
+	}
+	return ec.ToError()
+}
+
 func (s *Shard) shutdown(ctx context.Context) error {
 	if s.index.Config.TrackVectorDimensions {
 		// tracking vector dimensions goroutine only works when tracking is enabled
@@ -539,11 +743,13 @@ func (s *Shard) shutdown(ctx context.Context) error {
 	// 'RemoveTombstone' entry is not picked up on restarts
 	// resulting in perpetually attempting to remove a tombstone
 	// which doesn't actually exist anymore
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush vector index commitlog")
 	}
 
-	if err := s.vectorIndex.Shutdown(ctx); err != nil {
+	if err := s.forEachVectorIndex(func(_ string, vi VectorIndex) error {
+		return vi.Shutdown(ctx)
+	}); err != nil {
 		return errors.Wrap(err, "shut down vector index")
 	}
 
@@ -21,7 +21,7 @@ import (
 func (s *Shard) aggregate(ctx context.Context,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
-	return aggregator.New(s.store, params, s.index.getSchema,
+	return aggregator.New(s.store, params, &readSchemaGetter{s.index.getSchema, s},
 		s.index.classSearcher, s.deletedDocIDs, s.index.stopwords, s.versioner.Version(),
 		s.vectorIndex, s.index.logger, s.propLengths, s.isFallbackToSearchable, s.tenant()).
 		Do(ctx)
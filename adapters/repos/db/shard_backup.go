@@ -44,7 +44,9 @@ func (s *Shard) beginBackup(ctx context.Context) (err error) {
 	if err = s.cycleCallbacks.geoPropsCombinedCallbacksCtrl.Deactivate(ctx); err != nil {
 		return fmt.Errorf("pause geo props maintenance: %w", err)
 	}
-	if err = s.vectorIndex.SwitchCommitLogs(ctx); err != nil {
+	if err = s.forEachVectorIndex(func(_ string, vi VectorIndex) error {
+		return vi.SwitchCommitLogs(ctx)
+	}); err != nil {
 		return errors.Wrap(err, "switch commit logs")
 	}
 	return nil
@@ -59,12 +61,14 @@ func (s *Shard) listBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 	if ret.Files, err = s.store.ListFiles(ctx); err != nil {
 		return err
 	}
-	files2, err := s.vectorIndex.ListFiles(ctx)
-	if err != nil {
-		return err
-	}
-	ret.Files = append(ret.Files, files2...)
-	return nil
+	return s.forEachVectorIndex(func(_ string, vi VectorIndex) error {
+		files, err := vi.ListFiles(ctx)
+		if err != nil {
+			return err
+		}
+		ret.Files = append(ret.Files, files...)
+		return nil
+	})
 }
 
 func (s *Shard) resumeMaintenanceCycles(ctx context.Context) error {
@@ -0,0 +1,202 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// dropProperty removes the indexes of a property which was deleted from the
+// schema on all local shards. Shards which are not loaded are opened for it,
+// the buckets of their property are removed from disk beforehand, as buckets
+// of properties which are not part of the schema are not loaded. If purge is
+// set, the values of the property are removed from the stored objects before
+// returning, so that a property added again with the same name can not lose
+// its values to a purge still in progress.
+func (m *Migrator) dropProperty(ctx context.Context, idx *Index, propName string, purge bool) error {
+	sch := idx.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(idx.Config.ClassName)
+	if class == nil {
+		return fmt.Errorf("cannot find class %q", idx.Config.ClassName)
+	}
+
+	beforeOpen := func(name string) error {
+		shardID := fmt.Sprintf("%s_%s", idx.ID(), name)
+		return removePropertyFiles(idx.Config.RootPath, shardID, propName)
+	}
+
+	return m.forEachLocalShard(ctx, idx, class, beforeOpen, func(name string, shard *Shard) error {
+		if err := shard.dropProperty(ctx, propName); err != nil {
+			return errors.Wrapf(err, "drop property %q on shard %q", propName, name)
+		}
+		if !purge {
+			return nil
+		}
+		count, err := shard.purgeProperty(ctx, propName)
+		if err != nil {
+			return errors.Wrapf(err, "purge property %q on shard %q", propName, name)
+		}
+		m.logger.WithField("action", "purge_property").
+			WithField("class", idx.Config.ClassName).
+			WithField("property", propName).
+			WithField("shard", name).
+			Infof("purged values of deleted property from %d objects", count)
+		return nil
+	})
+}
+
+// removePropertyFiles removes the buckets and the geo index of a property
+// from the files of a shard which is not loaded
+func removePropertyFiles(rootPath, shardID, propName string) error {
+	lsmPath := fmt.Sprintf("%s/%s_lsm", rootPath, shardID)
+	entries, err := os.ReadDir(lsmPath)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "read dir %q", lsmPath)
+	}
+
+	buckets := map[string]struct{}{}
+	for _, bucket := range propertyBuckets(propName) {
+		buckets[bucket] = struct{}{}
+	}
+	nestedPrefix := helpers.BucketFromPropNameLSM(propName + schema.NestedPropertySeparator)
+	for _, entry := range entries {
+		_, ok := buckets[entry.Name()]
+		if !ok && !strings.HasPrefix(entry.Name(), nestedPrefix) {
+			continue
+		}
+		if err := os.RemoveAll(path.Join(lsmPath, entry.Name())); err != nil {
+			return errors.Wrapf(err, "remove bucket %q", entry.Name())
+		}
+	}
+
+	geoPath := fmt.Sprintf("%s/%s.hnsw.commitlog.d", rootPath, geoPropID(shardID, propName))
+	if err := os.RemoveAll(geoPath); err != nil {
+		return errors.Wrapf(err, "remove geo index %q", geoPath)
+	}
+	return nil
+}
+
+// propertyBuckets returns the names of all buckets which may exist for the
+// given property
+func propertyBuckets(propName string) []string {
+	return []string{
+		helpers.BucketFromPropNameLSM(propName),
+		helpers.BucketSearchableFromPropNameLSM(propName),
+		helpers.BucketFromPropNameMetaCountLSM(propName),
+		helpers.BucketFromPropNameLengthLSM(propName),
+		helpers.BucketFromPropNameNullLSM(propName),
+	}
+}
+
+// removeDeletedProperties strips the values of properties which are no longer
+// part of the schema, but which remain in the stored objects until they are
+// purged. The hits of grouped results are stripped as well.
+func (i *Index) removeDeletedProperties(objs ...*storobj.Object) {
+	sch := i.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(i.Config.ClassName)
+	if class == nil {
+		return
+	}
+
+	var known map[string]struct{}
+	removeUnknown := func(props map[string]interface{}) {
+		if known == nil {
+			known = make(map[string]struct{}, len(class.Properties))
+			for _, prop := range class.Properties {
+				known[prop.Name] = struct{}{}
+			}
+		}
+		for name := range props {
+			if _, ok := known[name]; !ok {
+				delete(props, name)
+			}
+		}
+	}
+
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		if props, ok := obj.Properties().(map[string]interface{}); ok && len(props) > 0 {
+			removeUnknown(props)
+		}
+		if group, ok := obj.AdditionalProperties()["group"].(*additional.Group); ok {
+			for _, hit := range group.Hits {
+				hitAdditional, hasAdditional := hit["_additional"]
+				removeUnknown(hit)
+				if hasAdditional {
+					hit["_additional"] = hitAdditional
+				}
+			}
+		}
+	}
+}
+
+// dropProperty removes the inverted and property-specific indexes of a
+// deleted property, as well as its tracked lengths
+func (s *Shard) dropProperty(ctx context.Context, propName string) error {
+	if s.isReadOnly() {
+		return storagestate.ErrStatusReadOnly
+	}
+
+	buckets := propertyBuckets(propName)
+	// nested properties are indexed by their path, e.g. "address.city"
+	nestedPrefix := helpers.BucketFromPropNameLSM(propName + schema.NestedPropertySeparator)
+	for bucket := range s.store.GetBucketsByName() {
+		if strings.HasPrefix(bucket, nestedPrefix) {
+			buckets = append(buckets, bucket)
+		}
+	}
+
+	for _, bucket := range buckets {
+		if s.store.Bucket(bucket) == nil {
+			continue
+		}
+		if err := s.store.DropBucket(ctx, bucket); err != nil {
+			return errors.Wrapf(err, "drop bucket %q", bucket)
+		}
+	}
+
+	s.propertyIndicesLock.Lock()
+	err := s.propertyIndices.Drop(ctx, propName)
+	s.propertyIndicesLock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	s.propLengths.DropProperty(propName)
+	return s.propLengths.Flush(false)
+}
+
+// purgeProperty removes the values of a deleted property from all stored
+// objects and returns the number of objects which were rewritten
+func (s *Shard) purgeProperty(ctx context.Context, propName string) (int, error) {
+	hasProperty := func(obj *storobj.Object) bool {
+		_, ok := obj.Properties().(map[string]interface{})[propName]
+		return ok
+	}
+	return s.rewriteObjects(ctx, hasProperty, func(obj *storobj.Object) error {
+		delete(obj.Properties().(map[string]interface{}), propName)
+		return nil
+	})
+}
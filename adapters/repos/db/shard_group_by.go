@@ -44,7 +44,8 @@ func (s *Shard) groupResults(ctx context.Context, ids []uint64,
 			err, groupBy.Property)
 	}
 
-	return newGrouper(ids, dists, groupBy, objsBucket, dt, additional).Do(ctx)
+	return newGrouper(ids, dists, groupBy, objsBucket, dt, additional,
+		sch.GetClass(className)).Do(ctx)
 }
 
 type grouper struct {
@@ -54,12 +55,13 @@ type grouper struct {
 	additional       additional.Properties
 	propertyDataType schema.PropertyDataType
 	objBucket        *lsmkv.Bucket
+	class            *models.Class
 }
 
 func newGrouper(ids []uint64, dists []float32,
 	groupBy *searchparams.GroupBy, objBucket *lsmkv.Bucket,
 	propertyDataType schema.PropertyDataType,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) *grouper {
 	return &grouper{
 		ids:              ids,
@@ -68,6 +70,7 @@ func newGrouper(ids []uint64, dists []float32,
 		objBucket:        objBucket,
 		propertyDataType: propertyDataType,
 		additional:       additional,
+		class:            class,
 	}
 }
 
@@ -118,7 +121,7 @@ DOCS_LOOP:
 
 			if _, ok := docIDObject[docID]; !ok {
 				// whole object, might be that we only need value and ID to be extracted
-				unmarshalled, err := storobj.FromBinaryOptional(objData, g.additional)
+				unmarshalled, err := storobj.FromBinaryOptional(objData, g.additional, g.class)
 				if err != nil {
 					return nil, nil, fmt.Errorf("%w: unmarshal data object at position %d", err, i)
 				}
@@ -197,7 +200,7 @@ func (g *grouper) getUnmarshalled(docID uint64,
 		if err != nil {
 			return nil, fmt.Errorf("%w: could not get obj by doc id %d", err, docID)
 		}
-		unmarshalled, err := storobj.FromBinaryOptional(objData, g.additional)
+		unmarshalled, err := storobj.FromBinaryOptional(objData, g.additional, g.class)
 		if err != nil {
 			return nil, fmt.Errorf("%w: unmarshal data object doc id %d", err, docID)
 		}
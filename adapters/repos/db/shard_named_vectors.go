@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// namedVectorIndexConfigs extracts the parsed vector index configs of the
+// named vectors of a class
+func namedVectorIndexConfigs(class *models.Class) map[string]schema.VectorIndexConfig {
+	if class == nil || len(class.VectorConfig) == 0 {
+		return nil
+	}
+
+	out := make(map[string]schema.VectorIndexConfig, len(class.VectorConfig))
+	for targetVector, vectorConfig := range class.VectorConfig {
+		if cfg, ok := vectorConfig.VectorIndexConfig.(schema.VectorIndexConfig); ok {
+			out[targetVector] = cfg
+		}
+	}
+	return out
+}
+
+// namedVectorIndex returns the vector index of the given target vector, an
+// empty target vector refers to the class level vector
+func (s *Shard) namedVectorIndex(targetVector string) (VectorIndex, error) {
+	if targetVector == "" {
+		return s.vectorIndex, nil
+	}
+
+	vi, ok := s.vectorIndexes[targetVector]
+	if !ok {
+		return nil, fmt.Errorf("target vector %q does not exist", targetVector)
+	}
+	return vi, nil
+}
+
+// forEachVectorIndex runs f for the class level vector index, identified by
+// an empty target vector, as well as for all named vector indexes
+func (s *Shard) forEachVectorIndex(f func(targetVector string, vi VectorIndex) error) error {
+	if err := f("", s.vectorIndex); err != nil {
+		return err
+	}
+
+	for targetVector, vi := range s.vectorIndexes {
+		if err := f(targetVector, vi); err != nil {
+			return fmt.Errorf("named vector %q: %w", targetVector, err)
+		}
+	}
+	return nil
+}
+
+func (s *Shard) postStartupVectorIndexes() {
+	s.forEachVectorIndex(func(_ string, vi VectorIndex) error {
+		vi.PostStartup()
+		return nil
+	})
+}
+
+func (s *Shard) flushVectorIndexes() error {
+	return s.forEachVectorIndex(func(_ string, vi VectorIndex) error {
+		return vi.Flush()
+	})
+}
+
+func (s *Shard) deleteFromVectorIndexes(docIDs ...uint64) error {
+	return s.forEachVectorIndex(func(_ string, vi VectorIndex) error {
+		return vi.Delete(docIDs...)
+	})
+}
+
+// validateNamedVectorsBeforeInsert makes sure all named vectors exist and can
+// be inserted, before any changes are made
+func (s *Shard) validateNamedVectorsBeforeInsert(vectors map[string][]float32) error {
+	for targetVector, vector := range vectors {
+		vi, err := s.namedVectorIndex(targetVector)
+		if err != nil {
+			return err
+		}
+		if len(vector) == 0 {
+			continue
+		}
+		if err := vi.ValidateBeforeInsert(vector); err != nil {
+			return errors.Wrapf(err, "named vector %q", targetVector)
+		}
+	}
+	return nil
+}
+
+// updateNamedVectorIndexes is the named vector counterpart of
+// updateVectorIndex
+func (s *Shard) updateNamedVectorIndexes(vectors map[string][]float32,
+	status objectInsertStatus,
+) error {
+	for targetVector, vi := range s.vectorIndexes {
+		if status.docIDChanged {
+			if err := vi.Delete(status.oldDocID); err != nil {
+				return errors.Wrapf(err, "delete doc id %d from vector index of %q",
+					status.oldDocID, targetVector)
+			}
+		}
+
+		if err := addToNamedVectorIndex(vi, targetVector, vectors[targetVector],
+			status.docID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateNamedVectorIndexesIgnoreDelete is the named vector counterpart of
+// updateVectorIndexIgnoreDelete
+func (s *Shard) updateNamedVectorIndexesIgnoreDelete(vectors map[string][]float32,
+	status objectInsertStatus,
+) error {
+	for targetVector, vi := range s.vectorIndexes {
+		if err := addToNamedVectorIndex(vi, targetVector, vectors[targetVector],
+			status.docID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func addToNamedVectorIndex(vi VectorIndex, targetVector string, vector []float32,
+	docID uint64,
+) error {
+	if len(vector) == 0 {
+		return nil
+	}
+
+	if err := vi.Add(docID, vector); err != nil {
+		return errors.Wrapf(err, "insert doc id %d to vector index of %q",
+			docID, targetVector)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+// offloadDescriptorKey is the key of the descriptor listing all files of an offloaded shard
+const offloadDescriptorKey = "shard.json"
+
+// offloadID returns the ID under which the files of an offloaded shard are
+// stored in the offload backend
+func offloadID(shardID string) string {
+	return "offload_" + shardID
+}
+
+// offload uploads all files of the shard together with a descriptor to the
+// given backend. Its local files are only removed once the shard is dropped.
+func (s *Shard) offload(ctx context.Context, backend modulecapabilities.BackupBackend) (err error) {
+	if err := s.beginBackup(ctx); err != nil {
+		return fmt.Errorf("pause compaction and flush: %w", err)
+	}
+	defer func() {
+		if err2 := s.resumeMaintenanceCycles(ctx); err2 != nil && err == nil {
+			err = err2
+		}
+	}()
+
+	var desc backup.ShardDescriptor
+	if err := s.listBackupFiles(ctx, &desc); err != nil {
+		return fmt.Errorf("list shard %v files: %w", s.name, err)
+	}
+
+	id := offloadID(s.ID())
+	if err := backend.Initialize(ctx, id); err != nil {
+		return fmt.Errorf("init offload backend %s: %w", backend.Name(), err)
+	}
+	for _, file := range desc.Files {
+		if err := backend.PutFile(ctx, id, file, file); err != nil {
+			return fmt.Errorf("upload file %s: %w", file, err)
+		}
+	}
+	data, err := json.Marshal(desc)
+	if err != nil {
+		return fmt.Errorf("marshal shard descriptor: %w", err)
+	}
+	if err := backend.PutObject(ctx, id, offloadDescriptorKey, data); err != nil {
+		return fmt.Errorf("upload shard descriptor: %w", err)
+	}
+	return nil
+}
+
+// reloadOffloaded downloads all files of an offloaded shard from the given
+// backend into the root path of the index. Files written so far are removed
+// again if the download fails.
+func (i *Index) reloadOffloaded(ctx context.Context, backend modulecapabilities.BackupBackend,
+	shardName string,
+) (err error) {
+	var written []string
+	defer func() {
+		if err != nil {
+			removeFiles(written)
+		}
+	}()
+
+	id := i.shardOffloadID(shardName)
+	data, err := backend.GetObject(ctx, id, offloadDescriptorKey)
+	if err != nil {
+		return fmt.Errorf("get shard descriptor: %w", err)
+	}
+	var desc backup.ShardDescriptor
+	if err := json.Unmarshal(data, &desc); err != nil {
+		return fmt.Errorf("unmarshal shard descriptor: %w", err)
+	}
+
+	for _, file := range desc.Files {
+		destPath := path.Join(i.Config.RootPath, file)
+		if err := os.MkdirAll(path.Dir(destPath), os.ModePerm); err != nil {
+			return fmt.Errorf("create folder %s: %w", path.Dir(destPath), err)
+		}
+		written = append(written, destPath)
+		if err := backend.WriteToFile(ctx, id, file, destPath); err != nil {
+			return fmt.Errorf("download file %s: %w", file, err)
+		}
+	}
+	for fpath, content := range map[string][]byte{
+		desc.DocIDCounterPath:      desc.DocIDCounter,
+		desc.PropLengthTrackerPath: desc.PropLengthTracker,
+		desc.ShardVersionPath:      desc.Version,
+	} {
+		destPath := path.Join(i.Config.RootPath, fpath)
+		written = append(written, destPath)
+		if err := os.WriteFile(destPath, content, os.ModePerm); err != nil {
+			return fmt.Errorf("write file %s: %w", destPath, err)
+		}
+	}
+	return nil
+}
+
+// shardOffloadID returns the ID under which the files of the given shard are
+// stored in the offload backend
+func (i *Index) shardOffloadID(shardName string) string {
+	return offloadID(fmt.Sprintf("%s_%s", i.ID(), shardName))
+}
+
+// shardTransfer is a running upload or download of an offloaded shard
+type shardTransfer struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// startTransfer registers an upload or download of the given shard. The
+// returned context is cancelled if the transfer is stopped, finish must be
+// called once the transfer is over.
+func (i *Index) startTransfer(ctx context.Context, shardName string,
+) (tctx context.Context, finish func(), err error) {
+	i.transfersLock.Lock()
+	defer i.transfersLock.Unlock()
+
+	if _, ok := i.transfers[shardName]; ok {
+		return nil, nil, fmt.Errorf("partition %q is already being transferred", shardName)
+	}
+	if i.transfers == nil {
+		i.transfers = make(map[string]*shardTransfer)
+	}
+	tctx, cancel := context.WithCancel(ctx)
+	t := &shardTransfer{cancel: cancel, done: make(chan struct{})}
+	i.transfers[shardName] = t
+
+	finish = func() {
+		i.transfersLock.Lock()
+		delete(i.transfers, shardName)
+		i.transfersLock.Unlock()
+		cancel()
+		close(t.done)
+	}
+	return tctx, finish, nil
+}
+
+// stopTransfer waits for a running upload or download of the given shard to
+// be over. If abort is set, the transfer is cancelled first.
+func (i *Index) stopTransfer(ctx context.Context, shardName string, abort bool) error {
+	i.transfersLock.Lock()
+	t := i.transfers[shardName]
+	i.transfersLock.Unlock()
+	if t == nil {
+		return nil
+	}
+
+	if abort {
+		t.cancel()
+	}
+	select {
+	case <-t.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("wait for transfer of partition %q: %w", shardName, ctx.Err())
+	}
+}
+
+// deleteOffloaded removes the files of the given shards from the offload
+// backend. It runs in the background, failures are only logged.
+func (i *Index) deleteOffloaded(backend modulecapabilities.OffloadBackend, shardNames []string) {
+	if backend == nil || len(shardNames) == 0 {
+		return
+	}
+	go func() {
+		for _, name := range shardNames {
+			if err := backend.Delete(context.Background(), i.shardOffloadID(name)); err != nil {
+				i.logger.WithField("action", "delete_offloaded_shard").
+					WithField("shard", name).Error(err)
+			}
+		}
+	}()
+}
+
+func removeFiles(paths []string) {
+	for _, p := range paths {
+		os.Remove(p)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
@@ -33,6 +34,13 @@ import (
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
+// class returns the class of the shard, which is needed to tell the data
+// types of the properties of stored objects apart
+func (s *Shard) class() *models.Class {
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+	return sch.GetClass(s.index.Config.ClassName)
+}
+
 func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 	props search.SelectProperties,
 	additional additional.Properties,
@@ -51,7 +59,7 @@ func (s *Shard) objectByID(ctx context.Context, id strfmt.UUID,
 		return nil, nil
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := storobj.FromBinaryWithClass(bytes, s.class())
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal object")
 	}
@@ -75,6 +83,7 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 	}
 
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	class := s.class()
 	for i, id := range ids {
 		bytes, err := bucket.Get(id)
 		if err != nil {
@@ -85,7 +94,7 @@ func (s *Shard) multiObjectByID(ctx context.Context,
 			continue
 		}
 
-		obj, err := storobj.FromBinary(bytes)
+		obj, err := storobj.FromBinaryWithClass(bytes, class)
 		if err != nil {
 			return nil, errors.Wrap(err, "unmarshal kind object")
 		}
@@ -135,7 +144,7 @@ func (s *Shard) objectByIndexID(ctx context.Context,
 			"uuid found for docID, but object is nil")
 	}
 
-	obj, err := storobj.FromBinary(bytes)
+	obj, err := storobj.FromBinaryWithClass(bytes, s.class())
 	if err != nil {
 		return nil, errors.Wrap(err, "unmarshal kind object")
 	}
@@ -143,12 +152,19 @@ func (s *Shard) objectByIndexID(ctx context.Context,
 	return obj, nil
 }
 
-func (s *Shard) vectorByIndexID(ctx context.Context, indexID uint64) ([]float32, error) {
+func (s *Shard) targetVectorByIndexID(ctx context.Context, indexID uint64,
+	targetVector string,
+) ([]float32, error) {
 	keyBuf := make([]byte, 8)
-	return s.readVectorByIndexIDIntoSlice(ctx, indexID, &hnsw.VectorSlice{Buff8: keyBuf})
+	return s.readTargetVectorByIndexIDIntoSlice(ctx, indexID,
+		&hnsw.VectorSlice{Buff8: keyBuf}, targetVector)
 }
 
-func (s *Shard) readVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64, container *hnsw.VectorSlice) ([]float32, error) {
+// readTargetVectorByIndexIDIntoSlice reads the vector of the given target
+// vector, an empty target vector refers to the class level vector
+func (s *Shard) readTargetVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64,
+	container *hnsw.VectorSlice, targetVector string,
+) ([]float32, error) {
 	binary.LittleEndian.PutUint64(container.Buff8, indexID)
 
 	bytes, newBuff, err := s.store.Bucket(helpers.ObjectsBucketLSM).
@@ -163,6 +179,9 @@ func (s *Shard) readVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64
 	}
 
 	container.Buff = newBuff
+	if targetVector != "" {
+		return storobj.NamedVectorFromBinary(bytes, container.Slice, targetVector)
+	}
 	return storobj.VectorFromBinary(bytes, container.Slice)
 }
 
@@ -182,7 +201,7 @@ func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 
 		if filters != nil {
 			objs, err = inverted.NewSearcher(s.index.logger, s.store,
-				s.index.getSchema.GetSchemaSkipAuth(),
+				s.readSchema(),
 				s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
 				s.index.stopwords, s.versioner.Version(), s.isFallbackToSearchable,
 				s.tenant()).
@@ -196,7 +215,7 @@ func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 
 		className := s.index.Config.ClassName
 		bm25Config := s.index.getInvertedIndexConfig().BM25
-		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store, s.index.getSchema.GetSchemaSkipAuth(), s.propertyIndices, s.index.classSearcher, s.deletedDocIDs, s.propLengths, s.index.logger, s.versioner.Version())
+		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store, s.readSchema(), s.propertyIndices, s.index.classSearcher, s.deletedDocIDs, s.propLengths, s.index.logger, s.versioner.Version())
 		bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking)
 		if err != nil {
 			return nil, nil, err
@@ -211,7 +230,7 @@ func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		return objs, nil, err
 	}
 	objs, err := inverted.NewSearcher(s.index.logger, s.store,
-		s.index.getSchema.GetSchemaSkipAuth(),
+		s.readSchema(),
 		s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
 		s.index.stopwords, s.versioner.Version(), s.isFallbackToSearchable,
 		s.tenant()).
@@ -220,14 +239,15 @@ func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 }
 
 func (s *Shard) objectVectorSearch(ctx context.Context,
-	searchVector []float32, targetDist float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, targetVector string, targetDist float32, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
 	var (
-		ids       []uint64
-		dists     []float32
-		err       error
-		allowList helpers.AllowList
+		ids             []uint64
+		dists           []float32
+		compressedDists []float32
+		err             error
+		allowList       helpers.AllowList
 	)
 
 	if filters != nil {
@@ -240,15 +260,26 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 		s.metrics.FilteredVectorFilter(time.Since(beforeFilter))
 	}
 
+	vectorIndex, err := s.namedVectorIndex(targetVector)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	beforeVector := time.Now()
 	if limit < 0 {
-		ids, dists, err = s.vectorIndex.SearchByVectorDistance(
+		ids, dists, err = vectorIndex.SearchByVectorDistance(
 			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search by distance")
 		}
+	} else if searcher, ok := vectorIndex.(compressedDistanceSearcher); ok && additional.CompressedDistance {
+		ids, dists, compressedDists, err = searcher.SearchByVectorWithCompressedDistances(
+			searchVector, limit, allowList)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "vector search")
+		}
 	} else {
-		ids, dists, err = s.vectorIndex.SearchByVector(searchVector, limit, allowList)
+		ids, dists, err = vectorIndex.SearchByVector(searchVector, limit, allowList)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search")
 		}
@@ -257,6 +288,16 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 		return nil, nil, nil
 	}
 
+	// the ids can be reordered by sorting, so the compressed distances are
+	// matched up with the objects by their doc id
+	var compressedDistsByID map[uint64]float32
+	if len(compressedDists) == len(ids) {
+		compressedDistsByID = make(map[uint64]float32, len(ids))
+		for i, id := range ids {
+			compressedDistsByID[id] = compressedDists[i]
+		}
+	}
+
 	if filters != nil {
 		s.metrics.FilteredVectorVector(time.Since(beforeVector))
 	}
@@ -280,7 +321,7 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 	beforeObjects := time.Now()
 
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-	objs, err := storobj.ObjectsByDocID(bucket, ids, additional)
+	objs, err := storobj.ObjectsByDocID(bucket, ids, additional, s.class())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -289,6 +330,19 @@ func (s *Shard) objectVectorSearch(ctx context.Context,
 		s.metrics.FilteredVectorObjects(time.Since(beforeObjects))
 	}
 
+	if compressedDistsByID != nil {
+		for _, obj := range objs {
+			dist, ok := compressedDistsByID[obj.DocID()]
+			if !ok {
+				continue
+			}
+			if obj.AdditionalProperties() == nil {
+				obj.Object.Additional = make(map[string]interface{})
+			}
+			obj.Object.Additional["compressedDistance"] = dist
+		}
+	}
+
 	return objs, dists, nil
 }
 
@@ -302,7 +356,7 @@ func (s *Shard) objectList(ctx context.Context, limit int,
 			return nil, err
 		}
 		bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
-		return storobj.ObjectsByDocID(bucket, docIDs, additional)
+		return storobj.ObjectsByDocID(bucket, docIDs, additional, s.class())
 	}
 
 	if cursor == nil {
@@ -335,9 +389,10 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 
 	i := 0
 	out := make([]*storobj.Object, c.Limit)
+	class := s.class()
 
 	for ; key != nil && i < c.Limit; key, val = cursor.Next() {
-		obj, err := storobj.FromBinary(val)
+		obj, err := storobj.FromBinaryWithClass(val, class)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarhsal item %d", i)
 		}
@@ -352,7 +407,7 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 func (s *Shard) sortedObjectList(ctx context.Context, limit int, sort []filters.Sort,
 	className schema.ClassName,
 ) ([]uint64, error) {
-	lsmSorter, err := sorter.NewLSMSorter(s.store, s.index.getSchema.GetSchemaSkipAuth(), className)
+	lsmSorter, err := sorter.NewLSMSorter(s.store, s.readSchema(), className)
 	if err != nil {
 		return nil, errors.Wrap(err, "sort object list")
 	}
@@ -366,7 +421,7 @@ func (s *Shard) sortedObjectList(ctx context.Context, limit int, sort []filters.
 func (s *Shard) sortDocIDsAndDists(ctx context.Context, limit int, sort []filters.Sort,
 	className schema.ClassName, docIDs []uint64, dists []float32,
 ) ([]uint64, []float32, error) {
-	lsmSorter, err := sorter.NewLSMSorter(s.store, s.index.getSchema.GetSchemaSkipAuth(), className)
+	lsmSorter, err := sorter.NewLSMSorter(s.store, s.readSchema(), className)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "sort objects with distances")
 	}
@@ -381,7 +436,7 @@ func (s *Shard) buildAllowList(ctx context.Context, filters *filters.LocalFilter
 	addl additional.Properties,
 ) (helpers.AllowList, error) {
 	list, err := inverted.NewSearcher(s.index.logger, s.store,
-		s.index.getSchema.GetSchemaSkipAuth(),
+		s.readSchema(),
 		s.propertyIndices, s.index.classSearcher, s.deletedDocIDs,
 		s.index.stopwords, s.versioner.Version(), s.isFallbackToSearchable,
 		s.tenant()).
@@ -454,7 +509,7 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndexes(docID); err != nil {
 		return errors.Wrap(err, "delete from vector index")
 	}
 
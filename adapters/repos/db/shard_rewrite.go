@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// rewriteObjects applies the rewrite to all stored objects which match and
+// returns the number of objects which were rewritten. The doc ids of the
+// objects are kept, so that neither the vector indexes nor the inverted
+// indexes of unchanged values need to be updated.
+func (s *Shard) rewriteObjects(ctx context.Context, matches func(obj *storobj.Object) bool,
+	rewrite func(obj *storobj.Object) error,
+) (int, error) {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+
+	// collect the affected objects first, so that the cursor is not held while
+	// writing to the bucket
+	var ids [][]byte
+	class := s.class()
+	cursor := bucket.Cursor()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		obj, err := storobj.FromBinaryWithClass(v, class)
+		if err != nil {
+			cursor.Close()
+			return 0, errors.Wrapf(err, "unmarshal object %x", k)
+		}
+		if matches(obj) {
+			ids = append(ids, append([]byte{}, k...))
+		}
+	}
+	cursor.Close()
+
+	count := 0
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+		if s.isReadOnly() {
+			return count, storagestate.ErrStatusReadOnly
+		}
+		rewritten, err := s.rewriteObject(bucket, id, matches, rewrite)
+		if err != nil {
+			return count, err
+		}
+		if rewritten {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *Shard) rewriteObject(bucket *lsmkv.Bucket, id []byte,
+	matches func(obj *storobj.Object) bool, rewrite func(obj *storobj.Object) error,
+) (bool, error) {
+	// hold the same lock as writes, so that concurrent updates are not lost
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(id)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	data, err := bucket.Get(id)
+	if err != nil || data == nil {
+		// the object was deleted in the meantime
+		return false, err
+	}
+	obj, err := storobj.FromBinaryWithClass(data, s.class())
+	if err != nil {
+		return false, errors.Wrapf(err, "unmarshal object %x", id)
+	}
+	// the object might have been updated in the meantime
+	if !matches(obj) {
+		return false, nil
+	}
+	if err := rewrite(obj); err != nil {
+		return false, errors.Wrapf(err, "rewrite object %s", obj.ID())
+	}
+
+	data, err = obj.MarshalBinary()
+	if err != nil {
+		return false, errors.Wrapf(err, "marshal object %s", obj.ID())
+	}
+	if err := s.upsertObjectDataLSM(bucket, id, data, obj.DocID()); err != nil {
+		return false, errors.Wrapf(err, "upsert object %s", obj.ID())
+	}
+	return true, nil
+}
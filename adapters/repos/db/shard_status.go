@@ -36,6 +36,24 @@ func (s *Shard) isReadOnly() bool {
 	return s.getStatus() == storagestate.StatusReadOnly
 }
 
+// getVectorIndexingStatus returns READONLY for a read-only shard, INDEXING
+// while one of its vector indexes is built in the background, e.g. a dynamic
+// index upgrading to hnsw, and READY otherwise
+func (s *Shard) getVectorIndexingStatus() storagestate.Status {
+	if s.isReadOnly() {
+		return storagestate.StatusReadOnly
+	}
+
+	status := storagestate.StatusReady
+	s.forEachVectorIndex(func(_ string, vi VectorIndex) error {
+		if upgrading, ok := vi.(interface{ Upgrading() bool }); ok && upgrading.Upgrading() {
+			status = storagestate.StatusIndexing
+		}
+		return nil
+	})
+	return status
+}
+
 func (s *Shard) updateStatus(in string) error {
 	s.statusLock.Lock()
 	defer s.statusLock.Unlock()
@@ -0,0 +1,670 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+)
+
+// updatePropertyIndexes applies changed index settings of a property to all
+// local shards. The indexes of loaded shards are updated in the background.
+// Shards which are not loaded are opened and updated right away, they are
+// opened with the updated property already, so the indexes to be rebuilt are
+// determined from the previous property rather than from the loaded buckets.
+func (m *Migrator) updatePropertyIndexes(ctx context.Context, idx *Index,
+	old, updated *models.Property,
+) error {
+	sch := idx.getSchema.GetSchemaSkipAuth()
+	class := sch.GetClass(idx.Config.ClassName)
+	if class == nil {
+		return fmt.Errorf("cannot find class %q", idx.Config.ClassName)
+	}
+
+	return m.forEachLocalShard(ctx, idx, class, nil, func(name string, shard *Shard) error {
+		if shard.isReadOnly() {
+			return errors.Wrapf(storagestate.ErrStatusReadOnly,
+				"update indexes of property %q on shard %q", updated.Name, name)
+		}
+		shard.updatePropertyIndexes(old, updated)
+		if idx.shards.Load(name) == shard {
+			shard.reindexInBackground(updated.Name)
+			return nil
+		}
+		if err := shard.reindexProperty(ctx, updated.Name, newReindexStatus(updated.Name)); err != nil {
+			return errors.Wrapf(err, "update indexes of property %q on shard %q", updated.Name, name)
+		}
+		return nil
+	})
+}
+
+// reindexedProperty holds the definitions of a property whose index settings
+// were changed, until its buckets were updated accordingly
+type reindexedProperty struct {
+	// previous is the definition the current buckets are served with
+	previous *models.Property
+	// updated is the most recent definition
+	updated *models.Property
+	// target is the definition the running reindexing rebuilds the buckets
+	// for, temporary buckets are written with it
+	target *models.Property
+	// trackPropLengths is set if the lengths of the property are tracked
+	// again for the target definition
+	trackPropLengths bool
+}
+
+// updatePropertyIndexes records the updated definition of the property. Reads
+// and writes keep using the definition the buckets were built with until they
+// were updated by reindexProperty.
+func (s *Shard) updatePropertyIndexes(old, updated *models.Property) {
+	s.doubleWriteLock.Lock()
+	defer s.doubleWriteLock.Unlock()
+
+	if s.reindexedProps == nil {
+		s.reindexedProps = map[string]*reindexedProperty{}
+	}
+	prop, ok := s.reindexedProps[updated.Name]
+	if !ok {
+		prop = &reindexedProperty{previous: old}
+		s.reindexedProps[updated.Name] = prop
+	}
+	prop.updated = updated
+}
+
+// servedProperties returns the given properties with the definitions the
+// current buckets are served with. The caller has to hold doubleWriteLock.
+func (s *Shard) servedProperties(props []*models.Property) []*models.Property {
+	return s.replaceProperties(props, func(prop *reindexedProperty) *models.Property {
+		return prop.previous
+	})
+}
+
+// reindexTargetProperties returns the given properties with the definitions
+// the running reindexing rebuilds the buckets for. The caller has to hold
+// doubleWriteLock.
+func (s *Shard) reindexTargetProperties(props []*models.Property) []*models.Property {
+	return s.replaceProperties(props, func(prop *reindexedProperty) *models.Property {
+		return prop.target
+	})
+}
+
+func (s *Shard) replaceProperties(props []*models.Property,
+	replacement func(prop *reindexedProperty) *models.Property,
+) []*models.Property {
+	if len(s.reindexedProps) == 0 {
+		return props
+	}
+	out := make([]*models.Property, len(props))
+	for i, prop := range props {
+		out[i] = prop
+		if reindexed, ok := s.reindexedProps[prop.Name]; ok && replacement(reindexed) != nil {
+			out[i] = replacement(reindexed)
+		}
+	}
+	return out
+}
+
+// readSchema returns the schema reads of the shard are served with, which
+// contains the previous definitions of properties whose buckets are not
+// updated yet
+func (s *Shard) readSchema() schema.Schema {
+	sch := s.index.getSchema.GetSchemaSkipAuth()
+
+	s.doubleWriteLock.RLock()
+	defer s.doubleWriteLock.RUnlock()
+
+	if len(s.reindexedProps) == 0 || sch.Objects == nil {
+		return sch
+	}
+	objects := *sch.Objects
+	objects.Classes = make([]*models.Class, len(sch.Objects.Classes))
+	for i, class := range sch.Objects.Classes {
+		objects.Classes[i] = class
+		if class.Class == s.index.Config.ClassName.String() {
+			served := *class
+			served.Properties = s.servedProperties(class.Properties)
+			objects.Classes[i] = &served
+		}
+	}
+	return schema.Schema{Objects: &objects}
+}
+
+// readSchemaGetter provides the schema reads of the shard are served with to
+// aggregations
+type readSchemaGetter struct {
+	schemaUC.SchemaGetter
+	shard *Shard
+}
+
+func (g *readSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return g.shard.readSchema()
+}
+
+// prepareReindex drops the buckets of indexes which were disabled and creates
+// empty buckets for indexes which were enabled since the previous definition
+// of the property. It returns the task to rebuild the buckets which need to be
+// rebuilt, or nil if there are none. Writes are blocked meanwhile, as the
+// buckets served change.
+func (s *Shard) prepareReindex(ctx context.Context, propName string,
+) (*shardInvertedReindexTaskUpdateProperty, error) {
+	s.doubleWriteLock.Lock()
+	defer s.doubleWriteLock.Unlock()
+
+	reindexed, ok := s.reindexedProps[propName]
+	if !ok {
+		// already updated by a previous reindexing
+		return nil, nil
+	}
+	old, updated := reindexed.previous, reindexed.updated
+
+	bucketNames := map[PropertyIndexType]string{
+		IndexTypePropValue:           helpers.BucketFromPropNameLSM(updated.Name),
+		IndexTypePropSearchableValue: helpers.BucketSearchableFromPropNameLSM(updated.Name),
+		IndexTypePropLength:          helpers.BucketFromPropNameLengthLSM(updated.Name),
+		IndexTypePropNull:            helpers.BucketFromPropNameNullLSM(updated.Name),
+	}
+	existedBefore := map[PropertyIndexType]bool{
+		IndexTypePropValue:           inverted.HasFilterableIndex(old),
+		IndexTypePropSearchableValue: inverted.HasSearchableIndex(old),
+		IndexTypePropLength:          inverted.HasInvertedIndex(old),
+		IndexTypePropNull:            inverted.HasInvertedIndex(old),
+	}
+
+	toDrop := []PropertyIndexType{}
+	if !inverted.HasFilterableIndex(updated) {
+		toDrop = append(toDrop, IndexTypePropValue)
+	}
+	if !inverted.HasSearchableIndex(updated) {
+		toDrop = append(toDrop, IndexTypePropSearchableValue)
+	}
+	if !inverted.HasInvertedIndex(updated) {
+		toDrop = append(toDrop, IndexTypePropLength, IndexTypePropNull)
+	}
+	for _, indexType := range toDrop {
+		if err := s.dropPropertyBucket(ctx, bucketNames[indexType]); err != nil {
+			return nil, err
+		}
+	}
+	if inverted.HasSearchableIndex(old) && !inverted.HasSearchableIndex(updated) {
+		s.propLengths.DropProperty(updated.Name)
+		if err := s.propLengths.Flush(false); err != nil {
+			return nil, err
+		}
+	}
+
+	if inverted.HasInvertedIndex(updated) {
+		if err := s.createPropertyValueIndex(ctx, updated); err != nil {
+			return nil, errors.Wrap(err, "create value index")
+		}
+		if s.index.invertedIndexConfig.IndexNullState {
+			if err := s.createPropertyNullIndex(ctx, updated); err != nil {
+				return nil, errors.Wrap(err, "create null index")
+			}
+		}
+		if s.index.invertedIndexConfig.IndexPropertyLength {
+			if err := s.createPropertyLengthIndex(ctx, updated); err != nil {
+				return nil, errors.Wrap(err, "create length index")
+			}
+		}
+	}
+
+	bucketOptions := []lsmkv.BucketOption{
+		s.memtableIdleConfig(),
+		s.dynamicMemtableSizing(),
+	}
+	tokenizationChanged := old.Tokenization != updated.Tokenization
+	positionsChanged := inverted.HasPositionsIndex(old) != inverted.HasPositionsIndex(updated)
+	task := &shardInvertedReindexTaskUpdateProperty{propName: updated.Name}
+	for _, indexType := range []PropertyIndexType{
+		IndexTypePropValue, IndexTypePropSearchableValue, IndexTypePropLength, IndexTypePropNull,
+	} {
+		if s.store.Bucket(bucketNames[indexType]) == nil {
+			continue
+		}
+		tokenized := indexType == IndexTypePropValue || indexType == IndexTypePropSearchableValue
+		rebuild := (tokenized && tokenizationChanged) ||
+			(indexType == IndexTypePropSearchableValue && positionsChanged)
+		if existedBefore[indexType] && !rebuild {
+			continue
+		}
+
+		property := ReindexableProperty{
+			PropertyName:    updated.Name,
+			IndexType:       indexType,
+			DesiredStrategy: lsmkv.StrategyRoaringSet,
+			BucketOptions:   bucketOptions,
+		}
+		if indexType == IndexTypePropSearchableValue {
+			property.DesiredStrategy = lsmkv.StrategyMapCollection
+			if s.versioner.Version() < 2 {
+				property.BucketOptions = append(property.BucketOptions, lsmkv.WithLegacyMapSorting())
+			}
+			task.trackPropLengths = true
+		}
+		task.properties = append(task.properties, property)
+	}
+
+	// the served definition only keeps the indexes which are rebuilt as they
+	// were, the others are served as updated right away
+	served := *updated
+	if len(task.properties) == 0 {
+		reindexed.previous = &served
+		if reindexed.updated == updated {
+			delete(s.reindexedProps, propName)
+		}
+		return nil, nil
+	}
+	for _, property := range task.properties {
+		switch property.IndexType {
+		case IndexTypePropValue:
+			served.IndexFilterable = old.IndexFilterable
+		case IndexTypePropSearchableValue:
+			served.IndexSearchable = old.IndexSearchable
+			served.IndexPositions = old.IndexPositions
+		}
+	}
+	if tokenizationChanged {
+		served.Tokenization = old.Tokenization
+	}
+	reindexed.previous = &served
+	reindexed.target = updated
+	reindexed.trackPropLengths = task.trackPropLengths
+	return task, nil
+}
+
+// dropPropertyBucket drops the bucket with the given name. Buckets of
+// disabled indexes are not loaded by shards which were opened with the
+// updated property, they are removed from disk.
+func (s *Shard) dropPropertyBucket(ctx context.Context, name string) error {
+	if s.store.Bucket(name) != nil {
+		if err := s.store.DropBucket(ctx, name); err != nil {
+			return errors.Wrapf(err, "drop bucket %q", name)
+		}
+		return nil
+	}
+	if err := os.RemoveAll(path.Join(s.DBPathLSM(), name)); err != nil {
+		return errors.Wrapf(err, "remove bucket %q", name)
+	}
+	return nil
+}
+
+func newReindexStatus(propName string) *models.ShardReindexStatus {
+	return &models.ShardReindexStatus{
+		Status:     models.ShardReindexStatusStatusINDEXING,
+		Properties: []string{propName},
+	}
+}
+
+// reindexInBackground updates the buckets of the property in the background.
+// The shard keeps serving reads from the current buckets while reindexing.
+// Writes go to both the current and the rebuilt buckets, so that objects
+// written in the meantime are not lost once the buckets are replaced.
+func (s *Shard) reindexInBackground(propName string) {
+	// the status is set right away, so that a pending reindexing is visible
+	// while a previous one is still running
+	status := newReindexStatus(propName)
+	s.setReindexStatus(status)
+
+	go func() {
+		if err := s.reindexProperty(context.Background(), propName, status); err != nil {
+			s.index.logger.WithField("action", "reindex_property").
+				WithField("class", s.index.Config.ClassName).
+				WithField("shard", s.name).
+				WithField("property", propName).
+				WithError(err).Error("failed to reindex property")
+		}
+	}()
+}
+
+// reindexProperty updates the buckets of the property to its most recent
+// definition and populates the rebuilt buckets from the stored objects. The
+// rebuilt buckets replace the current ones once they are populated.
+func (s *Shard) reindexProperty(ctx context.Context, propName string,
+	status *models.ShardReindexStatus,
+) error {
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	task, err := s.prepareReindex(ctx, propName)
+	if err != nil {
+		s.updateReindexStatus(status, func() {
+			status.Status = models.ShardReindexStatusStatusFAILED
+			status.Error = err.Error()
+		})
+		return err
+	}
+	if task == nil {
+		s.updateReindexStatus(status, func() {
+			status.Status = models.ShardReindexStatusStatusFINISHED
+		})
+		return nil
+	}
+
+	s.updateReindexStatus(status, func() {
+		status.ObjectsTotal = int64(s.objectCount())
+	})
+	task.onProgress = func(objectsProcessed int64) {
+		s.updateReindexStatus(status, func() {
+			status.ObjectsProcessed = objectsProcessed
+		})
+	}
+
+	reindexer := NewShardInvertedReindexer(s, s.index.logger)
+	reindexer.AddTask(task)
+	if err := reindexer.Do(ctx); err != nil {
+		s.restoreStoreAfterFailedReindex(ctx, reindexer, task)
+		s.updateReindexStatus(status, func() {
+			status.Status = models.ShardReindexStatusStatusFAILED
+			status.Error = err.Error()
+		})
+		return err
+	}
+
+	s.index.logger.WithField("action", "reindex_property").
+		WithField("class", s.index.Config.ClassName).
+		WithField("shard", s.name).
+		WithField("property", propName).
+		Infof("reindexed property of %d objects", task.objectsProcessed)
+	s.updateReindexStatus(status, func() {
+		status.Status = models.ShardReindexStatusStatusFINISHED
+	})
+	return nil
+}
+
+// restoreStoreAfterFailedReindex stops the double writes to the temporary
+// buckets and removes them. The current buckets keep being served with the
+// previous definition of the property.
+func (s *Shard) restoreStoreAfterFailedReindex(ctx context.Context,
+	reindexer *ShardInvertedReindexer, task *shardInvertedReindexTaskUpdateProperty,
+) {
+	s.stopDoubleWrites(nil)
+
+	s.doubleWriteLock.Lock()
+	if reindexed, ok := s.reindexedProps[task.propName]; ok {
+		reindexed.target = nil
+		reindexed.trackPropLengths = false
+	}
+	s.propLengths.DropProperty(reindexedPropLengthsName(task.propName))
+	s.doubleWriteLock.Unlock()
+
+	for _, property := range task.properties {
+		tempBucketName := helpers.TempBucketFromBucketName(
+			reindexer.bucketName(property.PropertyName, property.IndexType))
+		if s.store.Bucket(tempBucketName) == nil {
+			continue
+		}
+		if err := s.store.DropBucket(ctx, tempBucketName); err != nil {
+			s.index.logger.WithError(err).
+				Errorf("failed to drop temporary bucket %q after reindexing", tempBucketName)
+		}
+	}
+}
+
+// reindexedPropLengthsName is the name the lengths of a property are tracked
+// with while its searchable index is rebuilt, as the current lengths are
+// still used by the current bucket
+func reindexedPropLengthsName(propName string) string {
+	return helpers.TempBucketFromBucketName(propName)
+}
+
+// startDoubleWrites makes writes to the given buckets go to their temporary
+// buckets as well
+func (s *Shard) startDoubleWrites(bucketNames []string) error {
+	s.doubleWriteLock.Lock()
+	defer s.doubleWriteLock.Unlock()
+
+	buckets := make(map[string]*lsmkv.Bucket, len(bucketNames))
+	for _, name := range bucketNames {
+		tempBucketName := helpers.TempBucketFromBucketName(name)
+		tempBucket := s.store.Bucket(tempBucketName)
+		if tempBucket == nil {
+			return errors.Errorf("temporary bucket %q not found", tempBucketName)
+		}
+		buckets[name] = tempBucket
+	}
+	s.doubleWriteBuckets = buckets
+	s.doubleWriteCursor = nil
+	return nil
+}
+
+// stopDoubleWrites stops writing to the temporary buckets. If given, replace
+// is called before while no writes are in progress, so that the temporary
+// buckets can replace the current ones without losing any write. The
+// replaced buckets are served with the definitions they were rebuilt for
+// from then on.
+func (s *Shard) stopDoubleWrites(replace func() error) error {
+	s.doubleWriteLock.Lock()
+	defer s.doubleWriteLock.Unlock()
+
+	s.doubleWriteBuckets = nil
+	s.doubleWriteCursor = nil
+	if replace == nil {
+		return nil
+	}
+	if err := replace(); err != nil {
+		return err
+	}
+
+	for name, reindexed := range s.reindexedProps {
+		if reindexed.target == nil {
+			continue
+		}
+		if reindexed.trackPropLengths {
+			s.propLengths.DropProperty(name)
+			s.propLengths.RenameProperty(reindexedPropLengthsName(name), name)
+		}
+		reindexed.previous = reindexed.target
+		if reindexed.updated == reindexed.target {
+			delete(s.reindexedProps, name)
+		}
+		reindexed.target = nil
+		reindexed.trackPropLengths = false
+	}
+	return nil
+}
+
+// writeBuckets returns the bucket with the given name and, if the bucket is
+// being rebuilt, the temporary bucket it is rebuilt in. It returns nil if the
+// bucket does not exist. The caller has to hold doubleWriteLock for reading
+// while writing to the returned buckets.
+func (s *Shard) writeBuckets(name string) []*lsmkv.Bucket {
+	bucket := s.store.Bucket(name)
+	if bucket == nil {
+		return nil
+	}
+	if tempBucket, ok := s.doubleWriteBuckets[name]; ok {
+		return []*lsmkv.Bucket{bucket, tempBucket}
+	}
+	return []*lsmkv.Bucket{bucket}
+}
+
+// reindexedObject returns whether the running reindexing already populated
+// the temporary buckets with the object. The lengths of objects it did not
+// reach yet are tracked by the reindexing itself, so that they are not
+// tracked twice. The caller has to hold doubleWriteLock for reading.
+func (s *Shard) reindexedObject(object *storobj.Object) bool {
+	if s.doubleWriteCursor == nil {
+		return false
+	}
+	key, err := uuid.MustParse(object.ID().String()).MarshalBinary()
+	if err != nil {
+		return false
+	}
+	return bytes.Compare(key, s.doubleWriteCursor) <= 0
+}
+
+// extendReindexedBucketsLSM writes the object to the temporary buckets of a
+// running reindexing. Contrary to the current buckets, they are written with
+// the definitions the properties are rebuilt for. The caller has to hold
+// doubleWriteLock for reading.
+func (s *Shard) extendReindexedBucketsLSM(object *storobj.Object, docID uint64) error {
+	if len(s.doubleWriteBuckets) == 0 {
+		return nil
+	}
+	props, nilProps, err := s.analyzeObjectForReindex(object)
+	if err != nil {
+		return errors.Wrap(err, "analyze object for reindexing")
+	}
+
+	for _, prop := range props {
+		reindexed, ok := s.reindexedProps[prop.Name]
+		if !ok || reindexed.target == nil {
+			continue
+		}
+		if bucket := s.doubleWriteBuckets[helpers.BucketFromPropNameLSM(prop.Name)]; bucket != nil && prop.HasFilterableIndex {
+			for _, item := range prop.Items {
+				if err := s.addToPropertySetBucket(bucket, docID, item.Data); err != nil {
+					return errors.Wrapf(err, "failed adding to prop '%s' value bucket", prop.Name)
+				}
+			}
+		}
+		if bucket := s.doubleWriteBuckets[helpers.BucketSearchableFromPropNameLSM(prop.Name)]; bucket != nil && prop.HasSearchableIndex {
+			propLen := float32(len(prop.Items))
+			for _, item := range prop.Items {
+				pair := s.pairPropertyWithFrequency(docID, item.TermFrequency, propLen, item.Positions)
+				if err := s.addToPropertyMapBucket(bucket, pair, item.Data); err != nil {
+					return errors.Wrapf(err, "failed adding to prop '%s' value bucket", prop.Name)
+				}
+			}
+			if reindexed.trackPropLengths && s.reindexedObject(object) {
+				if err := s.propLengths.TrackProperty(reindexedPropLengthsName(prop.Name), propLen); err != nil {
+					return err
+				}
+			}
+		}
+		if err := s.extendReindexedNullAndLengthLSM(prop.Name, docID, prop.Length >= 0, prop.Length); err != nil {
+			return err
+		}
+	}
+
+	for _, nilProperty := range nilProps {
+		if reindexed, ok := s.reindexedProps[nilProperty.Name]; !ok || reindexed.target == nil {
+			continue
+		}
+		if err := s.extendReindexedNullAndLengthLSM(nilProperty.Name, docID,
+			nilProperty.AddToPropertyLength, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Shard) extendReindexedNullAndLengthLSM(propName string, docID uint64,
+	hasLength bool, length int,
+) error {
+	bucket := s.doubleWriteBuckets[helpers.BucketFromPropNameLengthLSM(propName)]
+	if bucket != nil && s.index.invertedIndexConfig.IndexPropertyLength && hasLength {
+		key, err := s.keyPropertyLength(length)
+		if err != nil {
+			return errors.Wrapf(err, "failed creating key for prop '%s' length", propName)
+		}
+		if err := s.addToPropertySetBucket(bucket, docID, key); err != nil {
+			return errors.Wrapf(err, "failed adding to prop '%s' length bucket", propName)
+		}
+	}
+
+	bucket = s.doubleWriteBuckets[helpers.BucketFromPropNameNullLSM(propName)]
+	if bucket != nil && s.index.invertedIndexConfig.IndexNullState {
+		key, err := s.keyPropertyNull(length == 0)
+		if err != nil {
+			return errors.Wrapf(err, "failed creating key for prop '%s' null", propName)
+		}
+		if err := s.addToPropertySetBucket(bucket, docID, key); err != nil {
+			return errors.Wrapf(err, "failed adding to prop '%s' null bucket", propName)
+		}
+	}
+	return nil
+}
+
+// deleteFromReindexedBucketsLSM removes the object from the temporary buckets
+// of a running reindexing. The caller has to hold doubleWriteLock for reading.
+func (s *Shard) deleteFromReindexedBucketsLSM(object *storobj.Object, docID uint64) error {
+	if len(s.doubleWriteBuckets) == 0 {
+		return nil
+	}
+	props, _, err := s.analyzeObjectForReindex(object)
+	if err != nil {
+		return errors.Wrap(err, "analyze object for reindexing")
+	}
+
+	for _, prop := range props {
+		reindexed, ok := s.reindexedProps[prop.Name]
+		if !ok || reindexed.target == nil {
+			continue
+		}
+		if bucket := s.doubleWriteBuckets[helpers.BucketFromPropNameLSM(prop.Name)]; bucket != nil && prop.HasFilterableIndex {
+			for _, item := range prop.Items {
+				if err := s.deleteInvertedIndexItemLSM(bucket, item, docID); err != nil {
+					return errors.Wrapf(err, "delete item '%s' from index", string(item.Data))
+				}
+			}
+		}
+		if bucket := s.doubleWriteBuckets[helpers.BucketSearchableFromPropNameLSM(prop.Name)]; bucket != nil && prop.HasSearchableIndex {
+			for _, item := range prop.Items {
+				if err := s.deleteInvertedIndexItemWithFrequencyLSM(bucket, item, docID); err != nil {
+					return errors.Wrapf(err, "delete item '%s' from index", string(item.Data))
+				}
+			}
+			if reindexed.trackPropLengths && s.reindexedObject(object) {
+				if err := s.propLengths.UnTrackProperty(reindexedPropLengthsName(prop.Name),
+					float32(len(prop.Items))); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Shard) setReindexStatus(status *models.ShardReindexStatus) {
+	s.reindexStatusLock.Lock()
+	defer s.reindexStatusLock.Unlock()
+
+	s.reindexStatus = status
+}
+
+// updateReindexStatus modifies the given status while holding the status
+// lock, so that it is not read while being modified
+func (s *Shard) updateReindexStatus(status *models.ShardReindexStatus, update func()) {
+	s.reindexStatusLock.Lock()
+	defer s.reindexStatusLock.Unlock()
+
+	update()
+}
+
+// getReindexStatus returns a copy of the status of the most recent
+// reindexing, or nil if the shard was never reindexed since it was loaded
+func (s *Shard) getReindexStatus() *models.ShardReindexStatus {
+	s.reindexStatusLock.Lock()
+	defer s.reindexStatusLock.Unlock()
+
+	if s.reindexStatus == nil {
+		return nil
+	}
+	status := *s.reindexStatus
+	status.Properties = append([]string{}, s.reindexStatus.Properties...)
+	return &status
+}
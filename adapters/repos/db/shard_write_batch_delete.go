@@ -123,7 +123,7 @@ func (b *deleteObjectsBatcher) flushWALs(ctx context.Context) {
 		}
 	}
 
-	if err := b.shard.vectorIndex.Flush(); err != nil {
+	if err := b.shard.flushVectorIndexes(); err != nil {
 		for i := range b.objects {
 			b.setErrorAtIndex(err, i)
 		}
@@ -146,7 +146,7 @@ func (s *Shard) findDocIDs(ctx context.Context,
 	filters *filters.LocalFilter,
 ) ([]uint64, error) {
 	allowList, err := inverted.NewSearcher(s.index.logger, s.store,
-		s.index.getSchema.GetSchemaSkipAuth(), nil,
+		s.readSchema(), nil,
 		s.index.classSearcher, s.deletedDocIDs, s.index.stopwords,
 		s.versioner.version, s.isFallbackToSearchable,
 		s.tenant()).
@@ -157,6 +157,10 @@ func (ob *objectsBatcher) storeObjectOfBatchInLSM(ctx context.Context,
 		return err
 	}
 
+	if err := ob.shard.validateNamedVectorsBeforeInsert(object.Vectors); err != nil {
+		return err
+	}
+
 	status, err := ob.shard.putObjectLSM(object, idBytes)
 	if err != nil {
 		return err
@@ -193,7 +197,7 @@ func (ob *objectsBatcher) markDeletedInVectorStorage(ctx context.Context) {
 		return
 	}
 
-	if err := ob.shard.vectorIndex.Delete(docIDsToDelete...); err != nil {
+	if err := ob.shard.deleteFromVectorIndexes(docIDsToDelete...); err != nil {
 		for _, pos := range positions {
 			ob.setErrorAtIndex(err, pos)
 		}
@@ -279,6 +283,11 @@ func (ob *objectsBatcher) storeSingleObjectInAdditionalStorage(ctx context.Conte
 		}
 	}
 
+	if err := ob.shard.updateNamedVectorIndexesIgnoreDelete(object.Vectors, status); err != nil {
+		ob.setErrorAtIndex(errors.Wrap(err, "insert to vector index"), index)
+		return
+	}
+
 	if err := ob.shard.updatePropertySpecificIndices(object, status); err != nil {
 		ob.setErrorAtIndex(errors.Wrap(err, "update prop-specific indices"), index)
 		return
@@ -329,7 +338,7 @@ func (ob *objectsBatcher) flushWALs(ctx context.Context) {
 		}
 	}
 
-	if err := ob.shard.vectorIndex.Flush(); err != nil {
+	if err := ob.shard.flushVectorIndexes(); err != nil {
 		for i := range ob.objects {
 			ob.setErrorAtIndex(err, i)
 		}
@@ -208,22 +208,27 @@ func (b *referencesBatcher) writeInverted(in inverted.DeltaMergeResult) error {
 func (b *referencesBatcher) writeInvertedDeletions(
 	in []inverted.MergeProperty,
 ) error {
+	b.shard.doubleWriteLock.RLock()
+	defer b.shard.doubleWriteLock.RUnlock()
+
 	for _, prop := range in {
 		// in the references batcher we can only ever write ref count entire which
 		// are guaranteed to be not have a frequency, meaning they will use the
 		// "Set" strategy in the lsmkv store
 		if prop.HasFilterableIndex {
-			bucket := b.shard.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name))
-			if bucket == nil {
+			buckets := b.shard.writeBuckets(helpers.BucketFromPropNameLSM(prop.Name))
+			if buckets == nil {
 				return errors.Errorf("no bucket for prop '%s' found", prop.Name)
 			}
 
-			for _, item := range prop.MergeItems {
-				for _, id := range item.DocIDs {
-					err := b.shard.deleteInvertedIndexItemLSM(bucket,
-						inverted.Countable{Data: item.Data}, id.DocID)
-					if err != nil {
-						return err
+			for _, bucket := range buckets {
+				for _, item := range prop.MergeItems {
+					for _, id := range item.DocIDs {
+						err := b.shard.deleteInvertedIndexItemLSM(bucket,
+							inverted.Countable{Data: item.Data}, id.DocID)
+						if err != nil {
+							return err
+						}
 					}
 				}
 			}
@@ -237,20 +242,25 @@ func (b *referencesBatcher) writeInvertedDeletions(
 func (b *referencesBatcher) writeInvertedAdditions(
 	in []inverted.MergeProperty,
 ) error {
+	b.shard.doubleWriteLock.RLock()
+	defer b.shard.doubleWriteLock.RUnlock()
+
 	for _, prop := range in {
 		// in the references batcher we can only ever write ref count entire which
 		// are guaranteed to be not have a frequency, meaning they will use the
 		// "Set" strategy in the lsmkv store
 		if prop.HasFilterableIndex {
-			bucket := b.shard.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name))
-			if bucket == nil {
+			buckets := b.shard.writeBuckets(helpers.BucketFromPropNameLSM(prop.Name))
+			if buckets == nil {
 				return errors.Errorf("no bucket for prop '%s' found", prop.Name)
 			}
 
-			for _, item := range prop.MergeItems {
-				err := b.shard.batchExtendInvertedIndexItemsLSMNoFrequency(bucket, item)
-				if err != nil {
-					return err
+			for _, bucket := range buckets {
+				for _, item := range prop.MergeItems {
+					err := b.shard.batchExtendInvertedIndexItemsLSMNoFrequency(bucket, item)
+					if err != nil {
+						return err
+					}
 				}
 			}
 		}
@@ -338,7 +348,7 @@ func (b *referencesBatcher) flushWALs(ctx context.Context) {
 		}
 	}
 
-	if err := b.shard.vectorIndex.Flush(); err != nil {
+	if err := b.shard.flushVectorIndexes(); err != nil {
 		for i := range b.refs {
 			b.setErrorAtIndex(err, i)
 		}
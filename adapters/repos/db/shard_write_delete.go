@@ -68,7 +68,7 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndexes(docID); err != nil {
 		return errors.Wrap(err, "delete from vector index")
 	}
 
@@ -76,7 +76,7 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "flush all buffered WALs")
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
@@ -125,7 +125,7 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
 
-	if err := s.vectorIndex.Delete(docID); err != nil {
+	if err := s.deleteFromVectorIndexes(docID); err != nil {
 		return fmt.Errorf("delete from vector index: %w", err)
 	}
 
@@ -133,7 +133,7 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 		return fmt.Errorf("flush all buffered WALs: %w", err)
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return fmt.Errorf("flush all vector index buffered WALs: %w", err)
 	}
 
@@ -141,7 +141,10 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 }
 
 func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) error {
-	previousObject, err := storobj.FromBinary(previous)
+	s.doubleWriteLock.RLock()
+	defer s.doubleWriteLock.RUnlock()
+
+	previousObject, err := storobj.FromBinaryWithClass(previous, s.class())
 	if err != nil {
 		return errors.Wrap(err, "unmarshal previous object")
 	}
@@ -158,6 +161,9 @@ func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) erro
 	if err != nil {
 		return errors.Wrap(err, "put inverted indices props")
 	}
+	if err := s.deleteFromReindexedBucketsLSM(previousObject, docID); err != nil {
+		return errors.Wrap(err, "delete reindexed inverted indices props")
+	}
 
 	if s.index.Config.TrackVectorDimensions {
 		err = s.removeDimensionsLSM(len(previousObject.Vector), docID)
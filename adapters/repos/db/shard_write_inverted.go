@@ -13,9 +13,11 @@ package db
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -34,12 +36,30 @@ func isPropertyForLength(dt schema.DataType) bool {
 	}
 }
 
+// analyzeObject analyzes the object with the property definitions the
+// inverted buckets are currently served with. The caller has to hold
+// doubleWriteLock, if the properties of the shard may be reindexed.
 func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []nilProp, error) {
+	return s.analyzeObjectWithProperties(object, s.servedProperties)
+}
+
+// analyzeObjectForReindex analyzes the object with the property definitions
+// the running reindexing rebuilds the inverted buckets for. The caller has to
+// hold doubleWriteLock.
+func (s *Shard) analyzeObjectForReindex(object *storobj.Object) ([]inverted.Property, []nilProp, error) {
+	return s.analyzeObjectWithProperties(object, s.reindexTargetProperties)
+}
+
+func (s *Shard) analyzeObjectWithProperties(object *storobj.Object,
+	properties func(props []*models.Property) []*models.Property,
+) ([]inverted.Property, []nilProp, error) {
 	schemaModel := s.index.getSchema.GetSchemaSkipAuth().Objects
-	c, err := schema.GetClassByName(schemaModel, object.Class().String())
+	class, err := schema.GetClassByName(schemaModel, object.Class().String())
 	if err != nil {
 		return nil, nil, err
 	}
+	c := *class
+	c.Properties = properties(class.Properties)
 
 	var schemaMap map[string]interface{}
 
@@ -64,6 +84,12 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 				continue
 			}
 
+			if _, ok := schema.AsNested(prop.DataType); ok {
+				// nested properties are indexed by their path, e.g. "address.city"
+				nilProps = append(nilProps, nestedNilProps(prop, schemaMap[prop.Name])...)
+				continue
+			}
+
 			// Add props as nil props if
 			// 1. They are not in the schema map ( == nil)
 			// 2. Their inverted index is enabled
@@ -88,3 +114,43 @@ func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, []ni
 	props, err := inverted.NewAnalyzer(s.isFallbackToSearchable).Object(schemaMap, c.Properties, object.ID())
 	return props, nilProps, err
 }
+
+// nestedNilProps returns the nested properties of an object or object[]
+// property which are not set in the given value
+func nestedNilProps(prop *models.Property, value interface{}) []nilProp {
+	var nilProps []nilProp
+	for _, leaf := range schema.NestedLeafProperties(prop) {
+		if !inverted.HasInvertedIndex(leaf) {
+			continue
+		}
+		if value != nil && nestedIsSet(value,
+			strings.Split(leaf.Name, schema.NestedPropertySeparator)[1:]) {
+			continue
+		}
+		nilProps = append(nilProps, nilProp{
+			Name:                leaf.Name,
+			AddToPropertyLength: isPropertyForLength(schema.DataType(leaf.DataType[0])),
+		})
+	}
+	return nilProps
+}
+
+// nestedIsSet returns whether the path is set in the nested object, or in
+// any of the objects of a nested object array
+func nestedIsSet(value interface{}, path []string) bool {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		child, ok := typed[path[0]]
+		if !ok || child == nil {
+			return false
+		}
+		return len(path) == 1 || nestedIsSet(child, path[1:])
+	case []interface{}:
+		for _, elem := range typed {
+			if nestedIsSet(elem, path) {
+				return true
+			}
+		}
+	}
+	return false
+}
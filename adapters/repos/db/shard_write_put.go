@@ -47,6 +47,9 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 			return errors.Wrapf(err, "Validate vector index for %v", uuid)
 		}
 	}
+	if err := s.validateNamedVectorsBeforeInsert(object.Vectors); err != nil {
+		return errors.Wrapf(err, "Validate vector index for %v", uuid)
+	}
 
 	status, err := s.putObjectLSM(object, uuid)
 	if err != nil {
@@ -57,6 +60,10 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 		return errors.Wrap(err, "update vector index")
 	}
 
+	if err := s.updateNamedVectorIndexes(object.Vectors, status); err != nil {
+		return errors.Wrap(err, "update vector index")
+	}
+
 	if err := s.updatePropertySpecificIndices(object, status); err != nil {
 		return errors.Wrap(err, "update property-specific indices")
 	}
@@ -69,7 +76,7 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 		return errors.Wrap(err, "flush prop length tracker to disk")
 	}
 
-	if err := s.vectorIndex.Flush(); err != nil {
+	if err := s.flushVectorIndexes(); err != nil {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
@@ -254,13 +261,16 @@ func (s *Shard) upsertObjectDataLSM(bucket *lsmkv.Bucket, id []byte, data []byte
 func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 	status objectInsertStatus, previous []byte,
 ) error {
+	s.doubleWriteLock.RLock()
+	defer s.doubleWriteLock.RUnlock()
+
 	props, nilprops, err := s.analyzeObject(object)
 	if err != nil {
 		return errors.Wrap(err, "analyze next object")
 	}
 
 	if status.docIDChanged {
-		oldObject, err := storobj.FromBinary(previous)
+		oldObject, err := storobj.FromBinaryWithClass(previous, s.class())
 		if err == nil {
 
 			oldProps, _, err := s.analyzeObject(oldObject)
@@ -294,6 +304,9 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 	if err != nil {
 		return errors.Wrap(err, "put inverted indices props")
 	}
+	if err := s.extendReindexedBucketsLSM(object, status.docID); err != nil {
+		return errors.Wrap(err, "put reindexed inverted indices props")
+	}
 	s.metrics.InvertedExtend(before, len(props))
 
 	if err := s.addPropLengths(props); err != nil {
@@ -352,7 +365,7 @@ func (s *Shard) updateInvertedIndexCleanupOldLSM(status objectInsertStatus,
 	// NOTE: Since Doc IDs are immutable, there is no need to use a
 	// DeltaAnalyzer. docIDChanged==true, therefore the old docID is
 	// "worthless" and can be cleaned up in the inverted index fully.
-	previousObject, err := storobj.FromBinary(previous)
+	previousObject, err := storobj.FromBinaryWithClass(previous, s.class())
 	if err != nil {
 		return errors.Wrap(err, "unmarshal previous object")
 	}
@@ -367,6 +380,9 @@ func (s *Shard) updateInvertedIndexCleanupOldLSM(status objectInsertStatus,
 	if err != nil {
 		return errors.Wrap(err, "put inverted indices props")
 	}
+	if err := s.deleteFromReindexedBucketsLSM(previousObject, status.oldDocID); err != nil {
+		return errors.Wrap(err, "delete reindexed inverted indices props")
+	}
 
 	if s.index.Config.TrackVectorDimensions {
 		err = s.removeDimensionsLSM(len(previousObject.Vector), status.oldDocID)
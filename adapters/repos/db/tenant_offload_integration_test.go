@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestTenantOffload(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		dirName = t.TempDir()
+		tenant  = "tenant1"
+		id      = strfmt.UUID("c6f85bf5-c3b7-4c1d-bd51-e899f9605336")
+	)
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "OffloadClass",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+		},
+	}
+	config, err := sharding.ParseConfig(nil, 1)
+	require.Nil(t, err)
+	shardState, err := sharding.InitState(class.Class, config,
+		fakeNodes{[]string{"node1"}}, 1, true)
+	require.Nil(t, err)
+	shardState.AddPartition(tenant, []string{"node1"}, models.TenantActivityStatusHOT)
+
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: shardState,
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, shardState))
+
+	obj := &models.Object{
+		Class:      class.Class,
+		ID:         id,
+		Tenant:     tenant,
+		Properties: map[string]interface{}{"name": "offloaded"},
+	}
+	require.Nil(t, repo.PutObject(ctx, obj, []float32{1, 2, 3}, nil))
+
+	updateStatus := func(t *testing.T, status string) error {
+		commit, err := migrator.UpdateTenants(ctx, class,
+			[]*migrate.UpdateTenantPayload{{Name: tenant, Status: status}})
+		if err != nil {
+			return err
+		}
+		commit(true)
+		p := shardState.Physical[tenant]
+		p.Status = status
+		shardState.Physical[tenant] = p
+		return nil
+	}
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+	lsmPath := path.Join(dirName, idx.ID()+"_"+tenant+"_lsm")
+
+	t.Run("offloading without backend fails", func(t *testing.T) {
+		err := updateStatus(t, models.TenantActivityStatusOFFLOADING)
+		assert.ErrorContains(t, err, "no offload backend configured")
+		assert.NotNil(t, idx.shards.Load(tenant))
+	})
+
+	backendDir := t.TempDir()
+	backendPath := path.Join(backendDir, idx.shardOffloadID(tenant))
+	repo.SetOffloadBackend(&fakeOffloadBackend{dataPath: dirName, dir: backendDir})
+
+	offload := func(t *testing.T) {
+		require.Nil(t, updateStatus(t, models.TenantActivityStatusOFFLOADING))
+		assert.Nil(t, idx.shards.Load(tenant))
+		require.Nil(t, migrator.OffloadTenant(ctx, class, tenant))
+		require.Nil(t, updateStatus(t, models.TenantActivityStatusOFFLOADED))
+	}
+	assertBackendDeleted := func(t *testing.T) {
+		assert.Eventually(t, func() bool {
+			_, err := os.Stat(backendPath)
+			return os.IsNotExist(err)
+		}, 5*time.Second, 10*time.Millisecond, "offloaded files are deleted")
+	}
+
+	t.Run("offload tenant", func(t *testing.T) {
+		offload(t)
+		_, err := os.Stat(lsmPath)
+		assert.True(t, os.IsNotExist(err), "local files are removed")
+		_, err = os.Stat(backendPath)
+		assert.Nil(t, err, "files are offloaded")
+
+		_, err = repo.ObjectByID(ctx, id, nil, additional.Properties{}, tenant)
+		assert.ErrorContains(t, err, errTenantNotActive.Error())
+	})
+
+	t.Run("offloaded tenant needs to be onloaded", func(t *testing.T) {
+		err := updateStatus(t, models.TenantActivityStatusHOT)
+		assert.ErrorContains(t, err, "cannot change activity status")
+	})
+
+	t.Run("reload tenant as cold", func(t *testing.T) {
+		require.Nil(t, updateStatus(t, models.TenantActivityStatusONLOADING))
+		require.Nil(t, migrator.OnloadTenant(ctx, class, tenant))
+		require.Nil(t, updateStatus(t, models.TenantActivityStatusCOLD))
+		assert.Nil(t, idx.shards.Load(tenant))
+		_, err := os.Stat(lsmPath)
+		assert.Nil(t, err, "local files are restored")
+		assertBackendDeleted(t)
+	})
+
+	t.Run("revert offloading", func(t *testing.T) {
+		require.Nil(t, updateStatus(t, models.TenantActivityStatusOFFLOADING))
+		require.Nil(t, updateStatus(t, models.TenantActivityStatusHOT))
+		require.NotNil(t, idx.shards.Load(tenant))
+		_, err := os.Stat(backendPath)
+		assert.True(t, os.IsNotExist(err), "nothing has been offloaded")
+	})
+
+	t.Run("offload hot tenant and activate it", func(t *testing.T) {
+		offload(t)
+		_, err := os.Stat(lsmPath)
+		assert.True(t, os.IsNotExist(err), "local files are removed")
+
+		require.Nil(t, updateStatus(t, models.TenantActivityStatusONLOADING))
+		require.Nil(t, migrator.OnloadTenant(ctx, class, tenant))
+		require.Nil(t, updateStatus(t, models.TenantActivityStatusHOT))
+		require.NotNil(t, idx.shards.Load(tenant))
+		res, err := repo.ObjectByID(ctx, id, nil, additional.Properties{}, tenant)
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "offloaded", res.Schema.(map[string]interface{})["name"])
+		assertBackendDeleted(t)
+	})
+
+	t.Run("delete offloaded tenant", func(t *testing.T) {
+		offload(t)
+		_, err := os.Stat(backendPath)
+		require.Nil(t, err)
+
+		commit, err := migrator.DeleteTenants(ctx, class, []string{tenant})
+		require.Nil(t, err)
+		commit(true)
+		assertBackendDeleted(t)
+	})
+}
+
+// fakeOffloadBackend stores offloaded files in a local directory
+type fakeOffloadBackend struct {
+	dataPath string
+	dir      string
+}
+
+func (f *fakeOffloadBackend) IsExternal() bool               { return false }
+func (f *fakeOffloadBackend) Name() string                   { return "fake-offload" }
+func (f *fakeOffloadBackend) HomeDir(backupID string) string { return path.Join(f.dir, backupID) }
+func (f *fakeOffloadBackend) SourceDataPath() string         { return f.dataPath }
+
+func (f *fakeOffloadBackend) Initialize(ctx context.Context, backupID string) error {
+	return nil
+}
+
+func (f *fakeOffloadBackend) GetObject(ctx context.Context, backupID, key string) ([]byte, error) {
+	return os.ReadFile(path.Join(f.dir, backupID, key))
+}
+
+func (f *fakeOffloadBackend) WriteToFile(ctx context.Context, backupID, key, destPath string) error {
+	data, err := os.ReadFile(path.Join(f.dir, backupID, key))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(destPath, data, os.ModePerm)
+}
+
+func (f *fakeOffloadBackend) PutFile(ctx context.Context, backupID, key, srcPath string) error {
+	data, err := os.ReadFile(path.Join(f.dataPath, srcPath))
+	if err != nil {
+		return err
+	}
+	return f.PutObject(ctx, backupID, key, data)
+}
+
+func (f *fakeOffloadBackend) PutObject(ctx context.Context, backupID, key string, data []byte) error {
+	dest := path.Join(f.dir, backupID, key)
+	if err := os.MkdirAll(path.Dir(dest), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(dest, data, os.ModePerm)
+}
+
+func (f *fakeOffloadBackend) Delete(ctx context.Context, backupID string) error {
+	return os.RemoveAll(path.Join(f.dir, backupID))
+}
@@ -0,0 +1,473 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestUpdatePropertyIndexes(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	vTrue := true
+	vFalse := false
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class:               "UpdateProperty",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:            "title",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationField,
+				IndexFilterable: &vTrue,
+				IndexSearchable: &vTrue,
+			},
+			{
+				Name:            "year",
+				DataType:        schema.DataTypeInt.PropString(),
+				IndexFilterable: &vFalse,
+			},
+		},
+	}
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	objects := map[strfmt.UUID]map[string]interface{}{
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506970": {"title": "big red car", "year": int64(2020)},
+		"86a380e9-cb60-4b2a-bc48-51f52acd72d6": {"title": "small red bike", "year": int64(2021)},
+		"2f8a3f0c-7c2e-4a8d-9d56-6c5e7a0b2c8e": {"title": "big blue car", "year": int64(2020)},
+	}
+	for id, props := range objects {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			Class:      class.Class,
+			ID:         id,
+			Properties: props,
+		}, []float32{1, 2, 3}, nil))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+	shard := idx.shards.Load(schemaGetter.shardState.AllPhysicalShards()[0])
+	require.NotNil(t, shard)
+
+	// update the property in the schema, as the schema manager would
+	updateProperty := func(t *testing.T, i int, update func(prop *models.Property)) {
+		old := class.Properties[i]
+		updated := *old
+		update(&updated)
+		class.Properties[i] = &updated
+		require.Nil(t, migrator.UpdatePropertyIndexes(ctx, class.Class, old, &updated))
+	}
+	waitForReindex := func(t *testing.T, objectsProcessed int) {
+		require.Eventually(t, func() bool {
+			status := shard.getReindexStatus()
+			return status != nil && status.Status != models.ShardReindexStatusStatusINDEXING
+		}, 10*time.Second, 10*time.Millisecond)
+		status := shard.getReindexStatus()
+		require.Equal(t, models.ShardReindexStatusStatusFINISHED, status.Status, status.Error)
+		assert.Equal(t, int64(objectsProcessed), status.ObjectsProcessed)
+	}
+	filter := func(t *testing.T, propName string, value interface{}, dataType schema.DataType) int {
+		res, err := repo.Search(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: schema.PropertyName(propName),
+					},
+					Value: &filters.Value{Value: value, Type: dataType},
+				},
+			},
+		})
+		require.Nil(t, err)
+		return len(res)
+	}
+	bm25 := func(t *testing.T, query string) int {
+		res, err := repo.Search(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type:       "bm25",
+				Query:      query,
+				Properties: []string{"title"},
+			},
+		})
+		require.Nil(t, err)
+		return len(res)
+	}
+
+	t.Run("field tokenization matches whole values only", func(t *testing.T) {
+		assert.Equal(t, 0, filter(t, "title", "red", schema.DataTypeText))
+		assert.Equal(t, 1, filter(t, "title", "big red car", schema.DataTypeText))
+	})
+
+	t.Run("change tokenization", func(t *testing.T) {
+		updateProperty(t, 0, func(prop *models.Property) {
+			prop.Tokenization = models.PropertyTokenizationWord
+		})
+		waitForReindex(t, len(objects))
+
+		assert.Equal(t, 2, filter(t, "title", "red", schema.DataTypeText))
+		assert.Equal(t, 2, filter(t, "title", "car", schema.DataTypeText))
+		assert.Equal(t, 2, bm25(t, "red"))
+		assert.Equal(t, []string{"title"}, shard.getReindexStatus().Properties)
+	})
+
+	t.Run("enable filterable index", func(t *testing.T) {
+		assert.Nil(t, shard.store.Bucket(helpers.BucketFromPropNameLSM("year")))
+
+		updateProperty(t, 1, func(prop *models.Property) {
+			prop.IndexFilterable = &vTrue
+		})
+		waitForReindex(t, len(objects))
+
+		assert.NotNil(t, shard.store.Bucket(helpers.BucketFromPropNameLSM("year")))
+		assert.Equal(t, 2, filter(t, "year", 2020, schema.DataTypeInt))
+	})
+
+	t.Run("enable token positions", func(t *testing.T) {
+		_, err := repo.Search(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type:       "bm25",
+				Query:      `"red car"`,
+				Properties: []string{"title"},
+			},
+		})
+		require.NotNil(t, err)
+
+		updateProperty(t, 0, func(prop *models.Property) {
+			prop.IndexPositions = &vTrue
+		})
+		waitForReindex(t, len(objects))
+
+		assert.Equal(t, 1, bm25(t, `"red car"`))
+		assert.Equal(t, 2, bm25(t, `"big car"~1`))
+	})
+
+	t.Run("disable searchable index", func(t *testing.T) {
+		updateProperty(t, 0, func(prop *models.Property) {
+			prop.IndexPositions = nil
+			prop.IndexSearchable = &vFalse
+		})
+		// disabled indexes are dropped without reindexing any object
+		waitForReindex(t, 0)
+
+		assert.Nil(t, shard.store.Bucket(helpers.BucketSearchableFromPropNameLSM("title")))
+		assert.Equal(t, 2, filter(t, "title", "red", schema.DataTypeText))
+	})
+
+	t.Run("writes after reindexing are indexed", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			Class:      class.Class,
+			ID:         "c5e1b4e0-5b59-4a0c-9b2b-0bdb0d3b6e4f",
+			Properties: map[string]interface{}{"title": "red truck", "year": int64(2020)},
+		}, []float32{1, 2, 3}, nil))
+
+		assert.Equal(t, 3, filter(t, "title", "red", schema.DataTypeText))
+		assert.Equal(t, 3, filter(t, "year", 2020, schema.DataTypeInt))
+	})
+
+	t.Run("status is part of the nodes status", func(t *testing.T) {
+		var statuses []*models.NodeShardStatus
+		idx.getShardsNodeStatus(&statuses)
+		require.Len(t, statuses, 1)
+		require.NotNil(t, statuses[0].ReindexStatus)
+		assert.Equal(t, models.ShardReindexStatusStatusFINISHED, statuses[0].ReindexStatus.Status)
+	})
+}
+
+func TestUpdatePropertyIndexesWithConcurrentWrites(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	schemaGetter := &fakeSchemaGetter{shardState: singleShardState()}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	class := &models.Class{
+		Class:               "UpdatePropertyConcurrentWrites",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+		},
+	}
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema.Objects = &models.Schema{Classes: []*models.Class{class}}
+
+	putObject := func(t *testing.T, id strfmt.UUID, title string) {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			Class:      class.Class,
+			ID:         id,
+			Properties: map[string]interface{}{"title": title},
+		}, []float32{rand.Float32(), rand.Float32(), rand.Float32()}, nil))
+	}
+
+	const before = 2000
+	ids := make([]strfmt.UUID, before)
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.NewString())
+		putObject(t, ids[i], "red car")
+	}
+	putObject(t, strfmt.UUID(uuid.NewString()), "blue boat")
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+	shard := idx.shards.Load(schemaGetter.shardState.AllPhysicalShards()[0])
+	require.NotNil(t, shard)
+
+	filter := func(t *testing.T, value string) int {
+		res, err := repo.Search(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10000},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: "title",
+					},
+					Value: &filters.Value{Value: value, Type: schema.DataTypeText},
+				},
+			},
+		})
+		require.Nil(t, err)
+		return len(res)
+	}
+
+	// objects are added and deleted until the reindexing is done, so that some
+	// of the writes happen while the temporary buckets are populated
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	added, deleted, duringReindex := 0, 0, 0
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+
+			shard.doubleWriteLock.RLock()
+			if shard.doubleWriteBuckets != nil {
+				duringReindex++
+			}
+			shard.doubleWriteLock.RUnlock()
+
+			putObject(t, strfmt.UUID(uuid.NewString()), "red bike")
+			added++
+			if deleted < before {
+				require.Nil(t, repo.DeleteObject(ctx, class.Class, ids[deleted], nil, ""))
+				deleted++
+			}
+		}
+	}()
+
+	old := class.Properties[0]
+	updated := *old
+	updated.Tokenization = models.PropertyTokenizationWord
+	class.Properties[0] = &updated
+	// the previous buckets are served with the previous tokenization until
+	// the reindexing is done, the reindexing is held back to query them
+	// deterministically
+	shard.reindexLock.Lock()
+	require.Nil(t, migrator.UpdatePropertyIndexes(ctx, class.Class, old, &updated))
+	assert.Equal(t, 1, filter(t, "blue boat"))
+	assert.Equal(t, 0, filter(t, "boat"))
+	shard.reindexLock.Unlock()
+
+	deadline := time.Now().Add(30 * time.Second)
+	for shard.getReindexStatus().Status == models.ShardReindexStatusStatusINDEXING {
+		require.True(t, time.Now().Before(deadline), "reindexing timed out")
+		assert.Equal(t, 1, filter(t, "blue boat"))
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(stop)
+	<-done
+	status := shard.getReindexStatus()
+	require.Equal(t, models.ShardReindexStatusStatusFINISHED, status.Status, status.Error)
+	require.Greater(t, duringReindex, 0)
+
+	assert.Equal(t, 1, filter(t, "blue boat"))
+	assert.Equal(t, 1, filter(t, "boat"))
+	assert.Equal(t, before+added-deleted, filter(t, "red"))
+	assert.Equal(t, before-deleted, filter(t, "car"))
+	assert.Equal(t, added, filter(t, "bike"))
+	assert.Nil(t, shard.store.Bucket(helpers.TempBucketFromBucketName(
+		helpers.BucketFromPropNameLSM("title"))))
+}
+
+func TestUpdatePropertyIndexesWithColdTenant(t *testing.T) {
+	ctx := context.Background()
+	tenants := []string{"hot", "cold"}
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "UpdatePropertyTenants",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+		},
+	}
+	config, err := sharding.ParseConfig(nil, 1)
+	require.Nil(t, err)
+	shardState, err := sharding.InitState(class.Class, config,
+		fakeNodes{[]string{"node1"}}, 1, true)
+	require.Nil(t, err)
+	for _, tenant := range tenants {
+		shardState.AddPartition(tenant, []string{"node1"}, models.TenantActivityStatusHOT)
+	}
+
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: shardState,
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushIdleAfter:   60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil)
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, shardState))
+
+	for _, tenant := range tenants {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			Class:      class.Class,
+			ID:         strfmt.UUID(uuid.NewString()),
+			Tenant:     tenant,
+			Properties: map[string]interface{}{"title": "red car"},
+		}, []float32{1, 2, 3}, nil))
+	}
+
+	updateStatus := func(t *testing.T, tenant, status string) {
+		commit, err := migrator.UpdateTenants(ctx, class,
+			[]*migrate.UpdateTenantPayload{{Name: tenant, Status: status}})
+		require.Nil(t, err)
+		commit(true)
+		p := shardState.Physical[tenant]
+		p.Status = status
+		shardState.Physical[tenant] = p
+	}
+	filter := func(t *testing.T, tenant, value string) int {
+		res, err := repo.Search(ctx, dto.GetParams{
+			ClassName:  class.Class,
+			Tenant:     tenant,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					On: &filters.Path{
+						Class:    schema.ClassName(class.Class),
+						Property: "title",
+					},
+					Value: &filters.Value{Value: value, Type: schema.DataTypeText},
+				},
+			},
+		})
+		require.Nil(t, err)
+		return len(res)
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+	updateStatus(t, "cold", models.TenantActivityStatusCOLD)
+	require.Nil(t, idx.shards.Load("cold"))
+
+	// update the property in the schema, as the schema manager would
+	old := class.Properties[0]
+	updated := *old
+	updated.Tokenization = models.PropertyTokenizationWord
+	class.Properties[0] = &updated
+	require.Nil(t, migrator.UpdatePropertyIndexes(ctx, class.Class, old, &updated))
+	assert.Nil(t, idx.shards.Load("cold"), "tenant remains unloaded")
+
+	t.Run("hot tenant is reindexed", func(t *testing.T) {
+		shard := idx.shards.Load("hot")
+		require.NotNil(t, shard)
+		require.Eventually(t, func() bool {
+			status := shard.getReindexStatus()
+			return status != nil && status.Status != models.ShardReindexStatusStatusINDEXING
+		}, 10*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 1, filter(t, "hot", "red"))
+	})
+
+	t.Run("cold tenant is reindexed", func(t *testing.T) {
+		updateStatus(t, "cold", models.TenantActivityStatusHOT)
+		assert.Equal(t, 1, filter(t, "cold", "red"))
+		assert.Equal(t, 1, filter(t, "cold", "car"))
+	})
+}
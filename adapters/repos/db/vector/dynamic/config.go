@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/entities/schema"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// VectorIndex is the common interface of the indexes wrapped by the dynamic
+// index. It mirrors the VectorIndex interface of the shard.
+type VectorIndex interface {
+	Dump(labels ...string)
+	Add(id uint64, vector []float32) error
+	Delete(id ...uint64) error
+	SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVectorDistance(vector []float32, dist float32,
+		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
+	UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error
+	Drop(ctx context.Context) error
+	Shutdown(ctx context.Context) error
+	Flush() error
+	SwitchCommitLogs(ctx context.Context) error
+	ListFiles(ctx context.Context) ([]string, error)
+	PostStartup()
+	ValidateBeforeInsert(vector []float32) error
+}
+
+// MakeHnswIndex creates (or loads) the hnsw index of the shard. It is provided
+// as a thunk, as the hnsw index is only needed once the threshold is crossed.
+type MakeHnswIndex func(uc hnswent.UserConfig) (VectorIndex, error)
+
+// Config for a new dynamic index, this contains information that is derived
+// internally, e.g. by the shard. All User-settable config is specified in
+// UserConfig
+type Config struct {
+	RootPath         string
+	ID               string
+	TargetVector     string
+	Logger           logrus.FieldLogger
+	DistanceProvider distancer.Provider
+	Store            *lsmkv.Store
+	MakeHnswIndex    MakeHnswIndex
+}
+
+func (c Config) Validate() error {
+	ec := &errorcompounder.ErrorCompounder{}
+
+	if c.ID == "" {
+		ec.Addf("id cannot be empty")
+	}
+
+	if c.RootPath == "" {
+		ec.Addf("rootPath cannot be empty")
+	}
+
+	if c.DistanceProvider == nil {
+		ec.Addf("distancerProvider cannot be nil")
+	}
+
+	if c.Store == nil {
+		ec.Addf("store cannot be nil")
+	}
+
+	if c.MakeHnswIndex == nil {
+		ec.Addf("makeHnswIndex cannot be nil")
+	}
+
+	return ec.ToError()
+}
@@ -0,0 +1,382 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/entities/schema"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// flatIndex is the subset of the flat index needed to build an hnsw index
+// from its vectors
+type flatIndex interface {
+	VectorIndex
+	Count() int
+	ContainsNode(id uint64) bool
+	Iterate(fn func(id uint64, vector []float32) error) error
+}
+
+// compressedDistanceSearcher is implemented by the underlying indexes which
+// can report the compressed distance of each result
+type compressedDistanceSearcher interface {
+	SearchByVectorWithCompressedDistances(vector []float32, k int,
+		allow helpers.AllowList) ([]uint64, []float32, []float32, error)
+}
+
+// dynamic starts out as a flat index and upgrades itself to an hnsw index
+// once the configured threshold of vectors is crossed. The hnsw index is
+// built in the background from the vectors of the flat index, while all
+// reads keep being served by the flat index and all writes are applied to
+// both. Once the build has completed, the hnsw index is swapped in.
+//
+// The upgrade is persisted through a marker file next to the hnsw commit log,
+// so that the shard directly loads the hnsw index on subsequent restarts. An
+// upgrade that was interrupted, e.g. by a crash, is started from scratch.
+type dynamic struct {
+	// guards the swap of the serving index. All operations hold it in read
+	// mode, so that the swap waits for in-flight operations to complete.
+	sync.RWMutex
+
+	id            string
+	targetVector  string
+	rootPath      string
+	logger        logrus.FieldLogger
+	store         *lsmkv.Store
+	makeHnswIndex MakeHnswIndex
+	threshold     uint64
+	hnswUC        hnswent.UserConfig
+
+	// index serves all reads and writes. It is the flat index until the
+	// upgrade has completed, then the hnsw index.
+	index    VectorIndex
+	flat     flatIndex
+	upgraded bool
+	count    atomic.Int64
+
+	// state of a running upgrade, guarded by upgradeLock
+	upgradeLock   sync.Mutex
+	upgrading     atomic.Bool
+	target        VectorIndex
+	seen          map[uint64]struct{}
+	deleted       map[uint64]struct{}
+	cancelUpgrade context.CancelFunc
+	upgradeDone   chan struct{}
+}
+
+func New(cfg Config, uc ent.UserConfig) (*dynamic, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if cfg.Logger == nil {
+		logger := logrus.New()
+		logger.Out = io.Discard
+		cfg.Logger = logger
+	}
+
+	index := &dynamic{
+		id:            cfg.ID,
+		targetVector:  cfg.TargetVector,
+		rootPath:      cfg.RootPath,
+		logger:        cfg.Logger,
+		store:         cfg.Store,
+		makeHnswIndex: cfg.MakeHnswIndex,
+		threshold:     uc.Threshold,
+		hnswUC:        uc.HnswUC,
+	}
+
+	upgraded, err := index.isUpgraded()
+	if err != nil {
+		return nil, errors.Wrapf(err, "init dynamic index %q", cfg.ID)
+	}
+
+	if upgraded {
+		hnsw, err := index.makeHnswIndex(index.hnswUC)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init dynamic index %q: hnsw index", cfg.ID)
+		}
+		index.index = hnsw
+		index.upgraded = true
+		return index, nil
+	}
+
+	flatIndex, err := flat.New(flat.Config{
+		ID:               cfg.ID,
+		TargetVector:     cfg.TargetVector,
+		Logger:           cfg.Logger,
+		DistanceProvider: cfg.DistanceProvider,
+	}, uc.FlatUC, cfg.Store)
+	if err != nil {
+		return nil, errors.Wrapf(err, "init dynamic index %q: flat index", cfg.ID)
+	}
+	index.index = flatIndex
+	index.flat = flatIndex
+	index.count.Store(int64(flatIndex.Count()))
+
+	return index, nil
+}
+
+func (d *dynamic) markerPath() string {
+	return filepath.Join(d.rootPath, fmt.Sprintf("%s.dynamic.upgraded", d.id))
+}
+
+// hnswCommitLogPath is the directory the commit log of the hnsw index is
+// written to, see hnsw.NewCommitLogger
+func (d *dynamic) hnswCommitLogPath() string {
+	return filepath.Join(d.rootPath, fmt.Sprintf("%s.hnsw.commitlog.d", d.id))
+}
+
+func (d *dynamic) isUpgraded() (bool, error) {
+	_, err := os.Stat(d.markerPath())
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+// Upgraded indicates whether the hnsw index has been swapped in
+func (d *dynamic) Upgraded() bool {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.upgraded
+}
+
+// Upgrading indicates whether the hnsw index is being built in the background
+func (d *dynamic) Upgrading() bool {
+	return d.upgrading.Load()
+}
+
+func (d *dynamic) ValidateBeforeInsert(vector []float32) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.ValidateBeforeInsert(vector)
+}
+
+func (d *dynamic) Add(id uint64, vector []float32) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.upgraded {
+		return d.index.Add(id, vector)
+	}
+
+	// the id needs to be marked before it is written to the flat index, so
+	// that the upgrade does not add it a second time if it observes it
+	target := d.markAdded(id)
+
+	if err := d.flat.Add(id, vector); err != nil {
+		return err
+	}
+
+	if target != nil {
+		if err := target.Add(id, vector); err != nil {
+			return errors.Wrap(err, "add to hnsw index during upgrade")
+		}
+	}
+
+	if uint64(d.count.Add(1)) >= d.threshold {
+		d.startUpgrade()
+	}
+
+	return nil
+}
+
+func (d *dynamic) Delete(ids ...uint64) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.upgraded {
+		return d.index.Delete(ids...)
+	}
+
+	// only ids which are actually stored count towards the threshold
+	removed := 0
+	for _, id := range ids {
+		if d.flat.ContainsNode(id) {
+			removed++
+		}
+	}
+
+	if err := d.flat.Delete(ids...); err != nil {
+		return err
+	}
+
+	d.markDeleted(ids)
+	d.count.Add(-int64(removed))
+
+	return nil
+}
+
+func (d *dynamic) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.SearchByVector(vector, k, allow)
+}
+
+// SearchByVectorWithCompressedDistances behaves like SearchByVector, but
+// additionally returns the compressed distances of the results if the
+// underlying index reports them. They are nil otherwise, e.g. before the
+// index has been upgraded.
+func (d *dynamic) SearchByVectorWithCompressedDistances(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, []float32, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	if searcher, ok := d.index.(compressedDistanceSearcher); ok {
+		return searcher.SearchByVectorWithCompressedDistances(vector, k, allow)
+	}
+	ids, dists, err := d.index.SearchByVector(vector, k, allow)
+	return ids, dists, nil, err
+}
+
+func (d *dynamic) SearchByVectorDistance(vector []float32, dist float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.SearchByVectorDistance(vector, dist, maxLimit, allow)
+}
+
+func (d *dynamic) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	// the threshold only matters as long as the index has not been upgraded
+	// yet, the hnsw config is kept for an upgrade that happens later on
+	d.threshold = parsed.Threshold
+	d.hnswUC = parsed.HnswUC
+
+	if d.upgraded {
+		return d.index.UpdateUserConfig(parsed.HnswUC, callback)
+	}
+	return d.index.UpdateUserConfig(parsed.FlatUC, callback)
+}
+
+func (d *dynamic) Drop(ctx context.Context) error {
+	d.stopUpgrade()
+
+	d.Lock()
+	defer d.Unlock()
+
+	if err := d.index.Drop(ctx); err != nil {
+		return err
+	}
+
+	if err := os.Remove(d.markerPath()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove upgrade marker")
+	}
+
+	return nil
+}
+
+func (d *dynamic) Shutdown(ctx context.Context) error {
+	d.stopUpgrade()
+
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.Shutdown(ctx)
+}
+
+func (d *dynamic) Flush() error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if err := d.index.Flush(); err != nil {
+		return err
+	}
+
+	d.upgradeLock.Lock()
+	target := d.target
+	d.upgradeLock.Unlock()
+
+	if target != nil {
+		return target.Flush()
+	}
+
+	return nil
+}
+
+func (d *dynamic) SwitchCommitLogs(ctx context.Context) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.index.SwitchCommitLogs(ctx)
+}
+
+// ListFiles lists the files of the serving index. Once upgraded, this
+// includes the marker file, so that a restored shard does not need to repeat
+// the upgrade. The files of an hnsw index that is still being built are not
+// listed, the upgrade simply starts over on the restored shard.
+func (d *dynamic) ListFiles(ctx context.Context) ([]string, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	files, err := d.index.ListFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.upgraded {
+		files = append(files, filepath.Base(d.markerPath()))
+	}
+
+	return files, nil
+}
+
+func (d *dynamic) PostStartup() {
+	d.RLock()
+	defer d.RUnlock()
+
+	d.index.PostStartup()
+
+	if !d.upgraded && uint64(d.count.Load()) >= d.threshold {
+		d.startUpgrade()
+	}
+}
+
+func (d *dynamic) Dump(labels ...string) {
+	d.RLock()
+	defer d.RUnlock()
+
+	d.index.Dump(labels...)
+}
@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func newTestStore(t *testing.T, dirName string) *lsmkv.Store {
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dirName, dirName, logger, nil,
+		cyclemanager.NewCycleCallbacksNoop(), cyclemanager.NewCycleCallbacksNoop())
+	require.Nil(t, err)
+	return store
+}
+
+// newTestIndex uses a flat index on a separate store in place of the hnsw
+// index, as the tests only care about the upgrade mechanics
+func newTestIndex(t *testing.T, rootPath string, store, targetStore *lsmkv.Store,
+	threshold uint64,
+) *dynamic {
+	uc := ent.NewDefaultUserConfig()
+	uc.Threshold = threshold
+
+	index, err := New(Config{
+		RootPath:         rootPath,
+		ID:               "dynamic-test",
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+		Store:            store,
+		MakeHnswIndex: func(hnswent.UserConfig) (VectorIndex, error) {
+			return flat.New(flat.Config{
+				ID:               "dynamic-test-target",
+				DistanceProvider: distancer.NewL2SquaredProvider(),
+			}, flatent.NewDefaultUserConfig(), targetStore)
+		},
+	}, uc)
+	require.Nil(t, err)
+	return index
+}
+
+func TestDynamicIndex_Upgrade(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	store := newTestStore(t, rootPath+"/store")
+	targetStore := newTestStore(t, rootPath+"/target")
+	index := newTestIndex(t, rootPath, store, targetStore, 10)
+	index.PostStartup()
+
+	t.Run("importing below the threshold", func(t *testing.T) {
+		for i := 0; i < 9; i++ {
+			require.Nil(t, index.Add(uint64(i), []float32{float32(i), float32(i)}))
+		}
+		assert.False(t, index.Upgraded())
+		assert.False(t, index.Upgrading())
+		require.Nil(t, index.Delete(0))
+
+		ids, _, compressedDists, err := index.SearchByVectorWithCompressedDistances([]float32{0, 0}, 3, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 2, 3}, ids)
+		assert.Nil(t, compressedDists)
+	})
+
+	t.Run("crossing the threshold", func(t *testing.T) {
+		for i := 9; i < 30; i++ {
+			require.Nil(t, index.Add(uint64(i), []float32{float32(i), float32(i)}))
+		}
+		require.Nil(t, index.Delete(10))
+
+		assert.Eventually(t, index.Upgraded, 5*time.Second, 10*time.Millisecond)
+		assert.False(t, index.Upgrading())
+		assert.Nil(t, store.Bucket(helpers.VectorsBucketLSM))
+	})
+
+	t.Run("searching the upgraded index", func(t *testing.T) {
+		ids, _, err := index.SearchByVector([]float32{0, 0}, 3, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 2, 3}, ids)
+
+		ids, _, err = index.SearchByVector([]float32{10, 10}, 3, nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{9, 11, 8}, ids)
+
+		ids, _, _, err = index.SearchByVectorWithCompressedDistances([]float32{0, 0}, 3, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 2, 3}, ids)
+	})
+
+	t.Run("restarting the index", func(t *testing.T) {
+		require.Nil(t, index.Flush())
+		require.Nil(t, index.Shutdown(ctx))
+		require.Nil(t, store.Shutdown(ctx))
+		require.Nil(t, targetStore.Shutdown(ctx))
+
+		store = newTestStore(t, rootPath+"/store")
+		targetStore = newTestStore(t, rootPath+"/target")
+		index = newTestIndex(t, rootPath, store, targetStore, 10)
+		index.PostStartup()
+
+		assert.True(t, index.Upgraded())
+		ids, _, err := index.SearchByVector([]float32{0, 0}, 3, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1, 2, 3}, ids)
+
+		files, err := index.ListFiles(ctx)
+		require.Nil(t, err)
+		assert.Contains(t, files, "dynamic-test.dynamic.upgraded")
+	})
+
+	t.Run("dropping the index", func(t *testing.T) {
+		require.Nil(t, index.Drop(ctx))
+		upgraded, err := index.isUpgraded()
+		require.Nil(t, err)
+		assert.False(t, upgraded)
+
+		require.Nil(t, store.Shutdown(ctx))
+		require.Nil(t, targetStore.Shutdown(ctx))
+	})
+}
+
+func TestDynamicIndex_DeleteCount(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	store := newTestStore(t, rootPath+"/store")
+	targetStore := newTestStore(t, rootPath+"/target")
+	index := newTestIndex(t, rootPath, store, targetStore, 10)
+	index.PostStartup()
+
+	for i := 0; i < 9; i++ {
+		require.Nil(t, index.Add(uint64(i), []float32{float32(i), float32(i)}))
+	}
+
+	t.Run("only stored ids are counted", func(t *testing.T) {
+		require.Nil(t, index.Delete(0))
+		require.Nil(t, index.Delete(0, 100))
+		assert.Equal(t, int64(8), index.count.Load())
+	})
+
+	t.Run("crossing the threshold", func(t *testing.T) {
+		require.Nil(t, index.Add(9, []float32{9, 9}))
+		require.Nil(t, index.Add(10, []float32{10, 10}))
+		assert.Eventually(t, index.Upgraded, 5*time.Second, 10*time.Millisecond)
+	})
+
+	require.Nil(t, index.Shutdown(ctx))
+	require.Nil(t, store.Shutdown(ctx))
+	require.Nil(t, targetStore.Shutdown(ctx))
+}
+
+func TestDynamicIndex_UpgradeDiscardsPartialIndex(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name            string
+		partialIndex    bool
+		expectedCreated int32
+	}{
+		{name: "without a partial index", partialIndex: false, expectedCreated: 1},
+		{name: "with a partial index", partialIndex: true, expectedCreated: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rootPath := t.TempDir()
+			store := newTestStore(t, rootPath+"/store")
+			targetStore := newTestStore(t, rootPath+"/target")
+			if test.partialIndex {
+				require.Nil(t, os.MkdirAll(filepath.Join(rootPath, "dynamic-test.hnsw.commitlog.d"), 0o755))
+			}
+
+			uc := ent.NewDefaultUserConfig()
+			uc.Threshold = 10
+			var created atomic.Int32
+			index, err := New(Config{
+				RootPath:         rootPath,
+				ID:               "dynamic-test",
+				DistanceProvider: distancer.NewL2SquaredProvider(),
+				Store:            store,
+				MakeHnswIndex: func(hnswent.UserConfig) (VectorIndex, error) {
+					created.Add(1)
+					return flat.New(flat.Config{
+						ID:               "dynamic-test-target",
+						DistanceProvider: distancer.NewL2SquaredProvider(),
+					}, flatent.NewDefaultUserConfig(), targetStore)
+				},
+			}, uc)
+			require.Nil(t, err)
+			index.PostStartup()
+
+			for i := 0; i < 10; i++ {
+				require.Nil(t, index.Add(uint64(i), []float32{float32(i), float32(i)}))
+			}
+			assert.Eventually(t, index.Upgraded, 5*time.Second, 10*time.Millisecond)
+			assert.Equal(t, test.expectedCreated, created.Load())
+
+			require.Nil(t, index.Shutdown(ctx))
+			require.Nil(t, store.Shutdown(ctx))
+			require.Nil(t, targetStore.Shutdown(ctx))
+		})
+	}
+}
@@ -0,0 +1,253 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+)
+
+// startUpgrade starts building the hnsw index in the background unless an
+// upgrade is already running. It is called while holding the read lock, so it
+// must not block.
+func (d *dynamic) startUpgrade() {
+	if !d.upgrading.CompareAndSwap(false, true) {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	d.upgradeLock.Lock()
+	d.cancelUpgrade = cancel
+	d.upgradeDone = done
+	d.upgradeLock.Unlock()
+
+	go func() {
+		defer close(done)
+
+		before := time.Now()
+		if err := d.upgrade(ctx); err != nil {
+			d.logger.WithField("action", "dynamic_index_upgrade").
+				WithField("id", d.id).
+				WithError(err).
+				Error("failed to upgrade flat index to hnsw")
+			d.abortUpgrade()
+			return
+		}
+
+		d.logger.WithField("action", "dynamic_index_upgrade").
+			WithField("id", d.id).
+			WithField("took", time.Since(before)).
+			Info("upgraded flat index to hnsw")
+	}()
+}
+
+// stopUpgrade cancels a running upgrade and waits for it to return
+func (d *dynamic) stopUpgrade() {
+	d.upgradeLock.Lock()
+	cancel, done := d.cancelUpgrade, d.upgradeDone
+	d.upgradeLock.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	<-done
+}
+
+func (d *dynamic) upgrade(ctx context.Context) error {
+	d.RLock()
+	uc := d.hnswUC
+	d.RUnlock()
+
+	// an earlier upgrade could have been interrupted by a crash, in which case
+	// its commit log is left behind and a partially built graph would be
+	// loaded. It is discarded, as it is impossible to tell which vectors made
+	// it into the graph.
+	if _, err := os.Stat(d.hnswCommitLogPath()); err == nil {
+		leftover, err := d.makeHnswIndex(uc)
+		if err != nil {
+			return errors.Wrap(err, "load partial hnsw index")
+		}
+		if err := leftover.Drop(ctx); err != nil {
+			return errors.Wrap(err, "discard partial hnsw index")
+		}
+	} else if !os.IsNotExist(err) {
+		return errors.Wrap(err, "check for partial hnsw index")
+	}
+
+	target, err := d.makeHnswIndex(uc)
+	if err != nil {
+		return errors.Wrap(err, "create hnsw index")
+	}
+
+	// the target is set while holding the write lock, this way every write
+	// from now on is guaranteed to observe it
+	d.Lock()
+	d.upgradeLock.Lock()
+	d.target = target
+	d.seen = map[uint64]struct{}{}
+	d.deleted = map[uint64]struct{}{}
+	d.upgradeLock.Unlock()
+	d.Unlock()
+
+	err = d.flat.Iterate(func(id uint64, vector []float32) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if !d.markBackfilled(id) {
+			return nil
+		}
+
+		return target.Add(id, vector)
+	})
+	if err != nil {
+		return errors.Wrap(err, "import vectors into hnsw index")
+	}
+
+	return d.swap(ctx)
+}
+
+// swap replaces the flat index with the fully built hnsw index
+func (d *dynamic) swap(ctx context.Context) error {
+	d.Lock()
+	defer d.Unlock()
+
+	d.upgradeLock.Lock()
+	target := d.target
+	var toDelete []uint64
+	for id := range d.deleted {
+		if _, ok := d.seen[id]; ok {
+			toDelete = append(toDelete, id)
+		}
+	}
+	d.target, d.seen, d.deleted = nil, nil, nil
+	d.cancelUpgrade, d.upgradeDone = nil, nil
+	d.upgradeLock.Unlock()
+
+	if len(toDelete) > 0 {
+		if err := target.Delete(toDelete...); err != nil {
+			return errors.Wrap(err, "apply deletes to hnsw index")
+		}
+	}
+
+	if err := target.Flush(); err != nil {
+		return errors.Wrap(err, "flush hnsw index")
+	}
+
+	// once the marker exists, the hnsw index is loaded on restarts
+	f, err := os.Create(d.markerPath())
+	if err != nil {
+		return errors.Wrap(err, "create upgrade marker")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "create upgrade marker")
+	}
+
+	d.index = target
+	d.flat = nil
+	d.upgraded = true
+	d.upgrading.Store(false)
+
+	// the hnsw index was created outside of the shard's startup, so it did not
+	// go through its post-startup steps yet
+	target.PostStartup()
+
+	if err := d.store.DropBucket(ctx, helpers.VectorsBucketName(d.targetVector)); err != nil {
+		// the hnsw index is already in use, the stale bucket only takes up
+		// disk space
+		d.logger.WithField("action", "dynamic_index_upgrade").
+			WithField("id", d.id).
+			WithError(err).
+			Warn("failed to remove vectors bucket of flat index")
+	}
+
+	return nil
+}
+
+// abortUpgrade discards the partially built hnsw index after a failed or
+// cancelled upgrade
+func (d *dynamic) abortUpgrade() {
+	d.Lock()
+	defer d.Unlock()
+
+	d.upgradeLock.Lock()
+	target := d.target
+	d.target, d.seen, d.deleted = nil, nil, nil
+	d.cancelUpgrade, d.upgradeDone = nil, nil
+	d.upgradeLock.Unlock()
+
+	if target != nil {
+		if err := target.Shutdown(context.Background()); err != nil {
+			d.logger.WithField("action", "dynamic_index_upgrade").
+				WithField("id", d.id).
+				WithError(err).
+				Warn("failed to shut down partial hnsw index")
+		}
+	}
+
+	// a failed upgrade is retried with the next insert
+	d.upgrading.Store(false)
+}
+
+// markAdded records an id inserted during an upgrade. It returns the hnsw
+// index under construction, if any.
+func (d *dynamic) markAdded(id uint64) VectorIndex {
+	d.upgradeLock.Lock()
+	defer d.upgradeLock.Unlock()
+
+	if d.target == nil {
+		return nil
+	}
+
+	d.seen[id] = struct{}{}
+	return d.target
+}
+
+// markDeleted records ids deleted during an upgrade. They are removed from
+// the hnsw index right before the swap.
+func (d *dynamic) markDeleted(ids []uint64) {
+	d.upgradeLock.Lock()
+	defer d.upgradeLock.Unlock()
+
+	if d.target == nil {
+		return
+	}
+
+	for _, id := range ids {
+		d.deleted[id] = struct{}{}
+	}
+}
+
+// markBackfilled indicates whether the upgrade still needs to import the
+// vector with the given id
+func (d *dynamic) markBackfilled(id uint64) bool {
+	d.upgradeLock.Lock()
+	defer d.upgradeLock.Unlock()
+
+	if _, ok := d.seen[id]; ok {
+		return false
+	}
+	if _, ok := d.deleted[id]; ok {
+		return false
+	}
+
+	d.seen[id] = struct{}{}
+	return true
+}
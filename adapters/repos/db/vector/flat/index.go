@@ -0,0 +1,413 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
+	"github.com/weaviate/weaviate/entities/schema"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+// iterateBatchSize is the amount of vectors read per cursor in Iterate
+const iterateBatchSize = 1000
+
+// flat is a brute-force vector index. Vectors are persisted in a dedicated
+// bucket of the shard's LSM store and every search is an exact scan over all
+// (or all allowed) vectors. It trades query latency for a very small memory
+// footprint, which makes it a good fit for many small (e.g. per-tenant)
+// shards.
+type flat struct {
+	id                string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	store             *lsmkv.Store
+	bucketName        string
+
+	// dims is set on the first insert and used to validate all subsequent
+	// inserts, so that a scan never compares vectors of different lengths
+	dims int32
+}
+
+// New creates a flat index that stores its vectors in the provided store. The
+// store is owned by the caller, the index only creates (or loads) its own
+// vectors bucket in it.
+func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*flat, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	if store == nil {
+		return nil, errors.Errorf("init flat index %q: store cannot be nil", cfg.ID)
+	}
+
+	if cfg.Logger == nil {
+		logger := logrus.New()
+		logger.Out = io.Discard
+		cfg.Logger = logger
+	}
+
+	bucketName := helpers.VectorsBucketName(cfg.TargetVector)
+	if err := store.CreateOrLoadBucket(context.Background(), bucketName,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace)); err != nil {
+		return nil, errors.Wrapf(err, "init flat index %q: create vectors bucket", cfg.ID)
+	}
+
+	index := &flat{
+		id:                cfg.ID,
+		logger:            cfg.Logger,
+		distancerProvider: cfg.DistanceProvider,
+		store:             store,
+		bucketName:        bucketName,
+	}
+	index.initDimensions()
+
+	return index, nil
+}
+
+func (f *flat) bucket() *lsmkv.Bucket {
+	return f.store.Bucket(f.bucketName)
+}
+
+// initDimensions reads the length of any persisted vector, so that inserts
+// can be validated after a restart
+func (f *flat) initDimensions() {
+	cursor := f.bucket().Cursor()
+	defer cursor.Close()
+
+	if _, v := cursor.First(); v != nil {
+		atomic.StoreInt32(&f.dims, int32(len(v)/4))
+	}
+}
+
+func (f *flat) ValidateBeforeInsert(vector []float32) error {
+	dims := int(atomic.LoadInt32(&f.dims))
+	if dims == 0 {
+		return nil
+	}
+
+	if dims != len(vector) {
+		return fmt.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+
+	return nil
+}
+
+func (f *flat) Add(id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+
+	atomic.CompareAndSwapInt32(&f.dims, 0, int32(len(vector)))
+
+	if f.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		vector = distancer.Normalize(vector)
+	}
+
+	return f.bucket().Put(keyFromID(id), vectorToBytes(vector))
+}
+
+func (f *flat) Delete(ids ...uint64) error {
+	bucket := f.bucket()
+	for _, id := range ids {
+		if err := bucket.Delete(keyFromID(id)); err != nil {
+			return errors.Wrapf(err, "delete vector for doc id %d", id)
+		}
+	}
+
+	return nil
+}
+
+// ContainsNode returns whether a vector is stored for the given doc id
+func (f *flat) ContainsNode(id uint64) bool {
+	v, err := f.bucket().Get(keyFromID(id))
+	return err == nil && v != nil
+}
+
+func (f *flat) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if k <= 0 {
+		// there is no top result to compare against, no need to scan
+		return []uint64{}, []float32{}, nil
+	}
+
+	vector = f.normalizeQuery(vector)
+	results := priorityqueue.NewMax(k)
+
+	err := f.scan(vector, allow, func(id uint64, dist float32) {
+		if results.Len() < k {
+			results.Insert(id, dist)
+		} else if results.Top().Dist > dist {
+			results.Pop()
+			results.Insert(id, dist)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := resultsFromQueue(results)
+	return ids, dists, nil
+}
+
+// SearchByVectorDistance returns all vectors within the target distance. As
+// every search is an exhaustive scan anyway, there is no need to iterate with
+// increasing limits like the hnsw index does. The maxLimit param places an
+// upper bound on the number of results, a negative value means no limit.
+func (f *flat) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	vector = f.normalizeQuery(vector)
+	results := priorityqueue.NewMax(0)
+
+	err := f.scan(vector, allow, func(id uint64, dist float32) {
+		if dist > targetDistance {
+			return
+		}
+
+		results.Insert(id, dist)
+		if maxLimit >= 0 && int64(results.Len()) > maxLimit {
+			results.Pop()
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := resultsFromQueue(results)
+	return ids, dists, nil
+}
+
+func (f *flat) normalizeQuery(vector []float32) []float32 {
+	if f.distancerProvider.Type() == "cosine-dot" {
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+// scan calculates the distance between the query vector and every stored
+// vector. If an allow list is set, only the allowed ids are looked up,
+// otherwise the entire bucket is iterated.
+func (f *flat) scan(query []float32, allow helpers.AllowList,
+	fn func(id uint64, dist float32),
+) error {
+	dist := f.distancerProvider.New(query)
+	bucket := f.bucket()
+	buf := make([]float32, len(query))
+
+	if allow != nil {
+		it := allow.Iterator()
+		for id, ok := it.Next(); ok; id, ok = it.Next() {
+			v, err := bucket.Get(keyFromID(id))
+			if err != nil {
+				return errors.Wrapf(err, "get vector for doc id %d", id)
+			}
+			if v == nil {
+				// the allow list may contain ids without a vector, e.g. objects
+				// which were imported without one
+				continue
+			}
+
+			d, ok, err := dist.Distance(vectorFromBytes(v, buf))
+			if err != nil {
+				return errors.Wrapf(err, "calculate distance for doc id %d", id)
+			}
+			if ok {
+				fn(id, d)
+			}
+		}
+
+		return nil
+	}
+
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		id := binary.BigEndian.Uint64(k)
+		d, ok, err := dist.Distance(vectorFromBytes(v, buf))
+		if err != nil {
+			return errors.Wrapf(err, "calculate distance for doc id %d", id)
+		}
+		if ok {
+			fn(id, d)
+		}
+	}
+
+	return nil
+}
+
+// Count returns the number of vectors currently stored in the index
+func (f *flat) Count() int {
+	return f.bucket().Count()
+}
+
+// Iterate calls fn for every stored vector in doc id order. Iteration stops
+// at the first error returned by fn.
+//
+// The vectors are read in batches and the cursor is closed in between, as an
+// open cursor prevents the bucket from flushing its memtable. This makes it
+// safe to call Iterate for long-running operations while the index keeps
+// receiving writes. Writes that happen during the iteration may or may not be
+// observed.
+func (f *flat) Iterate(fn func(id uint64, vector []float32) error) error {
+	var (
+		ids     = make([]uint64, 0, iterateBatchSize)
+		vectors = make([][]float32, 0, iterateBatchSize)
+		seek    []byte
+	)
+
+	for {
+		ids, vectors = ids[:0], vectors[:0]
+
+		var k, v []byte
+		cursor := f.bucket().Cursor()
+		if seek == nil {
+			k, v = cursor.First()
+		} else {
+			k, v = cursor.Seek(seek)
+		}
+		for ; k != nil && len(ids) < iterateBatchSize; k, v = cursor.Next() {
+			ids = append(ids, binary.BigEndian.Uint64(k))
+			vectors = append(vectors, vectorFromBytes(v, nil))
+		}
+		// the key following the last one of this batch, if any, is where the
+		// next batch starts
+		if k != nil {
+			seek = append(seek[:0], k...)
+		}
+		cursor.Close()
+
+		for i := range ids {
+			if err := fn(ids[i], vectors[i]); err != nil {
+				return err
+			}
+		}
+
+		if k == nil {
+			return nil
+		}
+	}
+}
+
+func (f *flat) UpdateUserConfig(updated schema.VectorIndexConfig, callback func()) error {
+	// nothing to update at runtime, the only setting (the distance) is
+	// immutable, see ValidateUserConfigUpdate
+	callback()
+	if _, ok := updated.(ent.UserConfig); !ok {
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	return nil
+}
+
+// Drop is a no-op, as the vectors bucket is part of the shard's store which
+// is removed along with the shard
+func (f *flat) Drop(ctx context.Context) error {
+	return nil
+}
+
+// Shutdown is a no-op, as the store is owned and shut down by the shard
+func (f *flat) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+func (f *flat) Flush() error {
+	return f.bucket().WriteWAL()
+}
+
+// SwitchCommitLogs is a no-op, the flat index has no commit log
+func (f *flat) SwitchCommitLogs(ctx context.Context) error {
+	return nil
+}
+
+// ListFiles returns no files, as all files of the vectors bucket are already
+// listed as part of the shard's store
+func (f *flat) ListFiles(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (f *flat) PostStartup() {
+}
+
+func (f *flat) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", labels[0])
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", f.id)
+	fmt.Printf("Dimensions: %d\n", atomic.LoadInt32(&f.dims))
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+func resultsFromQueue(results *priorityqueue.Queue) ([]uint64, []float32) {
+	ids := make([]uint64, results.Len())
+	dists := make([]float32, results.Len())
+
+	// results is ordered in reverse, we need to flip the order before presenting
+	// to the user!
+	i := len(ids) - 1
+	for results.Len() > 0 {
+		res := results.Pop()
+		ids[i] = res.ID
+		dists[i] = res.Dist
+		i--
+	}
+
+	return ids, dists
+}
+
+// keyFromID uses big endian encoding, so that the cursor iterates the vectors
+// in doc id order
+func keyFromID(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func vectorToBytes(vector []float32) []byte {
+	out := make([]byte, len(vector)*4)
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(out[i*4:], math.Float32bits(v))
+	}
+	return out
+}
+
+// vectorFromBytes decodes into the provided buffer if it is large enough to
+// avoid an allocation per scanned vector
+func vectorFromBytes(in []byte, buf []float32) []float32 {
+	dims := len(in) / 4
+	if cap(buf) < dims {
+		buf = make([]float32, dims)
+	}
+	buf = buf[:dims]
+	for i := range buf {
+		buf[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[i*4:]))
+	}
+	return buf
+}
@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func newTestStore(t *testing.T, dirName string) *lsmkv.Store {
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dirName, dirName, logger, nil,
+		cyclemanager.NewCycleCallbacksNoop(), cyclemanager.NewCycleCallbacksNoop())
+	require.Nil(t, err)
+	return store
+}
+
+func newTestIndex(t *testing.T, store *lsmkv.Store) *flat {
+	index, err := New(Config{
+		ID:               "flat-test",
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+	}, ent.NewDefaultUserConfig(), store)
+	require.Nil(t, err)
+	return index
+}
+
+func TestFlatIndex(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	store := newTestStore(t, dirName)
+	index := newTestIndex(t, store)
+
+	vectors := [][]float32{
+		{0, 0},
+		{1, 1},
+		{2, 2},
+		{3, 3},
+		{4, 4},
+	}
+
+	t.Run("importing vectors", func(t *testing.T) {
+		for i, vec := range vectors {
+			require.Nil(t, index.ValidateBeforeInsert(vec))
+			require.Nil(t, index.Add(uint64(i), vec))
+		}
+	})
+
+	t.Run("validating dimensions", func(t *testing.T) {
+		err := index.ValidateBeforeInsert([]float32{1, 2, 3})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "Existing nodes have vectors with length 2")
+	})
+
+	t.Run("searching without a filter", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector([]float32{3.1, 3.1}, 3, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{3, 4, 2}, ids)
+		assert.Len(t, dists, 3)
+		assert.InDelta(t, 0.02, dists[0], 0.0001)
+	})
+
+	t.Run("searching with a filter", func(t *testing.T) {
+		allow := helpers.NewAllowList(0, 1, 4)
+		ids, _, err := index.SearchByVector([]float32{3.1, 3.1}, 2, allow)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{4, 1}, ids)
+	})
+
+	t.Run("searching without a limit", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector([]float32{3.1, 3.1}, 0, nil)
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+		assert.Empty(t, dists)
+
+		ids, _, err = index.SearchByVector([]float32{3.1, 3.1}, -1, nil)
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("searching by distance", func(t *testing.T) {
+		ids, _, err := index.SearchByVectorDistance([]float32{0, 0}, 8, -1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1, 2}, ids)
+
+		ids, _, err = index.SearchByVectorDistance([]float32{0, 0}, 8, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 1}, ids)
+	})
+
+	t.Run("deleting vectors", func(t *testing.T) {
+		require.Nil(t, index.Delete(3, 4))
+		assert.False(t, index.ContainsNode(3))
+		assert.True(t, index.ContainsNode(2))
+
+		ids, _, err := index.SearchByVector([]float32{3.1, 3.1}, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 1}, ids)
+	})
+
+	t.Run("restarting the index", func(t *testing.T) {
+		require.Nil(t, index.Flush())
+		require.Nil(t, index.Shutdown(ctx))
+		require.Nil(t, store.Shutdown(ctx))
+
+		store = newTestStore(t, dirName)
+		index = newTestIndex(t, store)
+
+		err := index.ValidateBeforeInsert([]float32{1, 2, 3})
+		require.NotNil(t, err)
+
+		ids, _, err := index.SearchByVector([]float32{3.1, 3.1}, 2, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 1}, ids)
+
+		require.Nil(t, store.Shutdown(ctx))
+	})
+}
+
+func TestFlatIndex_Cosine(t *testing.T) {
+	store := newTestStore(t, t.TempDir())
+	defer store.Shutdown(context.Background())
+
+	index, err := New(Config{
+		ID:               "flat-test",
+		DistanceProvider: distancer.NewCosineDistanceProvider(),
+	}, ent.NewDefaultUserConfig(), store)
+	require.Nil(t, err)
+
+	require.Nil(t, index.Add(0, []float32{10, 0}))
+	require.Nil(t, index.Add(1, []float32{0, 0.1}))
+
+	ids, dists, err := index.SearchByVector([]float32{0, 5}, 2, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{1, 0}, ids)
+	assert.InDelta(t, 0, dists[0], 0.0001)
+	assert.InDelta(t, 1, dists[1], 0.0001)
+}
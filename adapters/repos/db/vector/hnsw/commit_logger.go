@@ -274,6 +274,7 @@ const (
 	ClearLinksAtLevel // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1701
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
+	AddSQ
 )
 
 func (t HnswCommitType) String() string {
@@ -302,6 +303,8 @@ func (t HnswCommitType) String() string {
 		return "ClearLinksAtLevel"
 	case AddPQ:
 		return "AddProductQuantizer"
+	case AddSQ:
+		return "AddScalarQuantizer"
 	}
 	return "unknown commit type"
 }
@@ -317,6 +320,13 @@ func (l *hnswCommitLogger) AddPQ(data ssdhelpers.PQData) error {
 	return l.commitLogger.AddPQ(data)
 }
 
+func (l *hnswCommitLogger) AddSQ(data ssdhelpers.SQData) error {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.commitLogger.AddSQ(data)
+}
+
 // AddNode adds an empty node
 func (l *hnswCommitLogger) AddNode(node *vertex) error {
 	l.Lock()
@@ -15,17 +15,26 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"path/filepath"
 
 	"github.com/pkg/errors"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
+// CompressedStorePath is the directory of the lsmkv store holding the
+// compressed vectors of the index with the given id. It is derived from the
+// id, as a shard can hold several compressed vector indexes.
+func CompressedStorePath(rootPath, id string) string {
+	return filepath.Join(rootPath, fmt.Sprintf("%s.hnsw.compressed.d", id))
+}
+
 func (h *hnsw) initCompressedStore() error {
-	store, err := lsmkv.New(fmt.Sprintf("%s/%s/%s", h.rootPath, h.className, h.shardName), "", h.logger, nil,
+	store, err := lsmkv.New(CompressedStorePath(h.rootPath, h.id), "", h.logger, nil,
 		h.classCompactionCallbacks, h.classFlushCallbacks)
 	if err != nil {
 		return errors.Wrap(err, "Init lsmkv (compressed vectors store)")
@@ -38,7 +47,39 @@ func (h *hnsw) initCompressedStore() error {
 	return nil
 }
 
+// trainableQuantizer is a quantizer which needs to be fitted to the existing
+// data before it can encode vectors
+type trainableQuantizer interface {
+	ssdhelpers.Quantizer
+	Fit(data [][]float32)
+}
+
 func (h *hnsw) Compress(cfg ent.PQConfig) error {
+	return h.compress(func(dims int) (trainableQuantizer, error) {
+		// segments == 0 (default value) means use as many segments as dimensions
+		if cfg.Segments <= 0 {
+			cfg.Segments = dims
+		}
+
+		pq, err := ssdhelpers.NewProductQuantizer(cfg, h.distancerProvider, dims)
+		if err != nil {
+			return nil, errors.Wrap(err, "Compressing vectors.")
+		}
+		return pq, nil
+	})
+}
+
+func (h *hnsw) CompressSQ(cfg ent.SQConfig) error {
+	return h.compress(func(dims int) (trainableQuantizer, error) {
+		sq, err := ssdhelpers.NewScalarQuantizer(cfg, h.distancerProvider, dims)
+		if err != nil {
+			return nil, errors.Wrap(err, "Compressing vectors.")
+		}
+		return sq, nil
+	})
+}
+
+func (h *hnsw) compress(newQuantizer func(dims int) (trainableQuantizer, error)) error {
 	if h.nodes[0] == nil {
 		return errors.New("Compress command cannot be executed before inserting some data. Please, insert your data first.")
 	}
@@ -53,14 +94,9 @@ func (h *hnsw) Compress(cfg ent.PQConfig) error {
 	}
 	dims := len(vec)
 
-	// segments == 0 (default value) means use as many segments as dimensions
-	if cfg.Segments <= 0 {
-		cfg.Segments = dims
-	}
-
-	h.pq, err = ssdhelpers.NewProductQuantizer(cfg, h.distancerProvider, dims)
+	quantizer, err := newQuantizer(dims)
 	if err != nil {
-		return errors.Wrap(err, "Compressing vectors.")
+		return err
 	}
 
 	data := h.cache.all()
@@ -72,25 +108,81 @@ func (h *hnsw) Compress(cfg ent.PQConfig) error {
 		cleanData = append(cleanData, point)
 	}
 	h.compressedVectorsCache.grow(uint64(len(data)))
-	h.pq.Fit(cleanData)
+	quantizer.Fit(cleanData)
 
 	h.compressActionLock.Lock()
 	defer h.compressActionLock.Unlock()
+	h.quantizer = quantizer
 	ssdhelpers.Concurrently(uint64(len(cleanData)),
 		func(index uint64) {
-			encoded := h.pq.Encode(cleanData[index])
+			encoded := quantizer.Encode(cleanData[index])
 			h.storeCompressedVector(index, encoded)
 			h.compressedVectorsCache.preload(index, encoded)
 		})
-	if err := h.commitLog.AddPQ(h.pq.ExposeFields()); err != nil {
-		return errors.Wrap(err, "Adding PQ to the commit logger")
+	if err := h.persistQuantizer(); err != nil {
+		return err
+	}
+
+	h.compressed.Store(true)
+	h.cache.drop()
+	return nil
+}
+
+// persistQuantizer writes the learnt parameters of the quantizer to the commit
+// log, so the index can be restored in its compressed state
+func (h *hnsw) persistQuantizer() error {
+	switch q := h.quantizer.(type) {
+	case *ssdhelpers.ProductQuantizer:
+		if err := h.commitLog.AddPQ(q.ExposeFields()); err != nil {
+			return errors.Wrap(err, "Adding PQ to the commit logger")
+		}
+	case *ssdhelpers.ScalarQuantizer:
+		if err := h.commitLog.AddSQ(q.ExposeFields()); err != nil {
+			return errors.Wrap(err, "Adding SQ to the commit logger")
+		}
+	}
+	return nil
+}
+
+// enableBQ switches the index to binary quantization. As the sign bits do not
+// depend on the rest of the data, there is no training step and the index is
+// compressed right from the start. Codes are persisted in the compressed
+// vectors store, so nothing needs to be written to the commit log.
+func (h *hnsw) enableBQ() error {
+	if err := h.initCompressedStore(); err != nil {
+		return errors.Wrap(err, "Initializing compressed vector store")
 	}
 
+	h.quantizer = ssdhelpers.NewBinaryQuantizer(h.distancerProvider)
+	h.compressedVectorsCache.grow(uint64(len(h.nodes)))
 	h.compressed.Store(true)
 	h.cache.drop()
 	return nil
 }
 
+// uncompressedVectorForID returns a float representation of a vector while
+// the index is compressed. Product and scalar quantization can reconstruct an
+// approximation from their codes, other quantizers fall back to the original
+// vector from the object store.
+func (h *hnsw) uncompressedVectorForID(ctx context.Context, id uint64) ([]float32, error) {
+	if decoder, ok := h.quantizer.(interface{ Decode([]byte) []float32 }); ok {
+		vec, err := h.compressedVectorsCache.get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return decoder.Decode(vec), nil
+	}
+
+	vec, err := h.VectorForIDThunk(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if h.distancerProvider.Type() == "cosine-dot" {
+		vec = distancer.Normalize(vec)
+	}
+	return vec, nil
+}
+
 //nolint:unused
 func (h *hnsw) encodedVector(id uint64) ([]byte, error) {
 	return h.compressedVectorsCache.get(context.Background(), id)
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestPQOversampling(t *testing.T) {
+	dimensions := 32
+	vectorsSize := 1000
+	queriesSize := 20
+	k := 10
+
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, queriesSize, dimensions)
+	distanceProvider := distancer.NewL2SquaredProvider()
+	distanceFn := func(x, y []float32) float32 {
+		dist, _, _ := distanceProvider.SingleDist(x, y)
+		return dist
+	}
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.PQ = ent.PQConfig{
+		Enabled:       true,
+		Segments:      dimensions,
+		Centroids:     ent.DefaultPQCentroids,
+		TrainingLimit: ent.DefaultPQTrainingLimit,
+		Encoder: ent.PQEncoder{
+			Type:         ent.PQEncoderTypeTile,
+			Distribution: ent.PQEncoderDistributionLogNormal,
+		},
+		OversamplingFactor: 4,
+	}
+
+	index, err := hnsw.New(
+		hnsw.Config{
+			RootPath:              t.TempDir(),
+			ID:                    "pq-oversampling",
+			MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+			DistanceProvider:      distanceProvider,
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk: func(ctx context.Context, id uint64, container *hnsw.VectorSlice) ([]float32, error) {
+				copy(container.Slice, vectors[int(id)])
+				return container.Slice, nil
+			},
+		}, uc,
+		cyclemanager.NewCycleCallbacksNoop(), cyclemanager.NewCycleCallbacksNoop(), cyclemanager.NewCycleCallbacksNoop())
+	require.Nil(t, err)
+	defer index.Shutdown(context.Background())
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	require.Nil(t, index.Compress(uc.PQ))
+
+	t.Run("both distances are reported", func(t *testing.T) {
+		ids, dists, compressedDists, err := index.SearchByVectorWithCompressedDistances(queries[0], k, nil)
+		require.Nil(t, err)
+		require.Len(t, ids, k)
+		require.Len(t, compressedDists, k)
+
+		for i, id := range ids {
+			assert.InDelta(t, distanceFn(queries[0], vectors[id]), dists[i], 1e-4)
+			if i > 0 {
+				assert.LessOrEqual(t, dists[i-1], dists[i])
+			}
+		}
+		assert.NotEqual(t, dists, compressedDists)
+	})
+
+	t.Run("both distances are reported by a flat search", func(t *testing.T) {
+		allowList := helpers.NewAllowList()
+		for id := uint64(0); id < 200; id++ {
+			allowList.Insert(id)
+		}
+
+		ids, dists, compressedDists, err := index.SearchByVectorWithCompressedDistances(queries[0], k, allowList)
+		require.Nil(t, err)
+		require.Len(t, ids, k)
+		require.Len(t, compressedDists, k)
+
+		for i, id := range ids {
+			assert.True(t, allowList.Contains(id))
+			assert.InDelta(t, distanceFn(queries[0], vectors[id]), dists[i], 1e-4)
+			if i > 0 {
+				assert.LessOrEqual(t, dists[i-1], dists[i])
+			}
+		}
+		assert.NotEqual(t, dists, compressedDists)
+	})
+
+	recall := func(t *testing.T) float32 {
+		var relevant uint64
+		for _, query := range queries {
+			truth := testinghelpers.BruteForce(vectors, query, k, distanceFn)
+			ids, _, err := index.SearchByVector(query, k, nil)
+			require.Nil(t, err)
+			relevant += testinghelpers.MatchesInLists(truth, ids)
+		}
+		return float32(relevant) / float32(k*queriesSize)
+	}
+
+	t.Run("more oversampling improves recall", func(t *testing.T) {
+		updated := uc
+		updated.PQ.OversamplingFactor = 1
+		require.Nil(t, index.UpdateUserConfig(updated, func() {}))
+		withoutOversampling := recall(t)
+
+		updated.PQ.OversamplingFactor = 20
+		require.Nil(t, index.UpdateUserConfig(updated, func() {}))
+		withOversampling := recall(t)
+
+		t.Logf("recall %f without and %f with oversampling", withoutOversampling, withOversampling)
+		assert.Greater(t, withOversampling, withoutOversampling)
+		assert.Greater(t, withOversampling, float32(0.9))
+	})
+}
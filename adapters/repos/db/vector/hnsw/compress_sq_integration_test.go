@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestHnswPersistence_SQ(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	indexID := "integrationtest_sq"
+	vectors, _ := testinghelpers.RandomVecs(500, 0, 16)
+
+	logger, _ := test.NewNullLogger()
+	uc := ent.NewDefaultUserConfig()
+	uc.SQ = ent.SQConfig{Enabled: true, TrainingLimit: ent.DefaultSQTrainingLimit}
+
+	makeIndex := func() *hnsw {
+		cl, err := NewCommitLogger(dirName, indexID, logger,
+			cyclemanager.NewCycleCallbacksNoop())
+		require.Nil(t, err)
+
+		index, err := New(Config{
+			RootPath: dirName,
+			ID:       indexID,
+			MakeCommitLoggerThunk: func() (CommitLogger, error) {
+				return cl, nil
+			},
+			DistanceProvider: distancer.NewL2SquaredProvider(),
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk: func(ctx context.Context, id uint64, container *VectorSlice) ([]float32, error) {
+				copy(container.Slice, vectors[int(id)])
+				return container.Slice, nil
+			},
+		}, uc, cyclemanager.NewCycleCallbacksNoop(),
+			cyclemanager.NewCycleCallbacksNoop(), cyclemanager.NewCycleCallbacksNoop())
+		require.Nil(t, err)
+		return index
+	}
+
+	index := makeIndex()
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+	require.Nil(t, index.CompressSQ(uc.SQ))
+	require.Nil(t, index.Flush())
+
+	original, ok := index.quantizer.(*ssdhelpers.ScalarQuantizer)
+	require.True(t, ok)
+	require.Nil(t, index.Shutdown(ctx))
+
+	t.Run("condense the commit log", func(t *testing.T) {
+		input, ok, err := getCurrentCommitLogFileName(commitLogDirectory(dirName, indexID))
+		require.Nil(t, err)
+		require.True(t, ok)
+
+		err = NewMemoryCondensor(logger).Do(commitLogFileName(dirName, indexID, input))
+		require.Nil(t, err)
+	})
+
+	t.Run("the restored index is still compressed with the same sq data", func(t *testing.T) {
+		restored := makeIndex()
+		defer restored.Shutdown(ctx)
+
+		assert.True(t, restored.compressed.Load())
+		sq, ok := restored.quantizer.(*ssdhelpers.ScalarQuantizer)
+		require.True(t, ok)
+		assert.Equal(t, original.ExposeFields(), sq.ExposeFields())
+		assert.Equal(t, original.Encode(vectors[0]), sq.Encode(vectors[0]))
+	})
+}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSQCompressedIndex(t *testing.T) {
+	dimensions := 64
+	vectorsSize := 2000
+	queriesSize := 20
+	k := 10
+
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, queriesSize, dimensions)
+	distanceProvider := distancer.NewL2SquaredProvider()
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.SQ = ent.SQConfig{Enabled: true, TrainingLimit: ent.DefaultSQTrainingLimit}
+
+	index, err := hnsw.New(
+		hnsw.Config{
+			RootPath:              t.TempDir(),
+			ID:                    "sq",
+			MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+			DistanceProvider:      distanceProvider,
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk: func(ctx context.Context, id uint64, container *hnsw.VectorSlice) ([]float32, error) {
+				copy(container.Slice, vectors[int(id)])
+				return container.Slice, nil
+			},
+		}, uc,
+		cyclemanager.NewCycleCallbacksNoop(), cyclemanager.NewCycleCallbacksNoop(), cyclemanager.NewCycleCallbacksNoop())
+	require.Nil(t, err)
+	defer index.Shutdown(context.Background())
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	require.Nil(t, index.CompressSQ(uc.SQ))
+
+	t.Run("distances are rescored with the original vectors", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector(queries[0], k, nil)
+		require.Nil(t, err)
+		require.Len(t, ids, k)
+
+		for i, id := range ids {
+			expected, _, err := distanceProvider.SingleDist(queries[0], vectors[id])
+			require.Nil(t, err)
+			assert.InDelta(t, expected, dists[i], 1e-4)
+		}
+	})
+
+	t.Run("recall", func(t *testing.T) {
+		distanceFn := func(x, y []float32) float32 {
+			dist, _, _ := distanceProvider.SingleDist(x, y)
+			return dist
+		}
+
+		var relevant uint64
+		for _, query := range queries {
+			truth := testinghelpers.BruteForce(vectors, query, k, distanceFn)
+			ids, _, err := index.SearchByVector(query, k, nil)
+			require.Nil(t, err)
+			relevant += testinghelpers.MatchesInLists(truth, ids)
+		}
+
+		recall := float32(relevant) / float32(k*queriesSize)
+		assert.Greater(t, recall, float32(0.9))
+	})
+}
@@ -54,8 +54,14 @@ func (c *MemoryCondensor) Do(fileName string) error {
 	c.newLog = NewWriterSize(c.newLogFile, 1*1024*1024)
 
 	if res.Compressed {
-		if err := c.AddPQ(res.PQData); err != nil {
-			return fmt.Errorf("write pq data: %w", err)
+		if res.SQData.Dimensions > 0 {
+			if err := c.AddSQ(res.SQData); err != nil {
+				return fmt.Errorf("write sq data: %w", err)
+			}
+		} else {
+			if err := c.AddPQ(res.PQData); err != nil {
+				return fmt.Errorf("write pq data: %w", err)
+			}
 		}
 	}
 
@@ -256,6 +262,20 @@ func (c *MemoryCondensor) AddPQ(data ssdhelpers.PQData) error {
 	return err
 }
 
+func (c *MemoryCondensor) AddSQ(data ssdhelpers.SQData) error {
+	toWrite := make([]byte, 3+int(data.Dimensions)*8)
+	toWrite[0] = byte(AddSQ)
+	binary.LittleEndian.PutUint16(toWrite[1:3], data.Dimensions)
+	for i := 0; i < int(data.Dimensions); i++ {
+		minOffset := 3 + i*4
+		deltaOffset := 3 + (int(data.Dimensions)+i)*4
+		binary.LittleEndian.PutUint32(toWrite[minOffset:minOffset+4], math.Float32bits(data.Min[i]))
+		binary.LittleEndian.PutUint32(toWrite[deltaOffset:deltaOffset+4], math.Float32bits(data.Delta[i]))
+	}
+	_, err := c.newLog.Write(toWrite)
+	return err
+}
+
 func NewMemoryCondensor(logger logrus.FieldLogger) *MemoryCondensor {
 	return &MemoryCondensor{logger: logger}
 }
@@ -55,6 +55,20 @@ func ValidateUserConfigUpdate(initial, updated schema.VectorIndexConfig) error {
 		}
 	}
 
+	if (initialParsed.PQ.Enabled && updatedParsed.SQ.Enabled) ||
+		(initialParsed.SQ.Enabled && updatedParsed.PQ.Enabled) {
+		return errors.Errorf("compression cannot be switched between pq and sq")
+	}
+
+	if initialParsed.SQ.Enabled && !updatedParsed.SQ.Enabled {
+		return errors.Errorf("sq cannot be disabled once it has been enabled")
+	}
+
+	if initialParsed.BQ.Enabled != updatedParsed.BQ.Enabled {
+		return errors.Errorf("bq is immutable: attempted change from \"%t\" to \"%t\"",
+			initialParsed.BQ.Enabled, updatedParsed.BQ.Enabled)
+	}
+
 	return nil
 }
 
@@ -90,8 +104,17 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.efMax, int64(parsed.DynamicEFMax))
 	atomic.StoreInt64(&h.efFactor, int64(parsed.DynamicEFFactor))
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+	atomic.StoreInt64(&h.pqOversamplingFactor, int64(parsed.PQ.OversamplingFactor))
 
-	if !parsed.PQ.Enabled {
+	if parsed.BQ.Enabled {
+		// bq is enabled at creation time and cannot be toggled, so only the
+		// cache size can have changed
+		h.compressedVectorsCache.updateMaxSize(int64(parsed.VectorCacheMaxObjects))
+		callback()
+		return nil
+	}
+
+	if !parsed.PQ.Enabled && !parsed.SQ.Enabled {
 		callback()
 		return nil
 	}
@@ -122,10 +145,12 @@ func (h *hnsw) UpdateUserConfig(updated schema.VectorIndexConfig, callback func(
 func (h *hnsw) turnOnCompression(cfg ent.UserConfig, callback func()) error {
 	h.logger.WithField("action", "compress").Info("switching to compressed vectors")
 
-	err := ent.ValidatePQConfig(cfg.PQ)
-	if err != nil {
-		callback()
-		return err
+	if cfg.PQ.Enabled {
+		err := ent.ValidatePQConfig(cfg.PQ)
+		if err != nil {
+			callback()
+			return err
+		}
 	}
 
 	go h.compressThenCallback(cfg, callback)
@@ -136,7 +161,13 @@ func (h *hnsw) turnOnCompression(cfg ent.UserConfig, callback func()) error {
 func (h *hnsw) compressThenCallback(cfg ent.UserConfig, callback func()) {
 	defer callback()
 
-	if err := h.Compress(cfg.PQ); err != nil {
+	var err error
+	if cfg.SQ.Enabled {
+		err = h.CompressSQ(cfg.SQ)
+	} else {
+		err = h.Compress(cfg.PQ)
+	}
+	if err != nil {
 		h.logger.Error(err)
 		return
 	}
@@ -55,6 +55,33 @@ func TestUserConfigUpdates(t *testing.T) {
 					"cleanupIntervalSeconds is immutable: " +
 						"attempted change from \"60\" to \"90\""),
 			},
+			{
+				name:    "attempting to enable bq",
+				initial: ent.UserConfig{BQ: ent.BQConfig{Enabled: false}},
+				update:  ent.UserConfig{BQ: ent.BQConfig{Enabled: true}},
+				expectedError: errors.Errorf(
+					"bq is immutable: " +
+						"attempted change from \"false\" to \"true\""),
+			},
+			{
+				name:    "attempting to switch from pq to sq",
+				initial: ent.UserConfig{PQ: ent.PQConfig{Enabled: true}},
+				update:  ent.UserConfig{SQ: ent.SQConfig{Enabled: true}},
+				expectedError: errors.Errorf(
+					"compression cannot be switched between pq and sq"),
+			},
+			{
+				name:    "attempting to disable sq",
+				initial: ent.UserConfig{SQ: ent.SQConfig{Enabled: true}},
+				update:  ent.UserConfig{SQ: ent.SQConfig{Enabled: false}},
+				expectedError: errors.Errorf(
+					"sq cannot be disabled once it has been enabled"),
+			},
+			{
+				name:    "enabling sq",
+				initial: ent.UserConfig{SQ: ent.SQConfig{Enabled: false}},
+				update:  ent.UserConfig{SQ: ent.SQConfig{Enabled: true}},
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
@@ -289,10 +289,7 @@ func (h *hnsw) reassignNeighbor(neighbor uint64, deleteList helpers.AllowList, b
 
 	var neighborVec []float32
 	if h.compressed.Load() {
-		vec, err := h.compressedVectorsCache.get(context.Background(), neighbor)
-		if err == nil {
-			neighborVec = h.pq.Decode(vec)
-		}
+		neighborVec, err = h.uncompressedVectorForID(context.Background(), neighbor)
 	} else {
 		neighborVec, err = h.cache.get(context.Background(), neighbor)
 	}
@@ -14,7 +14,6 @@ package hnsw
 import (
 	"context"
 	"fmt"
-	"os"
 	"sort"
 	"testing"
 
@@ -537,14 +536,11 @@ func TestDelete_InCompressedIndex_WithCleaningUpTombstonesOnce(t *testing.T) {
 		},
 	}
 
+	// the compressed vectors store lives in the root path until the index is
+	// dropped
+	rootPath := t.TempDir()
+
 	t.Run("import the test vectors", func(t *testing.T) {
-		rootPath := "doesnt-matter-as-committlogger-is-mocked-out"
-		defer func(path string) {
-			err := os.RemoveAll(path)
-			if err != nil {
-				fmt.Println(err)
-			}
-		}(rootPath)
 		index, err := New(Config{
 			RootPath:              rootPath,
 			ID:                    "delete-test",
@@ -675,14 +671,11 @@ func TestDelete_InCompressedIndex_WithCleaningUpTombstonesOnce_DoesNotCrash(t *t
 		PQ:                    ent.PQConfig{Enabled: true, Encoder: ent.PQEncoder{Type: "tile", Distribution: "normal"}},
 	}
 
+	// the compressed vectors store lives in the root path until the index is
+	// dropped
+	rootPath := t.TempDir()
+
 	t.Run("import the test vectors", func(t *testing.T) {
-		rootPath := "doesnt-matter-as-committlogger-is-mocked-out"
-		defer func(path string) {
-			err := os.RemoveAll(path)
-			if err != nil {
-				fmt.Println(err)
-			}
-		}(rootPath)
 		index, err := New(Config{
 			RootPath:              rootPath,
 			ID:                    "delete-test",
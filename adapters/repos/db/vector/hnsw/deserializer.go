@@ -35,6 +35,7 @@ type DeserializationResult struct {
 	Tombstones        map[uint64]struct{}
 	EntrypointChanged bool
 	PQData            ssdhelpers.PQData
+	SQData            ssdhelpers.SQData
 	Compressed        bool
 
 	// If there is no entry for the links at a level to be replaced, we must
@@ -143,6 +144,8 @@ func (d *Deserializer) Do(fd *bufio.Reader,
 		case AddPQ:
 			err = d.ReadPQ(fd, out)
 			readThisRound = 9
+		case AddSQ:
+			readThisRound, err = d.ReadSQ(fd, out)
 		default:
 			err = errors.Errorf("unrecognized commit type %d", ct)
 		}
@@ -574,6 +577,38 @@ func (d *Deserializer) ReadPQ(r io.Reader, res *DeserializationResult) error {
 	return nil
 }
 
+func (d *Deserializer) ReadSQ(r io.Reader, res *DeserializationResult) (int, error) {
+	dims, err := d.readUint16(r)
+	if err != nil {
+		return 0, err
+	}
+
+	min := make([]float32, dims)
+	for i := range min {
+		min[i], err = d.readFloat32(r)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	delta := make([]float32, dims)
+	for i := range delta {
+		delta[i], err = d.readFloat32(r)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	res.SQData = ssdhelpers.SQData{
+		Dimensions: dims,
+		Min:        min,
+		Delta:      delta,
+	}
+	res.Compressed = true
+
+	return 2 + int(dims)*8, nil
+}
+
 func (d *Deserializer) readUint64(r io.Reader) (uint64, error) {
 	var value uint64
 	d.resetResusableBuffer(8)
@@ -16,11 +16,15 @@ import (
 	"bytes"
 	"encoding/binary"
 	"math/rand"
+	"os"
+	"path"
 	"testing"
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/commitlog"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
 )
 
 func BenchmarkDeserializer2ReadUint64(b *testing.B) {
@@ -417,3 +421,31 @@ func TestDeserializerClearLinksAtLevel(t *testing.T) {
 		require.Nil(t, err)
 	}
 }
+
+func TestDeserializerReadSQ(t *testing.T) {
+	fileName := path.Join(t.TempDir(), "sq.hnsw.commitlog")
+	fd, err := os.Create(fileName)
+	require.Nil(t, err)
+
+	sqData := ssdhelpers.SQData{
+		Dimensions: 3,
+		Min:        []float32{-1, -0.5, 0},
+		Delta:      []float32{0.01, 0.02, 0.03},
+	}
+
+	l := commitlog.NewLoggerWithFile(fd)
+	require.Nil(t, l.AddSQ(sqData))
+	require.Nil(t, l.Close())
+
+	fd, err = os.Open(fileName)
+	require.Nil(t, err)
+	defer fd.Close()
+
+	logger, _ := test.NewNullLogger()
+	res, valid, err := NewDeserializer(logger).Do(bufio.NewReader(fd), nil, false)
+	require.Nil(t, err)
+
+	assert.True(t, res.Compressed)
+	assert.Equal(t, sqData, res.SQData)
+	assert.Equal(t, 1+2+3*8, valid)
+}
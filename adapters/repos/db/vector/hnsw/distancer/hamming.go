@@ -12,6 +12,9 @@
 package distancer
 
 import (
+	"encoding/binary"
+	"math/bits"
+
 	"github.com/pkg/errors"
 )
 
@@ -78,3 +81,25 @@ func (l HammingProvider) Step(x, y []float32) float32 {
 func (l HammingProvider) Wrap(x float32) float32 {
 	return x
 }
+
+// HammingBitwise counts the differing bits of two bit-packed codes, such as
+// the sign bits produced by binary quantization. Both codes must be of the
+// same length, which has to be a multiple of 8 bytes.
+func HammingBitwise(x, y []byte) (float32, error) {
+	if len(x) != len(y) {
+		return 0, errors.Errorf("code lengths don't match: %d vs %d",
+			len(x), len(y))
+	}
+
+	if len(x)%8 != 0 {
+		return 0, errors.Errorf("code length %d is not a multiple of 8", len(x))
+	}
+
+	sum := 0
+	for i := 0; i < len(x); i += 8 {
+		sum += bits.OnesCount64(binary.LittleEndian.Uint64(x[i:]) ^
+			binary.LittleEndian.Uint64(y[i:]))
+	}
+
+	return float32(sum), nil
+}
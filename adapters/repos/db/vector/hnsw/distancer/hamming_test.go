@@ -99,3 +99,27 @@ func TestHammingDistancerStepbyStep(t *testing.T) {
 		assert.Equal(t, control, expectedDistance)
 	})
 }
+
+func TestHammingBitwise(t *testing.T) {
+	t.Run("identical codes", func(t *testing.T) {
+		code := []byte{0xff, 0x0f, 0, 0, 0, 0, 0, 1}
+
+		dist, err := HammingBitwise(code, code)
+		require.Nil(t, err)
+		assert.Equal(t, float32(0), dist)
+	})
+
+	t.Run("differing bits across words", func(t *testing.T) {
+		x := []byte{0xff, 0, 0, 0, 0, 0, 0, 0, 0x01, 0, 0, 0, 0, 0, 0, 0}
+		y := []byte{0x0f, 0, 0, 0, 0, 0, 0, 0x80, 0x00, 0, 0, 0, 0, 0, 0, 0}
+
+		dist, err := HammingBitwise(x, y)
+		require.Nil(t, err)
+		assert.Equal(t, float32(6), dist)
+	})
+
+	t.Run("mismatching lengths", func(t *testing.T) {
+		_, err := HammingBitwise(make([]byte, 8), make([]byte, 16))
+		assert.NotNil(t, err)
+	})
+}
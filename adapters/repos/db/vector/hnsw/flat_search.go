@@ -16,10 +16,25 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/priorityqueue"
 )
 
+// flatSearch compares the query vector to every vector of the allow list. If
+// the index is compressed, the candidates are found using the compressed
+// distances and rescored with the original vectors afterwards, the same as in
+// the graph search. The compressed distances of the results are only returned
+// if withCompressedDists is set and the index is compressed.
 func (h *hnsw) flatSearch(queryVector []float32, limit int,
-	allowList helpers.AllowList,
-) ([]uint64, []float32, error) {
-	results := priorityqueue.NewMax(limit)
+	allowList helpers.AllowList, withCompressedDists bool,
+) ([]uint64, []float32, []float32, error) {
+	candidateLimit := limit
+	rescore := h.shouldRescore()
+	if rescore {
+		if rescoreLimit := h.rescoreLimit(limit); rescoreLimit == 0 {
+			candidateLimit = allowList.Len()
+		} else if rescoreLimit > limit {
+			candidateLimit = rescoreLimit
+		}
+	}
+
+	results := priorityqueue.NewMax(candidateLimit)
 
 	it := allowList.Iterator()
 	for candidate, ok := it.Next(); ok; candidate, ok = it.Next() {
@@ -41,7 +56,7 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 		h.RUnlock()
 		dist, ok, err := h.distBetweenNodeAndVec(candidate, queryVector)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		if !ok {
@@ -49,7 +64,7 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 			continue
 		}
 
-		if results.Len() < limit {
+		if results.Len() < candidateLimit {
 			results.Insert(candidate, dist)
 		} else if results.Top().Dist > dist {
 			results.Pop()
@@ -57,6 +72,36 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 		}
 	}
 
+	var compressedDists map[uint64]float32
+	if withCompressedDists && h.compressed.Load() {
+		compressedDists = make(map[uint64]float32, results.Len())
+	}
+	if rescore {
+		byteDistancer := h.quantizer.NewQuantizerDistancer(queryVector)
+		defer h.quantizer.ReturnQuantizerDistancer(byteDistancer)
+
+		rescored := priorityqueue.NewMax(limit)
+		for results.Len() > 0 {
+			res := results.Pop()
+			if compressedDists != nil {
+				compressedDists[res.ID] = res.Dist
+			}
+			dist, ok, err := h.distanceFromBytesToFloatNode(byteDistancer, res.ID)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			if !ok {
+				// deleted node, ignore
+				continue
+			}
+			rescored.Insert(res.ID, dist)
+			if rescored.Len() > limit {
+				rescored.Pop()
+			}
+		}
+		results = rescored
+	}
+
 	ids := make([]uint64, results.Len())
 	dists := make([]float32, results.Len())
 
@@ -70,5 +115,18 @@ func (h *hnsw) flatSearch(queryVector []float32, limit int,
 		i--
 	}
 
-	return ids, dists, nil
+	if compressedDists == nil {
+		return ids, dists, nil, nil
+	}
+
+	compressed := make([]float32, len(ids))
+	for i, id := range ids {
+		if dist, ok := compressedDists[id]; ok {
+			compressed[i] = dist
+		} else {
+			// not rescored, the distance is the compressed one
+			compressed[i] = dists[i]
+		}
+	}
+	return ids, dists, compressed, nil
 }
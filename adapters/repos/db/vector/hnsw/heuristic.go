@@ -63,7 +63,10 @@ func (h *hnsw) selectNeighborsHeuristic(input *priorityqueue.Queue,
 			currVec := vecs[curr.Index]
 			good := true
 			for _, item := range returnList {
-				peerDist := h.pq.DistanceBetweenCompressedVectors(currVec, vecs[item.Index])
+				peerDist, err := h.quantizer.DistanceBetweenCompressedVectors(currVec, vecs[item.Index])
+				if err != nil {
+					return errors.Wrap(err, "calculate distance between compressed vectors")
+				}
 
 				if peerDist < distToQuery {
 					good = false
@@ -17,6 +17,7 @@ import (
 	"io"
 	"math"
 	"math/rand"
+	"os"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -86,6 +87,10 @@ type hnsw struct {
 	// on filtered searches with less than n elements, perform flat search
 	flatSearchCutoff int64
 
+	// with product quantization, the best k*pqOversamplingFactor candidates are
+	// rescored using the uncompressed vectors, 0 rescores all ef candidates
+	pqOversamplingFactor int64
+
 	levelNormalizer float64
 
 	nodes []*vertex
@@ -150,8 +155,9 @@ type hnsw struct {
 
 	compressed             atomic.Bool
 	doNotRescore           bool
-	pq                     *ssdhelpers.ProductQuantizer
+	quantizer              ssdhelpers.Quantizer
 	pqConfig               ent.PQConfig
+	bqConfig               ent.BQConfig
 	compressedVectorsCache cache[byte]
 	compressedStore        *lsmkv.Store
 	compressActionLock     *sync.RWMutex
@@ -178,6 +184,7 @@ type CommitLogger interface {
 	RootPath() string
 	SwitchCommitLogs(bool) error
 	AddPQ(ssdhelpers.PQData) error
+	AddSQ(ssdhelpers.SQData) error
 }
 
 type BufferedLinksLogger interface {
@@ -222,7 +229,7 @@ func New(cfg Config, uc ent.UserConfig,
 		cfg.Logger, normalizeOnRead, defaultDeletionInterval)
 
 	var compressedVectorsCache *compressedShardedLockCache
-	if uc.PQ.Enabled {
+	if uc.PQ.Enabled || uc.BQ.Enabled || uc.SQ.Enabled {
 		compressedVectorsCache = newCompressedShardedLockCache(uc.VectorCacheMaxObjects, cfg.Logger)
 	}
 
@@ -259,6 +266,8 @@ func New(cfg Config, uc ent.UserConfig,
 		efMax:    int64(uc.DynamicEFMax),
 		efFactor: int64(uc.DynamicEFFactor),
 
+		pqOversamplingFactor: int64(uc.PQ.OversamplingFactor),
+
 		metrics:   NewMetrics(cfg.PrometheusMetrics, cfg.ClassName, cfg.ShardName),
 		shardName: cfg.ShardName,
 
@@ -268,6 +277,7 @@ func New(cfg Config, uc ent.UserConfig,
 		VectorForIDThunk:     cfg.VectorForIDThunk,
 		TempVectorForIDThunk: cfg.TempVectorForIDThunk,
 		pqConfig:             uc.PQ,
+		bqConfig:             uc.BQ,
 
 		classCompactionCallbacks: classCompactionCallbacks,
 		classFlushCallbacks:      classFlushCallbacks,
@@ -467,7 +477,13 @@ func (h *hnsw) distBetweenNodes(a, b uint64) (float32, bool, error) {
 			return 0, false, fmt.Errorf("got a nil or zero-length vector at docID %d", b)
 		}
 
-		return h.pq.DistanceBetweenCompressedVectors(v1, v2), true, nil
+		dist, err := h.quantizer.DistanceBetweenCompressedVectors(v1, v2)
+		if err != nil {
+			return 0, false, errors.Wrapf(err,
+				"calculate distance between compressed vectors of docIDs %d and %d", a, b)
+		}
+
+		return dist, true, nil
 	}
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
@@ -526,7 +542,13 @@ func (h *hnsw) distBetweenNodeAndVec(node uint64, vecB []float32) (float32, bool
 			return 0, false, fmt.Errorf("got a nil or zero-length vector at docID %d", node)
 		}
 
-		return h.pq.DistanceBetweenCompressedAndUncompressedVectors(vecB, v1), true, nil
+		dist, err := h.quantizer.DistanceBetweenCompressedAndUncompressedVectors(vecB, v1)
+		if err != nil {
+			return 0, false, errors.Wrapf(err,
+				"calculate distance to compressed vector of docID %d", node)
+		}
+
+		return dist, true, nil
 	}
 	// TODO: introduce single search/transaction context instead of spawning new
 	// ones
@@ -621,6 +643,12 @@ func (h *hnsw) Drop(ctx context.Context) error {
 
 	if h.compressed.Load() {
 		h.compressedVectorsCache.drop()
+		if err := h.compressedStore.Shutdown(ctx); err != nil {
+			return errors.Wrap(err, "hnsw drop")
+		}
+		if err := os.RemoveAll(CompressedStorePath(h.rootPath, h.id)); err != nil {
+			return errors.Wrap(err, "hnsw drop: remove compressed vectors store")
+		}
 	} else {
 		// cancel vector cache goroutine
 		h.cache.drop()
@@ -62,6 +62,23 @@ func (h *hnsw) autoEfFromK(k int) int {
 }
 
 func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
+	ids, dists, _, err := h.searchByVector(vector, k, allowList, false)
+	return ids, dists, err
+}
+
+// SearchByVectorWithCompressedDistances behaves like SearchByVector, but
+// additionally returns the distances each result had according to the
+// compressed vectors before it was rescored. The compressed distances are nil
+// if the index is not compressed.
+func (h *hnsw) SearchByVectorWithCompressedDistances(vector []float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, []float32, error) {
+	return h.searchByVector(vector, k, allowList, true)
+}
+
+func (h *hnsw) searchByVector(vector []float32, k int, allowList helpers.AllowList,
+	withCompressedDists bool,
+) ([]uint64, []float32, []float32, error) {
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
 
@@ -73,9 +90,14 @@ func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowLi
 
 	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
 	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
-		return h.flatSearch(vector, k, allowList)
+		return h.flatSearch(vector, k, allowList, withCompressedDists)
 	}
-	return h.knnSearchByVector(vector, k, h.searchTimeEF(k), allowList)
+
+	ef := h.searchTimeEF(k)
+	if limit := h.rescoreLimit(k); limit > ef {
+		ef = limit
+	}
+	return h.knnSearch(vector, k, ef, allowList, withCompressedDists)
 }
 
 // SearchByVectorDistance wraps SearchByVector, and calls it recursively until
@@ -161,21 +183,35 @@ func (h *hnsw) shouldRescore() bool {
 	return h.compressed.Load() && !h.doNotRescore
 }
 
+// rescoreLimit returns how many of the best candidates according to the
+// compressed distances are rescored for a search with limit k. 0 means all
+// candidates are rescored. The oversampling factor is part of the PQ config,
+// other quantizers always rescore all candidates.
+func (h *hnsw) rescoreLimit(k int) int {
+	if !h.compressed.Load() {
+		return 0
+	}
+	if _, ok := h.quantizer.(*ssdhelpers.ProductQuantizer); !ok {
+		return 0
+	}
+	return k * int(atomic.LoadInt64(&h.pqOversamplingFactor))
+}
+
 func (h *hnsw) searchLayerByVector(queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
 	allowList helpers.AllowList) (*priorityqueue.Queue, error,
 ) {
-	var byteDistancer *ssdhelpers.PQDistancer
+	var byteDistancer ssdhelpers.QuantizerDistancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(queryVector)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.quantizer.NewQuantizerDistancer(queryVector)
+		defer h.quantizer.ReturnQuantizerDistancer(byteDistancer)
 	}
 	return h.searchLayerByVectorWithDistancer(queryVector, entrypoints, ef, level, allowList, byteDistancer)
 }
 
 func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	entrypoints *priorityqueue.Queue, ef int, level int,
-	allowList helpers.AllowList, byteDistancer *ssdhelpers.PQDistancer) (*priorityqueue.Queue, error,
+	allowList helpers.AllowList, byteDistancer ssdhelpers.QuantizerDistancer) (*priorityqueue.Queue, error,
 ) {
 	h.pools.visitedListsLock.Lock()
 	visited := h.pools.visitedLists.Borrow()
@@ -185,8 +221,8 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	results := h.pools.pqResults.GetMax(ef)
 	var floatDistancer distancer.Distancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(queryVector)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.quantizer.NewQuantizerDistancer(queryVector)
+		defer h.quantizer.ReturnQuantizerDistancer(byteDistancer)
 	} else {
 		floatDistancer = h.distancerProvider.New(queryVector)
 	}
@@ -376,7 +412,7 @@ func (h *hnsw) currentWorstResultDistanceToFloat(results *priorityqueue.Queue,
 }
 
 func (h *hnsw) currentWorstResultDistanceToByte(results *priorityqueue.Queue,
-	distancer *ssdhelpers.PQDistancer,
+	distancer ssdhelpers.QuantizerDistancer,
 ) (float32, error) {
 	if results.Len() > 0 {
 		item := results.Top()
@@ -403,7 +439,7 @@ func (h *hnsw) currentWorstResultDistanceToByte(results *priorityqueue.Queue,
 	}
 }
 
-func (h *hnsw) distanceToByteNode(distancer *ssdhelpers.PQDistancer,
+func (h *hnsw) distanceToByteNode(distancer ssdhelpers.QuantizerDistancer,
 	nodeID uint64,
 ) (float32, bool, error) {
 	vec, err := h.compressedVectorsCache.get(context.Background(), nodeID)
@@ -420,7 +456,7 @@ func (h *hnsw) distanceToByteNode(distancer *ssdhelpers.PQDistancer,
 	return distancer.Distance(vec)
 }
 
-func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer *ssdhelpers.PQDistancer, nodeID uint64) (float32, bool, error) {
+func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer ssdhelpers.QuantizerDistancer, nodeID uint64) (float32, bool, error) {
 	slice := h.pools.tempVectors.Get(int(h.dims))
 	defer h.pools.tempVectors.Put(slice)
 	vec, err := h.TempVectorForIDThunk(context.Background(), nodeID, slice)
@@ -483,25 +519,32 @@ func (h *hnsw) handleDeletedNode(docID uint64) {
 func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 	ef int, allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
+	ids, dists, _, err := h.knnSearch(searchVec, k, ef, allowList, false)
+	return ids, dists, err
+}
+
+func (h *hnsw) knnSearch(searchVec []float32, k int, ef int,
+	allowList helpers.AllowList, withCompressedDists bool,
+) ([]uint64, []float32, []float32, error) {
 	if h.isEmpty() {
-		return nil, nil, nil
+		return nil, nil, nil, nil
 	}
 
 	entryPointID := h.entryPointID
 	entryPointDistance, ok, err := h.distBetweenNodeAndVec(entryPointID, searchVec)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "knn search: distance between entrypoint and query node")
+		return nil, nil, nil, errors.Wrap(err, "knn search: distance between entrypoint and query node")
 	}
 
 	if !ok {
-		return nil, nil, fmt.Errorf("entrypoint was deleted in the object store, " +
+		return nil, nil, nil, fmt.Errorf("entrypoint was deleted in the object store, " +
 			"it has been flagged for cleanup and should be fixed in the next cleanup cycle")
 	}
 
-	var byteDistancer *ssdhelpers.PQDistancer
+	var byteDistancer ssdhelpers.QuantizerDistancer
 	if h.compressed.Load() {
-		byteDistancer = h.pq.NewDistancer(searchVec)
-		defer h.pq.ReturnDistancer(byteDistancer)
+		byteDistancer = h.quantizer.NewQuantizerDistancer(searchVec)
+		defer h.quantizer.ReturnQuantizerDistancer(byteDistancer)
 	}
 	// stop at layer 1, not 0!
 	for level := h.currentMaximumLayer; level >= 1; level-- {
@@ -510,7 +553,7 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 
 		res, err := h.searchLayerByVectorWithDistancer(searchVec, eps, 1, level, nil, byteDistancer)
 		if err != nil {
-			return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", level)
+			return nil, nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", level)
 		}
 
 		// There might be situations where we did not find a better entrypoint at
@@ -528,7 +571,7 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 				// deleted, but not cleaned up properly. Make sure to add a tombstone to
 				// this node, so it can be cleaned up in the next cycle.
 				if err := h.addTombstone(cand.ID); err != nil {
-					return nil, nil, err
+					return nil, nil, nil, err
 				}
 
 				// skip the nil node, as it does not make a valid entrypoint
@@ -553,18 +596,32 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 	eps.Insert(entryPointID, entryPointDistance)
 	res, err := h.searchLayerByVectorWithDistancer(searchVec, eps, ef, 0, allowList, byteDistancer)
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
+		return nil, nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
 	}
 
+	var compressedDists map[uint64]float32
 	if h.shouldRescore() {
 		ids := make([]uint64, res.Len())
+		dists := make([]float32, res.Len())
 		i := len(ids) - 1
 		for res.Len() > 0 {
 			res := res.Pop()
 			ids[i] = res.ID
+			dists[i] = res.Dist
 			i--
 		}
 		res.Reset()
+		if limit := h.rescoreLimit(k); limit > 0 && limit < len(ids) {
+			// only the best candidates according to the compressed distances
+			// are rescored, the remaining ones are discarded
+			ids, dists = ids[:limit], dists[:limit]
+		}
+		if withCompressedDists {
+			compressedDists = make(map[uint64]float32, len(ids))
+			for i, id := range ids {
+				compressedDists[id] = dists[i]
+			}
+		}
 		for _, id := range ids {
 			dist, _, _ := h.distanceFromBytesToFloatNode(byteDistancer, id)
 			res.Insert(id, dist)
@@ -592,7 +649,21 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 		i--
 	}
 	h.pools.pqResults.Put(res)
-	return ids, dists, nil
+
+	if !withCompressedDists || !h.compressed.Load() {
+		return ids, dists, nil, nil
+	}
+
+	compressed := make([]float32, len(ids))
+	for i, id := range ids {
+		if dist, ok := compressedDists[id]; ok {
+			compressed[i] = dist
+		} else {
+			// not rescored, the distance is the compressed one
+			compressed[i] = dists[i]
+		}
+	}
+	return ids, dists, compressed, nil
 }
 
 func newSearchByDistParams(maxLimit int64) *searchByDistParams {
@@ -34,6 +34,12 @@ func (h *hnsw) init(cfg Config) error {
 		return errors.Wrapf(err, "restore hnsw index %q", cfg.ID)
 	}
 
+	if h.bqConfig.Enabled {
+		if err := h.enableBQ(); err != nil {
+			return errors.Wrapf(err, "enable bq on hnsw index %q", cfg.ID)
+		}
+	}
+
 	// init commit logger for future writes
 	cl, err := cfg.MakeCommitLoggerThunk()
 	if err != nil {
@@ -129,14 +135,23 @@ func (h *hnsw) restoreFromDisk() error {
 		}
 		h.cache.drop()
 
-		h.pq, err = ssdhelpers.NewProductQuantizerWithEncoders(
-			h.pqConfig,
-			h.distancerProvider,
-			int(state.PQData.Dimensions),
-			state.PQData.Encoders,
-		)
-		if err != nil {
-			return errors.Wrap(err, "Restoring PQ data.")
+		if state.SQData.Dimensions > 0 {
+			sq, err := ssdhelpers.NewScalarQuantizerWithData(h.distancerProvider, state.SQData)
+			if err != nil {
+				return errors.Wrap(err, "Restoring SQ data.")
+			}
+			h.quantizer = sq
+		} else {
+			pq, err := ssdhelpers.NewProductQuantizerWithEncoders(
+				h.pqConfig,
+				h.distancerProvider,
+				int(state.PQData.Dimensions),
+				state.PQData.Encoders,
+			)
+			if err != nil {
+				return errors.Wrap(err, "Restoring PQ data.")
+			}
+			h.quantizer = pq
 		}
 	} else {
 		// make sure the cache fits the current size
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+import (
+	"encoding/binary"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// BinaryQuantizer encodes every dimension of a vector as a single sign bit.
+// Contrary to product quantization it does not need to be fitted to the data,
+// so it can be used from the very first insert. Codes are compared using the
+// hamming distance, which is why results should always be rescored with the
+// original vectors.
+type BinaryQuantizer struct {
+	distance distancer.Provider
+}
+
+func NewBinaryQuantizer(distance distancer.Provider) *BinaryQuantizer {
+	return &BinaryQuantizer{
+		distance: distance,
+	}
+}
+
+// Encode sets a bit for every negative dimension. The code is padded to a
+// multiple of 8 bytes, so it can be compared word by word.
+func (bq *BinaryQuantizer) Encode(vec []float32) []byte {
+	words := (len(vec) + 63) / 64
+	code := make([]byte, words*8)
+	for w := 0; w < words; w++ {
+		var word uint64
+		for bit := 0; bit < 64; bit++ {
+			i := w*64 + bit
+			if i >= len(vec) {
+				break
+			}
+			if vec[i] < 0 {
+				word |= 1 << bit
+			}
+		}
+		binary.LittleEndian.PutUint64(code[w*8:], word)
+	}
+	return code
+}
+
+func (bq *BinaryQuantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	return distancer.HammingBitwise(x, y)
+}
+
+func (bq *BinaryQuantizer) DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) (float32, error) {
+	return distancer.HammingBitwise(bq.Encode(x), encoded)
+}
+
+type BQDistancer struct {
+	x    []float32
+	code []byte
+	bq   *BinaryQuantizer
+}
+
+func (bq *BinaryQuantizer) NewDistancer(a []float32) *BQDistancer {
+	return &BQDistancer{
+		x:    a,
+		code: bq.Encode(a),
+		bq:   bq,
+	}
+}
+
+func (bq *BinaryQuantizer) NewQuantizerDistancer(a []float32) QuantizerDistancer {
+	return bq.NewDistancer(a)
+}
+
+func (bq *BinaryQuantizer) ReturnQuantizerDistancer(d QuantizerDistancer) {}
+
+func (d *BQDistancer) Distance(x []byte) (float32, bool, error) {
+	dist, err := distancer.HammingBitwise(d.code, x)
+	if err != nil {
+		return 0, false, err
+	}
+	return dist, true, nil
+}
+
+func (d *BQDistancer) DistanceToFloat(x []float32) (float32, bool, error) {
+	return d.bq.distance.SingleDist(d.x, x)
+}
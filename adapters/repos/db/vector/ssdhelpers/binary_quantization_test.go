@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+)
+
+func TestBinaryQuantizerEncode(t *testing.T) {
+	bq := ssdhelpers.NewBinaryQuantizer(distancer.NewCosineDistanceProvider())
+
+	t.Run("short vectors are padded to a full word", func(t *testing.T) {
+		code := bq.Encode([]float32{-1, 2, -3, 4})
+		assert.Equal(t, []byte{0b0101, 0, 0, 0, 0, 0, 0, 0}, code)
+	})
+
+	t.Run("long vectors span multiple words", func(t *testing.T) {
+		vec := make([]float32, 65)
+		for i := range vec {
+			vec[i] = 1
+		}
+		vec[64] = -1
+
+		code := bq.Encode(vec)
+		require.Len(t, code, 16)
+		assert.Equal(t, byte(1), code[8])
+	})
+}
+
+func TestBinaryQuantizerDistances(t *testing.T) {
+	bq := ssdhelpers.NewBinaryQuantizer(distancer.NewL2SquaredProvider())
+	query := []float32{1, -1, 1, -1}
+	candidate := []float32{1, 1, -1, -1}
+
+	t.Run("between codes", func(t *testing.T) {
+		dist, err := bq.DistanceBetweenCompressedVectors(bq.Encode(query), bq.Encode(candidate))
+		require.Nil(t, err)
+		assert.Equal(t, float32(2), dist)
+	})
+
+	t.Run("between vector and code", func(t *testing.T) {
+		dist, err := bq.DistanceBetweenCompressedAndUncompressedVectors(query, bq.Encode(candidate))
+		require.Nil(t, err)
+		assert.Equal(t, float32(2), dist)
+	})
+
+	t.Run("distancer", func(t *testing.T) {
+		d := bq.NewQuantizerDistancer(query)
+		defer bq.ReturnQuantizerDistancer(d)
+
+		dist, ok, err := d.Distance(bq.Encode(candidate))
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, float32(2), dist)
+
+		// rescoring uses the original distance metric
+		dist, ok, err = d.DistanceToFloat(candidate)
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, float32(8), dist)
+	})
+}
@@ -270,7 +270,7 @@ func (pq *ProductQuantizer) ExposeFields() PQData {
 	}
 }
 
-func (pq *ProductQuantizer) DistanceBetweenCompressedVectors(x, y []byte) float32 {
+func (pq *ProductQuantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
 	dist := float32(0)
 
 	for i := 0; i < pq.m; i++ {
@@ -279,16 +279,16 @@ func (pq *ProductQuantizer) DistanceBetweenCompressedVectors(x, y []byte) float3
 		dist += pq.distance.Step(cX, cY)
 	}
 
-	return pq.distance.Wrap(dist)
+	return pq.distance.Wrap(dist), nil
 }
 
-func (pq *ProductQuantizer) DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) float32 {
+func (pq *ProductQuantizer) DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) (float32, error) {
 	dist := float32(0)
 	for i := 0; i < pq.m; i++ {
 		cY := pq.kms[i].Centroid(ExtractCode8(encoded, i))
 		dist += pq.distance.Step(x[i*pq.ds:(i+1)*pq.ds], cY)
 	}
-	return pq.distance.Wrap(dist)
+	return pq.distance.Wrap(dist), nil
 }
 
 type PQDistancer struct {
@@ -310,6 +310,16 @@ func (pq *ProductQuantizer) ReturnDistancer(d *PQDistancer) {
 	pq.dlutPool.Return(d.lut)
 }
 
+func (pq *ProductQuantizer) NewQuantizerDistancer(a []float32) QuantizerDistancer {
+	return pq.NewDistancer(a)
+}
+
+func (pq *ProductQuantizer) ReturnQuantizerDistancer(d QuantizerDistancer) {
+	if concrete, ok := d.(*PQDistancer); ok {
+		pq.ReturnDistancer(concrete)
+	}
+}
+
 func (d *PQDistancer) Distance(x []byte) (float32, bool, error) {
 	return d.pq.Distance(x, d.lut), true, nil
 }
@@ -0,0 +1,30 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+// Quantizer is implemented by every lossy vector encoding the hnsw index can
+// build and search its graph on.
+type Quantizer interface {
+	Encode(vec []float32) []byte
+	DistanceBetweenCompressedVectors(x, y []byte) (float32, error)
+	DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) (float32, error)
+	NewQuantizerDistancer(vec []float32) QuantizerDistancer
+	ReturnQuantizerDistancer(d QuantizerDistancer)
+}
+
+// QuantizerDistancer calculates distances from a fixed, uncompressed query
+// vector to either compressed codes or uncompressed vectors. The latter is
+// used to rescore candidates with their original vectors.
+type QuantizerDistancer interface {
+	Distance(x []byte) (float32, bool, error)
+	DistanceToFloat(x []float32) (float32, bool, error)
+}
@@ -0,0 +1,237 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const scalarQuantizerCodes = 255
+
+// ScalarQuantizer encodes every dimension of a vector as a single byte. The
+// range of each dimension is learnt from a training sample and split into 256
+// equally sized buckets. Values outside of the learnt range are clamped.
+type ScalarQuantizer struct {
+	distance      distancer.Provider
+	dimensions    int
+	trainingLimit int
+
+	// min and delta describe the buckets per dimension, a code c decodes to
+	// min + c * delta
+	min   []float32
+	delta []float32
+}
+
+type SQData struct {
+	Dimensions uint16
+	Min        []float32
+	Delta      []float32
+}
+
+func NewScalarQuantizer(cfg ent.SQConfig, distance distancer.Provider, dimensions int) (*ScalarQuantizer, error) {
+	if dimensions <= 0 {
+		return nil, errors.Errorf("invalid number of dimensions: %d", dimensions)
+	}
+
+	return &ScalarQuantizer{
+		distance:      distance,
+		dimensions:    dimensions,
+		trainingLimit: cfg.TrainingLimit,
+	}, nil
+}
+
+func NewScalarQuantizerWithData(distance distancer.Provider, data SQData) (*ScalarQuantizer, error) {
+	if int(data.Dimensions) != len(data.Min) || int(data.Dimensions) != len(data.Delta) {
+		return nil, errors.Errorf("sq data is inconsistent: %d dimensions, %d min, %d delta values",
+			data.Dimensions, len(data.Min), len(data.Delta))
+	}
+
+	return &ScalarQuantizer{
+		distance:   distance,
+		dimensions: int(data.Dimensions),
+		min:        data.Min,
+		delta:      data.Delta,
+	}, nil
+}
+
+func (sq *ScalarQuantizer) ExposeFields() SQData {
+	return SQData{
+		Dimensions: uint16(sq.dimensions),
+		Min:        sq.min,
+		Delta:      sq.delta,
+	}
+}
+
+// Fit learns the per-dimension min and max from the training data
+func (sq *ScalarQuantizer) Fit(data [][]float32) {
+	if sq.trainingLimit > 0 && len(data) > sq.trainingLimit {
+		data = data[:sq.trainingLimit]
+	}
+
+	min := make([]float32, sq.dimensions)
+	max := make([]float32, sq.dimensions)
+	for i := range min {
+		min[i] = math.MaxFloat32
+		max[i] = -math.MaxFloat32
+	}
+
+	for _, vec := range data {
+		for i := 0; i < sq.dimensions && i < len(vec); i++ {
+			if vec[i] < min[i] {
+				min[i] = vec[i]
+			}
+			if vec[i] > max[i] {
+				max[i] = vec[i]
+			}
+		}
+	}
+
+	delta := make([]float32, sq.dimensions)
+	for i := range delta {
+		if min[i] > max[i] {
+			// no training data for this dimension
+			min[i], max[i] = 0, 0
+		}
+		delta[i] = (max[i] - min[i]) / scalarQuantizerCodes
+	}
+
+	sq.min = min
+	sq.delta = delta
+}
+
+func (sq *ScalarQuantizer) Encode(vec []float32) []byte {
+	code := make([]byte, sq.dimensions)
+	for i := 0; i < sq.dimensions && i < len(vec); i++ {
+		if sq.delta[i] == 0 {
+			continue
+		}
+
+		bucket := math.Round(float64((vec[i] - sq.min[i]) / sq.delta[i]))
+		if bucket < 0 {
+			bucket = 0
+		} else if bucket > scalarQuantizerCodes {
+			bucket = scalarQuantizerCodes
+		}
+		code[i] = byte(bucket)
+	}
+	return code
+}
+
+func (sq *ScalarQuantizer) Decode(code []byte) []float32 {
+	vec := make([]float32, len(code))
+	for i, c := range code {
+		vec[i] = sq.decodeDimension(c, i)
+	}
+	return vec
+}
+
+func (sq *ScalarQuantizer) decodeDimension(c byte, i int) float32 {
+	return sq.min[i] + float32(c)*sq.delta[i]
+}
+
+func (sq *ScalarQuantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	if len(x) != sq.dimensions || len(y) != sq.dimensions {
+		return 0, errors.Errorf("code lengths don't match: %d vs %d vs %d dimensions",
+			len(x), len(y), sq.dimensions)
+	}
+
+	switch sq.distance.Type() {
+	case "l2-squared":
+		var sum float32
+		for i := range x {
+			diff := (float32(x[i]) - float32(y[i])) * sq.delta[i]
+			sum += diff * diff
+		}
+		return sum, nil
+	case "dot":
+		return -sq.dotCompressed(x, y), nil
+	case "cosine-dot":
+		return 1 - sq.dotCompressed(x, y), nil
+	default:
+		dist, _, err := sq.distance.SingleDist(sq.Decode(x), sq.Decode(y))
+		return dist, err
+	}
+}
+
+func (sq *ScalarQuantizer) dotCompressed(x, y []byte) float32 {
+	var sum float32
+	for i := range x {
+		sum += sq.decodeDimension(x[i], i) * sq.decodeDimension(y[i], i)
+	}
+	return sum
+}
+
+func (sq *ScalarQuantizer) DistanceBetweenCompressedAndUncompressedVectors(x []float32, encoded []byte) (float32, error) {
+	if len(x) != sq.dimensions || len(encoded) != sq.dimensions {
+		return 0, errors.Errorf("vector lengths don't match: %d vs %d vs %d dimensions",
+			len(x), len(encoded), sq.dimensions)
+	}
+
+	switch sq.distance.Type() {
+	case "l2-squared":
+		var sum float32
+		for i := range x {
+			diff := x[i] - sq.decodeDimension(encoded[i], i)
+			sum += diff * diff
+		}
+		return sum, nil
+	case "dot":
+		return -sq.dotMixed(x, encoded), nil
+	case "cosine-dot":
+		return 1 - sq.dotMixed(x, encoded), nil
+	default:
+		dist, _, err := sq.distance.SingleDist(x, sq.Decode(encoded))
+		return dist, err
+	}
+}
+
+func (sq *ScalarQuantizer) dotMixed(x []float32, encoded []byte) float32 {
+	var sum float32
+	for i := range x {
+		sum += x[i] * sq.decodeDimension(encoded[i], i)
+	}
+	return sum
+}
+
+type SQDistancer struct {
+	x  []float32
+	sq *ScalarQuantizer
+}
+
+func (sq *ScalarQuantizer) NewDistancer(a []float32) *SQDistancer {
+	return &SQDistancer{
+		x:  a,
+		sq: sq,
+	}
+}
+
+func (sq *ScalarQuantizer) NewQuantizerDistancer(a []float32) QuantizerDistancer {
+	return sq.NewDistancer(a)
+}
+
+func (sq *ScalarQuantizer) ReturnQuantizerDistancer(d QuantizerDistancer) {}
+
+func (d *SQDistancer) Distance(x []byte) (float32, bool, error) {
+	dist, err := d.sq.DistanceBetweenCompressedAndUncompressedVectors(d.x, x)
+	if err != nil {
+		return 0, false, err
+	}
+	return dist, true, nil
+}
+
+func (d *SQDistancer) DistanceToFloat(x []float32) (float32, bool, error) {
+	return d.sq.distance.SingleDist(d.x, x)
+}
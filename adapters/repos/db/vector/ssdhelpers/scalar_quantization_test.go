@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ssdhelpers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ssdhelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/ssdhelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestScalarQuantizer(t *testing.T) {
+	data := [][]float32{
+		{-1, 0, 10},
+		{1, 0.2, 20},
+		{0, 1, 15},
+	}
+
+	newSQ := func(t *testing.T, provider distancer.Provider) *ssdhelpers.ScalarQuantizer {
+		sq, err := ssdhelpers.NewScalarQuantizer(ent.SQConfig{Enabled: true}, provider, 3)
+		require.Nil(t, err)
+		sq.Fit(data)
+		return sq
+	}
+
+	t.Run("encoding uses the learnt range per dimension", func(t *testing.T) {
+		sq := newSQ(t, distancer.NewL2SquaredProvider())
+
+		assert.Equal(t, []byte{0, 0, 0}, sq.Encode(data[0]))
+		assert.Equal(t, []byte{255, 51, 255}, sq.Encode(data[1]))
+	})
+
+	t.Run("values outside of the range are clamped", func(t *testing.T) {
+		sq := newSQ(t, distancer.NewL2SquaredProvider())
+
+		assert.Equal(t, []byte{0, 255, 255}, sq.Encode([]float32{-5, 7, 100}))
+	})
+
+	t.Run("decoding approximates the original", func(t *testing.T) {
+		sq := newSQ(t, distancer.NewL2SquaredProvider())
+
+		for _, vec := range data {
+			decoded := sq.Decode(sq.Encode(vec))
+			assert.InDeltaSlice(t, vec, decoded, 0.05)
+		}
+	})
+
+	t.Run("approximate distances", func(t *testing.T) {
+		providers := []distancer.Provider{
+			distancer.NewL2SquaredProvider(),
+			distancer.NewDotProductProvider(),
+			distancer.NewCosineDistanceProvider(),
+			distancer.NewManhattanProvider(),
+		}
+
+		for _, provider := range providers {
+			t.Run(provider.Type(), func(t *testing.T) {
+				sq := newSQ(t, provider)
+				x, y := data[1], data[2]
+				expected, _, err := provider.SingleDist(x, y)
+				require.Nil(t, err)
+
+				dist, err := sq.DistanceBetweenCompressedVectors(sq.Encode(x), sq.Encode(y))
+				require.Nil(t, err)
+				assert.InDelta(t, expected, dist, 0.5)
+
+				dist, err = sq.DistanceBetweenCompressedAndUncompressedVectors(x, sq.Encode(y))
+				require.Nil(t, err)
+				assert.InDelta(t, expected, dist, 0.5)
+
+				d := sq.NewQuantizerDistancer(x)
+				rescored, _, err := d.DistanceToFloat(y)
+				require.Nil(t, err)
+				assert.Equal(t, expected, rescored)
+			})
+		}
+	})
+
+	t.Run("restoring from exposed data", func(t *testing.T) {
+		sq := newSQ(t, distancer.NewL2SquaredProvider())
+
+		restored, err := ssdhelpers.NewScalarQuantizerWithData(
+			distancer.NewL2SquaredProvider(), sq.ExposeFields())
+		require.Nil(t, err)
+		assert.Equal(t, sq.Encode(data[2]), restored.Encode(data[2]))
+	})
+}
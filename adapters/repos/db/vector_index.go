@@ -36,3 +36,11 @@ type VectorIndex interface {
 	PostStartup()
 	ValidateBeforeInsert(vector []float32) error
 }
+
+// compressedDistanceSearcher is implemented by vector indexes which can
+// report the compressed distance of each result in addition to the distance
+// it was rescored to
+type compressedDistanceSearcher interface {
+	SearchByVectorWithCompressedDistances(vector []float32, k int,
+		allow helpers.AllowList) ([]uint64, []float32, []float32, error)
+}
@@ -35,6 +35,7 @@ var (
 	keyMetaClass         = []byte{eTypeMeta, 0}
 	keyShardingState     = []byte{eTypeSharingState, 0}
 	keyConfig            = []byte{eTypeConfig, 0}
+	keyAliases           = []byte{eTypeAliases, 0}
 	_Version         int = 2
 )
 
@@ -44,6 +45,7 @@ const (
 	eTypeClass        byte = 2
 	eTypeShard        byte = 4
 	eTypeMeta         byte = 5
+	eTypeAliases      byte = 6
 	eTypeSharingState byte = 15
 )
 
@@ -59,6 +61,7 @@ It ensures that each class has a dedicated bucket, which includes metadata, and
 
 Schema Structure:
   - Config: contains metadata related to parsing the schema
+  - Aliases: maps alternative names to class names
   - Nested buckets for each class
 
 Schema Structure for a class Bucket:
@@ -299,6 +302,28 @@ func (r *store) NewShards(_ context.Context, class string, shards []ucs.KeyValue
 	return r.db.Update(f)
 }
 
+// UpdateShards updates (replaces) shards of existing class
+// Error is returned if class or shard does not exist
+func (r *store) UpdateShards(_ context.Context, class string, shards []ucs.KeyValuePair) error {
+	classKey := encodeClassName(class)
+	f := func(tx *bolt.Tx) error {
+		b := tx.Bucket(schemaBucket).Bucket(classKey)
+		if b == nil {
+			return fmt.Errorf("class not found")
+		}
+		keyBuf := make([]byte, 1, 68)
+		keyBuf[0] = eTypeShard
+		for _, pair := range shards {
+			keyBuf = append(keyBuf[:1], pair.Key...)
+			if b.Get(keyBuf) == nil {
+				return fmt.Errorf("shard %q not found", pair.Key)
+			}
+		}
+		return appendShards(b, shards, keyBuf[:1])
+	}
+	return r.db.Update(f)
+}
+
 // DeleteShards of a specific class
 //
 //	If the class or a shard does not exist then nothing is done and a nil error is returned
@@ -314,9 +339,27 @@ func (r *store) DeleteShards(_ context.Context, class string, shards []string) e
 	return r.db.Update(f)
 }
 
+// SaveAliases replaces all class aliases
+func (r *store) SaveAliases(_ context.Context, aliases map[string]string) error {
+	f := func(tx *bolt.Tx) error {
+		return saveAliases(tx.Bucket(schemaBucket), aliases)
+	}
+	return r.db.Update(f)
+}
+
 // Load loads the complete schema from the persistent storage
 func (r *store) Load(ctx context.Context) (ucs.State, error) {
 	state := ucs.NewState(32)
+	err := r.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(schemaBucket).Get(keyAliases)
+		if data == nil {
+			return nil
+		}
+		return json.Unmarshal(data, &state.Aliases)
+	})
+	if err != nil {
+		return state, fmt.Errorf("unmarshal aliases: %w", err)
+	}
 	for data := range r.load(ctx) {
 		if data.Error != nil {
 			return state, data.Error
@@ -445,8 +488,19 @@ func (r *store) saveAllTx(ctx context.Context, root *bolt.Bucket, ss ucs.State)
 			}
 		}
 
-		return nil
+		return saveAliases(root, ss.Aliases)
+	}
+}
+
+func saveAliases(root *bolt.Bucket, aliases map[string]string) error {
+	if len(aliases) == 0 {
+		return root.Delete(keyAliases)
+	}
+	data, err := json.Marshal(aliases)
+	if err != nil {
+		return fmt.Errorf("marshal aliases: %w", err)
 	}
+	return root.Put(keyAliases, data)
 }
 
 func saveConfig(root *bolt.Bucket, cfg config) error {
@@ -187,6 +187,44 @@ func TestRepositoryUpdateClass(t *testing.T) {
 	repo.asserEqualSchema(t, schema, "delete class")
 }
 
+func TestRepositorySaveAliases(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		dirName   = t.TempDir()
+	)
+	repo, err := newRepo(dirName, -1, logger)
+	if err != nil {
+		t.Fatalf("create new repo: %v", err)
+	}
+
+	schema := ucs.NewState(1)
+	cls, ss := addClass(&schema, "C1", 0, 1, 0)
+	payload, err := ucs.CreateClassPayload(cls, ss)
+	assert.Nil(t, err)
+	if err := repo.NewClass(ctx, payload); err != nil {
+		t.Fatalf("create new class: %v", err)
+	}
+
+	schema.Aliases = map[string]string{"A1": "C1"}
+	if err := repo.SaveAliases(ctx, schema.Aliases); err != nil {
+		t.Fatalf("save aliases: %v", err)
+	}
+	repo.asserEqualSchema(t, schema, "save aliases")
+
+	// the whole schema is saved along with the aliases
+	if err := repo.Save(ctx, schema); err != nil {
+		t.Fatalf("save schema: %v", err)
+	}
+	repo.asserEqualSchema(t, schema, "save schema")
+
+	schema.Aliases = nil
+	if err := repo.SaveAliases(ctx, map[string]string{}); err != nil {
+		t.Fatalf("delete aliases: %v", err)
+	}
+	repo.asserEqualSchema(t, schema, "delete aliases")
+}
+
 func TestRepositoryUpdateShards(t *testing.T) {
 	var (
 		ctx       = context.Background()
@@ -219,6 +257,23 @@ func TestRepositoryUpdateShards(t *testing.T) {
 	}
 	repo.asserEqualSchema(t, schema, "adding new shards")
 
+	// update status of existing shards
+	for name, p := range ss.Physical {
+		p.Status = models.TenantActivityStatusCOLD
+		ss.Physical[name] = p
+	}
+	if err := repo.UpdateShards(ctx, "C1", serializeShards(*ss)); err != nil {
+		t.Fatalf("update shards: %v", err)
+	}
+	repo.asserEqualSchema(t, schema, "updating shards")
+	unknown := []ucs.KeyValuePair{{Key: "unknown", Value: []byte("{}")}}
+	if err := repo.UpdateShards(ctx, "C1", unknown); err == nil {
+		t.Fatal("updating a non existing shard must fail")
+	}
+	if err := repo.UpdateShards(ctx, "C3", shards); err == nil {
+		t.Fatal("update shards of a non existing class must fail")
+	}
+
 	xset := removeShards(ss, []int{0, 3, 4})
 	if err := repo.DeleteShards(ctx, "C1", xset); err != nil {
 		t.Fatalf("delete shards: %v", err)
@@ -45,6 +45,8 @@ type ClientService interface {
 
 	NodesGetClass(params *NodesGetClassParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetClassOK, error)
 
+	NodesGetClassTenants(params *NodesGetClassTenantsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetClassTenantsOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -126,6 +128,45 @@ func (a *Client) NodesGetClass(params *NodesGetClassParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+NodesGetClassTenants Returns the status, node placement and statistics of the tenants of a multi-tenant class. The tenants are sorted by name and paginated.
+*/
+func (a *Client) NodesGetClassTenants(params *NodesGetClassTenantsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetClassTenantsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesGetClassTenantsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.get.class.tenants",
+		Method:             "GET",
+		PathPattern:        "/nodes/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesGetClassTenantsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesGetClassTenantsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.get.class.tenants: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
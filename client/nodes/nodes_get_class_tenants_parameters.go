@@ -0,0 +1,277 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewNodesGetClassTenantsParams creates a new NodesGetClassTenantsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewNodesGetClassTenantsParams() *NodesGetClassTenantsParams {
+	return &NodesGetClassTenantsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewNodesGetClassTenantsParamsWithTimeout creates a new NodesGetClassTenantsParams object
+// with the ability to set a timeout on a request.
+func NewNodesGetClassTenantsParamsWithTimeout(timeout time.Duration) *NodesGetClassTenantsParams {
+	return &NodesGetClassTenantsParams{
+		timeout: timeout,
+	}
+}
+
+// NewNodesGetClassTenantsParamsWithContext creates a new NodesGetClassTenantsParams object
+// with the ability to set a context for a request.
+func NewNodesGetClassTenantsParamsWithContext(ctx context.Context) *NodesGetClassTenantsParams {
+	return &NodesGetClassTenantsParams{
+		Context: ctx,
+	}
+}
+
+// NewNodesGetClassTenantsParamsWithHTTPClient creates a new NodesGetClassTenantsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewNodesGetClassTenantsParamsWithHTTPClient(client *http.Client) *NodesGetClassTenantsParams {
+	return &NodesGetClassTenantsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+NodesGetClassTenantsParams contains all the parameters to send to the API endpoint
+
+	for the nodes get class tenants operation.
+
+	Typically these are written to a http.Request.
+*/
+type NodesGetClassTenantsParams struct {
+
+	// ClassName.
+	ClassName string
+
+	/* Limit.
+
+	   The maximum number of items to be returned per page. Default value is set in Weaviate config.
+
+	   Format: int64
+	*/
+	Limit *int64
+
+	/* Offset.
+
+	   The starting index of the result window. Default value is 0.
+
+	   Format: int64
+	*/
+	Offset *int64
+
+	/* Tenant.
+
+	   Only return the status of the given tenant.
+	*/
+	Tenant *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the nodes get class tenants params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *NodesGetClassTenantsParams) WithDefaults() *NodesGetClassTenantsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the nodes get class tenants params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *NodesGetClassTenantsParams) SetDefaults() {
+	var (
+		offsetDefault = int64(0)
+	)
+
+	val := NodesGetClassTenantsParams{
+		Offset: &offsetDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) WithTimeout(timeout time.Duration) *NodesGetClassTenantsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) WithContext(ctx context.Context) *NodesGetClassTenantsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) WithHTTPClient(client *http.Client) *NodesGetClassTenantsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClassName adds the className to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) WithClassName(className string) *NodesGetClassTenantsParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WithLimit adds the limit to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) WithLimit(limit *int64) *NodesGetClassTenantsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithOffset adds the offset to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) WithOffset(offset *int64) *NodesGetClassTenantsParams {
+	o.SetOffset(offset)
+	return o
+}
+
+// SetOffset adds the offset to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) SetOffset(offset *int64) {
+	o.Offset = offset
+}
+
+// WithTenant adds the tenant to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) WithTenant(tenant *string) *NodesGetClassTenantsParams {
+	o.SetTenant(tenant)
+	return o
+}
+
+// SetTenant adds the tenant to the nodes get class tenants params
+func (o *NodesGetClassTenantsParams) SetTenant(tenant *string) {
+	o.Tenant = tenant
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *NodesGetClassTenantsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Offset != nil {
+
+		// query param offset
+		var qrOffset int64
+
+		if o.Offset != nil {
+			qrOffset = *o.Offset
+		}
+		qOffset := swag.FormatInt64(qrOffset)
+		if qOffset != "" {
+
+			if err := r.SetQueryParam("offset", qOffset); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Tenant != nil {
+
+		// query param tenant
+		var qrTenant string
+
+		if o.Tenant != nil {
+			qrTenant = *o.Tenant
+		}
+		qTenant := qrTenant
+		if qTenant != "" {
+
+			if err := r.SetQueryParam("tenant", qTenant); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
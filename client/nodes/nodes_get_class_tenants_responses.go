@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesGetClassTenantsReader is a Reader for the NodesGetClassTenants structure.
+type NodesGetClassTenantsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *NodesGetClassTenantsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewNodesGetClassTenantsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewNodesGetClassTenantsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewNodesGetClassTenantsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewNodesGetClassTenantsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewNodesGetClassTenantsUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewNodesGetClassTenantsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewNodesGetClassTenantsOK creates a NodesGetClassTenantsOK with default headers values
+func NewNodesGetClassTenantsOK() *NodesGetClassTenantsOK {
+	return &NodesGetClassTenantsOK{}
+}
+
+/*
+NodesGetClassTenantsOK describes a response with status code 200, with default header values.
+
+Tenants status successfully returned
+*/
+type NodesGetClassTenantsOK struct {
+	Payload *models.NodesTenantsStatusResponse
+}
+
+// IsSuccess returns true when this nodes get class tenants o k response has a 2xx status code
+func (o *NodesGetClassTenantsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this nodes get class tenants o k response has a 3xx status code
+func (o *NodesGetClassTenantsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes get class tenants o k response has a 4xx status code
+func (o *NodesGetClassTenantsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this nodes get class tenants o k response has a 5xx status code
+func (o *NodesGetClassTenantsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes get class tenants o k response a status code equal to that given
+func (o *NodesGetClassTenantsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the nodes get class tenants o k response
+func (o *NodesGetClassTenantsOK) Code() int {
+	return 200
+}
+
+func (o *NodesGetClassTenantsOK) Error() string {
+	return fmt.Sprintf("[GET /nodes/{className}/tenants][%d] nodesGetClassTenantsOK  %+v", 200, o.Payload)
+}
+
+func (o *NodesGetClassTenantsOK) String() string {
+	return fmt.Sprintf("[GET /nodes/{className}/tenants][%d] nodesGetClassTenantsOK  %+v", 200, o.Payload)
+}
+
+func (o *NodesGetClassTenantsOK) GetPayload() *models.NodesTenantsStatusResponse {
+	return o.Payload
+}
+
+func (o *NodesGetClassTenantsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NodesTenantsStatusResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesGetClassTenantsUnauthorized creates a NodesGetClassTenantsUnauthorized with default headers values
+func NewNodesGetClassTenantsUnauthorized() *NodesGetClassTenantsUnauthorized {
+	return &NodesGetClassTenantsUnauthorized{}
+}
+
+/*
+NodesGetClassTenantsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type NodesGetClassTenantsUnauthorized struct {
+}
+
+// IsSuccess returns true when this nodes get class tenants unauthorized response has a 2xx status code
+func (o *NodesGetClassTenantsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes get class tenants unauthorized response has a 3xx status code
+func (o *NodesGetClassTenantsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes get class tenants unauthorized response has a 4xx status code
+func (o *NodesGetClassTenantsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes get class tenants unauthorized response has a 5xx status code
+func (o *NodesGetClassTenantsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes get class tenants unauthorized response a status code equal to that given
+func (o *NodesGetClassTenantsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the nodes get class tenants unauthorized response
+func (o *NodesGetClassTenantsUnauthorized) Code() int {
+	return 401
+}
+
+func (o *NodesGetClassTenantsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /nodes/{className}/tenants][%d] nodesGetClassTenantsUnauthorized ", 401)
+}
+
+func (o *NodesGetClassTenantsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /nodes/{className}/tenants][%d] nodesGetClassTenantsUnauthorized ", 401)
+}
+
+func (o *NodesGetClassTenantsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewNodesGetClassTenantsForbidden creates a NodesGetClassTenantsForbidden with default headers values
+func NewNodesGetClassTenantsForbidden() *NodesGetClassTenantsForbidden {
+	return &NodesGetClassTenantsForbidden{}
+}
+
+/*
+NodesGetClassTenantsForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type NodesGetClassTenantsForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes get class tenants forbidden response has a 2xx status code
+func (o *NodesGetClassTenantsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes get class tenants forbidden response has a 3xx status code
+func (o *NodesGetClassTenantsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes get class tenants forbidden response has a 4xx status code
+func (o *NodesGetClassTenantsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes get class tenants forbidden response has a 5xx status code
+func (o *NodesGetClassTenantsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes get class tenants forbidden response a status code equal to that given
+func (o *NodesGetClassTenantsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the nodes get class tenants forbidden response
+func (o *NodesGetClassTenantsForbidden) Code() int {
+	return 403
+}
+
+func (o *NodesGetClassTenantsForbidden) Error() string {
+	return fmt.Sprintf("[GET /nodes/{className}/tenants][%d] nodesGetClassTenantsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *NodesGetClassTenantsForbidden) String() string {
+	return fmt.Sprintf("[GET /nodes/{className}/tenants][%d] nodesGetClassTenantsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *NodesGetClassTenantsForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesGetClassTenantsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesGetClassTenantsNotFound creates a NodesGetClassTenantsNotFound with default headers values
+func NewNodesGetClassTenantsNotFound() *NodesGetClassTenantsNotFound {
+	return &NodesGetClassTenantsNotFound{}
+}
+
+/*
+NodesGetClassTenantsNotFound describes a response with status code 404, with default header values.
+
+Class or tenant not found
+*/
+type NodesGetClassTenantsNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes get class tenants not found response has a 2xx status code
+func (o *NodesGetClassTenantsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes get class tenants not found response has a 3xx status code
+func (o *NodesGetClassTenantsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes get class tenants not found response has a 4xx status code
+func (o *NodesGetClassTenantsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes get class tenants not found response has a 5xx status code
+func (o *NodesGetClassTenantsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes get class tenants not found response a status code equal to that given
+func (o *NodesGetClassTenantsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the nodes get class tenants not found response
+func (o *NodesGetClassTenantsNotFound) Code() int {
+	return 404
+}
+
+func (o *NodesGetClassTenantsNotFound) Error() string {
+	return fmt.Sprintf("[GET /nodes/{className}/tenants][%d] nodesGetClassTenantsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *NodesGetClassTenantsNotFound) String() string {
+	return fmt.Sprintf("[GET /nodes/{className}/tenants][%d] nodesGetClassTenantsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *NodesGetClassTenantsNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesGetClassTenantsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesGetClassTenantsUnprocessableEntity creates a NodesGetClassTenantsUnprocessableEntity with default headers values
+func NewNodesGetClassTenantsUnprocessableEntity() *NodesGetClassTenantsUnprocessableEntity {
+	return &NodesGetClassTenantsUnprocessableEntity{}
+}
+
+/*
+NodesGetClassTenantsUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid query, e.g. the class is not multi-tenant or the maximum number of results is exceeded
+*/
+type NodesGetClassTenantsUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes get class tenants unprocessable entity response has a 2xx status code
+func (o *NodesGetClassTenantsUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes get class tenants unprocessable entity response has a 3xx status code
+func (o *NodesGetClassTenantsUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes get class tenants unprocessable entity response has a 4xx status code
+func (o *NodesGetClassTenantsUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes get class tenants unprocessable entity response has a 5xx status code
+func (o *NodesGetClassTenantsUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes get class tenants unprocessable entity response a status code equal to that given
+func (o *NodesGetClassTenantsUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the nodes get class tenants unprocessable entity response
+func (o *NodesGetClassTenantsUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *NodesGetClassTenantsUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[GET /nodes/{className}/tenants][%d] nodesGetClassTenantsUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *NodesGetClassTenantsUnprocessableEntity) String() string {
+	return fmt.Sprintf("[GET /nodes/{className}/tenants][%d] nodesGetClassTenantsUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *NodesGetClassTenantsUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesGetClassTenantsUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesGetClassTenantsInternalServerError creates a NodesGetClassTenantsInternalServerError with default headers values
+func NewNodesGetClassTenantsInternalServerError() *NodesGetClassTenantsInternalServerError {
+	return &NodesGetClassTenantsInternalServerError{}
+}
+
+/*
+NodesGetClassTenantsInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type NodesGetClassTenantsInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes get class tenants internal server error response has a 2xx status code
+func (o *NodesGetClassTenantsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes get class tenants internal server error response has a 3xx status code
+func (o *NodesGetClassTenantsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes get class tenants internal server error response has a 4xx status code
+func (o *NodesGetClassTenantsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this nodes get class tenants internal server error response has a 5xx status code
+func (o *NodesGetClassTenantsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this nodes get class tenants internal server error response a status code equal to that given
+func (o *NodesGetClassTenantsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the nodes get class tenants internal server error response
+func (o *NodesGetClassTenantsInternalServerError) Code() int {
+	return 500
+}
+
+func (o *NodesGetClassTenantsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /nodes/{className}/tenants][%d] nodesGetClassTenantsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *NodesGetClassTenantsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /nodes/{className}/tenants][%d] nodesGetClassTenantsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *NodesGetClassTenantsInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesGetClassTenantsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewSchemaAliasesDeleteParams creates a new SchemaAliasesDeleteParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSchemaAliasesDeleteParams() *SchemaAliasesDeleteParams {
+	return &SchemaAliasesDeleteParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaAliasesDeleteParamsWithTimeout creates a new SchemaAliasesDeleteParams object
+// with the ability to set a timeout on a request.
+func NewSchemaAliasesDeleteParamsWithTimeout(timeout time.Duration) *SchemaAliasesDeleteParams {
+	return &SchemaAliasesDeleteParams{
+		timeout: timeout,
+	}
+}
+
+// NewSchemaAliasesDeleteParamsWithContext creates a new SchemaAliasesDeleteParams object
+// with the ability to set a context for a request.
+func NewSchemaAliasesDeleteParamsWithContext(ctx context.Context) *SchemaAliasesDeleteParams {
+	return &SchemaAliasesDeleteParams{
+		Context: ctx,
+	}
+}
+
+// NewSchemaAliasesDeleteParamsWithHTTPClient creates a new SchemaAliasesDeleteParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSchemaAliasesDeleteParamsWithHTTPClient(client *http.Client) *SchemaAliasesDeleteParams {
+	return &SchemaAliasesDeleteParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SchemaAliasesDeleteParams contains all the parameters to send to the API endpoint
+
+	for the schema aliases delete operation.
+
+	Typically these are written to a http.Request.
+*/
+type SchemaAliasesDeleteParams struct {
+
+	// AliasName.
+	AliasName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the schema aliases delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaAliasesDeleteParams) WithDefaults() *SchemaAliasesDeleteParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the schema aliases delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaAliasesDeleteParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the schema aliases delete params
+func (o *SchemaAliasesDeleteParams) WithTimeout(timeout time.Duration) *SchemaAliasesDeleteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema aliases delete params
+func (o *SchemaAliasesDeleteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema aliases delete params
+func (o *SchemaAliasesDeleteParams) WithContext(ctx context.Context) *SchemaAliasesDeleteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema aliases delete params
+func (o *SchemaAliasesDeleteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema aliases delete params
+func (o *SchemaAliasesDeleteParams) WithHTTPClient(client *http.Client) *SchemaAliasesDeleteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema aliases delete params
+func (o *SchemaAliasesDeleteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAliasName adds the aliasName to the schema aliases delete params
+func (o *SchemaAliasesDeleteParams) WithAliasName(aliasName string) *SchemaAliasesDeleteParams {
+	o.SetAliasName(aliasName)
+	return o
+}
+
+// SetAliasName adds the aliasName to the schema aliases delete params
+func (o *SchemaAliasesDeleteParams) SetAliasName(aliasName string) {
+	o.AliasName = aliasName
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaAliasesDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param aliasName
+	if err := r.SetPathParam("aliasName", o.AliasName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,374 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaAliasesDeleteReader is a Reader for the SchemaAliasesDelete structure.
+type SchemaAliasesDeleteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaAliasesDeleteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaAliasesDeleteOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaAliasesDeleteUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaAliasesDeleteForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaAliasesDeleteNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaAliasesDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaAliasesDeleteOK creates a SchemaAliasesDeleteOK with default headers values
+func NewSchemaAliasesDeleteOK() *SchemaAliasesDeleteOK {
+	return &SchemaAliasesDeleteOK{}
+}
+
+/*
+SchemaAliasesDeleteOK describes a response with status code 200, with default header values.
+
+Removed the alias.
+*/
+type SchemaAliasesDeleteOK struct {
+}
+
+// IsSuccess returns true when this schema aliases delete o k response has a 2xx status code
+func (o *SchemaAliasesDeleteOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema aliases delete o k response has a 3xx status code
+func (o *SchemaAliasesDeleteOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases delete o k response has a 4xx status code
+func (o *SchemaAliasesDeleteOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema aliases delete o k response has a 5xx status code
+func (o *SchemaAliasesDeleteOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema aliases delete o k response a status code equal to that given
+func (o *SchemaAliasesDeleteOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema aliases delete o k response
+func (o *SchemaAliasesDeleteOK) Code() int {
+	return 200
+}
+
+func (o *SchemaAliasesDeleteOK) Error() string {
+	return fmt.Sprintf("[DELETE /schema/aliases/{aliasName}][%d] schemaAliasesDeleteOK ", 200)
+}
+
+func (o *SchemaAliasesDeleteOK) String() string {
+	return fmt.Sprintf("[DELETE /schema/aliases/{aliasName}][%d] schemaAliasesDeleteOK ", 200)
+}
+
+func (o *SchemaAliasesDeleteOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaAliasesDeleteUnauthorized creates a SchemaAliasesDeleteUnauthorized with default headers values
+func NewSchemaAliasesDeleteUnauthorized() *SchemaAliasesDeleteUnauthorized {
+	return &SchemaAliasesDeleteUnauthorized{}
+}
+
+/*
+SchemaAliasesDeleteUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaAliasesDeleteUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema aliases delete unauthorized response has a 2xx status code
+func (o *SchemaAliasesDeleteUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema aliases delete unauthorized response has a 3xx status code
+func (o *SchemaAliasesDeleteUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases delete unauthorized response has a 4xx status code
+func (o *SchemaAliasesDeleteUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema aliases delete unauthorized response has a 5xx status code
+func (o *SchemaAliasesDeleteUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema aliases delete unauthorized response a status code equal to that given
+func (o *SchemaAliasesDeleteUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema aliases delete unauthorized response
+func (o *SchemaAliasesDeleteUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaAliasesDeleteUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /schema/aliases/{aliasName}][%d] schemaAliasesDeleteUnauthorized ", 401)
+}
+
+func (o *SchemaAliasesDeleteUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /schema/aliases/{aliasName}][%d] schemaAliasesDeleteUnauthorized ", 401)
+}
+
+func (o *SchemaAliasesDeleteUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaAliasesDeleteForbidden creates a SchemaAliasesDeleteForbidden with default headers values
+func NewSchemaAliasesDeleteForbidden() *SchemaAliasesDeleteForbidden {
+	return &SchemaAliasesDeleteForbidden{}
+}
+
+/*
+SchemaAliasesDeleteForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaAliasesDeleteForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema aliases delete forbidden response has a 2xx status code
+func (o *SchemaAliasesDeleteForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema aliases delete forbidden response has a 3xx status code
+func (o *SchemaAliasesDeleteForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases delete forbidden response has a 4xx status code
+func (o *SchemaAliasesDeleteForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema aliases delete forbidden response has a 5xx status code
+func (o *SchemaAliasesDeleteForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema aliases delete forbidden response a status code equal to that given
+func (o *SchemaAliasesDeleteForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema aliases delete forbidden response
+func (o *SchemaAliasesDeleteForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaAliasesDeleteForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /schema/aliases/{aliasName}][%d] schemaAliasesDeleteForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaAliasesDeleteForbidden) String() string {
+	return fmt.Sprintf("[DELETE /schema/aliases/{aliasName}][%d] schemaAliasesDeleteForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaAliasesDeleteForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaAliasesDeleteForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaAliasesDeleteNotFound creates a SchemaAliasesDeleteNotFound with default headers values
+func NewSchemaAliasesDeleteNotFound() *SchemaAliasesDeleteNotFound {
+	return &SchemaAliasesDeleteNotFound{}
+}
+
+/*
+SchemaAliasesDeleteNotFound describes a response with status code 404, with default header values.
+
+The alias does not exist.
+*/
+type SchemaAliasesDeleteNotFound struct {
+}
+
+// IsSuccess returns true when this schema aliases delete not found response has a 2xx status code
+func (o *SchemaAliasesDeleteNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema aliases delete not found response has a 3xx status code
+func (o *SchemaAliasesDeleteNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases delete not found response has a 4xx status code
+func (o *SchemaAliasesDeleteNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema aliases delete not found response has a 5xx status code
+func (o *SchemaAliasesDeleteNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema aliases delete not found response a status code equal to that given
+func (o *SchemaAliasesDeleteNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema aliases delete not found response
+func (o *SchemaAliasesDeleteNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaAliasesDeleteNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /schema/aliases/{aliasName}][%d] schemaAliasesDeleteNotFound ", 404)
+}
+
+func (o *SchemaAliasesDeleteNotFound) String() string {
+	return fmt.Sprintf("[DELETE /schema/aliases/{aliasName}][%d] schemaAliasesDeleteNotFound ", 404)
+}
+
+func (o *SchemaAliasesDeleteNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaAliasesDeleteInternalServerError creates a SchemaAliasesDeleteInternalServerError with default headers values
+func NewSchemaAliasesDeleteInternalServerError() *SchemaAliasesDeleteInternalServerError {
+	return &SchemaAliasesDeleteInternalServerError{}
+}
+
+/*
+SchemaAliasesDeleteInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaAliasesDeleteInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema aliases delete internal server error response has a 2xx status code
+func (o *SchemaAliasesDeleteInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema aliases delete internal server error response has a 3xx status code
+func (o *SchemaAliasesDeleteInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases delete internal server error response has a 4xx status code
+func (o *SchemaAliasesDeleteInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema aliases delete internal server error response has a 5xx status code
+func (o *SchemaAliasesDeleteInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema aliases delete internal server error response a status code equal to that given
+func (o *SchemaAliasesDeleteInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema aliases delete internal server error response
+func (o *SchemaAliasesDeleteInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaAliasesDeleteInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /schema/aliases/{aliasName}][%d] schemaAliasesDeleteInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaAliasesDeleteInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /schema/aliases/{aliasName}][%d] schemaAliasesDeleteInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaAliasesDeleteInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaAliasesDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
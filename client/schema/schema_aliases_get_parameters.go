@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewSchemaAliasesGetParams creates a new SchemaAliasesGetParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSchemaAliasesGetParams() *SchemaAliasesGetParams {
+	return &SchemaAliasesGetParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaAliasesGetParamsWithTimeout creates a new SchemaAliasesGetParams object
+// with the ability to set a timeout on a request.
+func NewSchemaAliasesGetParamsWithTimeout(timeout time.Duration) *SchemaAliasesGetParams {
+	return &SchemaAliasesGetParams{
+		timeout: timeout,
+	}
+}
+
+// NewSchemaAliasesGetParamsWithContext creates a new SchemaAliasesGetParams object
+// with the ability to set a context for a request.
+func NewSchemaAliasesGetParamsWithContext(ctx context.Context) *SchemaAliasesGetParams {
+	return &SchemaAliasesGetParams{
+		Context: ctx,
+	}
+}
+
+// NewSchemaAliasesGetParamsWithHTTPClient creates a new SchemaAliasesGetParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSchemaAliasesGetParamsWithHTTPClient(client *http.Client) *SchemaAliasesGetParams {
+	return &SchemaAliasesGetParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SchemaAliasesGetParams contains all the parameters to send to the API endpoint
+
+	for the schema aliases get operation.
+
+	Typically these are written to a http.Request.
+*/
+type SchemaAliasesGetParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the schema aliases get params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaAliasesGetParams) WithDefaults() *SchemaAliasesGetParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the schema aliases get params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaAliasesGetParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the schema aliases get params
+func (o *SchemaAliasesGetParams) WithTimeout(timeout time.Duration) *SchemaAliasesGetParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema aliases get params
+func (o *SchemaAliasesGetParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema aliases get params
+func (o *SchemaAliasesGetParams) WithContext(ctx context.Context) *SchemaAliasesGetParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema aliases get params
+func (o *SchemaAliasesGetParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema aliases get params
+func (o *SchemaAliasesGetParams) WithHTTPClient(client *http.Client) *SchemaAliasesGetParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema aliases get params
+func (o *SchemaAliasesGetParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaAliasesGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
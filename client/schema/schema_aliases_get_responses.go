@@ -0,0 +1,322 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaAliasesGetReader is a Reader for the SchemaAliasesGet structure.
+type SchemaAliasesGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaAliasesGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaAliasesGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaAliasesGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaAliasesGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaAliasesGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaAliasesGetOK creates a SchemaAliasesGetOK with default headers values
+func NewSchemaAliasesGetOK() *SchemaAliasesGetOK {
+	return &SchemaAliasesGetOK{}
+}
+
+/*
+SchemaAliasesGetOK describes a response with status code 200, with default header values.
+
+The aliases sorted by name.
+*/
+type SchemaAliasesGetOK struct {
+	Payload []*models.Alias
+}
+
+// IsSuccess returns true when this schema aliases get o k response has a 2xx status code
+func (o *SchemaAliasesGetOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema aliases get o k response has a 3xx status code
+func (o *SchemaAliasesGetOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases get o k response has a 4xx status code
+func (o *SchemaAliasesGetOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema aliases get o k response has a 5xx status code
+func (o *SchemaAliasesGetOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema aliases get o k response a status code equal to that given
+func (o *SchemaAliasesGetOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema aliases get o k response
+func (o *SchemaAliasesGetOK) Code() int {
+	return 200
+}
+
+func (o *SchemaAliasesGetOK) Error() string {
+	return fmt.Sprintf("[GET /schema/aliases][%d] schemaAliasesGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaAliasesGetOK) String() string {
+	return fmt.Sprintf("[GET /schema/aliases][%d] schemaAliasesGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaAliasesGetOK) GetPayload() []*models.Alias {
+	return o.Payload
+}
+
+func (o *SchemaAliasesGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaAliasesGetUnauthorized creates a SchemaAliasesGetUnauthorized with default headers values
+func NewSchemaAliasesGetUnauthorized() *SchemaAliasesGetUnauthorized {
+	return &SchemaAliasesGetUnauthorized{}
+}
+
+/*
+SchemaAliasesGetUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaAliasesGetUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema aliases get unauthorized response has a 2xx status code
+func (o *SchemaAliasesGetUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema aliases get unauthorized response has a 3xx status code
+func (o *SchemaAliasesGetUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases get unauthorized response has a 4xx status code
+func (o *SchemaAliasesGetUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema aliases get unauthorized response has a 5xx status code
+func (o *SchemaAliasesGetUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema aliases get unauthorized response a status code equal to that given
+func (o *SchemaAliasesGetUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema aliases get unauthorized response
+func (o *SchemaAliasesGetUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaAliasesGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/aliases][%d] schemaAliasesGetUnauthorized ", 401)
+}
+
+func (o *SchemaAliasesGetUnauthorized) String() string {
+	return fmt.Sprintf("[GET /schema/aliases][%d] schemaAliasesGetUnauthorized ", 401)
+}
+
+func (o *SchemaAliasesGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaAliasesGetForbidden creates a SchemaAliasesGetForbidden with default headers values
+func NewSchemaAliasesGetForbidden() *SchemaAliasesGetForbidden {
+	return &SchemaAliasesGetForbidden{}
+}
+
+/*
+SchemaAliasesGetForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaAliasesGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema aliases get forbidden response has a 2xx status code
+func (o *SchemaAliasesGetForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema aliases get forbidden response has a 3xx status code
+func (o *SchemaAliasesGetForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases get forbidden response has a 4xx status code
+func (o *SchemaAliasesGetForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema aliases get forbidden response has a 5xx status code
+func (o *SchemaAliasesGetForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema aliases get forbidden response a status code equal to that given
+func (o *SchemaAliasesGetForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema aliases get forbidden response
+func (o *SchemaAliasesGetForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaAliasesGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/aliases][%d] schemaAliasesGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaAliasesGetForbidden) String() string {
+	return fmt.Sprintf("[GET /schema/aliases][%d] schemaAliasesGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaAliasesGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaAliasesGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaAliasesGetInternalServerError creates a SchemaAliasesGetInternalServerError with default headers values
+func NewSchemaAliasesGetInternalServerError() *SchemaAliasesGetInternalServerError {
+	return &SchemaAliasesGetInternalServerError{}
+}
+
+/*
+SchemaAliasesGetInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaAliasesGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema aliases get internal server error response has a 2xx status code
+func (o *SchemaAliasesGetInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema aliases get internal server error response has a 3xx status code
+func (o *SchemaAliasesGetInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases get internal server error response has a 4xx status code
+func (o *SchemaAliasesGetInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema aliases get internal server error response has a 5xx status code
+func (o *SchemaAliasesGetInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema aliases get internal server error response a status code equal to that given
+func (o *SchemaAliasesGetInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema aliases get internal server error response
+func (o *SchemaAliasesGetInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaAliasesGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/aliases][%d] schemaAliasesGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaAliasesGetInternalServerError) String() string {
+	return fmt.Sprintf("[GET /schema/aliases][%d] schemaAliasesGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaAliasesGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaAliasesGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewSchemaAliasesPutParams creates a new SchemaAliasesPutParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSchemaAliasesPutParams() *SchemaAliasesPutParams {
+	return &SchemaAliasesPutParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaAliasesPutParamsWithTimeout creates a new SchemaAliasesPutParams object
+// with the ability to set a timeout on a request.
+func NewSchemaAliasesPutParamsWithTimeout(timeout time.Duration) *SchemaAliasesPutParams {
+	return &SchemaAliasesPutParams{
+		timeout: timeout,
+	}
+}
+
+// NewSchemaAliasesPutParamsWithContext creates a new SchemaAliasesPutParams object
+// with the ability to set a context for a request.
+func NewSchemaAliasesPutParamsWithContext(ctx context.Context) *SchemaAliasesPutParams {
+	return &SchemaAliasesPutParams{
+		Context: ctx,
+	}
+}
+
+// NewSchemaAliasesPutParamsWithHTTPClient creates a new SchemaAliasesPutParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSchemaAliasesPutParamsWithHTTPClient(client *http.Client) *SchemaAliasesPutParams {
+	return &SchemaAliasesPutParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SchemaAliasesPutParams contains all the parameters to send to the API endpoint
+
+	for the schema aliases put operation.
+
+	Typically these are written to a http.Request.
+*/
+type SchemaAliasesPutParams struct {
+
+	// AliasName.
+	AliasName string
+
+	// Body.
+	Body *models.Alias
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the schema aliases put params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaAliasesPutParams) WithDefaults() *SchemaAliasesPutParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the schema aliases put params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaAliasesPutParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the schema aliases put params
+func (o *SchemaAliasesPutParams) WithTimeout(timeout time.Duration) *SchemaAliasesPutParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema aliases put params
+func (o *SchemaAliasesPutParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema aliases put params
+func (o *SchemaAliasesPutParams) WithContext(ctx context.Context) *SchemaAliasesPutParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema aliases put params
+func (o *SchemaAliasesPutParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema aliases put params
+func (o *SchemaAliasesPutParams) WithHTTPClient(client *http.Client) *SchemaAliasesPutParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema aliases put params
+func (o *SchemaAliasesPutParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAliasName adds the aliasName to the schema aliases put params
+func (o *SchemaAliasesPutParams) WithAliasName(aliasName string) *SchemaAliasesPutParams {
+	o.SetAliasName(aliasName)
+	return o
+}
+
+// SetAliasName adds the aliasName to the schema aliases put params
+func (o *SchemaAliasesPutParams) SetAliasName(aliasName string) {
+	o.AliasName = aliasName
+}
+
+// WithBody adds the body to the schema aliases put params
+func (o *SchemaAliasesPutParams) WithBody(body *models.Alias) *SchemaAliasesPutParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the schema aliases put params
+func (o *SchemaAliasesPutParams) SetBody(body *models.Alias) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaAliasesPutParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param aliasName
+	if err := r.SetPathParam("aliasName", o.AliasName); err != nil {
+		return err
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaAliasesPutReader is a Reader for the SchemaAliasesPut structure.
+type SchemaAliasesPutReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaAliasesPutReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaAliasesPutOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaAliasesPutUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaAliasesPutForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaAliasesPutUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaAliasesPutInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaAliasesPutOK creates a SchemaAliasesPutOK with default headers values
+func NewSchemaAliasesPutOK() *SchemaAliasesPutOK {
+	return &SchemaAliasesPutOK{}
+}
+
+/*
+SchemaAliasesPutOK describes a response with status code 200, with default header values.
+
+The alias points to the class.
+*/
+type SchemaAliasesPutOK struct {
+	Payload *models.Alias
+}
+
+// IsSuccess returns true when this schema aliases put o k response has a 2xx status code
+func (o *SchemaAliasesPutOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema aliases put o k response has a 3xx status code
+func (o *SchemaAliasesPutOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases put o k response has a 4xx status code
+func (o *SchemaAliasesPutOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema aliases put o k response has a 5xx status code
+func (o *SchemaAliasesPutOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema aliases put o k response a status code equal to that given
+func (o *SchemaAliasesPutOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema aliases put o k response
+func (o *SchemaAliasesPutOK) Code() int {
+	return 200
+}
+
+func (o *SchemaAliasesPutOK) Error() string {
+	return fmt.Sprintf("[PUT /schema/aliases/{aliasName}][%d] schemaAliasesPutOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaAliasesPutOK) String() string {
+	return fmt.Sprintf("[PUT /schema/aliases/{aliasName}][%d] schemaAliasesPutOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaAliasesPutOK) GetPayload() *models.Alias {
+	return o.Payload
+}
+
+func (o *SchemaAliasesPutOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Alias)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaAliasesPutUnauthorized creates a SchemaAliasesPutUnauthorized with default headers values
+func NewSchemaAliasesPutUnauthorized() *SchemaAliasesPutUnauthorized {
+	return &SchemaAliasesPutUnauthorized{}
+}
+
+/*
+SchemaAliasesPutUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaAliasesPutUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema aliases put unauthorized response has a 2xx status code
+func (o *SchemaAliasesPutUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema aliases put unauthorized response has a 3xx status code
+func (o *SchemaAliasesPutUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases put unauthorized response has a 4xx status code
+func (o *SchemaAliasesPutUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema aliases put unauthorized response has a 5xx status code
+func (o *SchemaAliasesPutUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema aliases put unauthorized response a status code equal to that given
+func (o *SchemaAliasesPutUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema aliases put unauthorized response
+func (o *SchemaAliasesPutUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaAliasesPutUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /schema/aliases/{aliasName}][%d] schemaAliasesPutUnauthorized ", 401)
+}
+
+func (o *SchemaAliasesPutUnauthorized) String() string {
+	return fmt.Sprintf("[PUT /schema/aliases/{aliasName}][%d] schemaAliasesPutUnauthorized ", 401)
+}
+
+func (o *SchemaAliasesPutUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaAliasesPutForbidden creates a SchemaAliasesPutForbidden with default headers values
+func NewSchemaAliasesPutForbidden() *SchemaAliasesPutForbidden {
+	return &SchemaAliasesPutForbidden{}
+}
+
+/*
+SchemaAliasesPutForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaAliasesPutForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema aliases put forbidden response has a 2xx status code
+func (o *SchemaAliasesPutForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema aliases put forbidden response has a 3xx status code
+func (o *SchemaAliasesPutForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases put forbidden response has a 4xx status code
+func (o *SchemaAliasesPutForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema aliases put forbidden response has a 5xx status code
+func (o *SchemaAliasesPutForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema aliases put forbidden response a status code equal to that given
+func (o *SchemaAliasesPutForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema aliases put forbidden response
+func (o *SchemaAliasesPutForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaAliasesPutForbidden) Error() string {
+	return fmt.Sprintf("[PUT /schema/aliases/{aliasName}][%d] schemaAliasesPutForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaAliasesPutForbidden) String() string {
+	return fmt.Sprintf("[PUT /schema/aliases/{aliasName}][%d] schemaAliasesPutForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaAliasesPutForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaAliasesPutForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaAliasesPutUnprocessableEntity creates a SchemaAliasesPutUnprocessableEntity with default headers values
+func NewSchemaAliasesPutUnprocessableEntity() *SchemaAliasesPutUnprocessableEntity {
+	return &SchemaAliasesPutUnprocessableEntity{}
+}
+
+/*
+SchemaAliasesPutUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid alias, e.g. the class does not exist or the alias conflicts with a class name.
+*/
+type SchemaAliasesPutUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema aliases put unprocessable entity response has a 2xx status code
+func (o *SchemaAliasesPutUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema aliases put unprocessable entity response has a 3xx status code
+func (o *SchemaAliasesPutUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases put unprocessable entity response has a 4xx status code
+func (o *SchemaAliasesPutUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema aliases put unprocessable entity response has a 5xx status code
+func (o *SchemaAliasesPutUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema aliases put unprocessable entity response a status code equal to that given
+func (o *SchemaAliasesPutUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema aliases put unprocessable entity response
+func (o *SchemaAliasesPutUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaAliasesPutUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /schema/aliases/{aliasName}][%d] schemaAliasesPutUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaAliasesPutUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PUT /schema/aliases/{aliasName}][%d] schemaAliasesPutUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaAliasesPutUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaAliasesPutUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaAliasesPutInternalServerError creates a SchemaAliasesPutInternalServerError with default headers values
+func NewSchemaAliasesPutInternalServerError() *SchemaAliasesPutInternalServerError {
+	return &SchemaAliasesPutInternalServerError{}
+}
+
+/*
+SchemaAliasesPutInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaAliasesPutInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema aliases put internal server error response has a 2xx status code
+func (o *SchemaAliasesPutInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema aliases put internal server error response has a 3xx status code
+func (o *SchemaAliasesPutInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema aliases put internal server error response has a 4xx status code
+func (o *SchemaAliasesPutInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema aliases put internal server error response has a 5xx status code
+func (o *SchemaAliasesPutInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema aliases put internal server error response a status code equal to that given
+func (o *SchemaAliasesPutInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema aliases put internal server error response
+func (o *SchemaAliasesPutInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaAliasesPutInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /schema/aliases/{aliasName}][%d] schemaAliasesPutInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaAliasesPutInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /schema/aliases/{aliasName}][%d] schemaAliasesPutInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaAliasesPutInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaAliasesPutInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewSchemaObjectsPropertiesDeleteParams creates a new SchemaObjectsPropertiesDeleteParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSchemaObjectsPropertiesDeleteParams() *SchemaObjectsPropertiesDeleteParams {
+	return &SchemaObjectsPropertiesDeleteParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaObjectsPropertiesDeleteParamsWithTimeout creates a new SchemaObjectsPropertiesDeleteParams object
+// with the ability to set a timeout on a request.
+func NewSchemaObjectsPropertiesDeleteParamsWithTimeout(timeout time.Duration) *SchemaObjectsPropertiesDeleteParams {
+	return &SchemaObjectsPropertiesDeleteParams{
+		timeout: timeout,
+	}
+}
+
+// NewSchemaObjectsPropertiesDeleteParamsWithContext creates a new SchemaObjectsPropertiesDeleteParams object
+// with the ability to set a context for a request.
+func NewSchemaObjectsPropertiesDeleteParamsWithContext(ctx context.Context) *SchemaObjectsPropertiesDeleteParams {
+	return &SchemaObjectsPropertiesDeleteParams{
+		Context: ctx,
+	}
+}
+
+// NewSchemaObjectsPropertiesDeleteParamsWithHTTPClient creates a new SchemaObjectsPropertiesDeleteParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSchemaObjectsPropertiesDeleteParamsWithHTTPClient(client *http.Client) *SchemaObjectsPropertiesDeleteParams {
+	return &SchemaObjectsPropertiesDeleteParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SchemaObjectsPropertiesDeleteParams contains all the parameters to send to the API endpoint
+
+	for the schema objects properties delete operation.
+
+	Typically these are written to a http.Request.
+*/
+type SchemaObjectsPropertiesDeleteParams struct {
+
+	// ClassName.
+	ClassName string
+
+	// PropertyName.
+	PropertyName string
+
+	/* Purge.
+
+	   Remove the stored values of the property from all objects before responding.
+	*/
+	Purge *bool
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the schema objects properties delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsPropertiesDeleteParams) WithDefaults() *SchemaObjectsPropertiesDeleteParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the schema objects properties delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsPropertiesDeleteParams) SetDefaults() {
+	var (
+		purgeDefault = bool(false)
+	)
+
+	val := SchemaObjectsPropertiesDeleteParams{
+		Purge: &purgeDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the schema objects properties delete params
+func (o *SchemaObjectsPropertiesDeleteParams) WithTimeout(timeout time.Duration) *SchemaObjectsPropertiesDeleteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema objects properties delete params
+func (o *SchemaObjectsPropertiesDeleteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema objects properties delete params
+func (o *SchemaObjectsPropertiesDeleteParams) WithContext(ctx context.Context) *SchemaObjectsPropertiesDeleteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema objects properties delete params
+func (o *SchemaObjectsPropertiesDeleteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema objects properties delete params
+func (o *SchemaObjectsPropertiesDeleteParams) WithHTTPClient(client *http.Client) *SchemaObjectsPropertiesDeleteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema objects properties delete params
+func (o *SchemaObjectsPropertiesDeleteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClassName adds the className to the schema objects properties delete params
+func (o *SchemaObjectsPropertiesDeleteParams) WithClassName(className string) *SchemaObjectsPropertiesDeleteParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the schema objects properties delete params
+func (o *SchemaObjectsPropertiesDeleteParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WithPropertyName adds the propertyName to the schema objects properties delete params
+func (o *SchemaObjectsPropertiesDeleteParams) WithPropertyName(propertyName string) *SchemaObjectsPropertiesDeleteParams {
+	o.SetPropertyName(propertyName)
+	return o
+}
+
+// SetPropertyName adds the propertyName to the schema objects properties delete params
+func (o *SchemaObjectsPropertiesDeleteParams) SetPropertyName(propertyName string) {
+	o.PropertyName = propertyName
+}
+
+// WithPurge adds the purge to the schema objects properties delete params
+func (o *SchemaObjectsPropertiesDeleteParams) WithPurge(purge *bool) *SchemaObjectsPropertiesDeleteParams {
+	o.SetPurge(purge)
+	return o
+}
+
+// SetPurge adds the purge to the schema objects properties delete params
+func (o *SchemaObjectsPropertiesDeleteParams) SetPurge(purge *bool) {
+	o.Purge = purge
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaObjectsPropertiesDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	// path param propertyName
+	if err := r.SetPathParam("propertyName", o.PropertyName); err != nil {
+		return err
+	}
+
+	if o.Purge != nil {
+
+		// query param purge
+		var qrPurge bool
+
+		if o.Purge != nil {
+			qrPurge = *o.Purge
+		}
+		qPurge := swag.FormatBool(qrPurge)
+		if qPurge != "" {
+
+			if err := r.SetQueryParam("purge", qPurge); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,386 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsPropertiesDeleteReader is a Reader for the SchemaObjectsPropertiesDelete structure.
+type SchemaObjectsPropertiesDeleteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsPropertiesDeleteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsPropertiesDeleteOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsPropertiesDeleteUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsPropertiesDeleteForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsPropertiesDeleteUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsPropertiesDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsPropertiesDeleteOK creates a SchemaObjectsPropertiesDeleteOK with default headers values
+func NewSchemaObjectsPropertiesDeleteOK() *SchemaObjectsPropertiesDeleteOK {
+	return &SchemaObjectsPropertiesDeleteOK{}
+}
+
+/*
+SchemaObjectsPropertiesDeleteOK describes a response with status code 200, with default header values.
+
+Removed the property.
+*/
+type SchemaObjectsPropertiesDeleteOK struct {
+}
+
+// IsSuccess returns true when this schema objects properties delete o k response has a 2xx status code
+func (o *SchemaObjectsPropertiesDeleteOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects properties delete o k response has a 3xx status code
+func (o *SchemaObjectsPropertiesDeleteOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties delete o k response has a 4xx status code
+func (o *SchemaObjectsPropertiesDeleteOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects properties delete o k response has a 5xx status code
+func (o *SchemaObjectsPropertiesDeleteOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties delete o k response a status code equal to that given
+func (o *SchemaObjectsPropertiesDeleteOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects properties delete o k response
+func (o *SchemaObjectsPropertiesDeleteOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsPropertiesDeleteOK) Error() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesDeleteOK ", 200)
+}
+
+func (o *SchemaObjectsPropertiesDeleteOK) String() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesDeleteOK ", 200)
+}
+
+func (o *SchemaObjectsPropertiesDeleteOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsPropertiesDeleteUnauthorized creates a SchemaObjectsPropertiesDeleteUnauthorized with default headers values
+func NewSchemaObjectsPropertiesDeleteUnauthorized() *SchemaObjectsPropertiesDeleteUnauthorized {
+	return &SchemaObjectsPropertiesDeleteUnauthorized{}
+}
+
+/*
+SchemaObjectsPropertiesDeleteUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsPropertiesDeleteUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects properties delete unauthorized response has a 2xx status code
+func (o *SchemaObjectsPropertiesDeleteUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties delete unauthorized response has a 3xx status code
+func (o *SchemaObjectsPropertiesDeleteUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties delete unauthorized response has a 4xx status code
+func (o *SchemaObjectsPropertiesDeleteUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects properties delete unauthorized response has a 5xx status code
+func (o *SchemaObjectsPropertiesDeleteUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties delete unauthorized response a status code equal to that given
+func (o *SchemaObjectsPropertiesDeleteUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects properties delete unauthorized response
+func (o *SchemaObjectsPropertiesDeleteUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsPropertiesDeleteUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesDeleteUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsPropertiesDeleteUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesDeleteUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsPropertiesDeleteUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsPropertiesDeleteForbidden creates a SchemaObjectsPropertiesDeleteForbidden with default headers values
+func NewSchemaObjectsPropertiesDeleteForbidden() *SchemaObjectsPropertiesDeleteForbidden {
+	return &SchemaObjectsPropertiesDeleteForbidden{}
+}
+
+/*
+SchemaObjectsPropertiesDeleteForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsPropertiesDeleteForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects properties delete forbidden response has a 2xx status code
+func (o *SchemaObjectsPropertiesDeleteForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties delete forbidden response has a 3xx status code
+func (o *SchemaObjectsPropertiesDeleteForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties delete forbidden response has a 4xx status code
+func (o *SchemaObjectsPropertiesDeleteForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects properties delete forbidden response has a 5xx status code
+func (o *SchemaObjectsPropertiesDeleteForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties delete forbidden response a status code equal to that given
+func (o *SchemaObjectsPropertiesDeleteForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects properties delete forbidden response
+func (o *SchemaObjectsPropertiesDeleteForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsPropertiesDeleteForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesDeleteForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesDeleteForbidden) String() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesDeleteForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesDeleteForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPropertiesDeleteForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsPropertiesDeleteUnprocessableEntity creates a SchemaObjectsPropertiesDeleteUnprocessableEntity with default headers values
+func NewSchemaObjectsPropertiesDeleteUnprocessableEntity() *SchemaObjectsPropertiesDeleteUnprocessableEntity {
+	return &SchemaObjectsPropertiesDeleteUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsPropertiesDeleteUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid property, e.g. the property does not exist.
+*/
+type SchemaObjectsPropertiesDeleteUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects properties delete unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsPropertiesDeleteUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties delete unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsPropertiesDeleteUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties delete unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsPropertiesDeleteUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects properties delete unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsPropertiesDeleteUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties delete unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsPropertiesDeleteUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects properties delete unprocessable entity response
+func (o *SchemaObjectsPropertiesDeleteUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsPropertiesDeleteUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesDeleteUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesDeleteUnprocessableEntity) String() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesDeleteUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesDeleteUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPropertiesDeleteUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsPropertiesDeleteInternalServerError creates a SchemaObjectsPropertiesDeleteInternalServerError with default headers values
+func NewSchemaObjectsPropertiesDeleteInternalServerError() *SchemaObjectsPropertiesDeleteInternalServerError {
+	return &SchemaObjectsPropertiesDeleteInternalServerError{}
+}
+
+/*
+SchemaObjectsPropertiesDeleteInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsPropertiesDeleteInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects properties delete internal server error response has a 2xx status code
+func (o *SchemaObjectsPropertiesDeleteInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties delete internal server error response has a 3xx status code
+func (o *SchemaObjectsPropertiesDeleteInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties delete internal server error response has a 4xx status code
+func (o *SchemaObjectsPropertiesDeleteInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects properties delete internal server error response has a 5xx status code
+func (o *SchemaObjectsPropertiesDeleteInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects properties delete internal server error response a status code equal to that given
+func (o *SchemaObjectsPropertiesDeleteInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects properties delete internal server error response
+func (o *SchemaObjectsPropertiesDeleteInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsPropertiesDeleteInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesDeleteInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesDeleteInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesDeleteInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesDeleteInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPropertiesDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsPropertiesUpdateReader is a Reader for the SchemaObjectsPropertiesUpdate structure.
+type SchemaObjectsPropertiesUpdateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsPropertiesUpdateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsPropertiesUpdateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsPropertiesUpdateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsPropertiesUpdateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsPropertiesUpdateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsPropertiesUpdateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsPropertiesUpdateOK creates a SchemaObjectsPropertiesUpdateOK with default headers values
+func NewSchemaObjectsPropertiesUpdateOK() *SchemaObjectsPropertiesUpdateOK {
+	return &SchemaObjectsPropertiesUpdateOK{}
+}
+
+/*
+SchemaObjectsPropertiesUpdateOK describes a response with status code 200, with default header values.
+
+Updated the property, reindexing was started.
+*/
+type SchemaObjectsPropertiesUpdateOK struct {
+	Payload *models.Property
+}
+
+// IsSuccess returns true when this schema objects properties update o k response has a 2xx status code
+func (o *SchemaObjectsPropertiesUpdateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects properties update o k response has a 3xx status code
+func (o *SchemaObjectsPropertiesUpdateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties update o k response has a 4xx status code
+func (o *SchemaObjectsPropertiesUpdateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects properties update o k response has a 5xx status code
+func (o *SchemaObjectsPropertiesUpdateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties update o k response a status code equal to that given
+func (o *SchemaObjectsPropertiesUpdateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects properties update o k response
+func (o *SchemaObjectsPropertiesUpdateOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsPropertiesUpdateOK) Error() string {
+	return fmt.Sprintf("[PUT /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesUpdateOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesUpdateOK) String() string {
+	return fmt.Sprintf("[PUT /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesUpdateOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesUpdateOK) GetPayload() *models.Property {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPropertiesUpdateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Property)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsPropertiesUpdateUnauthorized creates a SchemaObjectsPropertiesUpdateUnauthorized with default headers values
+func NewSchemaObjectsPropertiesUpdateUnauthorized() *SchemaObjectsPropertiesUpdateUnauthorized {
+	return &SchemaObjectsPropertiesUpdateUnauthorized{}
+}
+
+/*
+SchemaObjectsPropertiesUpdateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsPropertiesUpdateUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects properties update unauthorized response has a 2xx status code
+func (o *SchemaObjectsPropertiesUpdateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties update unauthorized response has a 3xx status code
+func (o *SchemaObjectsPropertiesUpdateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties update unauthorized response has a 4xx status code
+func (o *SchemaObjectsPropertiesUpdateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects properties update unauthorized response has a 5xx status code
+func (o *SchemaObjectsPropertiesUpdateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties update unauthorized response a status code equal to that given
+func (o *SchemaObjectsPropertiesUpdateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects properties update unauthorized response
+func (o *SchemaObjectsPropertiesUpdateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsPropertiesUpdateUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesUpdateUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsPropertiesUpdateUnauthorized) String() string {
+	return fmt.Sprintf("[PUT /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesUpdateUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsPropertiesUpdateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsPropertiesUpdateForbidden creates a SchemaObjectsPropertiesUpdateForbidden with default headers values
+func NewSchemaObjectsPropertiesUpdateForbidden() *SchemaObjectsPropertiesUpdateForbidden {
+	return &SchemaObjectsPropertiesUpdateForbidden{}
+}
+
+/*
+SchemaObjectsPropertiesUpdateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsPropertiesUpdateForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects properties update forbidden response has a 2xx status code
+func (o *SchemaObjectsPropertiesUpdateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties update forbidden response has a 3xx status code
+func (o *SchemaObjectsPropertiesUpdateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties update forbidden response has a 4xx status code
+func (o *SchemaObjectsPropertiesUpdateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects properties update forbidden response has a 5xx status code
+func (o *SchemaObjectsPropertiesUpdateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties update forbidden response a status code equal to that given
+func (o *SchemaObjectsPropertiesUpdateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects properties update forbidden response
+func (o *SchemaObjectsPropertiesUpdateForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsPropertiesUpdateForbidden) Error() string {
+	return fmt.Sprintf("[PUT /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesUpdateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesUpdateForbidden) String() string {
+	return fmt.Sprintf("[PUT /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesUpdateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesUpdateForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPropertiesUpdateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsPropertiesUpdateUnprocessableEntity creates a SchemaObjectsPropertiesUpdateUnprocessableEntity with default headers values
+func NewSchemaObjectsPropertiesUpdateUnprocessableEntity() *SchemaObjectsPropertiesUpdateUnprocessableEntity {
+	return &SchemaObjectsPropertiesUpdateUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsPropertiesUpdateUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid property, e.g. the property does not exist or the settings are not valid for its data type.
+*/
+type SchemaObjectsPropertiesUpdateUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects properties update unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsPropertiesUpdateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties update unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsPropertiesUpdateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties update unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsPropertiesUpdateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects properties update unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsPropertiesUpdateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects properties update unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsPropertiesUpdateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects properties update unprocessable entity response
+func (o *SchemaObjectsPropertiesUpdateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsPropertiesUpdateUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesUpdateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesUpdateUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PUT /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesUpdateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesUpdateUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPropertiesUpdateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsPropertiesUpdateInternalServerError creates a SchemaObjectsPropertiesUpdateInternalServerError with default headers values
+func NewSchemaObjectsPropertiesUpdateInternalServerError() *SchemaObjectsPropertiesUpdateInternalServerError {
+	return &SchemaObjectsPropertiesUpdateInternalServerError{}
+}
+
+/*
+SchemaObjectsPropertiesUpdateInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsPropertiesUpdateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects properties update internal server error response has a 2xx status code
+func (o *SchemaObjectsPropertiesUpdateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects properties update internal server error response has a 3xx status code
+func (o *SchemaObjectsPropertiesUpdateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects properties update internal server error response has a 4xx status code
+func (o *SchemaObjectsPropertiesUpdateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects properties update internal server error response has a 5xx status code
+func (o *SchemaObjectsPropertiesUpdateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects properties update internal server error response a status code equal to that given
+func (o *SchemaObjectsPropertiesUpdateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects properties update internal server error response
+func (o *SchemaObjectsPropertiesUpdateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsPropertiesUpdateInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesUpdateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesUpdateInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /schema/{className}/properties/{propertyName}][%d] schemaObjectsPropertiesUpdateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsPropertiesUpdateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsPropertiesUpdateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -31,6 +31,7 @@ type Properties struct {
 	LastUpdateTimeUnix bool                   `json:"lastUpdateTimeUnix"`
 	ModuleParams       map[string]interface{} `json:"moduleParams"`
 	Distance           bool                   `json:"distance"`
+	CompressedDistance bool                   `json:"compressedDistance"`
 	Score              bool                   `json:"score"`
 	ExplainScore       bool                   `json:"explainScore"`
 	IsConsistent       bool                   `json:"isConsistent"`
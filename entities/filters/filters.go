@@ -13,6 +13,7 @@ package filters
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -33,6 +34,8 @@ const (
 	OperatorWithinGeoRange
 	OperatorLike
 	OperatorIsNull
+	OperatorContainsAny
+	OperatorContainsAll
 )
 
 func (o Operator) OnValue() bool {
@@ -45,7 +48,9 @@ func (o Operator) OnValue() bool {
 		OperatorLessThanEqual,
 		OperatorWithinGeoRange,
 		OperatorLike,
-		OperatorIsNull:
+		OperatorIsNull,
+		OperatorContainsAny,
+		OperatorContainsAll:
 		return true
 	default:
 		return false
@@ -78,11 +83,21 @@ func (o Operator) Name() string {
 		return "Like"
 	case OperatorIsNull:
 		return "IsNull"
+	case OperatorContainsAny:
+		return "ContainsAny"
+	case OperatorContainsAll:
+		return "ContainsAll"
 	default:
 		panic("Unknown operator")
 	}
 }
 
+// IsContains is true for the operators that match a property against a list
+// of values instead of a single one
+func (o Operator) IsContains() bool {
+	return o == OperatorContainsAny || o == OperatorContainsAll
+}
+
 type LocalFilter struct {
 	Root *Clause `json:"root"`
 }
@@ -110,6 +125,19 @@ func (v *Value) UnmarshalJSON(data []byte) error {
 		v.Value = int(asFloat)
 	}
 
+	asSlice, ok := v.Value.([]interface{})
+	if v.Type == schema.DataTypeIntArray && ok {
+		asInts := make([]int, len(asSlice))
+		for i := range asSlice {
+			asFloat, ok := asSlice[i].(float64)
+			if !ok {
+				return fmt.Errorf("expected int array element, got %T", asSlice[i])
+			}
+			asInts[i] = int(asFloat)
+		}
+		v.Value = asInts
+	}
+
 	return nil
 }
 
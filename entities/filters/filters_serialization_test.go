@@ -50,6 +50,21 @@ func TestSerializeValue(t *testing.T) {
 		err = json.Unmarshal(bytes, &after)
 		require.Nil(t, err)
 
+		assert.Equal(t, before, after)
+	})
+	t.Run("with an int array value", func(t *testing.T) {
+		before := Value{
+			Value: []int{3, 4},
+			Type:  schema.DataTypeIntArray,
+		}
+
+		bytes, err := json.Marshal(before)
+		require.Nil(t, err)
+
+		var after Value
+		err = json.Unmarshal(bytes, &after)
+		require.Nil(t, err)
+
 		assert.Equal(t, before, after)
 	})
 }
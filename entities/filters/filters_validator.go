@@ -58,6 +58,10 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 
 	// validate current
 
+	if err := validateContainsValue(cw); err != nil {
+		return err
+	}
+
 	className := cw.getClassName()
 	propName := cw.getPropertyName()
 
@@ -82,6 +86,12 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 		return err
 	}
 
+	if _, ok := schema.AsNested(prop.DataType); ok {
+		return errors.Errorf("Property %q is an object prop. Filter on one of its "+
+			"nested props instead, using a path in the form of \"%s.<nestedPropName>\"",
+			propName, propName)
+	}
+
 	if cw.getOperator() == OperatorIsNull {
 		if !cw.isType(schema.DataTypeBoolean) {
 			return errors.Errorf("operator IsNull requires a booleanValue, got %q instead",
@@ -142,6 +152,23 @@ func validateClause(sch schema.Schema, cw *clauseWrapper) error {
 	return nil
 }
 
+// validateContainsValue makes sure array values are used with the
+// ContainsAny/ContainsAll operators and only with them
+func validateContainsValue(cw *clauseWrapper) error {
+	op := cw.getOperator()
+	_, isArray := schema.IsArrayType(cw.origType)
+
+	if op.IsContains() && !isArray {
+		return errors.Errorf("operator %q requires an array value, e.g. %q, got %q instead",
+			op.Name(), valueNameFromDataType(schema.DataTypeTextArray), cw.getValueNameFromType())
+	}
+	if !op.IsContains() && isArray {
+		return errors.Errorf("%q can only be used with operators %q and %q",
+			cw.getValueNameFromType(), OperatorContainsAny.Name(), OperatorContainsAll.Name())
+	}
+	return nil
+}
+
 func valueNameFromDataType(dt schema.DataType) string {
 	return "value" + strings.ToUpper(string(dt[0])) + string(dt[1:])
 }
@@ -205,7 +232,7 @@ func validateUUIDOperators(propName schema.PropertyName, cw *clauseWrapper) erro
 
 	switch op {
 	case OperatorEqual, OperatorNotEqual, OperatorLessThan, OperatorLessThanEqual,
-		OperatorGreaterThan, OperatorGreaterThanEqual:
+		OperatorGreaterThan, OperatorGreaterThanEqual, OperatorContainsAny, OperatorContainsAll:
 		return nil
 	default:
 		return fmt.Errorf("operator %q cannot be used on uuid/uuid[] props", op.Name())
@@ -237,7 +264,14 @@ func (w *clauseWrapper) isType(dt schema.DataType) bool {
 	if w.operands != nil {
 		return false
 	}
-	return dt == w.origType || (dt == w.aliasType && w.aliasType != "")
+	origType, aliasType := w.origType, w.aliasType
+	if w.clause.Operator.IsContains() {
+		// values of ContainsAny/ContainsAll are arrays, their elements are
+		// compared the same way a single value would be
+		origType, _ = schema.IsArrayType(origType)
+		aliasType, _ = schema.IsArrayType(aliasType)
+	}
+	return dt == origType || (dt == aliasType && aliasType != "")
 }
 
 func (w *clauseWrapper) getValueNameFromType() string {
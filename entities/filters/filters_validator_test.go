@@ -213,6 +213,109 @@ func TestValidateUUIDFilter(t *testing.T) {
 	}
 }
 
+func TestValidateContainsOperators(t *testing.T) {
+	tests := []struct {
+		name      string
+		prop      schema.PropertyName
+		operator  Operator
+		valueType schema.DataType
+		valid     bool
+	}{
+		{
+			name:      "ContainsAny with text array on text[] prop",
+			prop:      "tags",
+			operator:  OperatorContainsAny,
+			valueType: schema.DataTypeTextArray,
+			valid:     true,
+		},
+		{
+			name:      "ContainsAll with text array on text prop",
+			prop:      "modelName",
+			operator:  OperatorContainsAll,
+			valueType: schema.DataTypeTextArray,
+			valid:     true,
+		},
+		{
+			name:      "ContainsAny with deprecated string array",
+			prop:      "tags",
+			operator:  OperatorContainsAny,
+			valueType: schema.DataTypeStringArray,
+			valid:     true,
+		},
+		{
+			name:      "ContainsAny with int array on int[] prop",
+			prop:      "sizes",
+			operator:  OperatorContainsAny,
+			valueType: schema.DataTypeIntArray,
+			valid:     true,
+		},
+		{
+			name:      "ContainsAll with text array on uuid prop",
+			prop:      "my_id",
+			operator:  OperatorContainsAll,
+			valueType: schema.DataTypeTextArray,
+			valid:     true,
+		},
+		{
+			name:      "ContainsAny with text array on internal id prop",
+			prop:      InternalPropID,
+			operator:  OperatorContainsAny,
+			valueType: schema.DataTypeTextArray,
+			valid:     true,
+		},
+		{
+			name:      "ContainsAny with a single value",
+			prop:      "tags",
+			operator:  OperatorContainsAny,
+			valueType: schema.DataTypeText,
+			valid:     false,
+		},
+		{
+			name:      "ContainsAny with wrong array type",
+			prop:      "sizes",
+			operator:  OperatorContainsAny,
+			valueType: schema.DataTypeTextArray,
+			valid:     false,
+		},
+		{
+			name:      "Equal with an array value",
+			prop:      "tags",
+			operator:  OperatorEqual,
+			valueType: schema.DataTypeTextArray,
+			valid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sch := schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class: "Car",
+						Properties: []*models.Property{
+							{Name: "modelName", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+							{Name: "tags", DataType: schema.DataTypeTextArray.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+							{Name: "sizes", DataType: schema.DataTypeIntArray.PropString()},
+							{Name: "my_id", DataType: schema.DataTypeUUID.PropString()},
+						},
+					},
+				},
+			}}
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &Value{Value: []string{"a", "b"}, Type: tt.valueType},
+				On:       &Path{Class: "Car", Property: tt.prop},
+			}
+			err := validateClause(sch, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
 func TestClauseWrapper(t *testing.T) {
 	type testCase struct {
 		name         string
@@ -28,6 +28,9 @@ import (
 // swagger:model MultiTenancyConfig
 type MultiTenancyConfig struct {
 
+	// Nonexistent tenants should (not) be created implicitly on batch import. Creating them requires the permission to update the tenants of the schema
+	AutoTenantCreation bool `json:"autoTenantCreation,omitempty"`
+
 	// Whether or not multi-tenancy is enabled for this class
 	Enabled bool `json:"enabled"`
 }
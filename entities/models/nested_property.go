@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NestedProperty nested property
+//
+// swagger:model NestedProperty
+type NestedProperty struct {
+
+	// Data type of the nested property. Can be any primitive data type except for geoCoordinates, phoneNumber and blob, or "object" and "object[]" for deeper nesting.
+	DataType []string `json:"dataType"`
+
+	// Description of the nested property.
+	Description string `json:"description,omitempty"`
+
+	// Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules
+	IndexFilterable *bool `json:"indexFilterable,omitempty"`
+
+	// Optional. Should the positions of the tokens be stored in the searchable index. Defaults to false. Applicable only to properties of data type text and text[] with indexSearchable enabled. Token positions are required to use quoted phrases (e.g. "exact phrase" or "exact phrase"~2 with slop) in bm25 or hybrid search
+	IndexPositions *bool `json:"indexPositions,omitempty"`
+
+	// Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules
+	IndexSearchable *bool `json:"indexSearchable,omitempty"`
+
+	// Name of the nested property.
+	Name string `json:"name,omitempty"`
+
+	// The properties of the nested object(s). Applies to nested properties of data type object and object[].
+	NestedProperties []*NestedProperty `json:"nestedProperties,omitempty"`
+
+	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims), `ascii_folding` (like `word`, additionally removes diacritics, e.g. `café` becomes `cafe`), `snowball_english`, `snowball_german`, `snowball_french` (like `ascii_folding`, additionally reduces words to their stem using the Snowball stemmer of the language), `cjk_bigram` (like `word`, but splits Chinese, Japanese and Korean text into overlapping pairs of characters). Not supported for remaining data types
+	// Enum: [word lowercase whitespace field ascii_folding snowball_english snowball_german snowball_french cjk_bigram]
+	Tokenization string `json:"tokenization,omitempty"`
+}
+
+// Validate validates this nested property
+func (m *NestedProperty) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateNestedProperties(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTokenization(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NestedProperty) validateNestedProperties(formats strfmt.Registry) error {
+	if swag.IsZero(m.NestedProperties) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NestedProperties); i++ {
+		if swag.IsZero(m.NestedProperties[i]) { // not required
+			continue
+		}
+
+		if m.NestedProperties[i] != nil {
+			if err := m.NestedProperties[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var nestedPropertyTypeTokenizationPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["word","lowercase","whitespace","field","ascii_folding","snowball_english","snowball_german","snowball_french","cjk_bigram"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		nestedPropertyTypeTokenizationPropEnum = append(nestedPropertyTypeTokenizationPropEnum, v)
+	}
+}
+
+const (
+
+	// NestedPropertyTokenizationWord captures enum value "word"
+	NestedPropertyTokenizationWord string = "word"
+
+	// NestedPropertyTokenizationLowercase captures enum value "lowercase"
+	NestedPropertyTokenizationLowercase string = "lowercase"
+
+	// NestedPropertyTokenizationWhitespace captures enum value "whitespace"
+	NestedPropertyTokenizationWhitespace string = "whitespace"
+
+	// NestedPropertyTokenizationField captures enum value "field"
+	NestedPropertyTokenizationField string = "field"
+
+	// NestedPropertyTokenizationASCIIFolding captures enum value "ascii_folding"
+	NestedPropertyTokenizationASCIIFolding string = "ascii_folding"
+
+	// NestedPropertyTokenizationSnowballEnglish captures enum value "snowball_english"
+	NestedPropertyTokenizationSnowballEnglish string = "snowball_english"
+
+	// NestedPropertyTokenizationSnowballGerman captures enum value "snowball_german"
+	NestedPropertyTokenizationSnowballGerman string = "snowball_german"
+
+	// NestedPropertyTokenizationSnowballFrench captures enum value "snowball_french"
+	NestedPropertyTokenizationSnowballFrench string = "snowball_french"
+
+	// NestedPropertyTokenizationCjkBigram captures enum value "cjk_bigram"
+	NestedPropertyTokenizationCjkBigram string = "cjk_bigram"
+)
+
+// prop value enum
+func (m *NestedProperty) validateTokenizationEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, nestedPropertyTypeTokenizationPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *NestedProperty) validateTokenization(formats strfmt.Registry) error {
+	if swag.IsZero(m.Tokenization) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTokenizationEnum("tokenization", "body", m.Tokenization); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this nested property based on the context it is used
+func (m *NestedProperty) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNestedProperties(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NestedProperty) contextValidateNestedProperties(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.NestedProperties); i++ {
+
+		if m.NestedProperties[i] != nil {
+			if err := m.NestedProperties[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NestedProperty) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NestedProperty) UnmarshalBinary(b []byte) error {
+	var res NestedProperty
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
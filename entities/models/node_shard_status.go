@@ -19,6 +19,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -36,15 +37,77 @@ type NodeShardStatus struct {
 
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
+
+	// The status of the most recent reindexing of property settings on this shard.
+	ReindexStatus *ShardReindexStatus `json:"reindexStatus,omitempty"`
+
+	// The storage status of the shard, READY or READONLY.
+	StorageStatus string `json:"storageStatus,omitempty"`
+
+	// The status of the shard's vector indexing, READY, INDEXING while a vector index is built in the background, or READONLY.
+	VectorIndexingStatus string `json:"vectorIndexingStatus,omitempty"`
 }
 
 // Validate validates this node shard status
 func (m *NodeShardStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateReindexStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) validateReindexStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.ReindexStatus) { // not required
+		return nil
+	}
+
+	if m.ReindexStatus != nil {
+		if err := m.ReindexStatus.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("reindexStatus")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("reindexStatus")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ContextValidate validates this node shard status based on context it is used
+// ContextValidate validate this node shard status based on the context it is used
 func (m *NodeShardStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateReindexStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateReindexStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ReindexStatus != nil {
+		if err := m.ReindexStatus.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("reindexStatus")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("reindexStatus")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
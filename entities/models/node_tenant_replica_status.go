@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NodeTenantReplicaStatus The status of a single replica of a tenant
+//
+// swagger:model NodeTenantReplicaStatus
+type NodeTenantReplicaStatus struct {
+
+	// The name of the node the replica is placed on.
+	Node string `json:"node,omitempty"`
+
+	// The number of objects in the replica.
+	ObjectCount int64 `json:"objectCount"`
+
+	// The storage status of the replica, READY or READONLY. Empty if the replica is not loaded or its node is unavailable.
+	StorageStatus string `json:"storageStatus,omitempty"`
+
+	// The status of the replica's vector indexing, READY, INDEXING or READONLY. Empty if the replica is not loaded or its node is unavailable.
+	VectorIndexingStatus string `json:"vectorIndexingStatus,omitempty"`
+}
+
+// Validate validates this node tenant replica status
+func (m *NodeTenantReplicaStatus) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this node tenant replica status based on context it is used
+func (m *NodeTenantReplicaStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NodeTenantReplicaStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NodeTenantReplicaStatus) UnmarshalBinary(b []byte) error {
+	var res NodeTenantReplicaStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NodeTenantStatus The status and placement of a tenant's shard in the cluster
+//
+// swagger:model NodeTenantStatus
+type NodeTenantStatus struct {
+
+	// The activity status of the tenant.
+	ActivityStatus string `json:"activityStatus,omitempty"`
+
+	// The nodes holding a replica of the tenant.
+	BelongsToNodes []string `json:"belongsToNodes"`
+
+	// The name of the tenant.
+	Name string `json:"name,omitempty"`
+
+	// The statistics of each of the tenant's replicas.
+	Replicas []*NodeTenantReplicaStatus `json:"replicas"`
+}
+
+// Validate validates this node tenant status
+func (m *NodeTenantStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateReplicas(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeTenantStatus) validateReplicas(formats strfmt.Registry) error {
+	if swag.IsZero(m.Replicas) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Replicas); i++ {
+		if swag.IsZero(m.Replicas[i]) { // not required
+			continue
+		}
+
+		if m.Replicas[i] != nil {
+			if err := m.Replicas[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("replicas" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("replicas" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this node tenant status based on the context it is used
+func (m *NodeTenantStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateReplicas(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeTenantStatus) contextValidateReplicas(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Replicas); i++ {
+
+		if m.Replicas[i] != nil {
+			if err := m.Replicas[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("replicas" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("replicas" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NodeTenantStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NodeTenantStatus) UnmarshalBinary(b []byte) error {
+	var res NodeTenantStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -19,6 +19,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -31,7 +32,7 @@ import (
 // swagger:model Property
 type Property struct {
 
-	// Can be a reference to another type when it starts with a capital (for example Person), otherwise "string" or "int".
+	// Can be a reference to another type when it starts with a capital (for example Person), otherwise "string" or "int". Properties of data type "object" or "object[]" are structured by their nested properties.
 	DataType []string `json:"dataType"`
 
 	// Description of the property.
@@ -43,6 +44,9 @@ type Property struct {
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)
 	IndexInverted *bool `json:"indexInverted,omitempty"`
 
+	// Optional. Should the positions of the tokens be stored in the searchable index. Defaults to false. Applicable only to properties of data type text and text[] with indexSearchable enabled. Token positions are required to use quoted phrases (e.g. "exact phrase" or "exact phrase"~2 with slop) in bm25 or hybrid search
+	IndexPositions *bool `json:"indexPositions,omitempty"`
+
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules
 	IndexSearchable *bool `json:"indexSearchable,omitempty"`
 
@@ -52,8 +56,11 @@ type Property struct {
 	// Name of the property as URI relative to the schema URL.
 	Name string `json:"name,omitempty"`
 
-	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims). Not supported for remaining data types
-	// Enum: [word lowercase whitespace field]
+	// The properties of the nested object(s). Applies to properties of data type object and object[].
+	NestedProperties []*NestedProperty `json:"nestedProperties,omitempty"`
+
+	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims), `ascii_folding` (like `word`, additionally removes diacritics, e.g. `café` becomes `cafe`), `snowball_english`, `snowball_german`, `snowball_french` (like `ascii_folding`, additionally reduces words to their stem using the Snowball stemmer of the language), `cjk_bigram` (like `word`, but splits Chinese, Japanese and Korean text into overlapping pairs of characters). Not supported for remaining data types
+	// Enum: [word lowercase whitespace field ascii_folding snowball_english snowball_german snowball_french cjk_bigram]
 	Tokenization string `json:"tokenization,omitempty"`
 }
 
@@ -61,6 +68,10 @@ type Property struct {
 func (m *Property) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateNestedProperties(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTokenization(formats); err != nil {
 		res = append(res, err)
 	}
@@ -71,11 +82,37 @@ func (m *Property) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Property) validateNestedProperties(formats strfmt.Registry) error {
+	if swag.IsZero(m.NestedProperties) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NestedProperties); i++ {
+		if swag.IsZero(m.NestedProperties[i]) { // not required
+			continue
+		}
+
+		if m.NestedProperties[i] != nil {
+			if err := m.NestedProperties[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 var propertyTypeTokenizationPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["word","lowercase","whitespace","field"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["word","lowercase","whitespace","field","ascii_folding","snowball_english","snowball_german","snowball_french","cjk_bigram"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -96,6 +133,21 @@ const (
 
 	// PropertyTokenizationField captures enum value "field"
 	PropertyTokenizationField string = "field"
+
+	// PropertyTokenizationASCIIFolding captures enum value "ascii_folding"
+	PropertyTokenizationASCIIFolding string = "ascii_folding"
+
+	// PropertyTokenizationSnowballEnglish captures enum value "snowball_english"
+	PropertyTokenizationSnowballEnglish string = "snowball_english"
+
+	// PropertyTokenizationSnowballGerman captures enum value "snowball_german"
+	PropertyTokenizationSnowballGerman string = "snowball_german"
+
+	// PropertyTokenizationSnowballFrench captures enum value "snowball_french"
+	PropertyTokenizationSnowballFrench string = "snowball_french"
+
+	// PropertyTokenizationCjkBigram captures enum value "cjk_bigram"
+	PropertyTokenizationCjkBigram string = "cjk_bigram"
 )
 
 // prop value enum
@@ -119,8 +171,37 @@ func (m *Property) validateTokenization(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this property based on context it is used
+// ContextValidate validate this property based on the context it is used
 func (m *Property) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNestedProperties(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Property) contextValidateNestedProperties(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.NestedProperties); i++ {
+
+		if m.NestedProperties[i] != nil {
+			if err := m.NestedProperties[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
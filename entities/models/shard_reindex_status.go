@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ShardReindexStatus The progress of rebuilding the inverted indexes of a shard after property index settings were changed.
+//
+// swagger:model ShardReindexStatus
+type ShardReindexStatus struct {
+
+	// The reason why the reindexing failed.
+	Error string `json:"error,omitempty"`
+
+	// The number of objects processed so far.
+	ObjectsProcessed int64 `json:"objectsProcessed,omitempty"`
+
+	// The number of objects in the shard when the reindexing started.
+	ObjectsTotal int64 `json:"objectsTotal,omitempty"`
+
+	// The names of the properties being reindexed.
+	Properties []string `json:"properties"`
+
+	// The state of the reindexing.
+	// Enum: [INDEXING FINISHED FAILED]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this shard reindex status
+func (m *ShardReindexStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var shardReindexStatusTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["INDEXING","FINISHED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		shardReindexStatusTypeStatusPropEnum = append(shardReindexStatusTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ShardReindexStatusStatusINDEXING captures enum value "INDEXING"
+	ShardReindexStatusStatusINDEXING string = "INDEXING"
+
+	// ShardReindexStatusStatusFINISHED captures enum value "FINISHED"
+	ShardReindexStatusStatusFINISHED string = "FINISHED"
+
+	// ShardReindexStatusStatusFAILED captures enum value "FAILED"
+	ShardReindexStatusStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *ShardReindexStatus) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, shardReindexStatusTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ShardReindexStatus) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this shard reindex status based on context it is used
+func (m *ShardReindexStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ShardReindexStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ShardReindexStatus) UnmarshalBinary(b []byte) error {
+	var res ShardReindexStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
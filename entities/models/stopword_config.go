@@ -31,7 +31,7 @@ type StopwordConfig struct {
 	// stopwords to be considered additionally
 	Additions []string `json:"additions"`
 
-	// pre-existing list of common words by language
+	// pre-existing list of common words by language. Built-in presets are `en` (default), `de`, `fr`, `es`, `it`, `nl`, `pt` and `none`. Additional presets can be registered in the server config under `stopword_presets`
 	Preset string `json:"preset,omitempty"`
 
 	// stopwords to be removed from consideration
@@ -18,9 +18,12 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Tenant attributes representing a single tenant within weaviate
@@ -28,12 +31,76 @@ import (
 // swagger:model Tenant
 type Tenant struct {
 
+	// activity status of the tenant's shard. Optional for creating tenant (implicit `HOT`) and required for updating tenant. Allowed values are `HOT` - tenant is fully active, `COLD` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally, `OFFLOADED` - tenant is inactive; no actions can be performed on tenant, tenant's files are uploaded to the configured offload backend and removed from local disk. The transfer to and from the offload backend happens in the background, meanwhile the tenant is inactive and reported as `OFFLOADING` or `ONLOADING`. These statuses cannot be requested
+	// Enum: [HOT COLD OFFLOADED OFFLOADING ONLOADING]
+	ActivityStatus string `json:"activityStatus,omitempty"`
+
 	// name of the tenant
 	Name string `json:"name,omitempty"`
 }
 
 // Validate validates this tenant
 func (m *Tenant) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateActivityStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var tenantTypeActivityStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["HOT","COLD","OFFLOADED","OFFLOADING","ONLOADING"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		tenantTypeActivityStatusPropEnum = append(tenantTypeActivityStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// TenantActivityStatusHOT captures enum value "HOT"
+	TenantActivityStatusHOT string = "HOT"
+
+	// TenantActivityStatusCOLD captures enum value "COLD"
+	TenantActivityStatusCOLD string = "COLD"
+
+	// TenantActivityStatusOFFLOADED captures enum value "OFFLOADED"
+	TenantActivityStatusOFFLOADED string = "OFFLOADED"
+
+	// TenantActivityStatusOFFLOADING captures enum value "OFFLOADING"
+	TenantActivityStatusOFFLOADING string = "OFFLOADING"
+
+	// TenantActivityStatusONLOADING captures enum value "ONLOADING"
+	TenantActivityStatusONLOADING string = "ONLOADING"
+)
+
+// prop value enum
+func (m *Tenant) validateActivityStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, tenantTypeActivityStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Tenant) validateActivityStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.ActivityStatus) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateActivityStatusEnum("activityStatus", "body", m.ActivityStatus); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -41,3 +41,13 @@ type BackupBackend interface {
 	// Initialize initializes backup provider and make sure that app have access rights to write into the object store.
 	Initialize(ctx context.Context, backupID string) error
 }
+
+// OffloadBackend is a BackupBackend which can also be used to offload
+// tenants. Offloaded tenants are removed from the backend once they are
+// loaded back or deleted.
+type OffloadBackend interface {
+	BackupBackend
+
+	// Delete removes all objects stored under backupID
+	Delete(ctx context.Context, backupID string) error
+}
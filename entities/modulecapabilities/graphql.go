@@ -34,6 +34,12 @@ type NearParam interface {
 	SimilarityMetricProvided() bool
 }
 
+// TargetVectorsParam defines params which specify the named vectors
+// that should be searched
+type TargetVectorsParam interface {
+	GetTargetVectors() []string
+}
+
 // ValidateFn validates a given module param
 type ValidateFn = func(param interface{}) error
 
@@ -13,6 +13,7 @@ package moduletools
 
 type ObjectDiff struct {
 	oldVec        []float32
+	oldVectors    map[string][]float32
 	oldPropValues map[string]interface{}
 	newPropValues map[string]interface{}
 }
@@ -31,6 +32,28 @@ func (od *ObjectDiff) WithProp(propName string, oldValue, newValue interface{})
 	return od
 }
 
+// WithTargetVector sets the previous vector of the given named vector
+func (od *ObjectDiff) WithTargetVector(targetVector string, oldVec []float32) *ObjectDiff {
+	if od.oldVectors == nil {
+		od.oldVectors = map[string][]float32{}
+	}
+	od.oldVectors[targetVector] = oldVec
+	return od
+}
+
+// ForTargetVector returns a diff for the given named vector. It shares the
+// property changes, but GetVec returns the previous value of the named vector.
+func (od *ObjectDiff) ForTargetVector(targetVector string) *ObjectDiff {
+	if od == nil {
+		return nil
+	}
+	return &ObjectDiff{
+		oldVec:        od.oldVectors[targetVector],
+		oldPropValues: od.oldPropValues,
+		newPropValues: od.newPropValues,
+	}
+}
+
 func (od *ObjectDiff) GetVec() []float32 {
 	return od.oldVec
 }
@@ -12,6 +12,8 @@
 package schema
 
 import (
+	"strings"
+
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -49,6 +51,10 @@ func (s *Schema) GetProperty(className ClassName, propName PropertyName) (*model
 		return nil, err
 	}
 
+	if strings.Contains(string(propName), NestedPropertySeparator) {
+		return GetNestedPropertyByPath(semSchemaClass, string(propName))
+	}
+
 	semProp, err := GetPropertyByName(semSchemaClass, string(propName))
 	if err != nil {
 		return nil, err
@@ -19,3 +19,12 @@ func MultiTenancyEnabled(class *models.Class) bool {
 	}
 	return false
 }
+
+// ActivityStatus returns the given tenant activity status or the default
+// status (HOT) if none was provided
+func ActivityStatus(status string) string {
+	if status == "" {
+		return models.TenantActivityStatusHOT
+	}
+	return status
+}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NamedVectorSourcePropertiesKey is the key of the vectorizer setting of a
+// named vector which restricts vectorization to the listed properties
+const NamedVectorSourcePropertiesKey = "properties"
+
+// NamedVectorsEnabled indicates whether the class declares any named vectors
+func NamedVectorsEnabled(class *models.Class) bool {
+	return len(class.VectorConfig) > 0
+}
+
+// HasNamedVector indicates whether the class declares a named vector with
+// the given name
+func HasNamedVector(class *models.Class, name string) bool {
+	_, ok := class.VectorConfig[name]
+	return ok
+}
+
+// NamedVectorVectorizer returns the name of the module configured as the
+// vectorizer of a named vector together with the module specific settings.
+// The vectorizer config is expected to contain exactly one module, e.g.
+// {"text2vec-contextionary": {"properties": ["title"]}}.
+func NamedVectorVectorizer(cfg models.VectorConfig) (string, map[string]interface{}, error) {
+	asMap, ok := cfg.Vectorizer.(map[string]interface{})
+	if !ok || len(asMap) != 1 {
+		return "", nil, fmt.Errorf("vectorizer config must contain exactly one module, "+
+			"got %v", cfg.Vectorizer)
+	}
+
+	for moduleName, moduleConfig := range asMap {
+		if moduleConfig == nil {
+			return moduleName, map[string]interface{}{}, nil
+		}
+		settings, ok := moduleConfig.(map[string]interface{})
+		if !ok {
+			return "", nil, fmt.Errorf("config of vectorizer %q must be an object, "+
+				"got %T", moduleName, moduleConfig)
+		}
+		return moduleName, settings, nil
+	}
+
+	return "", nil, nil
+}
+
+// NamedVectorSourceProperties returns the properties a named vector is
+// vectorized from. An empty result means all properties are used.
+func NamedVectorSourceProperties(settings map[string]interface{}) []string {
+	props, ok := settings[NamedVectorSourcePropertiesKey].([]interface{})
+	if !ok {
+		if asStrings, ok := settings[NamedVectorSourcePropertiesKey].([]string); ok {
+			return asStrings
+		}
+		return nil
+	}
+
+	out := make([]string, 0, len(props))
+	for _, prop := range props {
+		if name, ok := prop.(string); ok {
+			out = append(out, name)
+		}
+	}
+	return out
+}
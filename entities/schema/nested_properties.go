@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NestedPropertySeparator separates the names in the path to a nested
+// property, such as "address.city"
+const NestedPropertySeparator = "."
+
+// AsNested returns the nested data type of the given data type, if it is one
+func AsNested(dataType []string) (DataType, bool) {
+	if len(dataType) == 1 {
+		for _, dt := range NestedDataTypes {
+			if dataType[0] == dt.String() {
+				return dt, true
+			}
+		}
+	}
+	return "", false
+}
+
+// IsNestedPath returns whether the property name is the path to a nested
+// property
+func IsNestedPath(propName string) bool {
+	return strings.Contains(propName, NestedPropertySeparator)
+}
+
+// GetNestedPropertyByPath resolves the path to a nested property, such as
+// "address.city", within the class. The nested property is returned as a
+// property named by its path, so that it can be handled like any top level
+// property. Nested properties within an object[] hold one value per object,
+// so they are returned with the array version of their data type. A path
+// without separator resolves to the top level property.
+func GetNestedPropertyByPath(class *models.Class, path string) (*models.Property, error) {
+	names := strings.Split(path, NestedPropertySeparator)
+	prop, err := GetPropertyByName(class, names[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 1 {
+		return prop, nil
+	}
+
+	nestedProps := prop.NestedProperties
+	inArray := isObjectArray(prop.DataType)
+	var nested *models.NestedProperty
+	for i, name := range names[1:] {
+		nested = nil
+		for _, nestedProp := range nestedProps {
+			if nestedProp.Name == name {
+				nested = nestedProp
+				break
+			}
+		}
+		if nested == nil {
+			return nil, fmt.Errorf(ErrorNoSuchProperty, path, class.Class)
+		}
+		if i < len(names)-2 {
+			inArray = inArray || isObjectArray(nested.DataType)
+		}
+		nestedProps = nested.NestedProperties
+	}
+
+	return nestedAsProperty(path, nested, inArray), nil
+}
+
+// NestedLeafProperties returns all nested properties of a property of data
+// type object or object[], which are not nested objects themselves. They are
+// returned as properties named by their path, e.g. "address.city", in the
+// same way as by GetNestedPropertyByPath.
+func NestedLeafProperties(prop *models.Property) []*models.Property {
+	var leaves []*models.Property
+
+	var collect func(path string, nestedProps []*models.NestedProperty, inArray bool)
+	collect = func(path string, nestedProps []*models.NestedProperty, inArray bool) {
+		for _, nestedProp := range nestedProps {
+			nestedPath := path + NestedPropertySeparator + nestedProp.Name
+			if _, ok := AsNested(nestedProp.DataType); ok {
+				collect(nestedPath, nestedProp.NestedProperties,
+					inArray || isObjectArray(nestedProp.DataType))
+				continue
+			}
+			leaves = append(leaves, nestedAsProperty(nestedPath, nestedProp, inArray))
+		}
+	}
+
+	if _, ok := AsNested(prop.DataType); ok {
+		collect(prop.Name, prop.NestedProperties, isObjectArray(prop.DataType))
+	}
+	return leaves
+}
+
+func isObjectArray(dataType []string) bool {
+	return len(dataType) == 1 && dataType[0] == DataTypeObjectArray.String()
+}
+
+func nestedAsProperty(path string, nestedProp *models.NestedProperty, inArray bool) *models.Property {
+	dataType := nestedProp.DataType
+	if _, ok := AsNested(dataType); !ok && inArray && !IsArrayDataType(dataType) {
+		dataType = []string{dataType[0] + "[]"}
+	}
+
+	return &models.Property{
+		Name:             path,
+		DataType:         dataType,
+		Description:      nestedProp.Description,
+		IndexFilterable:  nestedProp.IndexFilterable,
+		IndexSearchable:  nestedProp.IndexSearchable,
+		IndexPositions:   nestedProp.IndexPositions,
+		Tokenization:     nestedProp.Tokenization,
+		NestedProperties: nestedProp.NestedProperties,
+	}
+}
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestNestedProperties(t *testing.T) {
+	person := &models.Class{
+		Class: "Person",
+		Properties: []*models.Property{
+			{Name: "name", DataType: DataTypeText.PropString()},
+			{
+				Name:     "address",
+				DataType: DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: DataTypeText.PropString()},
+					{
+						Name:     "residents",
+						DataType: DataTypeObjectArray.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "age", DataType: DataTypeInt.PropString()},
+							{Name: "tags", DataType: DataTypeTextArray.PropString()},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("get nested property by path", func(t *testing.T) {
+		prop, err := GetNestedPropertyByPath(person, "address.city")
+		require.Nil(t, err)
+		assert.Equal(t, "address.city", prop.Name)
+		assert.Equal(t, DataTypeText.PropString(), prop.DataType)
+
+		prop, err = GetNestedPropertyByPath(person, "address.residents.age")
+		require.Nil(t, err)
+		assert.Equal(t, "address.residents.age", prop.Name)
+		assert.Equal(t, DataTypeIntArray.PropString(), prop.DataType)
+
+		prop, err = GetNestedPropertyByPath(person, "address.residents")
+		require.Nil(t, err)
+		assert.Equal(t, DataTypeObjectArray.PropString(), prop.DataType)
+
+		prop, err = GetNestedPropertyByPath(person, "name")
+		require.Nil(t, err)
+		assert.Equal(t, "name", prop.Name)
+
+		_, err = GetNestedPropertyByPath(person, "address.street")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("get nested property by path through the schema", func(t *testing.T) {
+		sch := Schema{Objects: &models.Schema{Classes: []*models.Class{person}}}
+		prop, err := sch.GetProperty("Person", "address.city")
+		require.Nil(t, err)
+		assert.Equal(t, "address.city", prop.Name)
+	})
+
+	t.Run("nested leaf properties", func(t *testing.T) {
+		leaves := NestedLeafProperties(person.Properties[1])
+		require.Len(t, leaves, 3)
+		assert.Equal(t, "address.city", leaves[0].Name)
+		assert.Equal(t, DataTypeText.PropString(), leaves[0].DataType)
+		assert.Equal(t, "address.residents.age", leaves[1].Name)
+		assert.Equal(t, DataTypeIntArray.PropString(), leaves[1].DataType)
+		assert.Equal(t, "address.residents.tags", leaves[2].Name)
+		assert.Equal(t, DataTypeTextArray.PropString(), leaves[2].DataType)
+
+		assert.Empty(t, NestedLeafProperties(person.Properties[0]))
+	})
+}
@@ -39,6 +39,8 @@ type ClassAndProperty struct {
 // Describes the schema that is used in Weaviate.
 type Schema struct {
 	Objects *models.Schema
+	// Aliases maps alternative names to the names of existing classes
+	Aliases map[string]string
 }
 
 func Empty() Schema {
@@ -19,6 +19,7 @@ import (
 var (
 	validateClassNameRegex    *regexp.Regexp
 	validatePropertyNameRegex *regexp.Regexp
+	validateNamedVectorRegex  *regexp.Regexp
 	reservedPropertyNames     []string
 )
 
@@ -30,6 +31,7 @@ const (
 func init() {
 	validateClassNameRegex = regexp.MustCompile(`^` + ClassNameRegexCore + `$`)
 	validatePropertyNameRegex = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+	validateNamedVectorRegex = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
 	reservedPropertyNames = []string{"_additional", "_id", "id"}
 }
 
@@ -51,6 +53,17 @@ func ValidatePropertyName(name string) (PropertyName, error) {
 		"which must be “/[_A-Za-z][_0-9A-Za-z]*/”.", name)
 }
 
+// ValidateNamedVectorName validates that this string is a valid name for a
+// named vector
+func ValidateNamedVectorName(name string) error {
+	if validateNamedVectorRegex.MatchString(name) {
+		return nil
+	}
+	return fmt.Errorf("'%s' is not a valid vector name. "+
+		"Vector names in Weaviate are restricted to valid GraphQL names, "+
+		"which must be “/[_A-Za-z][_0-9A-Za-z]*/”.", name)
+}
+
 // ValidateReservedPropertyName validates that a string is not a reserved property name
 func ValidateReservedPropertyName(name string) error {
 	for i := range reservedPropertyNames {
@@ -12,10 +12,11 @@
 package searchparams
 
 type NearVector struct {
-	Vector       []float32 `json:"vector"`
-	Certainty    float64   `json:"certainty"`
-	Distance     float64   `json:"distance"`
-	WithDistance bool      `json:"-"`
+	Vector        []float32 `json:"vector"`
+	Certainty     float64   `json:"certainty"`
+	Distance      float64   `json:"distance"`
+	WithDistance  bool      `json:"-"`
+	TargetVectors []string  `json:"targetVectors"`
 }
 
 type KeywordRanking struct {
@@ -39,14 +40,16 @@ type HybridSearch struct {
 	Vector          []float32   `json:"vector"`
 	Properties      []string    `json:"properties"`
 	FusionAlgorithm int         `json:"fusionalgorithm"`
+	TargetVectors   []string    `json:"targetVectors"`
 }
 
 type NearObject struct {
-	ID           string  `json:"id"`
-	Beacon       string  `json:"beacon"`
-	Certainty    float64 `json:"certainty"`
-	Distance     float64 `json:"distance"`
-	WithDistance bool    `json:"-"`
+	ID            string   `json:"id"`
+	Beacon        string   `json:"beacon"`
+	Certainty     float64  `json:"certainty"`
+	Distance      float64  `json:"distance"`
+	WithDistance  bool     `json:"-"`
+	TargetVectors []string `json:"targetVectors"`
 }
 
 type ObjectMove struct {
@@ -62,15 +65,16 @@ type ExploreMove struct {
 }
 
 type NearTextParams struct {
-	Values       []string
-	Limit        int
-	MoveTo       ExploreMove
-	MoveAwayFrom ExploreMove
-	Certainty    float64
-	Distance     float64
-	WithDistance bool
-	Network      bool
-	Autocorrect  bool
+	Values        []string
+	Limit         int
+	MoveTo        ExploreMove
+	MoveAwayFrom  ExploreMove
+	Certainty     float64
+	Distance      float64
+	WithDistance  bool
+	Network       bool
+	Autocorrect   bool
+	TargetVectors []string
 }
 
 type GroupBy struct {
@@ -16,6 +16,10 @@ import "errors"
 const (
 	StatusReadOnly Status = "READONLY"
 	StatusReady    Status = "READY"
+
+	// StatusIndexing is only reported for the vector indexing of a shard, it
+	// is not a valid storage status
+	StatusIndexing Status = "INDEXING"
 )
 
 var (
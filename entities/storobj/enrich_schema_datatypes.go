@@ -17,68 +17,174 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
-func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
-	if schema == nil {
+// enrichSchemaTypes turns the JSON representation of the properties into
+// their typed values. The types are taken from the data types of the
+// properties in the class. Only if there is no class, or the property is not
+// part of it, the type is sniffed from the value itself.
+func (ko *Object) enrichSchemaTypes(properties map[string]interface{},
+	class *models.Class,
+) error {
+	if properties == nil {
 		return nil
 	}
 
-	for propName, value := range schema {
-		switch typed := value.(type) {
-		case []interface{}:
-			if isArrayValue(typed) {
-				switch typed[0].(type) {
-				case float64:
-					parsed, err := parseNumberArrayValue(typed)
-					if err != nil {
-						return errors.Wrapf(err, "property %q of type string array", propName)
-					}
-
-					schema[propName] = parsed
-				case bool:
-					parsed, err := parseBoolArrayValue(typed)
-					if err != nil {
-						return errors.Wrapf(err, "property %q of type boolean array", propName)
-					}
-
-					schema[propName] = parsed
-				default:
-					parsed, err := parseStringArrayValue(typed)
-					if err != nil {
-						return errors.Wrapf(err, "property %q of type string array", propName)
-					}
-
-					schema[propName] = parsed
-				}
-			} else if len(typed) == 0 {
-				// empty arrays. Here we use []interface{} as a placeholder
-				// type for an empty array, since we cannot determine its
-				// actual type. in the future, we should persist the schema
-				// property type information alongside the value to avoid
-				// this situation
-				schema[propName] = typed
-			} else {
-				parsed, err := parseCrossRef(typed)
-				if err != nil {
-					return errors.Wrapf(err, "property %q of type cross-ref", propName)
-				}
+	for propName, value := range properties {
+		var (
+			parsed interface{}
+			err    error
+		)
+		if prop := propertyByName(class, propName); prop != nil {
+			parsed, err = parseTypedProp(propName, value, prop.DataType)
+		} else {
+			parsed, err = parseUntypedProp(propName, value)
+		}
+		if err != nil {
+			return err
+		}
 
-				schema[propName] = parsed
-			}
-		case map[string]interface{}:
-			parsed, err := parseMapProp(typed)
+		properties[propName] = parsed
+	}
+
+	return nil
+}
+
+func propertyByName(class *models.Class, propName string) *models.Property {
+	if class == nil {
+		return nil
+	}
+	for _, prop := range class.Properties {
+		if prop.Name == propName {
+			return prop
+		}
+	}
+	return nil
+}
+
+// parseTypedProp parses the value of a property of the given data type
+func parseTypedProp(propName string, value interface{}, dataType []string) (interface{}, error) {
+	if len(dataType) == 0 {
+		return value, nil
+	}
+	if schema.IsRefDataType(dataType) {
+		if typed, ok := value.([]interface{}); ok && len(typed) > 0 {
+			parsed, err := parseCrossRef(typed)
 			if err != nil {
-				return errors.Wrapf(err, "property %q of type map", propName)
+				return nil, errors.Wrapf(err, "property %q of type cross-ref", propName)
 			}
+			return parsed, nil
+		}
+		return value, nil
+	}
+	if _, ok := schema.AsNested(dataType); ok {
+		// nested objects are returned as they are
+		return value, nil
+	}
 
-			schema[propName] = parsed
-		default:
-			continue
+	switch schema.DataType(dataType[0]) {
+	case schema.DataTypeGeoCoordinates:
+		if typed, ok := value.(map[string]interface{}); ok {
+			parsed, err := parseGeoProp(typed["latitude"], typed["longitude"])
+			if err != nil {
+				return nil, errors.Wrapf(err, "property %q of type geoCoordinates", propName)
+			}
+			return parsed, nil
+		}
+	case schema.DataTypePhoneNumber:
+		if typed, ok := value.(map[string]interface{}); ok {
+			parsed, err := parsePhoneNumber(typed)
+			if err != nil {
+				return nil, errors.Wrapf(err, "property %q of type phoneNumber", propName)
+			}
+			return parsed, nil
+		}
+	case schema.DataTypeNumberArray, schema.DataTypeIntArray:
+		if typed, ok := value.([]interface{}); ok && len(typed) > 0 {
+			parsed, err := parseNumberArrayValue(typed)
+			if err != nil {
+				return nil, errors.Wrapf(err, "property %q of type number array", propName)
+			}
+			return parsed, nil
+		}
+	case schema.DataTypeBooleanArray:
+		if typed, ok := value.([]interface{}); ok && len(typed) > 0 {
+			parsed, err := parseBoolArrayValue(typed)
+			if err != nil {
+				return nil, errors.Wrapf(err, "property %q of type boolean array", propName)
+			}
+			return parsed, nil
+		}
+	case schema.DataTypeTextArray, schema.DataTypeStringArray,
+		schema.DataTypeDateArray, schema.DataTypeUUIDArray:
+		if typed, ok := value.([]interface{}); ok && len(typed) > 0 {
+			parsed, err := parseStringArrayValue(typed)
+			if err != nil {
+				return nil, errors.Wrapf(err, "property %q of type string array", propName)
+			}
+			return parsed, nil
 		}
 	}
 
-	return nil
+	// empty arrays are kept as []interface{}, as are the values of all other
+	// data types, which need no parsing
+	return value, nil
+}
+
+// parseUntypedProp parses the value of a property whose data type is unknown
+// by looking at the value itself
+func parseUntypedProp(propName string, value interface{}) (interface{}, error) {
+	switch typed := value.(type) {
+	case []interface{}:
+		if isArrayValue(typed) {
+			switch typed[0].(type) {
+			case float64:
+				parsed, err := parseNumberArrayValue(typed)
+				if err != nil {
+					return nil, errors.Wrapf(err, "property %q of type number array", propName)
+				}
+				return parsed, nil
+			case bool:
+				parsed, err := parseBoolArrayValue(typed)
+				if err != nil {
+					return nil, errors.Wrapf(err, "property %q of type boolean array", propName)
+				}
+				return parsed, nil
+			default:
+				parsed, err := parseStringArrayValue(typed)
+				if err != nil {
+					return nil, errors.Wrapf(err, "property %q of type string array", propName)
+				}
+				return parsed, nil
+			}
+		} else if len(typed) == 0 {
+			// empty arrays. Here we use []interface{} as a placeholder
+			// type for an empty array, since we cannot determine its
+			// actual type. in the future, we should persist the schema
+			// property type information alongside the value to avoid
+			// this situation
+			return typed, nil
+		} else if !isCrossRefValue(typed) {
+			// nested objects of a property of data type object[] are
+			// returned as they are
+			return typed, nil
+		}
+
+		parsed, err := parseCrossRef(typed)
+		if err != nil {
+			return nil, errors.Wrapf(err, "property %q of type cross-ref", propName)
+		}
+		return parsed, nil
+	case map[string]interface{}:
+		parsed, err := parseMapProp(typed)
+		if err != nil {
+			return nil, errors.Wrapf(err, "property %q of type map", propName)
+		}
+		return parsed, nil
+	default:
+		return value, nil
+	}
 }
 
 func parseMapProp(input map[string]interface{}) (interface{}, error) {
@@ -86,17 +192,34 @@ func parseMapProp(input map[string]interface{}) (interface{}, error) {
 	lon, lonOK := input["longitude"]
 	_, phoneInputOK := input["input"]
 
-	if latOK && lonOK {
+	if latOK && lonOK && len(input) == 2 {
 		// this is a geoCoordinates prop
 		return parseGeoProp(lat, lon)
 	}
 
-	if phoneInputOK {
+	if phoneInputOK && isPhoneNumberValue(input) {
 		// this is a phone number
 		return parsePhoneNumber(input)
 	}
 
-	return nil, fmt.Errorf("unknown map prop which is not a geo prop or phone: %v", input)
+	// this is a nested object of a property of data type object
+	return input, nil
+}
+
+var phoneNumberKeys = map[string]struct{}{
+	"input": {}, "internationalFormatted": {}, "nationalFormatted": {}, "national": {},
+	"countryCode": {}, "defaultCountry": {}, "valid": {},
+}
+
+// isPhoneNumberValue returns whether the map only contains the fields of a
+// phone number, so that it can be told apart from a nested object
+func isPhoneNumberValue(input map[string]interface{}) bool {
+	for key := range input {
+		if _, ok := phoneNumberKeys[key]; !ok {
+			return false
+		}
+	}
+	return true
 }
 
 func parseGeoProp(lat interface{}, lon interface{}) (*models.GeoCoordinates, error) {
@@ -215,6 +338,17 @@ func isArrayValue(value []interface{}) bool {
 	return false
 }
 
+// isCrossRefValue returns whether the array of maps contains references,
+// otherwise it contains the nested objects of a property of data type object[]
+func isCrossRefValue(value []interface{}) bool {
+	asMap, ok := value[0].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	_, ok = asMap["beacon"]
+	return ok
+}
+
 func parseStringArrayValue(value []interface{}) ([]string, error) {
 	parsed := make([]string, len(value))
 	for i := range value {
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sort"
 
 	"github.com/buger/jsonparser"
 
@@ -34,12 +35,13 @@ import (
 
 type Object struct {
 	MarshallerVersion uint8
-	Object            models.Object `json:"object"`
-	Vector            []float32     `json:"vector"`
-	VectorLen         int           `json:"-"`
-	BelongsToNode     string        `json:"-"`
-	BelongsToShard    string        `json:"-"`
-	IsConsistent      bool          `json:"-"`
+	Object            models.Object        `json:"object"`
+	Vector            []float32            `json:"vector"`
+	Vectors           map[string][]float32 `json:"vectors"`
+	VectorLen         int                  `json:"-"`
+	BelongsToNode     string               `json:"-"`
+	BelongsToShard    string               `json:"-"`
+	IsConsistent      bool                 `json:"-"`
 
 	docID uint64
 }
@@ -63,17 +65,34 @@ func FromObject(object *models.Object, vector []float32) *Object {
 		object.Properties = properties
 	}
 
+	var vectors map[string][]float32
+	if len(object.Vectors) > 0 {
+		vectors = make(map[string][]float32, len(object.Vectors))
+		for name, vector := range object.Vectors {
+			vectors[name] = vector
+		}
+	}
+
 	return &Object{
 		Object:            *object,
 		Vector:            vector,
+		Vectors:           vectors,
 		MarshallerVersion: 1,
 		VectorLen:         len(vector),
 	}
 }
 
 func FromBinary(data []byte) (*Object, error) {
+	return FromBinaryWithClass(data, nil)
+}
+
+// FromBinaryWithClass unmarshals the object like FromBinary, but takes the
+// types of its properties from their data types in the class. This way
+// nested objects cannot be mistaken for geo coordinates, phone numbers or
+// references. Without a class, the types are told from the values.
+func FromBinaryWithClass(data []byte, class *models.Class) (*Object, error) {
 	ko := &Object{}
-	if err := ko.UnmarshalBinary(data); err != nil {
+	if err := ko.unmarshalBinary(data, class); err != nil {
 		return nil, err
 	}
 
@@ -108,8 +127,11 @@ func FromBinaryUUIDOnly(data []byte) (*Object, error) {
 	return ko, nil
 }
 
+// FromBinaryOptional unmarshals only the parts of the object that are
+// requested by the additional properties. The class is used like in
+// FromBinaryWithClass and may be nil.
 func FromBinaryOptional(data []byte,
-	addProp additional.Properties,
+	addProp additional.Properties, class *models.Class,
 ) (*Object, error) {
 	if addProp.NoProps {
 		return FromBinaryUUIDOnly(data)
@@ -180,6 +202,16 @@ func FromBinaryOptional(data []byte,
 	_, err = r.Read(vectorWeights)
 	ec.AddWrap(err, "vector weights")
 
+	if addProp.Vector && r.Len() > 0 {
+		var namedVectorsLength uint32
+		ec.AddWrap(binary.Read(r, le, &namedVectorsLength), "named vectors length")
+		namedVectors := make([]byte, namedVectorsLength)
+		_, err = r.Read(namedVectors)
+		ec.AddWrap(err, "named vectors")
+		ko.Vectors, err = unmarshalNamedVectors(namedVectors)
+		ec.AddWrap(err, "parse named vectors")
+	}
+
 	if err := ec.ToError(); err != nil {
 		return nil, errors.Wrap(err, "compound err")
 	}
@@ -197,6 +229,7 @@ func FromBinaryOptional(data []byte,
 		schema,
 		meta,
 		vectorWeights,
+		class,
 	); err != nil {
 		return nil, errors.Wrap(err, "parse")
 	}
@@ -209,7 +242,7 @@ type bucket interface {
 }
 
 func ObjectsByDocID(bucket bucket, ids []uint64,
-	additional additional.Properties,
+	additional additional.Properties, class *models.Class,
 ) ([]*Object, error) {
 	if bucket == nil {
 		return nil, fmt.Errorf("objects bucket not found")
@@ -232,7 +265,7 @@ func ObjectsByDocID(bucket bucket, ids []uint64,
 			continue
 		}
 
-		unmarshalled, err := FromBinaryOptional(res, additional)
+		unmarshalled, err := FromBinaryOptional(res, additional, class)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarshal data object at position %d", i)
 		}
@@ -369,6 +402,11 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		if additional.Group {
 			additionalProperties["group"] = ko.AdditionalProperties()["group"]
 		}
+		if additional.CompressedDistance {
+			if dist, ok := ko.AdditionalProperties()["compressedDistance"]; ok {
+				additionalProperties["compressedDistance"] = dist
+			}
+		}
 	}
 	if ko.ExplainScore() != "" {
 		additionalProperties["explainScore"] = ko.ExplainScore()
@@ -379,6 +417,7 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		ClassName: ko.Class().String(),
 		Schema:    ko.Properties(),
 		Vector:    ko.Vector,
+		Vectors:   ko.namedVectors(),
 		Dims:      ko.VectorLen,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
@@ -466,6 +505,17 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 // n          | []byte    | meta as json
 // 2          | uint32    | length of vectorweights json
 // n          | []byte    | vectorweights as json
+// 4          | uint32    | length of named vectors, optional
+// n          | []byte    | named vectors, optional
+//
+// The named vectors section is only present if the object has named
+// vectors. Each named vector is encoded as
+// No. of B   | Type      | Content
+// ------------------------------------------------
+// 2          | uint16    | length of name
+// n          | []byte    | name
+// 2          | uint16    | VectorLength
+// n*4        | []float32 | vector of length n
 func (ko *Object) MarshalBinary() ([]byte, error) {
 	if ko.MarshallerVersion != 1 {
 		return nil, errors.Errorf("unsupported marshaller version %d", ko.MarshallerVersion)
@@ -502,7 +552,13 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	}
 	vectorWeightsLength := uint32(len(vectorWeights))
 
+	namedVectors := marshalNamedVectors(ko.Vectors)
+	namedVectorsLength := uint32(len(namedVectors))
+
 	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 + 2 + vectorLength*4 + 2 + classNameLength + 4 + schemaLength + 4 + metaLength + 4 + vectorWeightsLength
+	if namedVectorsLength > 0 {
+		totalBufferLength += 4 + namedVectorsLength
+	}
 	byteBuffer := make([]byte, totalBufferLength)
 	byteOps := byte_operations.ByteOperations{Buffer: byteBuffer}
 	byteOps.WriteByte(ko.MarshallerVersion)
@@ -542,9 +598,112 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		return byteBuffer, errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
+	if namedVectorsLength > 0 {
+		byteOps.WriteUint32(namedVectorsLength)
+		err = byteOps.CopyBytesToBuffer(namedVectors)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy named vectors")
+		}
+	}
+
 	return byteBuffer, nil
 }
 
+func marshalNamedVectors(vectors map[string][]float32) []byte {
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(vectors))
+	length := 0
+	for name, vector := range vectors {
+		names = append(names, name)
+		length += 2 + len(name) + 2 + len(vector)*4
+	}
+	// sort names for a deterministic representation
+	sort.Strings(names)
+
+	out := make([]byte, length)
+	pos := 0
+	for _, name := range names {
+		vector := vectors[name]
+		binary.LittleEndian.PutUint16(out[pos:], uint16(len(name)))
+		pos += 2
+		pos += copy(out[pos:], name)
+		binary.LittleEndian.PutUint16(out[pos:], uint16(len(vector)))
+		pos += 2
+		for _, v := range vector {
+			binary.LittleEndian.PutUint32(out[pos:], math.Float32bits(v))
+			pos += 4
+		}
+	}
+
+	return out
+}
+
+func unmarshalNamedVectors(in []byte) (map[string][]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	out := map[string][]float32{}
+	pos := 0
+	for pos < len(in) {
+		name, vector, next, err := readNamedVector(in, pos, nil)
+		if err != nil {
+			return nil, err
+		}
+		out[name] = vector
+		pos = next
+	}
+
+	return out, nil
+}
+
+// readNamedVector reads the named vector starting at pos and returns the
+// position of the next one. A nil buffer skips decoding the vector.
+func readNamedVector(in []byte, pos int, buffer []float32) (string, []float32, int, error) {
+	if pos+2 > len(in) {
+		return "", nil, 0, errors.Errorf("named vector at %d: unexpected end of data", pos)
+	}
+	nameLen := int(binary.LittleEndian.Uint16(in[pos:]))
+	pos += 2
+	if pos+nameLen+2 > len(in) {
+		return "", nil, 0, errors.Errorf("named vector at %d: unexpected end of data", pos)
+	}
+	name := string(in[pos : pos+nameLen])
+	pos += nameLen
+	vecLen := int(binary.LittleEndian.Uint16(in[pos:]))
+	pos += 2
+	if pos+vecLen*4 > len(in) {
+		return "", nil, 0, errors.Errorf("named vector %q: unexpected end of data", name)
+	}
+
+	var out []float32
+	if cap(buffer) >= vecLen {
+		out = buffer[:vecLen]
+	} else {
+		out = make([]float32, vecLen)
+	}
+	for i := range out {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[pos+i*4:]))
+	}
+
+	return name, out, pos + vecLen*4, nil
+}
+
+func (ko *Object) namedVectors() models.Vectors {
+	if len(ko.Vectors) == 0 {
+		return nil
+	}
+
+	out := make(models.Vectors, len(ko.Vectors))
+	for name, vector := range ko.Vectors {
+		out[name] = vector
+	}
+	return out
+}
+
 // UnmarshalPropertiesFromObject only unmarshals and returns the properties part of the object
 //
 // Check MarshalBinary for the order of elements in the input array
@@ -634,6 +793,10 @@ func parseValues(dt jsonparser.ValueType, value []byte) (interface{}, error) {
 // UnmarshalBinary is the versioned way to unmarshal a kind object from binary,
 // see MarshalBinary for the exact contents of each version
 func (ko *Object) UnmarshalBinary(data []byte) error {
+	return ko.unmarshalBinary(data, nil)
+}
+
+func (ko *Object) unmarshalBinary(data []byte, class *models.Class) error {
 	version := data[0]
 	if version != 1 {
 		return errors.Errorf("unsupported binary marshaller version %d", version)
@@ -684,6 +847,18 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		return errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
+	if byteOps.Position < uint64(len(data)) {
+		namedVectorsLength := uint64(byteOps.ReadUint32())
+		namedVectors, err := byteOps.CopyBytesFromBuffer(namedVectorsLength, nil)
+		if err != nil {
+			return errors.Wrap(err, "Could not copy named vectors")
+		}
+		ko.Vectors, err = unmarshalNamedVectors(namedVectors)
+		if err != nil {
+			return errors.Wrap(err, "Could not parse named vectors")
+		}
+	}
+
 	return ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
 		createTime,
@@ -692,6 +867,7 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		schema,
 		meta,
 		vectorWeights,
+		class,
 	)
 }
 
@@ -730,15 +906,62 @@ func VectorFromBinary(in []byte, buffer []float32) ([]float32, error) {
 	return out, nil
 }
 
+// NamedVectorFromBinary reads the named vector with the given name from the
+// binary representation of an object. It returns nil if the object has no
+// such vector.
+func NamedVectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	version := in[0]
+	if version != 1 {
+		return nil, errors.Errorf("unsupported marshaller version %d", version)
+	}
+
+	// skip the fixed size header and all variable size fields in front of the
+	// named vectors, see MarshalBinary for the layout
+	le := binary.LittleEndian
+	pos := 42
+	pos += 2 + int(le.Uint16(in[pos:]))*4 // vector
+	pos += 2 + int(le.Uint16(in[pos:]))   // class name
+	pos += 4 + int(le.Uint32(in[pos:]))   // schema
+	pos += 4 + int(le.Uint32(in[pos:]))   // meta
+	pos += 4 + int(le.Uint32(in[pos:]))   // vector weights
+	if pos+4 > len(in) {
+		// object has no named vectors
+		return nil, nil
+	}
+
+	end := pos + 4 + int(le.Uint32(in[pos:]))
+	if end > len(in) {
+		return nil, errors.Errorf("named vectors: unexpected end of data")
+	}
+	namedVectors := in[pos+4 : end]
+
+	for pos = 0; pos < len(namedVectors); {
+		name, vector, next, err := readNamedVector(namedVectors, pos, buffer)
+		if err != nil {
+			return nil, err
+		}
+		if name == targetVector {
+			return vector, nil
+		}
+		pos = next
+	}
+
+	return nil, nil
+}
+
 func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className string,
-	schemaB []byte, additionalB []byte, vectorWeightsB []byte,
+	schemaB []byte, additionalB []byte, vectorWeightsB []byte, class *models.Class,
 ) error {
 	var schema map[string]interface{}
 	if err := json.Unmarshal(schemaB, &schema); err != nil {
 		return err
 	}
 
-	if err := ko.enrichSchemaTypes(schema); err != nil {
+	if err := ko.enrichSchemaTypes(schema, class); err != nil {
 		return errors.Wrap(err, "enrich schema datatypes")
 	}
 
@@ -825,6 +1048,7 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		docID:             ko.docID,
 		Object:            deepCopyObject(ko.Object),
 		Vector:            deepCopyVector(ko.Vector),
+		Vectors:           deepCopyVectors(ko.Vectors),
 	}
 }
 
@@ -841,6 +1065,18 @@ func deepCopyVector(orig []float32) []float32 {
 	return out
 }
 
+func deepCopyVectors(orig map[string][]float32) map[string][]float32 {
+	if orig == nil {
+		return nil
+	}
+
+	out := make(map[string][]float32, len(orig))
+	for name, vector := range orig {
+		out[name] = deepCopyVector(vector)
+	}
+	return out
+}
+
 func deepCopyObject(orig models.Object) models.Object {
 	return models.Object{
 		Class:              orig.Class,
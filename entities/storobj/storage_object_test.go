@@ -85,6 +85,84 @@ func TestStorageObjectMarshalling(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingWithNamedVectors(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+			Vectors: models.Vectors{
+				"title":   {1, 2, 3},
+				"summary": {0.5, 0.25},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("full unmarshalling", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before.Vector, after.Vector)
+		assert.Equal(t, before.Vectors, after.Vectors)
+		assert.Equal(t, before.Properties(), after.Properties())
+	})
+
+	t.Run("optional unmarshalling with vector", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{Vector: true}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, before.Vectors, after.Vectors)
+		assert.Equal(t, models.Vectors{
+			"title":   {1, 2, 3},
+			"summary": {0.5, 0.25},
+		}, after.SearchResult(additional.Properties{}, "").Vectors)
+	})
+
+	t.Run("optional unmarshalling without vector", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.Nil(t, after.Vectors)
+	})
+
+	t.Run("extract single named vector", func(t *testing.T) {
+		vec, err := NamedVectorFromBinary(asBinary, nil, "summary")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0.5, 0.25}, vec)
+
+		vec, err = NamedVectorFromBinary(asBinary, make([]float32, 0, 8), "title")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{1, 2, 3}, vec)
+
+		vec, err = NamedVectorFromBinary(asBinary, nil, "unknown")
+		require.Nil(t, err)
+		assert.Nil(t, vec)
+	})
+
+	t.Run("objects without named vectors", func(t *testing.T) {
+		obj := FromObject(&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+		}, []float32{1, 2})
+		asBinary, err := obj.MarshalBinary()
+		require.Nil(t, err)
+
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Nil(t, after.Vectors)
+
+		vec, err := NamedVectorFromBinary(asBinary, nil, "title")
+		require.Nil(t, err)
+		assert.Nil(t, vec)
+	})
+}
+
 func TestFilteringNilProperty(t *testing.T) {
 	object := FromObject(
 		&models.Object{
@@ -141,7 +219,7 @@ func TestStorageObjectUnmarshallingSpecificProps(t *testing.T) {
 	require.Nil(t, err)
 
 	t.Run("without any optional", func(t *testing.T) {
-		after, err := FromBinaryOptional(asBinary, additional.Properties{})
+		after, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
 		require.Nil(t, err)
 
 		t.Run("compare", func(t *testing.T) {
@@ -332,6 +410,81 @@ func TestStorageArrayObjectMarshalling(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingWithClass(t *testing.T) {
+	// nested objects whose keys look like the values of other data types
+	geoLike := map[string]interface{}{"latitude": float64(1.5), "longitude": float64(2.5)}
+	phoneLike := map[string]interface{}{"input": "0171 1234567"}
+	refLike := []interface{}{map[string]interface{}{"beacon": "not a beacon"}}
+
+	class := &models.Class{
+		Class: "MyFavoriteClass",
+		Properties: []*models.Property{
+			{Name: "geoLike", DataType: schema.DataTypeObject.PropString()},
+			{Name: "phoneLike", DataType: schema.DataTypeObject.PropString()},
+			{Name: "refLike", DataType: schema.DataTypeObjectArray.PropString()},
+			{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+			{Name: "phone", DataType: schema.DataTypePhoneNumber.PropString()},
+			{Name: "ref", DataType: []string{"OtherClass"}},
+			{Name: "numbers", DataType: schema.DataTypeNumberArray.PropString()},
+		},
+	}
+
+	before := FromObject(
+		&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"geoLike":   geoLike,
+				"phoneLike": phoneLike,
+				"refLike":   refLike,
+				"location": &models.GeoCoordinates{
+					Latitude:  ptFloat32(1.5),
+					Longitude: ptFloat32(2.5),
+				},
+				"phone": &models.PhoneNumber{Input: "0171 1234567", Valid: true},
+				"ref": models.MultipleRef{
+					{Beacon: "weaviate://localhost/OtherClass/2c76ca18-2073-4c48-aa52-7f444d2f5b80"},
+				},
+				"numbers": []float64{1, 2},
+			},
+		},
+		nil,
+	)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("with class", func(t *testing.T) {
+		after, err := FromBinaryWithClass(asBinary, class)
+		require.Nil(t, err)
+
+		props := after.Properties().(map[string]interface{})
+		assert.Equal(t, geoLike, props["geoLike"])
+		assert.Equal(t, phoneLike, props["phoneLike"])
+		assert.Equal(t, refLike, props["refLike"])
+		assert.Equal(t, before.Properties(), after.Properties())
+	})
+
+	t.Run("optional with class", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{}, class)
+		require.Nil(t, err)
+		assert.Equal(t, before.Properties(), after.Properties())
+	})
+
+	t.Run("without class", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+
+		// the types are told from the values, which mistakes the nested
+		// objects for values of other data types
+		props := after.Properties().(map[string]interface{})
+		assert.IsType(t, &models.GeoCoordinates{}, props["geoLike"])
+		assert.IsType(t, &models.PhoneNumber{}, props["phoneLike"])
+		assert.IsType(t, models.MultipleRef{}, props["refLike"])
+		assert.Equal(t, before.Properties().(map[string]interface{})["location"], props["location"])
+	})
+}
+
 func TestExtractionOfSingleProperties(t *testing.T) {
 	expected := map[string]interface{}{
 		"numberArray":  []interface{}{1.1, 2.1},
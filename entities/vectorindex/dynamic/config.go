@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	// Set these defaults if the user leaves them blank
+	DefaultThreshold      = 10000
+	DefaultDistanceMetric = hnsw.DistanceCosine
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings
+// of a dynamic vector index. The index starts out as a flat index and is
+// upgraded to an hnsw index once Threshold vectors have been imported. The
+// configs of both underlying indexes can be set individually, the distance is
+// shared between them.
+type UserConfig struct {
+	Distance  string          `json:"distance"`
+	Threshold uint64          `json:"threshold"`
+	HnswUC    hnsw.UserConfig `json:"hnsw"`
+	FlatUC    flat.UserConfig `json:"flat"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "dynamic"
+}
+
+// DistanceName returns the distance metric used by the index
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = DefaultDistanceMetric
+	u.Threshold = DefaultThreshold
+	u.HnswUC = hnsw.NewDefaultUserConfig()
+	u.FlatUC = flat.NewDefaultUserConfig()
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if distance, ok := asMap["distance"].(string); ok {
+		uc.Distance = distance
+	}
+
+	if err := optionalThresholdFromMap(asMap, func(v uint64) {
+		uc.Threshold = v
+	}); err != nil {
+		return uc, err
+	}
+
+	hnswUC, err := hnsw.ParseAndValidateConfig(subConfig(asMap, "hnsw", uc.Distance))
+	if err != nil {
+		return uc, errors.Wrap(err, "invalid dynamic config")
+	}
+	uc.HnswUC = hnswUC.(hnsw.UserConfig)
+
+	flatUC, err := flat.ParseAndValidateConfig(subConfig(asMap, "flat", uc.Distance))
+	if err != nil {
+		return uc, errors.Wrap(err, "invalid dynamic config")
+	}
+	uc.FlatUC = flatUC.(flat.UserConfig)
+
+	return uc, uc.validate()
+}
+
+func (u *UserConfig) validate() error {
+	if u.HnswUC.Skip {
+		return fmt.Errorf("invalid dynamic config: hnsw.skip cannot be set, " +
+			"use vectorIndexType hnsw with skip instead")
+	}
+
+	return nil
+}
+
+// subConfig returns the config of one of the underlying indexes with the
+// shared distance applied to it
+func subConfig(in map[string]interface{}, name, distance string) map[string]interface{} {
+	out := map[string]interface{}{}
+	if asMap, ok := in[name].(map[string]interface{}); ok {
+		for key, value := range asMap {
+			out[key] = value
+		}
+	}
+	out["distance"] = distance
+	return out
+}
+
+func optionalThresholdFromMap(in map[string]interface{}, setFn func(v uint64)) error {
+	value, ok := in["threshold"]
+	if !ok {
+		return nil
+	}
+
+	var asInt64 int64
+	var err error
+
+	// depending on whether we get the results from disk or from the REST API,
+	// numbers may be represented slightly differently
+	switch typed := value.(type) {
+	case json.Number:
+		asInt64, err = typed.Int64()
+	case float64:
+		asInt64 = int64(typed)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "json.Number to int64 for %q", "threshold")
+	}
+
+	if asInt64 < 0 {
+		return fmt.Errorf("invalid dynamic config: threshold must be a positive integer")
+	}
+
+	setFn(uint64(asInt64))
+	return nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
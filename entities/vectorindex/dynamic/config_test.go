@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UserConfig(t *testing.T) {
+	t.Run("nothing specified, all defaults", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(nil)
+		require.Nil(t, err)
+		assert.Equal(t, NewDefaultUserConfig(), cfg)
+	})
+
+	t.Run("with threshold, distance and nested configs", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{
+			"distance":  "dot",
+			"threshold": json.Number("500"),
+			"hnsw": map[string]interface{}{
+				"maxConnections": json.Number("16"),
+				"distance":       "l2-squared",
+			},
+		})
+		require.Nil(t, err)
+
+		parsed := cfg.(UserConfig)
+		assert.Equal(t, "dot", parsed.Distance)
+		assert.Equal(t, uint64(500), parsed.Threshold)
+		assert.Equal(t, 16, parsed.HnswUC.MaxConnections)
+		// the distance is shared by both indexes
+		assert.Equal(t, "dot", parsed.HnswUC.Distance)
+		assert.Equal(t, "dot", parsed.FlatUC.Distance)
+	})
+
+	t.Run("with negative threshold", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"threshold": json.Number("-1"),
+		})
+		require.NotNil(t, err)
+	})
+
+	t.Run("with skip set on hnsw", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"hnsw": map[string]interface{}{
+				"skip": true,
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "skip cannot be set")
+	})
+}
@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UserConfig(t *testing.T) {
+	t.Run("nothing specified, all defaults", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(nil)
+		require.Nil(t, err)
+		assert.Equal(t, UserConfig{Distance: DefaultDistanceMetric}, cfg)
+	})
+
+	t.Run("with distance", func(t *testing.T) {
+		cfg, err := ParseAndValidateConfig(map[string]interface{}{
+			"distance": "l2-squared",
+		})
+		require.Nil(t, err)
+		assert.Equal(t, UserConfig{Distance: "l2-squared"}, cfg)
+	})
+
+	t.Run("with invalid distance", func(t *testing.T) {
+		_, err := ParseAndValidateConfig(map[string]interface{}{
+			"distance": "euclidean",
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unrecognized distance metric")
+	})
+}
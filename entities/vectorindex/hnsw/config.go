@@ -63,6 +63,8 @@ type UserConfig struct {
 	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
 	Distance               string   `json:"distance"`
 	PQ                     PQConfig `json:"pq"`
+	BQ                     BQConfig `json:"bq"`
+	SQ                     SQConfig `json:"sq"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -71,6 +73,11 @@ func (u UserConfig) IndexType() string {
 	return "hnsw"
 }
 
+// DistanceName returns the distance metric used by the index
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
 // SetDefaults in the user-specifyable part of the config
 func (u *UserConfig) SetDefaults() {
 	u.MaxConnections = DefaultMaxConnections
@@ -94,6 +101,14 @@ func (u *UserConfig) SetDefaults() {
 			Type:         DefaultPQEncoderType,
 			Distribution: DefaultPQEncoderDistribution,
 		},
+		OversamplingFactor: DefaultPQOversamplingFactor,
+	}
+	u.BQ = BQConfig{
+		Enabled: DefaultBQEnabled,
+	}
+	u.SQ = SQConfig{
+		Enabled:       DefaultSQEnabled,
+		TrainingLimit: DefaultSQTrainingLimit,
 	}
 }
 
@@ -182,6 +197,14 @@ func ParseAndValidateConfig(input interface{}) (schema.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := parseBQMap(asMap, &uc.BQ); err != nil {
+		return uc, err
+	}
+
+	if err := parseSQMap(asMap, &uc.SQ); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -201,6 +224,18 @@ func (u *UserConfig) validate() error {
 		))
 	}
 
+	if u.PQ.OversamplingFactor < 0 {
+		errMsgs = append(errMsgs, "pq oversamplingFactor must not be negative")
+	}
+
+	if u.PQ.Enabled && u.BQ.Enabled {
+		errMsgs = append(errMsgs, "pq and bq cannot be enabled at the same time")
+	}
+
+	if u.SQ.Enabled && (u.PQ.Enabled || u.BQ.Enabled) {
+		errMsgs = append(errMsgs, "sq cannot be enabled together with pq or bq")
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
@@ -30,6 +30,8 @@ const (
 	DefaultPQEncoderDistribution = PQEncoderDistributionLogNormal
 	DefaultPQCentroids           = 256
 	DefaultPQTrainingLimit       = 100000
+	// 0 disables oversampling, all ef candidates are rescored
+	DefaultPQOversamplingFactor = 0
 )
 
 // Product Quantization encoder configuration
@@ -46,6 +48,12 @@ type PQConfig struct {
 	Centroids      int       `json:"centroids"`
 	TrainingLimit  int       `json:"trainingLimit"`
 	Encoder        PQEncoder `json:"encoder"`
+
+	// OversamplingFactor controls how many candidates are fetched using the
+	// compressed distances before they are re-ranked with the original
+	// vectors. A search with limit k rescores the best k*OversamplingFactor
+	// candidates.
+	OversamplingFactor int `json:"oversamplingFactor"`
 }
 
 func validEncoder(v string) error {
@@ -165,6 +173,12 @@ func parsePQMap(in map[string]interface{}, pq *PQConfig) error {
 		return err
 	}
 
+	if err := optionalIntFromMap(pqConfigMap, "oversamplingFactor", func(v int) {
+		pq.OversamplingFactor = v
+	}); err != nil {
+		return err
+	}
+
 	pqEncoderValue, ok := pqConfigMap["encoder"]
 	if !ok {
 		return nil
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+const (
+	DefaultSQEnabled       = false
+	DefaultSQTrainingLimit = 100000
+)
+
+// Scalar Quantization configuration
+type SQConfig struct {
+	Enabled       bool `json:"enabled"`
+	TrainingLimit int  `json:"trainingLimit"`
+}
+
+func parseSQMap(in map[string]interface{}, sq *SQConfig) error {
+	sqConfigValue, ok := in["sq"]
+	if !ok {
+		return nil
+	}
+
+	sqConfigMap, ok := sqConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := optionalBoolFromMap(sqConfigMap, "enabled", func(v bool) {
+		sq.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := optionalIntFromMap(sqConfigMap, "trainingLimit", func(v int) {
+		sq.TrainingLimit = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
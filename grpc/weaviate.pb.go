@@ -27,23 +27,233 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type SearchRequest struct {
+type ConsistencyLevel int32
+
+const (
+	ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED ConsistencyLevel = 0
+	ConsistencyLevel_CONSISTENCY_LEVEL_ONE         ConsistencyLevel = 1
+	ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM      ConsistencyLevel = 2
+	ConsistencyLevel_CONSISTENCY_LEVEL_ALL         ConsistencyLevel = 3
+)
+
+// Enum value maps for ConsistencyLevel.
+var (
+	ConsistencyLevel_name = map[int32]string{
+		0: "CONSISTENCY_LEVEL_UNSPECIFIED",
+		1: "CONSISTENCY_LEVEL_ONE",
+		2: "CONSISTENCY_LEVEL_QUORUM",
+		3: "CONSISTENCY_LEVEL_ALL",
+	}
+	ConsistencyLevel_value = map[string]int32{
+		"CONSISTENCY_LEVEL_UNSPECIFIED": 0,
+		"CONSISTENCY_LEVEL_ONE":         1,
+		"CONSISTENCY_LEVEL_QUORUM":      2,
+		"CONSISTENCY_LEVEL_ALL":         3,
+	}
+)
+
+func (x ConsistencyLevel) Enum() *ConsistencyLevel {
+	p := new(ConsistencyLevel)
+	*p = x
+	return p
+}
+
+func (x ConsistencyLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsistencyLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_weaviate_proto_enumTypes[0].Descriptor()
+}
+
+func (ConsistencyLevel) Type() protoreflect.EnumType {
+	return &file_weaviate_proto_enumTypes[0]
+}
+
+func (x ConsistencyLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsistencyLevel.Descriptor instead.
+func (ConsistencyLevel) EnumDescriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{0}
+}
+
+type Filters_Operator int32
+
+const (
+	Filters_OPERATOR_UNSPECIFIED        Filters_Operator = 0
+	Filters_OPERATOR_EQUAL              Filters_Operator = 1
+	Filters_OPERATOR_NOT_EQUAL          Filters_Operator = 2
+	Filters_OPERATOR_GREATER_THAN       Filters_Operator = 3
+	Filters_OPERATOR_GREATER_THAN_EQUAL Filters_Operator = 4
+	Filters_OPERATOR_LESS_THAN          Filters_Operator = 5
+	Filters_OPERATOR_LESS_THAN_EQUAL    Filters_Operator = 6
+	Filters_OPERATOR_AND                Filters_Operator = 7
+	Filters_OPERATOR_OR                 Filters_Operator = 8
+	Filters_OPERATOR_NOT                Filters_Operator = 9
+	Filters_OPERATOR_WITHIN_GEO_RANGE   Filters_Operator = 10
+	Filters_OPERATOR_LIKE               Filters_Operator = 11
+	Filters_OPERATOR_IS_NULL            Filters_Operator = 12
+)
+
+// Enum value maps for Filters_Operator.
+var (
+	Filters_Operator_name = map[int32]string{
+		0:  "OPERATOR_UNSPECIFIED",
+		1:  "OPERATOR_EQUAL",
+		2:  "OPERATOR_NOT_EQUAL",
+		3:  "OPERATOR_GREATER_THAN",
+		4:  "OPERATOR_GREATER_THAN_EQUAL",
+		5:  "OPERATOR_LESS_THAN",
+		6:  "OPERATOR_LESS_THAN_EQUAL",
+		7:  "OPERATOR_AND",
+		8:  "OPERATOR_OR",
+		9:  "OPERATOR_NOT",
+		10: "OPERATOR_WITHIN_GEO_RANGE",
+		11: "OPERATOR_LIKE",
+		12: "OPERATOR_IS_NULL",
+	}
+	Filters_Operator_value = map[string]int32{
+		"OPERATOR_UNSPECIFIED":        0,
+		"OPERATOR_EQUAL":              1,
+		"OPERATOR_NOT_EQUAL":          2,
+		"OPERATOR_GREATER_THAN":       3,
+		"OPERATOR_GREATER_THAN_EQUAL": 4,
+		"OPERATOR_LESS_THAN":          5,
+		"OPERATOR_LESS_THAN_EQUAL":    6,
+		"OPERATOR_AND":                7,
+		"OPERATOR_OR":                 8,
+		"OPERATOR_NOT":                9,
+		"OPERATOR_WITHIN_GEO_RANGE":   10,
+		"OPERATOR_LIKE":               11,
+		"OPERATOR_IS_NULL":            12,
+	}
+)
+
+func (x Filters_Operator) Enum() *Filters_Operator {
+	p := new(Filters_Operator)
+	*p = x
+	return p
+}
+
+func (x Filters_Operator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Filters_Operator) Descriptor() protoreflect.EnumDescriptor {
+	return file_weaviate_proto_enumTypes[1].Descriptor()
+}
+
+func (Filters_Operator) Type() protoreflect.EnumType {
+	return &file_weaviate_proto_enumTypes[1]
+}
+
+func (x Filters_Operator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Filters_Operator.Descriptor instead.
+func (Filters_Operator) EnumDescriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type AggregateRequest_Aggregator int32
+
+const (
+	AggregateRequest_AGGREGATOR_UNSPECIFIED      AggregateRequest_Aggregator = 0
+	AggregateRequest_AGGREGATOR_COUNT            AggregateRequest_Aggregator = 1
+	AggregateRequest_AGGREGATOR_TYPE             AggregateRequest_Aggregator = 2
+	AggregateRequest_AGGREGATOR_MEAN             AggregateRequest_Aggregator = 3
+	AggregateRequest_AGGREGATOR_MEDIAN           AggregateRequest_Aggregator = 4
+	AggregateRequest_AGGREGATOR_MODE             AggregateRequest_Aggregator = 5
+	AggregateRequest_AGGREGATOR_MAXIMUM          AggregateRequest_Aggregator = 6
+	AggregateRequest_AGGREGATOR_MINIMUM          AggregateRequest_Aggregator = 7
+	AggregateRequest_AGGREGATOR_SUM              AggregateRequest_Aggregator = 8
+	AggregateRequest_AGGREGATOR_TOTAL_TRUE       AggregateRequest_Aggregator = 9
+	AggregateRequest_AGGREGATOR_TOTAL_FALSE      AggregateRequest_Aggregator = 10
+	AggregateRequest_AGGREGATOR_PERCENTAGE_TRUE  AggregateRequest_Aggregator = 11
+	AggregateRequest_AGGREGATOR_PERCENTAGE_FALSE AggregateRequest_Aggregator = 12
+	AggregateRequest_AGGREGATOR_TOP_OCCURRENCES  AggregateRequest_Aggregator = 13
+	AggregateRequest_AGGREGATOR_POINTING_TO      AggregateRequest_Aggregator = 14
+)
+
+// Enum value maps for AggregateRequest_Aggregator.
+var (
+	AggregateRequest_Aggregator_name = map[int32]string{
+		0:  "AGGREGATOR_UNSPECIFIED",
+		1:  "AGGREGATOR_COUNT",
+		2:  "AGGREGATOR_TYPE",
+		3:  "AGGREGATOR_MEAN",
+		4:  "AGGREGATOR_MEDIAN",
+		5:  "AGGREGATOR_MODE",
+		6:  "AGGREGATOR_MAXIMUM",
+		7:  "AGGREGATOR_MINIMUM",
+		8:  "AGGREGATOR_SUM",
+		9:  "AGGREGATOR_TOTAL_TRUE",
+		10: "AGGREGATOR_TOTAL_FALSE",
+		11: "AGGREGATOR_PERCENTAGE_TRUE",
+		12: "AGGREGATOR_PERCENTAGE_FALSE",
+		13: "AGGREGATOR_TOP_OCCURRENCES",
+		14: "AGGREGATOR_POINTING_TO",
+	}
+	AggregateRequest_Aggregator_value = map[string]int32{
+		"AGGREGATOR_UNSPECIFIED":      0,
+		"AGGREGATOR_COUNT":            1,
+		"AGGREGATOR_TYPE":             2,
+		"AGGREGATOR_MEAN":             3,
+		"AGGREGATOR_MEDIAN":           4,
+		"AGGREGATOR_MODE":             5,
+		"AGGREGATOR_MAXIMUM":          6,
+		"AGGREGATOR_MINIMUM":          7,
+		"AGGREGATOR_SUM":              8,
+		"AGGREGATOR_TOTAL_TRUE":       9,
+		"AGGREGATOR_TOTAL_FALSE":      10,
+		"AGGREGATOR_PERCENTAGE_TRUE":  11,
+		"AGGREGATOR_PERCENTAGE_FALSE": 12,
+		"AGGREGATOR_TOP_OCCURRENCES":  13,
+		"AGGREGATOR_POINTING_TO":      14,
+	}
+)
+
+func (x AggregateRequest_Aggregator) Enum() *AggregateRequest_Aggregator {
+	p := new(AggregateRequest_Aggregator)
+	*p = x
+	return p
+}
+
+func (x AggregateRequest_Aggregator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregateRequest_Aggregator) Descriptor() protoreflect.EnumDescriptor {
+	return file_weaviate_proto_enumTypes[2].Descriptor()
+}
+
+func (AggregateRequest_Aggregator) Type() protoreflect.EnumType {
+	return &file_weaviate_proto_enumTypes[2]
+}
+
+func (x AggregateRequest_Aggregator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregateRequest_Aggregator.Descriptor instead.
+func (AggregateRequest_Aggregator) EnumDescriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{24, 0}
+}
+
+type BatchObjectsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClassName            string                `protobuf:"bytes,1,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
-	Limit                uint32                `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	AdditionalProperties *AdditionalProperties `protobuf:"bytes,3,opt,name=additional_properties,json=additionalProperties,proto3" json:"additional_properties,omitempty"`
-	NearVector           *NearVectorParams     `protobuf:"bytes,4,opt,name=near_vector,json=nearVector,proto3" json:"near_vector,omitempty"`
-	NearObject           *NearObjectParams     `protobuf:"bytes,5,opt,name=near_object,json=nearObject,proto3" json:"near_object,omitempty"`
-	Properties           *Properties           `protobuf:"bytes,6,opt,name=properties,proto3" json:"properties,omitempty"`
-	HybridSearch         *HybridSearchParams   `protobuf:"bytes,7,opt,name=hybrid_search,json=hybridSearch,proto3" json:"hybrid_search,omitempty"`
-	Bm25Search           *BM25SearchParams     `protobuf:"bytes,8,opt,name=bm25_search,json=bm25Search,proto3" json:"bm25_search,omitempty"`
+	Objects          []*BatchObject    `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	ConsistencyLevel *ConsistencyLevel `protobuf:"varint,2,opt,name=consistency_level,json=consistencyLevel,proto3,enum=weaviategrpc.ConsistencyLevel,oneof" json:"consistency_level,omitempty"`
 }
 
-func (x *SearchRequest) Reset() {
-	*x = SearchRequest{}
+func (x *BatchObjectsRequest) Reset() {
+	*x = BatchObjectsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_weaviate_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -51,13 +261,13 @@ func (x *SearchRequest) Reset() {
 	}
 }
 
-func (x *SearchRequest) String() string {
+func (x *BatchObjectsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest) ProtoMessage() {}
+func (*BatchObjectsRequest) ProtoMessage() {}
 
-func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchObjectsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_weaviate_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -69,84 +279,41 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
-func (*SearchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchObjectsRequest.ProtoReflect.Descriptor instead.
+func (*BatchObjectsRequest) Descriptor() ([]byte, []int) {
 	return file_weaviate_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *SearchRequest) GetClassName() string {
-	if x != nil {
-		return x.ClassName
-	}
-	return ""
-}
-
-func (x *SearchRequest) GetLimit() uint32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *SearchRequest) GetAdditionalProperties() *AdditionalProperties {
-	if x != nil {
-		return x.AdditionalProperties
-	}
-	return nil
-}
-
-func (x *SearchRequest) GetNearVector() *NearVectorParams {
-	if x != nil {
-		return x.NearVector
-	}
-	return nil
-}
-
-func (x *SearchRequest) GetNearObject() *NearObjectParams {
-	if x != nil {
-		return x.NearObject
-	}
-	return nil
-}
-
-func (x *SearchRequest) GetProperties() *Properties {
-	if x != nil {
-		return x.Properties
-	}
-	return nil
-}
-
-func (x *SearchRequest) GetHybridSearch() *HybridSearchParams {
+func (x *BatchObjectsRequest) GetObjects() []*BatchObject {
 	if x != nil {
-		return x.HybridSearch
+		return x.Objects
 	}
 	return nil
 }
 
-func (x *SearchRequest) GetBm25Search() *BM25SearchParams {
-	if x != nil {
-		return x.Bm25Search
+func (x *BatchObjectsRequest) GetConsistencyLevel() ConsistencyLevel {
+	if x != nil && x.ConsistencyLevel != nil {
+		return *x.ConsistencyLevel
 	}
-	return nil
+	return ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED
 }
 
-type AdditionalProperties struct {
+type BatchObject struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid               bool `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Vector             bool `protobuf:"varint,2,opt,name=vector,proto3" json:"vector,omitempty"`
-	CreationTimeUnix   bool `protobuf:"varint,3,opt,name=creationTimeUnix,proto3" json:"creationTimeUnix,omitempty"`
-	LastUpdateTimeUnix bool `protobuf:"varint,4,opt,name=lastUpdateTimeUnix,proto3" json:"lastUpdateTimeUnix,omitempty"`
-	Distance           bool `protobuf:"varint,5,opt,name=distance,proto3" json:"distance,omitempty"`
-	Certainty          bool `protobuf:"varint,6,opt,name=certainty,proto3" json:"certainty,omitempty"`
-	Score              bool `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
-	ExplainScore       bool `protobuf:"varint,8,opt,name=explainScore,proto3" json:"explainScore,omitempty"`
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// little endian encoded float32 values
+	VectorBytes []byte                     `protobuf:"bytes,2,opt,name=vector_bytes,json=vectorBytes,proto3" json:"vector_bytes,omitempty"`
+	Properties  *BatchObject_Properties    `protobuf:"bytes,3,opt,name=properties,proto3" json:"properties,omitempty"`
+	ClassName   string                     `protobuf:"bytes,4,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	Tenant      string                     `protobuf:"bytes,5,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Vectors     []*BatchObject_NamedVector `protobuf:"bytes,6,rep,name=vectors,proto3" json:"vectors,omitempty"`
 }
 
-func (x *AdditionalProperties) Reset() {
-	*x = AdditionalProperties{}
+func (x *BatchObject) Reset() {
+	*x = BatchObject{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_weaviate_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -154,13 +321,13 @@ func (x *AdditionalProperties) Reset() {
 	}
 }
 
-func (x *AdditionalProperties) String() string {
+func (x *BatchObject) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdditionalProperties) ProtoMessage() {}
+func (*BatchObject) ProtoMessage() {}
 
-func (x *AdditionalProperties) ProtoReflect() protoreflect.Message {
+func (x *BatchObject) ProtoReflect() protoreflect.Message {
 	mi := &file_weaviate_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -172,78 +339,66 @@ func (x *AdditionalProperties) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdditionalProperties.ProtoReflect.Descriptor instead.
-func (*AdditionalProperties) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchObject.ProtoReflect.Descriptor instead.
+func (*BatchObject) Descriptor() ([]byte, []int) {
 	return file_weaviate_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *AdditionalProperties) GetUuid() bool {
+func (x *BatchObject) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
-	return false
-}
-
-func (x *AdditionalProperties) GetVector() bool {
-	if x != nil {
-		return x.Vector
-	}
-	return false
-}
-
-func (x *AdditionalProperties) GetCreationTimeUnix() bool {
-	if x != nil {
-		return x.CreationTimeUnix
-	}
-	return false
+	return ""
 }
 
-func (x *AdditionalProperties) GetLastUpdateTimeUnix() bool {
+func (x *BatchObject) GetVectorBytes() []byte {
 	if x != nil {
-		return x.LastUpdateTimeUnix
+		return x.VectorBytes
 	}
-	return false
+	return nil
 }
 
-func (x *AdditionalProperties) GetDistance() bool {
+func (x *BatchObject) GetProperties() *BatchObject_Properties {
 	if x != nil {
-		return x.Distance
+		return x.Properties
 	}
-	return false
+	return nil
 }
 
-func (x *AdditionalProperties) GetCertainty() bool {
+func (x *BatchObject) GetClassName() string {
 	if x != nil {
-		return x.Certainty
+		return x.ClassName
 	}
-	return false
+	return ""
 }
 
-func (x *AdditionalProperties) GetScore() bool {
+func (x *BatchObject) GetTenant() string {
 	if x != nil {
-		return x.Score
+		return x.Tenant
 	}
-	return false
+	return ""
 }
 
-func (x *AdditionalProperties) GetExplainScore() bool {
+func (x *BatchObject) GetVectors() []*BatchObject_NamedVector {
 	if x != nil {
-		return x.ExplainScore
+		return x.Vectors
 	}
-	return false
+	return nil
 }
 
-type Properties struct {
+// BatchStreamRequest is a single chunk of a streamed import, every chunk is
+// acknowledged by exactly one BatchStreamReply
+type BatchStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NonRefProperties []string         `protobuf:"bytes,1,rep,name=non_ref_properties,json=nonRefProperties,proto3" json:"non_ref_properties,omitempty"`
-	RefProperties    []*RefProperties `protobuf:"bytes,2,rep,name=ref_properties,json=refProperties,proto3" json:"ref_properties,omitempty"`
+	Objects          []*BatchObject    `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	ConsistencyLevel *ConsistencyLevel `protobuf:"varint,2,opt,name=consistency_level,json=consistencyLevel,proto3,enum=weaviategrpc.ConsistencyLevel,oneof" json:"consistency_level,omitempty"`
 }
 
-func (x *Properties) Reset() {
-	*x = Properties{}
+func (x *BatchStreamRequest) Reset() {
+	*x = BatchStreamRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_weaviate_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -251,13 +406,13 @@ func (x *Properties) Reset() {
 	}
 }
 
-func (x *Properties) String() string {
+func (x *BatchStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Properties) ProtoMessage() {}
+func (*BatchStreamRequest) ProtoMessage() {}
 
-func (x *Properties) ProtoReflect() protoreflect.Message {
+func (x *BatchStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_weaviate_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -269,39 +424,45 @@ func (x *Properties) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Properties.ProtoReflect.Descriptor instead.
-func (*Properties) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchStreamRequest.ProtoReflect.Descriptor instead.
+func (*BatchStreamRequest) Descriptor() ([]byte, []int) {
 	return file_weaviate_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Properties) GetNonRefProperties() []string {
+func (x *BatchStreamRequest) GetObjects() []*BatchObject {
 	if x != nil {
-		return x.NonRefProperties
+		return x.Objects
 	}
 	return nil
 }
 
-func (x *Properties) GetRefProperties() []*RefProperties {
-	if x != nil {
-		return x.RefProperties
+func (x *BatchStreamRequest) GetConsistencyLevel() ConsistencyLevel {
+	if x != nil && x.ConsistencyLevel != nil {
+		return *x.ConsistencyLevel
 	}
-	return nil
+	return ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED
 }
 
-type HybridSearchParams struct {
+type BatchStreamReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query      string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Properties []string `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
-	// protolint:disable:next REPEATED_FIELD_NAMES_PLURALIZED
-	Vector []float32 `protobuf:"fixed32,3,rep,packed,name=vector,proto3" json:"vector,omitempty"`
-	Alpha  float32   `protobuf:"fixed32,4,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	// sequence number of the acknowledged chunk, starting at 0
+	Chunk int64 `protobuf:"varint,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// number of objects of the chunk that were persisted
+	Persisted int32                          `protobuf:"varint,2,opt,name=persisted,proto3" json:"persisted,omitempty"`
+	Errors    []*BatchStreamReply_BatchError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	// set if the server is under memory or memtable pressure, the server
+	// delays reading the next chunk until the pressure is relieved
+	Backpressure bool `protobuf:"varint,4,opt,name=backpressure,proto3" json:"backpressure,omitempty"`
+	// suggested number of objects for the next chunk
+	NextChunkSize int32   `protobuf:"varint,5,opt,name=next_chunk_size,json=nextChunkSize,proto3" json:"next_chunk_size,omitempty"`
+	Took          float32 `protobuf:"fixed32,6,opt,name=took,proto3" json:"took,omitempty"`
 }
 
-func (x *HybridSearchParams) Reset() {
-	*x = HybridSearchParams{}
+func (x *BatchStreamReply) Reset() {
+	*x = BatchStreamReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_weaviate_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -309,13 +470,13 @@ func (x *HybridSearchParams) Reset() {
 	}
 }
 
-func (x *HybridSearchParams) String() string {
+func (x *BatchStreamReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HybridSearchParams) ProtoMessage() {}
+func (*BatchStreamReply) ProtoMessage() {}
 
-func (x *HybridSearchParams) ProtoReflect() protoreflect.Message {
+func (x *BatchStreamReply) ProtoReflect() protoreflect.Message {
 	mi := &file_weaviate_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -327,50 +488,65 @@ func (x *HybridSearchParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HybridSearchParams.ProtoReflect.Descriptor instead.
-func (*HybridSearchParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchStreamReply.ProtoReflect.Descriptor instead.
+func (*BatchStreamReply) Descriptor() ([]byte, []int) {
 	return file_weaviate_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *HybridSearchParams) GetQuery() string {
+func (x *BatchStreamReply) GetChunk() int64 {
 	if x != nil {
-		return x.Query
+		return x.Chunk
 	}
-	return ""
+	return 0
 }
 
-func (x *HybridSearchParams) GetProperties() []string {
+func (x *BatchStreamReply) GetPersisted() int32 {
 	if x != nil {
-		return x.Properties
+		return x.Persisted
 	}
-	return nil
+	return 0
 }
 
-func (x *HybridSearchParams) GetVector() []float32 {
+func (x *BatchStreamReply) GetErrors() []*BatchStreamReply_BatchError {
 	if x != nil {
-		return x.Vector
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *HybridSearchParams) GetAlpha() float32 {
+func (x *BatchStreamReply) GetBackpressure() bool {
 	if x != nil {
-		return x.Alpha
+		return x.Backpressure
+	}
+	return false
+}
+
+func (x *BatchStreamReply) GetNextChunkSize() int32 {
+	if x != nil {
+		return x.NextChunkSize
 	}
 	return 0
 }
 
-type BM25SearchParams struct {
+func (x *BatchStreamReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+type BatchObjectsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query      string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Properties []string `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
+	// results in the order of the request objects
+	Results []*BatchObjectsReply_BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Took    float32                          `protobuf:"fixed32,2,opt,name=took,proto3" json:"took,omitempty"`
 }
 
-func (x *BM25SearchParams) Reset() {
-	*x = BM25SearchParams{}
+func (x *BatchObjectsReply) Reset() {
+	*x = BatchObjectsReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_weaviate_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -378,13 +554,13 @@ func (x *BM25SearchParams) Reset() {
 	}
 }
 
-func (x *BM25SearchParams) String() string {
+func (x *BatchObjectsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BM25SearchParams) ProtoMessage() {}
+func (*BatchObjectsReply) ProtoMessage() {}
 
-func (x *BM25SearchParams) ProtoReflect() protoreflect.Message {
+func (x *BatchObjectsReply) ProtoReflect() protoreflect.Message {
 	mi := &file_weaviate_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -396,37 +572,55 @@ func (x *BM25SearchParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BM25SearchParams.ProtoReflect.Descriptor instead.
-func (*BM25SearchParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchObjectsReply.ProtoReflect.Descriptor instead.
+func (*BatchObjectsReply) Descriptor() ([]byte, []int) {
 	return file_weaviate_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *BM25SearchParams) GetQuery() string {
+func (x *BatchObjectsReply) GetResults() []*BatchObjectsReply_BatchResult {
 	if x != nil {
-		return x.Query
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *BM25SearchParams) GetProperties() []string {
+func (x *BatchObjectsReply) GetTook() float32 {
 	if x != nil {
-		return x.Properties
+		return x.Took
 	}
-	return nil
+	return 0
 }
 
-type RefProperties struct {
+type SearchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LinkedClass       string      `protobuf:"bytes,1,opt,name=linked_class,json=linkedClass,proto3" json:"linked_class,omitempty"`
-	ReferenceProperty string      `protobuf:"bytes,2,opt,name=reference_property,json=referenceProperty,proto3" json:"reference_property,omitempty"`
-	LinkedProperties  *Properties `protobuf:"bytes,3,opt,name=linked_properties,json=linkedProperties,proto3" json:"linked_properties,omitempty"`
+	ClassName            string                `protobuf:"bytes,1,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	Limit                uint32                `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	AdditionalProperties *AdditionalProperties `protobuf:"bytes,3,opt,name=additional_properties,json=additionalProperties,proto3" json:"additional_properties,omitempty"`
+	NearVector           *NearVectorParams     `protobuf:"bytes,4,opt,name=near_vector,json=nearVector,proto3" json:"near_vector,omitempty"`
+	NearObject           *NearObjectParams     `protobuf:"bytes,5,opt,name=near_object,json=nearObject,proto3" json:"near_object,omitempty"`
+	Properties           *Properties           `protobuf:"bytes,6,opt,name=properties,proto3" json:"properties,omitempty"`
+	HybridSearch         *HybridSearchParams   `protobuf:"bytes,7,opt,name=hybrid_search,json=hybridSearch,proto3" json:"hybrid_search,omitempty"`
+	Bm25Search           *BM25SearchParams     `protobuf:"bytes,8,opt,name=bm25_search,json=bm25Search,proto3" json:"bm25_search,omitempty"`
+	Filters              *Filters              `protobuf:"bytes,9,opt,name=filters,proto3" json:"filters,omitempty"`
+	Offset               uint32                `protobuf:"varint,10,opt,name=offset,proto3" json:"offset,omitempty"`
+	Autocut              uint32                `protobuf:"varint,11,opt,name=autocut,proto3" json:"autocut,omitempty"`
+	// cursor, id of the object after which results are returned
+	After            string            `protobuf:"bytes,12,opt,name=after,proto3" json:"after,omitempty"`
+	SortBy           []*SortBy         `protobuf:"bytes,13,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	GroupBy          *GroupBy          `protobuf:"bytes,14,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	Tenant           string            `protobuf:"bytes,15,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	ConsistencyLevel *ConsistencyLevel `protobuf:"varint,16,opt,name=consistency_level,json=consistencyLevel,proto3,enum=weaviategrpc.ConsistencyLevel,oneof" json:"consistency_level,omitempty"`
+	// search operators provided by modules, e.g. nearText, nearImage or ask
+	ModuleSearch []*ModuleArgument `protobuf:"bytes,17,rep,name=module_search,json=moduleSearch,proto3" json:"module_search,omitempty"`
+	// additional properties provided by modules, e.g. generate or answer
+	ModuleAdditional []*ModuleArgument `protobuf:"bytes,18,rep,name=module_additional,json=moduleAdditional,proto3" json:"module_additional,omitempty"`
 }
 
-func (x *RefProperties) Reset() {
-	*x = RefProperties{}
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_weaviate_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -434,13 +628,13 @@ func (x *RefProperties) Reset() {
 	}
 }
 
-func (x *RefProperties) String() string {
+func (x *SearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefProperties) ProtoMessage() {}
+func (*SearchRequest) ProtoMessage() {}
 
-func (x *RefProperties) ProtoReflect() protoreflect.Message {
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_weaviate_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -452,123 +646,166 @@ func (x *RefProperties) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefProperties.ProtoReflect.Descriptor instead.
-func (*RefProperties) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
 	return file_weaviate_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RefProperties) GetLinkedClass() string {
+func (x *SearchRequest) GetClassName() string {
 	if x != nil {
-		return x.LinkedClass
+		return x.ClassName
 	}
 	return ""
 }
 
-func (x *RefProperties) GetReferenceProperty() string {
+func (x *SearchRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.ReferenceProperty
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *RefProperties) GetLinkedProperties() *Properties {
+func (x *SearchRequest) GetAdditionalProperties() *AdditionalProperties {
 	if x != nil {
-		return x.LinkedProperties
+		return x.AdditionalProperties
 	}
 	return nil
 }
 
-type NearVectorParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// protolint:disable:next REPEATED_FIELD_NAMES_PLURALIZED
-	Vector    []float32 `protobuf:"fixed32,1,rep,packed,name=vector,proto3" json:"vector,omitempty"`
-	Certainty *float64  `protobuf:"fixed64,2,opt,name=certainty,proto3,oneof" json:"certainty,omitempty"`
-	Distance  *float64  `protobuf:"fixed64,3,opt,name=distance,proto3,oneof" json:"distance,omitempty"`
+func (x *SearchRequest) GetNearVector() *NearVectorParams {
+	if x != nil {
+		return x.NearVector
+	}
+	return nil
 }
 
-func (x *NearVectorParams) Reset() {
-	*x = NearVectorParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SearchRequest) GetNearObject() *NearObjectParams {
+	if x != nil {
+		return x.NearObject
 	}
+	return nil
 }
 
-func (x *NearVectorParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SearchRequest) GetProperties() *Properties {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
 }
 
-func (*NearVectorParams) ProtoMessage() {}
-
-func (x *NearVectorParams) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SearchRequest) GetHybridSearch() *HybridSearchParams {
+	if x != nil {
+		return x.HybridSearch
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use NearVectorParams.ProtoReflect.Descriptor instead.
-func (*NearVectorParams) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{6}
+func (x *SearchRequest) GetBm25Search() *BM25SearchParams {
+	if x != nil {
+		return x.Bm25Search
+	}
+	return nil
 }
 
-func (x *NearVectorParams) GetVector() []float32 {
+func (x *SearchRequest) GetFilters() *Filters {
 	if x != nil {
-		return x.Vector
+		return x.Filters
 	}
 	return nil
 }
 
-func (x *NearVectorParams) GetCertainty() float64 {
-	if x != nil && x.Certainty != nil {
-		return *x.Certainty
+func (x *SearchRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *NearVectorParams) GetDistance() float64 {
-	if x != nil && x.Distance != nil {
-		return *x.Distance
+func (x *SearchRequest) GetAutocut() uint32 {
+	if x != nil {
+		return x.Autocut
 	}
 	return 0
 }
 
-type NearObjectParams struct {
+func (x *SearchRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetSortBy() []*SortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetGroupBy() *GroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetConsistencyLevel() ConsistencyLevel {
+	if x != nil && x.ConsistencyLevel != nil {
+		return *x.ConsistencyLevel
+	}
+	return ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED
+}
+
+func (x *SearchRequest) GetModuleSearch() []*ModuleArgument {
+	if x != nil {
+		return x.ModuleSearch
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetModuleAdditional() []*ModuleArgument {
+	if x != nil {
+		return x.ModuleAdditional
+	}
+	return nil
+}
+
+// ModuleArgument passes arguments to a module the same way the GraphQL API
+// does. The name is the name of the GraphQL argument or field and the
+// arguments follow the structure of its GraphQL input type.
+type ModuleArgument struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Certainty *float64 `protobuf:"fixed64,2,opt,name=certainty,proto3,oneof" json:"certainty,omitempty"`
-	Distance  *float64 `protobuf:"fixed64,3,opt,name=distance,proto3,oneof" json:"distance,omitempty"`
+	Name      string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Arguments *structpb.Struct `protobuf:"bytes,2,opt,name=arguments,proto3" json:"arguments,omitempty"`
 }
 
-func (x *NearObjectParams) Reset() {
-	*x = NearObjectParams{}
+func (x *ModuleArgument) Reset() {
+	*x = ModuleArgument{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[7]
+		mi := &file_weaviate_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NearObjectParams) String() string {
+func (x *ModuleArgument) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NearObjectParams) ProtoMessage() {}
+func (*ModuleArgument) ProtoMessage() {}
 
-func (x *NearObjectParams) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[7]
+func (x *ModuleArgument) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,58 +816,52 @@ func (x *NearObjectParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NearObjectParams.ProtoReflect.Descriptor instead.
-func (*NearObjectParams) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ModuleArgument.ProtoReflect.Descriptor instead.
+func (*ModuleArgument) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *NearObjectParams) GetId() string {
+func (x *ModuleArgument) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *NearObjectParams) GetCertainty() float64 {
-	if x != nil && x.Certainty != nil {
-		return *x.Certainty
-	}
-	return 0
-}
-
-func (x *NearObjectParams) GetDistance() float64 {
-	if x != nil && x.Distance != nil {
-		return *x.Distance
+func (x *ModuleArgument) GetArguments() *structpb.Struct {
+	if x != nil {
+		return x.Arguments
 	}
-	return 0
+	return nil
 }
 
-type SearchReply struct {
+type SortBy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Results []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	Took    float32         `protobuf:"fixed32,2,opt,name=took,proto3" json:"took,omitempty"`
+	Ascending bool `protobuf:"varint,1,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	// path to the property, currently only a single property is supported
+	Path []string `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *SearchReply) Reset() {
-	*x = SearchReply{}
+func (x *SortBy) Reset() {
+	*x = SortBy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[8]
+		mi := &file_weaviate_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchReply) String() string {
+func (x *SortBy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchReply) ProtoMessage() {}
+func (*SortBy) ProtoMessage() {}
 
-func (x *SearchReply) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[8]
+func (x *SortBy) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,51 +872,53 @@ func (x *SearchReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchReply.ProtoReflect.Descriptor instead.
-func (*SearchReply) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SortBy.ProtoReflect.Descriptor instead.
+func (*SortBy) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SearchReply) GetResults() []*SearchResult {
+func (x *SortBy) GetAscending() bool {
 	if x != nil {
-		return x.Results
+		return x.Ascending
 	}
-	return nil
+	return false
 }
 
-func (x *SearchReply) GetTook() float32 {
+func (x *SortBy) GetPath() []string {
 	if x != nil {
-		return x.Took
+		return x.Path
 	}
-	return 0
+	return nil
 }
 
-type SearchResult struct {
+type GroupBy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Properties           *ResultProperties      `protobuf:"bytes,1,opt,name=properties,proto3" json:"properties,omitempty"`
-	AdditionalProperties *ResultAdditionalProps `protobuf:"bytes,2,opt,name=additional_properties,json=additionalProperties,proto3" json:"additional_properties,omitempty"`
+	// path to the property, currently only a single property is supported
+	Path            []string `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	NumberOfGroups  int32    `protobuf:"varint,2,opt,name=number_of_groups,json=numberOfGroups,proto3" json:"number_of_groups,omitempty"`
+	ObjectsPerGroup int32    `protobuf:"varint,3,opt,name=objects_per_group,json=objectsPerGroup,proto3" json:"objects_per_group,omitempty"`
 }
 
-func (x *SearchResult) Reset() {
-	*x = SearchResult{}
+func (x *GroupBy) Reset() {
+	*x = GroupBy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[9]
+		mi := &file_weaviate_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchResult) String() string {
+func (x *GroupBy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchResult) ProtoMessage() {}
+func (*GroupBy) ProtoMessage() {}
 
-func (x *SearchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[9]
+func (x *GroupBy) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -696,63 +929,70 @@ func (x *SearchResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
-func (*SearchResult) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GroupBy.ProtoReflect.Descriptor instead.
+func (*GroupBy) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SearchResult) GetProperties() *ResultProperties {
+func (x *GroupBy) GetPath() []string {
 	if x != nil {
-		return x.Properties
+		return x.Path
 	}
 	return nil
 }
 
-func (x *SearchResult) GetAdditionalProperties() *ResultAdditionalProps {
+func (x *GroupBy) GetNumberOfGroups() int32 {
 	if x != nil {
-		return x.AdditionalProperties
+		return x.NumberOfGroups
 	}
-	return nil
+	return 0
 }
 
-type ResultAdditionalProps struct {
+func (x *GroupBy) GetObjectsPerGroup() int32 {
+	if x != nil {
+		return x.ObjectsPerGroup
+	}
+	return 0
+}
+
+type Filters struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                        string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Vector                    []float32 `protobuf:"fixed32,2,rep,packed,name=vector,proto3" json:"vector,omitempty"`
-	CreationTimeUnix          int64     `protobuf:"varint,3,opt,name=creation_time_unix,json=creationTimeUnix,proto3" json:"creation_time_unix,omitempty"`
-	CreationTimeUnixPresent   bool      `protobuf:"varint,4,opt,name=creation_time_unix_present,json=creationTimeUnixPresent,proto3" json:"creation_time_unix_present,omitempty"`
-	LastUpdateTimeUnix        int64     `protobuf:"varint,5,opt,name=last_update_time_unix,json=lastUpdateTimeUnix,proto3" json:"last_update_time_unix,omitempty"`
-	LastUpdateTimeUnixPresent bool      `protobuf:"varint,6,opt,name=last_update_time_unix_present,json=lastUpdateTimeUnixPresent,proto3" json:"last_update_time_unix_present,omitempty"`
-	Distance                  float32   `protobuf:"fixed32,7,opt,name=distance,proto3" json:"distance,omitempty"`
-	DistancePresent           bool      `protobuf:"varint,8,opt,name=distance_present,json=distancePresent,proto3" json:"distance_present,omitempty"`
-	Certainty                 float32   `protobuf:"fixed32,9,opt,name=certainty,proto3" json:"certainty,omitempty"`
-	CertaintyPresent          bool      `protobuf:"varint,10,opt,name=certainty_present,json=certaintyPresent,proto3" json:"certainty_present,omitempty"`
-	Score                     float32   `protobuf:"fixed32,11,opt,name=score,proto3" json:"score,omitempty"`
-	ScorePresent              bool      `protobuf:"varint,12,opt,name=score_present,json=scorePresent,proto3" json:"score_present,omitempty"`
-	ExplainScore              string    `protobuf:"bytes,13,opt,name=explain_score,json=explainScore,proto3" json:"explain_score,omitempty"`
-	ExplainScorePresent       bool      `protobuf:"varint,14,opt,name=explain_score_present,json=explainScorePresent,proto3" json:"explain_score_present,omitempty"`
+	Operator Filters_Operator `protobuf:"varint,1,opt,name=operator,proto3,enum=weaviategrpc.Filters_Operator" json:"operator,omitempty"`
+	// path to the property, references alternate between the reference
+	// property and the target class, e.g. ["hasAuthor", "Author", "name"]
+	On []string `protobuf:"bytes,2,rep,name=on,proto3" json:"on,omitempty"`
+	// operands of the And, Or and Not operators
+	Filters []*Filters `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+	// Types that are assignable to TestValue:
+	//	*Filters_ValueText
+	//	*Filters_ValueInt
+	//	*Filters_ValueBoolean
+	//	*Filters_ValueNumber
+	//	*Filters_ValueDate
+	//	*Filters_ValueGeo
+	TestValue isFilters_TestValue `protobuf_oneof:"test_value"`
 }
 
-func (x *ResultAdditionalProps) Reset() {
-	*x = ResultAdditionalProps{}
+func (x *Filters) Reset() {
+	*x = Filters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[10]
+		mi := &file_weaviate_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResultAdditionalProps) String() string {
+func (x *Filters) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResultAdditionalProps) ProtoMessage() {}
+func (*Filters) ProtoMessage() {}
 
-func (x *ResultAdditionalProps) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[10]
+func (x *Filters) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,136 +1003,2325 @@ func (x *ResultAdditionalProps) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResultAdditionalProps.ProtoReflect.Descriptor instead.
-func (*ResultAdditionalProps) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Filters.ProtoReflect.Descriptor instead.
+func (*Filters) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ResultAdditionalProps) GetId() string {
+func (x *Filters) GetOperator() Filters_Operator {
 	if x != nil {
-		return x.Id
+		return x.Operator
 	}
-	return ""
+	return Filters_OPERATOR_UNSPECIFIED
 }
 
-func (x *ResultAdditionalProps) GetVector() []float32 {
+func (x *Filters) GetOn() []string {
 	if x != nil {
-		return x.Vector
+		return x.On
 	}
 	return nil
 }
 
-func (x *ResultAdditionalProps) GetCreationTimeUnix() int64 {
+func (x *Filters) GetFilters() []*Filters {
 	if x != nil {
-		return x.CreationTimeUnix
+		return x.Filters
 	}
-	return 0
+	return nil
 }
 
-func (x *ResultAdditionalProps) GetCreationTimeUnixPresent() bool {
-	if x != nil {
-		return x.CreationTimeUnixPresent
+func (m *Filters) GetTestValue() isFilters_TestValue {
+	if m != nil {
+		return m.TestValue
 	}
-	return false
+	return nil
 }
 
-func (x *ResultAdditionalProps) GetLastUpdateTimeUnix() int64 {
-	if x != nil {
+func (x *Filters) GetValueText() string {
+	if x, ok := x.GetTestValue().(*Filters_ValueText); ok {
+		return x.ValueText
+	}
+	return ""
+}
+
+func (x *Filters) GetValueInt() int64 {
+	if x, ok := x.GetTestValue().(*Filters_ValueInt); ok {
+		return x.ValueInt
+	}
+	return 0
+}
+
+func (x *Filters) GetValueBoolean() bool {
+	if x, ok := x.GetTestValue().(*Filters_ValueBoolean); ok {
+		return x.ValueBoolean
+	}
+	return false
+}
+
+func (x *Filters) GetValueNumber() float64 {
+	if x, ok := x.GetTestValue().(*Filters_ValueNumber); ok {
+		return x.ValueNumber
+	}
+	return 0
+}
+
+func (x *Filters) GetValueDate() string {
+	if x, ok := x.GetTestValue().(*Filters_ValueDate); ok {
+		return x.ValueDate
+	}
+	return ""
+}
+
+func (x *Filters) GetValueGeo() *GeoCoordinatesFilter {
+	if x, ok := x.GetTestValue().(*Filters_ValueGeo); ok {
+		return x.ValueGeo
+	}
+	return nil
+}
+
+type isFilters_TestValue interface {
+	isFilters_TestValue()
+}
+
+type Filters_ValueText struct {
+	ValueText string `protobuf:"bytes,4,opt,name=value_text,json=valueText,proto3,oneof"`
+}
+
+type Filters_ValueInt struct {
+	ValueInt int64 `protobuf:"varint,5,opt,name=value_int,json=valueInt,proto3,oneof"`
+}
+
+type Filters_ValueBoolean struct {
+	ValueBoolean bool `protobuf:"varint,6,opt,name=value_boolean,json=valueBoolean,proto3,oneof"`
+}
+
+type Filters_ValueNumber struct {
+	ValueNumber float64 `protobuf:"fixed64,7,opt,name=value_number,json=valueNumber,proto3,oneof"`
+}
+
+type Filters_ValueDate struct {
+	ValueDate string `protobuf:"bytes,8,opt,name=value_date,json=valueDate,proto3,oneof"`
+}
+
+type Filters_ValueGeo struct {
+	ValueGeo *GeoCoordinatesFilter `protobuf:"bytes,9,opt,name=value_geo,json=valueGeo,proto3,oneof"`
+}
+
+func (*Filters_ValueText) isFilters_TestValue() {}
+
+func (*Filters_ValueInt) isFilters_TestValue() {}
+
+func (*Filters_ValueBoolean) isFilters_TestValue() {}
+
+func (*Filters_ValueNumber) isFilters_TestValue() {}
+
+func (*Filters_ValueDate) isFilters_TestValue() {}
+
+func (*Filters_ValueGeo) isFilters_TestValue() {}
+
+type GeoCoordinatesFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float32 `protobuf:"fixed32,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float32 `protobuf:"fixed32,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Distance  float32 `protobuf:"fixed32,3,opt,name=distance,proto3" json:"distance,omitempty"`
+}
+
+func (x *GeoCoordinatesFilter) Reset() {
+	*x = GeoCoordinatesFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoCoordinatesFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoCoordinatesFilter) ProtoMessage() {}
+
+func (x *GeoCoordinatesFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoCoordinatesFilter.ProtoReflect.Descriptor instead.
+func (*GeoCoordinatesFilter) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GeoCoordinatesFilter) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoCoordinatesFilter) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GeoCoordinatesFilter) GetDistance() float32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type AdditionalProperties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid               bool `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Vector             bool `protobuf:"varint,2,opt,name=vector,proto3" json:"vector,omitempty"`
+	CreationTimeUnix   bool `protobuf:"varint,3,opt,name=creationTimeUnix,proto3" json:"creationTimeUnix,omitempty"`
+	LastUpdateTimeUnix bool `protobuf:"varint,4,opt,name=lastUpdateTimeUnix,proto3" json:"lastUpdateTimeUnix,omitempty"`
+	Distance           bool `protobuf:"varint,5,opt,name=distance,proto3" json:"distance,omitempty"`
+	Certainty          bool `protobuf:"varint,6,opt,name=certainty,proto3" json:"certainty,omitempty"`
+	Score              bool `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
+	ExplainScore       bool `protobuf:"varint,8,opt,name=explainScore,proto3" json:"explainScore,omitempty"`
+}
+
+func (x *AdditionalProperties) Reset() {
+	*x = AdditionalProperties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdditionalProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdditionalProperties) ProtoMessage() {}
+
+func (x *AdditionalProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdditionalProperties.ProtoReflect.Descriptor instead.
+func (*AdditionalProperties) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AdditionalProperties) GetUuid() bool {
+	if x != nil {
+		return x.Uuid
+	}
+	return false
+}
+
+func (x *AdditionalProperties) GetVector() bool {
+	if x != nil {
+		return x.Vector
+	}
+	return false
+}
+
+func (x *AdditionalProperties) GetCreationTimeUnix() bool {
+	if x != nil {
+		return x.CreationTimeUnix
+	}
+	return false
+}
+
+func (x *AdditionalProperties) GetLastUpdateTimeUnix() bool {
+	if x != nil {
 		return x.LastUpdateTimeUnix
 	}
-	return 0
+	return false
+}
+
+func (x *AdditionalProperties) GetDistance() bool {
+	if x != nil {
+		return x.Distance
+	}
+	return false
+}
+
+func (x *AdditionalProperties) GetCertainty() bool {
+	if x != nil {
+		return x.Certainty
+	}
+	return false
+}
+
+func (x *AdditionalProperties) GetScore() bool {
+	if x != nil {
+		return x.Score
+	}
+	return false
+}
+
+func (x *AdditionalProperties) GetExplainScore() bool {
+	if x != nil {
+		return x.ExplainScore
+	}
+	return false
+}
+
+type Properties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NonRefProperties []string         `protobuf:"bytes,1,rep,name=non_ref_properties,json=nonRefProperties,proto3" json:"non_ref_properties,omitempty"`
+	RefProperties    []*RefProperties `protobuf:"bytes,2,rep,name=ref_properties,json=refProperties,proto3" json:"ref_properties,omitempty"`
+}
+
+func (x *Properties) Reset() {
+	*x = Properties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Properties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Properties) ProtoMessage() {}
+
+func (x *Properties) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Properties.ProtoReflect.Descriptor instead.
+func (*Properties) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Properties) GetNonRefProperties() []string {
+	if x != nil {
+		return x.NonRefProperties
+	}
+	return nil
+}
+
+func (x *Properties) GetRefProperties() []*RefProperties {
+	if x != nil {
+		return x.RefProperties
+	}
+	return nil
+}
+
+type HybridSearchParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query      string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Properties []string `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
+	// protolint:disable:next REPEATED_FIELD_NAMES_PLURALIZED
+	Vector        []float32 `protobuf:"fixed32,3,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	Alpha         float32   `protobuf:"fixed32,4,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	TargetVectors []string  `protobuf:"bytes,5,rep,name=target_vectors,json=targetVectors,proto3" json:"target_vectors,omitempty"`
+}
+
+func (x *HybridSearchParams) Reset() {
+	*x = HybridSearchParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HybridSearchParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HybridSearchParams) ProtoMessage() {}
+
+func (x *HybridSearchParams) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HybridSearchParams.ProtoReflect.Descriptor instead.
+func (*HybridSearchParams) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *HybridSearchParams) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *HybridSearchParams) GetProperties() []string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *HybridSearchParams) GetVector() []float32 {
+	if x != nil {
+		return x.Vector
+	}
+	return nil
+}
+
+func (x *HybridSearchParams) GetAlpha() float32 {
+	if x != nil {
+		return x.Alpha
+	}
+	return 0
+}
+
+func (x *HybridSearchParams) GetTargetVectors() []string {
+	if x != nil {
+		return x.TargetVectors
+	}
+	return nil
+}
+
+type BM25SearchParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query      string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Properties []string `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
+}
+
+func (x *BM25SearchParams) Reset() {
+	*x = BM25SearchParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BM25SearchParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BM25SearchParams) ProtoMessage() {}
+
+func (x *BM25SearchParams) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BM25SearchParams.ProtoReflect.Descriptor instead.
+func (*BM25SearchParams) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BM25SearchParams) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *BM25SearchParams) GetProperties() []string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+type RefProperties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkedClass       string      `protobuf:"bytes,1,opt,name=linked_class,json=linkedClass,proto3" json:"linked_class,omitempty"`
+	ReferenceProperty string      `protobuf:"bytes,2,opt,name=reference_property,json=referenceProperty,proto3" json:"reference_property,omitempty"`
+	LinkedProperties  *Properties `protobuf:"bytes,3,opt,name=linked_properties,json=linkedProperties,proto3" json:"linked_properties,omitempty"`
+}
+
+func (x *RefProperties) Reset() {
+	*x = RefProperties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefProperties) ProtoMessage() {}
+
+func (x *RefProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefProperties.ProtoReflect.Descriptor instead.
+func (*RefProperties) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RefProperties) GetLinkedClass() string {
+	if x != nil {
+		return x.LinkedClass
+	}
+	return ""
+}
+
+func (x *RefProperties) GetReferenceProperty() string {
+	if x != nil {
+		return x.ReferenceProperty
+	}
+	return ""
+}
+
+func (x *RefProperties) GetLinkedProperties() *Properties {
+	if x != nil {
+		return x.LinkedProperties
+	}
+	return nil
+}
+
+type NearVectorParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// protolint:disable:next REPEATED_FIELD_NAMES_PLURALIZED
+	Vector        []float32 `protobuf:"fixed32,1,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	Certainty     *float64  `protobuf:"fixed64,2,opt,name=certainty,proto3,oneof" json:"certainty,omitempty"`
+	Distance      *float64  `protobuf:"fixed64,3,opt,name=distance,proto3,oneof" json:"distance,omitempty"`
+	TargetVectors []string  `protobuf:"bytes,4,rep,name=target_vectors,json=targetVectors,proto3" json:"target_vectors,omitempty"`
+}
+
+func (x *NearVectorParams) Reset() {
+	*x = NearVectorParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NearVectorParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearVectorParams) ProtoMessage() {}
+
+func (x *NearVectorParams) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearVectorParams.ProtoReflect.Descriptor instead.
+func (*NearVectorParams) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *NearVectorParams) GetVector() []float32 {
+	if x != nil {
+		return x.Vector
+	}
+	return nil
+}
+
+func (x *NearVectorParams) GetCertainty() float64 {
+	if x != nil && x.Certainty != nil {
+		return *x.Certainty
+	}
+	return 0
+}
+
+func (x *NearVectorParams) GetDistance() float64 {
+	if x != nil && x.Distance != nil {
+		return *x.Distance
+	}
+	return 0
+}
+
+func (x *NearVectorParams) GetTargetVectors() []string {
+	if x != nil {
+		return x.TargetVectors
+	}
+	return nil
+}
+
+type NearObjectParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Certainty     *float64 `protobuf:"fixed64,2,opt,name=certainty,proto3,oneof" json:"certainty,omitempty"`
+	Distance      *float64 `protobuf:"fixed64,3,opt,name=distance,proto3,oneof" json:"distance,omitempty"`
+	TargetVectors []string `protobuf:"bytes,4,rep,name=target_vectors,json=targetVectors,proto3" json:"target_vectors,omitempty"`
+}
+
+func (x *NearObjectParams) Reset() {
+	*x = NearObjectParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NearObjectParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearObjectParams) ProtoMessage() {}
+
+func (x *NearObjectParams) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearObjectParams.ProtoReflect.Descriptor instead.
+func (*NearObjectParams) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *NearObjectParams) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NearObjectParams) GetCertainty() float64 {
+	if x != nil && x.Certainty != nil {
+		return *x.Certainty
+	}
+	return 0
+}
+
+func (x *NearObjectParams) GetDistance() float64 {
+	if x != nil && x.Distance != nil {
+		return *x.Distance
+	}
+	return 0
+}
+
+func (x *NearObjectParams) GetTargetVectors() []string {
+	if x != nil {
+		return x.TargetVectors
+	}
+	return nil
+}
+
+type SearchReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Took    float32         `protobuf:"fixed32,2,opt,name=took,proto3" json:"took,omitempty"`
+	// only set for group by searches, results is empty in that case
+	GroupByResults []*GroupByResult `protobuf:"bytes,3,rep,name=group_by_results,json=groupByResults,proto3" json:"group_by_results,omitempty"`
+}
+
+func (x *SearchReply) Reset() {
+	*x = SearchReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReply) ProtoMessage() {}
+
+func (x *SearchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReply.ProtoReflect.Descriptor instead.
+func (*SearchReply) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SearchReply) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+func (x *SearchReply) GetGroupByResults() []*GroupByResult {
+	if x != nil {
+		return x.GroupByResults
+	}
+	return nil
+}
+
+type GroupByResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// value of the grouped by property
+	Value       string          `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Path        []string        `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+	MinDistance float32         `protobuf:"fixed32,4,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"`
+	MaxDistance float32         `protobuf:"fixed32,5,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	Count       int64           `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Hits        []*SearchResult `protobuf:"bytes,7,rep,name=hits,proto3" json:"hits,omitempty"`
+}
+
+func (x *GroupByResult) Reset() {
+	*x = GroupByResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupByResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupByResult) ProtoMessage() {}
+
+func (x *GroupByResult) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupByResult.ProtoReflect.Descriptor instead.
+func (*GroupByResult) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GroupByResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GroupByResult) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *GroupByResult) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *GroupByResult) GetMinDistance() float32 {
+	if x != nil {
+		return x.MinDistance
+	}
+	return 0
+}
+
+func (x *GroupByResult) GetMaxDistance() float32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+func (x *GroupByResult) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GroupByResult) GetHits() []*SearchResult {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Properties           *ResultProperties      `protobuf:"bytes,1,opt,name=properties,proto3" json:"properties,omitempty"`
+	AdditionalProperties *ResultAdditionalProps `protobuf:"bytes,2,opt,name=additional_properties,json=additionalProperties,proto3" json:"additional_properties,omitempty"`
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SearchResult) GetProperties() *ResultProperties {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *SearchResult) GetAdditionalProperties() *ResultAdditionalProps {
+	if x != nil {
+		return x.AdditionalProperties
+	}
+	return nil
+}
+
+type ResultAdditionalProps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                        string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Vector                    []float32 `protobuf:"fixed32,2,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	CreationTimeUnix          int64     `protobuf:"varint,3,opt,name=creation_time_unix,json=creationTimeUnix,proto3" json:"creation_time_unix,omitempty"`
+	CreationTimeUnixPresent   bool      `protobuf:"varint,4,opt,name=creation_time_unix_present,json=creationTimeUnixPresent,proto3" json:"creation_time_unix_present,omitempty"`
+	LastUpdateTimeUnix        int64     `protobuf:"varint,5,opt,name=last_update_time_unix,json=lastUpdateTimeUnix,proto3" json:"last_update_time_unix,omitempty"`
+	LastUpdateTimeUnixPresent bool      `protobuf:"varint,6,opt,name=last_update_time_unix_present,json=lastUpdateTimeUnixPresent,proto3" json:"last_update_time_unix_present,omitempty"`
+	Distance                  float32   `protobuf:"fixed32,7,opt,name=distance,proto3" json:"distance,omitempty"`
+	DistancePresent           bool      `protobuf:"varint,8,opt,name=distance_present,json=distancePresent,proto3" json:"distance_present,omitempty"`
+	Certainty                 float32   `protobuf:"fixed32,9,opt,name=certainty,proto3" json:"certainty,omitempty"`
+	CertaintyPresent          bool      `protobuf:"varint,10,opt,name=certainty_present,json=certaintyPresent,proto3" json:"certainty_present,omitempty"`
+	Score                     float32   `protobuf:"fixed32,11,opt,name=score,proto3" json:"score,omitempty"`
+	ScorePresent              bool      `protobuf:"varint,12,opt,name=score_present,json=scorePresent,proto3" json:"score_present,omitempty"`
+	ExplainScore              string    `protobuf:"bytes,13,opt,name=explain_score,json=explainScore,proto3" json:"explain_score,omitempty"`
+	ExplainScorePresent       bool      `protobuf:"varint,14,opt,name=explain_score_present,json=explainScorePresent,proto3" json:"explain_score_present,omitempty"`
+	// results of the requested module additional properties, keyed by name
+	ModuleAdditional *structpb.Struct `protobuf:"bytes,15,opt,name=module_additional,json=moduleAdditional,proto3" json:"module_additional,omitempty"`
+}
+
+func (x *ResultAdditionalProps) Reset() {
+	*x = ResultAdditionalProps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResultAdditionalProps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultAdditionalProps) ProtoMessage() {}
+
+func (x *ResultAdditionalProps) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultAdditionalProps.ProtoReflect.Descriptor instead.
+func (*ResultAdditionalProps) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ResultAdditionalProps) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResultAdditionalProps) GetVector() []float32 {
+	if x != nil {
+		return x.Vector
+	}
+	return nil
+}
+
+func (x *ResultAdditionalProps) GetCreationTimeUnix() int64 {
+	if x != nil {
+		return x.CreationTimeUnix
+	}
+	return 0
+}
+
+func (x *ResultAdditionalProps) GetCreationTimeUnixPresent() bool {
+	if x != nil {
+		return x.CreationTimeUnixPresent
+	}
+	return false
+}
+
+func (x *ResultAdditionalProps) GetLastUpdateTimeUnix() int64 {
+	if x != nil {
+		return x.LastUpdateTimeUnix
+	}
+	return 0
+}
+
+func (x *ResultAdditionalProps) GetLastUpdateTimeUnixPresent() bool {
+	if x != nil {
+		return x.LastUpdateTimeUnixPresent
+	}
+	return false
+}
+
+func (x *ResultAdditionalProps) GetDistance() float32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *ResultAdditionalProps) GetDistancePresent() bool {
+	if x != nil {
+		return x.DistancePresent
+	}
+	return false
+}
+
+func (x *ResultAdditionalProps) GetCertainty() float32 {
+	if x != nil {
+		return x.Certainty
+	}
+	return 0
+}
+
+func (x *ResultAdditionalProps) GetCertaintyPresent() bool {
+	if x != nil {
+		return x.CertaintyPresent
+	}
+	return false
+}
+
+func (x *ResultAdditionalProps) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ResultAdditionalProps) GetScorePresent() bool {
+	if x != nil {
+		return x.ScorePresent
+	}
+	return false
+}
+
+func (x *ResultAdditionalProps) GetExplainScore() string {
+	if x != nil {
+		return x.ExplainScore
+	}
+	return ""
+}
+
+func (x *ResultAdditionalProps) GetExplainScorePresent() bool {
+	if x != nil {
+		return x.ExplainScorePresent
+	}
+	return false
+}
+
+func (x *ResultAdditionalProps) GetModuleAdditional() *structpb.Struct {
+	if x != nil {
+		return x.ModuleAdditional
+	}
+	return nil
+}
+
+type ResultProperties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NonRefProperties *structpb.Struct       `protobuf:"bytes,1,opt,name=non_ref_properties,json=nonRefProperties,proto3" json:"non_ref_properties,omitempty"`
+	RefProps         []*ReturnRefProperties `protobuf:"bytes,2,rep,name=ref_props,json=refProps,proto3" json:"ref_props,omitempty"`
+	ClassName        string                 `protobuf:"bytes,3,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+}
+
+func (x *ResultProperties) Reset() {
+	*x = ResultProperties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResultProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultProperties) ProtoMessage() {}
+
+func (x *ResultProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultProperties.ProtoReflect.Descriptor instead.
+func (*ResultProperties) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ResultProperties) GetNonRefProperties() *structpb.Struct {
+	if x != nil {
+		return x.NonRefProperties
+	}
+	return nil
+}
+
+func (x *ResultProperties) GetRefProps() []*ReturnRefProperties {
+	if x != nil {
+		return x.RefProps
+	}
+	return nil
+}
+
+func (x *ResultProperties) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+type ReturnRefProperties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Properties []*ResultProperties `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"`
+	PropName   string              `protobuf:"bytes,2,opt,name=prop_name,json=propName,proto3" json:"prop_name,omitempty"`
+}
+
+func (x *ReturnRefProperties) Reset() {
+	*x = ReturnRefProperties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnRefProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnRefProperties) ProtoMessage() {}
+
+func (x *ReturnRefProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnRefProperties.ProtoReflect.Descriptor instead.
+func (*ReturnRefProperties) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReturnRefProperties) GetProperties() []*ResultProperties {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *ReturnRefProperties) GetPropName() string {
+	if x != nil {
+		return x.PropName
+	}
+	return ""
+}
+
+type AggregateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClassName string `protobuf:"bytes,1,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	// return the number of objects per group, meta { count } in GraphQL
+	MetaCount    bool                            `protobuf:"varint,2,opt,name=meta_count,json=metaCount,proto3" json:"meta_count,omitempty"`
+	Aggregations []*AggregateRequest_Aggregation `protobuf:"bytes,3,rep,name=aggregations,proto3" json:"aggregations,omitempty"`
+	// path to the property to group by
+	GroupBy []string `protobuf:"bytes,4,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	// maximum number of groups
+	Limit *uint32 `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// maximum number of objects to aggregate, only for vector and hybrid searches
+	ObjectLimit  *uint32             `protobuf:"varint,6,opt,name=object_limit,json=objectLimit,proto3,oneof" json:"object_limit,omitempty"`
+	Filters      *Filters            `protobuf:"bytes,7,opt,name=filters,proto3" json:"filters,omitempty"`
+	NearVector   *NearVectorParams   `protobuf:"bytes,8,opt,name=near_vector,json=nearVector,proto3" json:"near_vector,omitempty"`
+	NearObject   *NearObjectParams   `protobuf:"bytes,9,opt,name=near_object,json=nearObject,proto3" json:"near_object,omitempty"`
+	Hybrid       *HybridSearchParams `protobuf:"bytes,10,opt,name=hybrid,proto3" json:"hybrid,omitempty"`
+	ModuleSearch []*ModuleArgument   `protobuf:"bytes,11,rep,name=module_search,json=moduleSearch,proto3" json:"module_search,omitempty"`
+	Tenant       string              `protobuf:"bytes,12,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *AggregateRequest) Reset() {
+	*x = AggregateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest) ProtoMessage() {}
+
+func (x *AggregateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest.ProtoReflect.Descriptor instead.
+func (*AggregateRequest) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AggregateRequest) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *AggregateRequest) GetMetaCount() bool {
+	if x != nil {
+		return x.MetaCount
+	}
+	return false
+}
+
+func (x *AggregateRequest) GetAggregations() []*AggregateRequest_Aggregation {
+	if x != nil {
+		return x.Aggregations
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *AggregateRequest) GetObjectLimit() uint32 {
+	if x != nil && x.ObjectLimit != nil {
+		return *x.ObjectLimit
+	}
+	return 0
+}
+
+func (x *AggregateRequest) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearVector() *NearVectorParams {
+	if x != nil {
+		return x.NearVector
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearObject() *NearObjectParams {
+	if x != nil {
+		return x.NearObject
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetHybrid() *HybridSearchParams {
+	if x != nil {
+		return x.Hybrid
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetModuleSearch() []*ModuleArgument {
+	if x != nil {
+		return x.ModuleSearch
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+type AggregateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*AggregateGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Took   float32           `protobuf:"fixed32,2,opt,name=took,proto3" json:"took,omitempty"`
+}
+
+func (x *AggregateReply) Reset() {
+	*x = AggregateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply) ProtoMessage() {}
+
+func (x *AggregateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply.ProtoReflect.Descriptor instead.
+func (*AggregateReply) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AggregateReply) GetGroups() []*AggregateGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *AggregateReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+type AggregateGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// not set for ungrouped aggregations
+	GroupedBy *AggregateGroup_GroupedBy `protobuf:"bytes,1,opt,name=grouped_by,json=groupedBy,proto3" json:"grouped_by,omitempty"`
+	// only set if meta_count was requested
+	Count      *int64               `protobuf:"varint,2,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Properties []*AggregateProperty `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty"`
+}
+
+func (x *AggregateGroup) Reset() {
+	*x = AggregateGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateGroup) ProtoMessage() {}
+
+func (x *AggregateGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateGroup.ProtoReflect.Descriptor instead.
+func (*AggregateGroup) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AggregateGroup) GetGroupedBy() *AggregateGroup_GroupedBy {
+	if x != nil {
+		return x.GroupedBy
+	}
+	return nil
+}
+
+func (x *AggregateGroup) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *AggregateGroup) GetProperties() []*AggregateProperty {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+type AggregateProperty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// data type of the property, only set if the type aggregator was requested
+	SchemaType string `protobuf:"bytes,2,opt,name=schema_type,json=schemaType,proto3" json:"schema_type,omitempty"`
+	// Types that are assignable to Aggregation:
+	//	*AggregateProperty_Numerical
+	//	*AggregateProperty_Text
+	//	*AggregateProperty_Boolean
+	//	*AggregateProperty_Date
+	//	*AggregateProperty_Reference
+	Aggregation isAggregateProperty_Aggregation `protobuf_oneof:"aggregation"`
+}
+
+func (x *AggregateProperty) Reset() {
+	*x = AggregateProperty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateProperty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateProperty) ProtoMessage() {}
+
+func (x *AggregateProperty) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateProperty.ProtoReflect.Descriptor instead.
+func (*AggregateProperty) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AggregateProperty) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AggregateProperty) GetSchemaType() string {
+	if x != nil {
+		return x.SchemaType
+	}
+	return ""
+}
+
+func (m *AggregateProperty) GetAggregation() isAggregateProperty_Aggregation {
+	if m != nil {
+		return m.Aggregation
+	}
+	return nil
+}
+
+func (x *AggregateProperty) GetNumerical() *NumericalAggregation {
+	if x, ok := x.GetAggregation().(*AggregateProperty_Numerical); ok {
+		return x.Numerical
+	}
+	return nil
+}
+
+func (x *AggregateProperty) GetText() *TextAggregation {
+	if x, ok := x.GetAggregation().(*AggregateProperty_Text); ok {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *AggregateProperty) GetBoolean() *BooleanAggregation {
+	if x, ok := x.GetAggregation().(*AggregateProperty_Boolean); ok {
+		return x.Boolean
+	}
+	return nil
+}
+
+func (x *AggregateProperty) GetDate() *DateAggregation {
+	if x, ok := x.GetAggregation().(*AggregateProperty_Date); ok {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *AggregateProperty) GetReference() *ReferenceAggregation {
+	if x, ok := x.GetAggregation().(*AggregateProperty_Reference); ok {
+		return x.Reference
+	}
+	return nil
+}
+
+type isAggregateProperty_Aggregation interface {
+	isAggregateProperty_Aggregation()
+}
+
+type AggregateProperty_Numerical struct {
+	Numerical *NumericalAggregation `protobuf:"bytes,3,opt,name=numerical,proto3,oneof"`
+}
+
+type AggregateProperty_Text struct {
+	Text *TextAggregation `protobuf:"bytes,4,opt,name=text,proto3,oneof"`
+}
+
+type AggregateProperty_Boolean struct {
+	Boolean *BooleanAggregation `protobuf:"bytes,5,opt,name=boolean,proto3,oneof"`
+}
+
+type AggregateProperty_Date struct {
+	Date *DateAggregation `protobuf:"bytes,6,opt,name=date,proto3,oneof"`
+}
+
+type AggregateProperty_Reference struct {
+	Reference *ReferenceAggregation `protobuf:"bytes,7,opt,name=reference,proto3,oneof"`
+}
+
+func (*AggregateProperty_Numerical) isAggregateProperty_Aggregation() {}
+
+func (*AggregateProperty_Text) isAggregateProperty_Aggregation() {}
+
+func (*AggregateProperty_Boolean) isAggregateProperty_Aggregation() {}
+
+func (*AggregateProperty_Date) isAggregateProperty_Aggregation() {}
+
+func (*AggregateProperty_Reference) isAggregateProperty_Aggregation() {}
+
+type NumericalAggregation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count   *int64   `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Mean    *float64 `protobuf:"fixed64,2,opt,name=mean,proto3,oneof" json:"mean,omitempty"`
+	Median  *float64 `protobuf:"fixed64,3,opt,name=median,proto3,oneof" json:"median,omitempty"`
+	Mode    *float64 `protobuf:"fixed64,4,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	Maximum *float64 `protobuf:"fixed64,5,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
+	Minimum *float64 `protobuf:"fixed64,6,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+	Sum     *float64 `protobuf:"fixed64,7,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
+}
+
+func (x *NumericalAggregation) Reset() {
+	*x = NumericalAggregation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumericalAggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumericalAggregation) ProtoMessage() {}
+
+func (x *NumericalAggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumericalAggregation.ProtoReflect.Descriptor instead.
+func (*NumericalAggregation) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *NumericalAggregation) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *NumericalAggregation) GetMean() float64 {
+	if x != nil && x.Mean != nil {
+		return *x.Mean
+	}
+	return 0
+}
+
+func (x *NumericalAggregation) GetMedian() float64 {
+	if x != nil && x.Median != nil {
+		return *x.Median
+	}
+	return 0
+}
+
+func (x *NumericalAggregation) GetMode() float64 {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return 0
+}
+
+func (x *NumericalAggregation) GetMaximum() float64 {
+	if x != nil && x.Maximum != nil {
+		return *x.Maximum
+	}
+	return 0
+}
+
+func (x *NumericalAggregation) GetMinimum() float64 {
+	if x != nil && x.Minimum != nil {
+		return *x.Minimum
+	}
+	return 0
+}
+
+func (x *NumericalAggregation) GetSum() float64 {
+	if x != nil && x.Sum != nil {
+		return *x.Sum
+	}
+	return 0
+}
+
+type TextAggregation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count          *int64                        `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	TopOccurrences []*TextAggregation_Occurrence `protobuf:"bytes,2,rep,name=top_occurrences,json=topOccurrences,proto3" json:"top_occurrences,omitempty"`
+}
+
+func (x *TextAggregation) Reset() {
+	*x = TextAggregation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextAggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextAggregation) ProtoMessage() {}
+
+func (x *TextAggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextAggregation.ProtoReflect.Descriptor instead.
+func (*TextAggregation) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TextAggregation) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *TextAggregation) GetTopOccurrences() []*TextAggregation_Occurrence {
+	if x != nil {
+		return x.TopOccurrences
+	}
+	return nil
+}
+
+type BooleanAggregation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count           *int64   `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	TotalTrue       *int64   `protobuf:"varint,2,opt,name=total_true,json=totalTrue,proto3,oneof" json:"total_true,omitempty"`
+	TotalFalse      *int64   `protobuf:"varint,3,opt,name=total_false,json=totalFalse,proto3,oneof" json:"total_false,omitempty"`
+	PercentageTrue  *float64 `protobuf:"fixed64,4,opt,name=percentage_true,json=percentageTrue,proto3,oneof" json:"percentage_true,omitempty"`
+	PercentageFalse *float64 `protobuf:"fixed64,5,opt,name=percentage_false,json=percentageFalse,proto3,oneof" json:"percentage_false,omitempty"`
+}
+
+func (x *BooleanAggregation) Reset() {
+	*x = BooleanAggregation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BooleanAggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BooleanAggregation) ProtoMessage() {}
+
+func (x *BooleanAggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BooleanAggregation.ProtoReflect.Descriptor instead.
+func (*BooleanAggregation) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BooleanAggregation) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *BooleanAggregation) GetTotalTrue() int64 {
+	if x != nil && x.TotalTrue != nil {
+		return *x.TotalTrue
+	}
+	return 0
+}
+
+func (x *BooleanAggregation) GetTotalFalse() int64 {
+	if x != nil && x.TotalFalse != nil {
+		return *x.TotalFalse
+	}
+	return 0
+}
+
+func (x *BooleanAggregation) GetPercentageTrue() float64 {
+	if x != nil && x.PercentageTrue != nil {
+		return *x.PercentageTrue
+	}
+	return 0
+}
+
+func (x *BooleanAggregation) GetPercentageFalse() float64 {
+	if x != nil && x.PercentageFalse != nil {
+		return *x.PercentageFalse
+	}
+	return 0
+}
+
+type DateAggregation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count   *int64  `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Median  *string `protobuf:"bytes,2,opt,name=median,proto3,oneof" json:"median,omitempty"`
+	Mode    *string `protobuf:"bytes,3,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	Maximum *string `protobuf:"bytes,4,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
+	Minimum *string `protobuf:"bytes,5,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+}
+
+func (x *DateAggregation) Reset() {
+	*x = DateAggregation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DateAggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateAggregation) ProtoMessage() {}
+
+func (x *DateAggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateAggregation.ProtoReflect.Descriptor instead.
+func (*DateAggregation) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DateAggregation) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *DateAggregation) GetMedian() string {
+	if x != nil && x.Median != nil {
+		return *x.Median
+	}
+	return ""
+}
+
+func (x *DateAggregation) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
+func (x *DateAggregation) GetMaximum() string {
+	if x != nil && x.Maximum != nil {
+		return *x.Maximum
+	}
+	return ""
+}
+
+func (x *DateAggregation) GetMinimum() string {
+	if x != nil && x.Minimum != nil {
+		return *x.Minimum
+	}
+	return ""
+}
+
+type ReferenceAggregation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PointingTo []string `protobuf:"bytes,1,rep,name=pointing_to,json=pointingTo,proto3" json:"pointing_to,omitempty"`
+}
+
+func (x *ReferenceAggregation) Reset() {
+	*x = ReferenceAggregation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferenceAggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferenceAggregation) ProtoMessage() {}
+
+func (x *ReferenceAggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferenceAggregation.ProtoReflect.Descriptor instead.
+func (*ReferenceAggregation) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReferenceAggregation) GetPointingTo() []string {
+	if x != nil {
+		return x.PointingTo
+	}
+	return nil
+}
+
+type BatchObject_Properties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NonRefProperties *structpb.Struct             `protobuf:"bytes,1,opt,name=non_ref_properties,json=nonRefProperties,proto3" json:"non_ref_properties,omitempty"`
+	RefProps         []*BatchObject_RefProperties `protobuf:"bytes,2,rep,name=ref_props,json=refProps,proto3" json:"ref_props,omitempty"`
+	// integer properties, numbers of non_ref_properties are doubles and cannot
+	// represent integers beyond 2^53 exactly
+	IntProperties      map[string]int64                  `protobuf:"bytes,3,rep,name=int_properties,json=intProperties,proto3" json:"int_properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	IntArrayProperties []*BatchObject_IntArrayProperties `protobuf:"bytes,4,rep,name=int_array_properties,json=intArrayProperties,proto3" json:"int_array_properties,omitempty"`
+}
+
+func (x *BatchObject_Properties) Reset() {
+	*x = BatchObject_Properties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchObject_Properties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchObject_Properties) ProtoMessage() {}
+
+func (x *BatchObject_Properties) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchObject_Properties.ProtoReflect.Descriptor instead.
+func (*BatchObject_Properties) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *BatchObject_Properties) GetNonRefProperties() *structpb.Struct {
+	if x != nil {
+		return x.NonRefProperties
+	}
+	return nil
+}
+
+func (x *BatchObject_Properties) GetRefProps() []*BatchObject_RefProperties {
+	if x != nil {
+		return x.RefProps
+	}
+	return nil
+}
+
+func (x *BatchObject_Properties) GetIntProperties() map[string]int64 {
+	if x != nil {
+		return x.IntProperties
+	}
+	return nil
+}
+
+func (x *BatchObject_Properties) GetIntArrayProperties() []*BatchObject_IntArrayProperties {
+	if x != nil {
+		return x.IntArrayProperties
+	}
+	return nil
+}
+
+type BatchObject_IntArrayProperties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PropName string  `protobuf:"bytes,1,opt,name=prop_name,json=propName,proto3" json:"prop_name,omitempty"`
+	Values   []int64 `protobuf:"varint,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *BatchObject_IntArrayProperties) Reset() {
+	*x = BatchObject_IntArrayProperties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchObject_IntArrayProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchObject_IntArrayProperties) ProtoMessage() {}
+
+func (x *BatchObject_IntArrayProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchObject_IntArrayProperties.ProtoReflect.Descriptor instead.
+func (*BatchObject_IntArrayProperties) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *BatchObject_IntArrayProperties) GetPropName() string {
+	if x != nil {
+		return x.PropName
+	}
+	return ""
+}
+
+func (x *BatchObject_IntArrayProperties) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type BatchObject_RefProperties struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PropName string   `protobuf:"bytes,1,opt,name=prop_name,json=propName,proto3" json:"prop_name,omitempty"`
+	Uuids    []string `protobuf:"bytes,2,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// class of the referenced objects, may be empty for single target references
+	TargetClass string `protobuf:"bytes,3,opt,name=target_class,json=targetClass,proto3" json:"target_class,omitempty"`
+}
+
+func (x *BatchObject_RefProperties) Reset() {
+	*x = BatchObject_RefProperties{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchObject_RefProperties) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchObject_RefProperties) ProtoMessage() {}
+
+func (x *BatchObject_RefProperties) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchObject_RefProperties.ProtoReflect.Descriptor instead.
+func (*BatchObject_RefProperties) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *BatchObject_RefProperties) GetPropName() string {
+	if x != nil {
+		return x.PropName
+	}
+	return ""
+}
+
+func (x *BatchObject_RefProperties) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *BatchObject_RefProperties) GetTargetClass() string {
+	if x != nil {
+		return x.TargetClass
+	}
+	return ""
+}
+
+type BatchObject_NamedVector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// little endian encoded float32 values
+	VectorBytes []byte `protobuf:"bytes,2,opt,name=vector_bytes,json=vectorBytes,proto3" json:"vector_bytes,omitempty"`
+}
+
+func (x *BatchObject_NamedVector) Reset() {
+	*x = BatchObject_NamedVector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *ResultAdditionalProps) GetLastUpdateTimeUnixPresent() bool {
-	if x != nil {
-		return x.LastUpdateTimeUnixPresent
+func (x *BatchObject_NamedVector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchObject_NamedVector) ProtoMessage() {}
+
+func (x *BatchObject_NamedVector) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *ResultAdditionalProps) GetDistance() float32 {
+// Deprecated: Use BatchObject_NamedVector.ProtoReflect.Descriptor instead.
+func (*BatchObject_NamedVector) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *BatchObject_NamedVector) GetName() string {
 	if x != nil {
-		return x.Distance
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ResultAdditionalProps) GetDistancePresent() bool {
+func (x *BatchObject_NamedVector) GetVectorBytes() []byte {
 	if x != nil {
-		return x.DistancePresent
+		return x.VectorBytes
 	}
-	return false
+	return nil
 }
 
-func (x *ResultAdditionalProps) GetCertainty() float32 {
+type BatchStreamReply_BatchError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// position of the object within its chunk
+	Index int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Uuid  string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchStreamReply_BatchError) Reset() {
+	*x = BatchStreamReply_BatchError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchStreamReply_BatchError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStreamReply_BatchError) ProtoMessage() {}
+
+func (x *BatchStreamReply_BatchError) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStreamReply_BatchError.ProtoReflect.Descriptor instead.
+func (*BatchStreamReply_BatchError) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *BatchStreamReply_BatchError) GetIndex() int32 {
 	if x != nil {
-		return x.Certainty
+		return x.Index
 	}
 	return 0
 }
 
-func (x *ResultAdditionalProps) GetCertaintyPresent() bool {
+func (x *BatchStreamReply_BatchError) GetUuid() string {
 	if x != nil {
-		return x.CertaintyPresent
+		return x.Uuid
 	}
-	return false
+	return ""
 }
 
-func (x *ResultAdditionalProps) GetScore() float32 {
+func (x *BatchStreamReply_BatchError) GetError() string {
 	if x != nil {
-		return x.Score
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *ResultAdditionalProps) GetScorePresent() bool {
-	if x != nil {
-		return x.ScorePresent
+type BatchObjectsReply_BatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// empty if the object was imported successfully
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchObjectsReply_BatchResult) Reset() {
+	*x = BatchObjectsReply_BatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *ResultAdditionalProps) GetExplainScore() string {
+func (x *BatchObjectsReply_BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchObjectsReply_BatchResult) ProtoMessage() {}
+
+func (x *BatchObjectsReply_BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchObjectsReply_BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchObjectsReply_BatchResult) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *BatchObjectsReply_BatchResult) GetUuid() string {
 	if x != nil {
-		return x.ExplainScore
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *ResultAdditionalProps) GetExplainScorePresent() bool {
+func (x *BatchObjectsReply_BatchResult) GetError() string {
 	if x != nil {
-		return x.ExplainScorePresent
+		return x.Error
 	}
-	return false
+	return ""
 }
 
-type ResultProperties struct {
+type AggregateRequest_Aggregation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NonRefProperties *structpb.Struct       `protobuf:"bytes,1,opt,name=non_ref_properties,json=nonRefProperties,proto3" json:"non_ref_properties,omitempty"`
-	RefProps         []*ReturnRefProperties `protobuf:"bytes,2,rep,name=ref_props,json=refProps,proto3" json:"ref_props,omitempty"`
-	ClassName        string                 `protobuf:"bytes,3,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	Property    string                        `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Aggregators []AggregateRequest_Aggregator `protobuf:"varint,2,rep,packed,name=aggregators,proto3,enum=weaviategrpc.AggregateRequest_Aggregator" json:"aggregators,omitempty"`
+	// number of returned top occurrences, defaults to 5
+	TopOccurrencesLimit *uint32 `protobuf:"varint,3,opt,name=top_occurrences_limit,json=topOccurrencesLimit,proto3,oneof" json:"top_occurrences_limit,omitempty"`
 }
 
-func (x *ResultProperties) Reset() {
-	*x = ResultProperties{}
+func (x *AggregateRequest_Aggregation) Reset() {
+	*x = AggregateRequest_Aggregation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[11]
+		mi := &file_weaviate_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResultProperties) String() string {
+func (x *AggregateRequest_Aggregation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResultProperties) ProtoMessage() {}
+func (*AggregateRequest_Aggregation) ProtoMessage() {}
 
-func (x *ResultProperties) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[11]
+func (x *AggregateRequest_Aggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,58 +3332,58 @@ func (x *ResultProperties) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResultProperties.ProtoReflect.Descriptor instead.
-func (*ResultProperties) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AggregateRequest_Aggregation.ProtoReflect.Descriptor instead.
+func (*AggregateRequest_Aggregation) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{24, 0}
 }
 
-func (x *ResultProperties) GetNonRefProperties() *structpb.Struct {
+func (x *AggregateRequest_Aggregation) GetProperty() string {
 	if x != nil {
-		return x.NonRefProperties
+		return x.Property
 	}
-	return nil
+	return ""
 }
 
-func (x *ResultProperties) GetRefProps() []*ReturnRefProperties {
+func (x *AggregateRequest_Aggregation) GetAggregators() []AggregateRequest_Aggregator {
 	if x != nil {
-		return x.RefProps
+		return x.Aggregators
 	}
 	return nil
 }
 
-func (x *ResultProperties) GetClassName() string {
-	if x != nil {
-		return x.ClassName
+func (x *AggregateRequest_Aggregation) GetTopOccurrencesLimit() uint32 {
+	if x != nil && x.TopOccurrencesLimit != nil {
+		return *x.TopOccurrencesLimit
 	}
-	return ""
+	return 0
 }
 
-type ReturnRefProperties struct {
+type AggregateGroup_GroupedBy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Properties []*ResultProperties `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"`
-	PropName   string              `protobuf:"bytes,2,opt,name=prop_name,json=propName,proto3" json:"prop_name,omitempty"`
+	Path  []string        `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	Value *structpb.Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *ReturnRefProperties) Reset() {
-	*x = ReturnRefProperties{}
+func (x *AggregateGroup_GroupedBy) Reset() {
+	*x = AggregateGroup_GroupedBy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_weaviate_proto_msgTypes[12]
+		mi := &file_weaviate_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReturnRefProperties) String() string {
+func (x *AggregateGroup_GroupedBy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReturnRefProperties) ProtoMessage() {}
+func (*AggregateGroup_GroupedBy) ProtoMessage() {}
 
-func (x *ReturnRefProperties) ProtoReflect() protoreflect.Message {
-	mi := &file_weaviate_proto_msgTypes[12]
+func (x *AggregateGroup_GroupedBy) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,207 +3394,715 @@ func (x *ReturnRefProperties) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReturnRefProperties.ProtoReflect.Descriptor instead.
-func (*ReturnRefProperties) Descriptor() ([]byte, []int) {
-	return file_weaviate_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use AggregateGroup_GroupedBy.ProtoReflect.Descriptor instead.
+func (*AggregateGroup_GroupedBy) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{26, 0}
 }
 
-func (x *ReturnRefProperties) GetProperties() []*ResultProperties {
+func (x *AggregateGroup_GroupedBy) GetPath() []string {
 	if x != nil {
-		return x.Properties
+		return x.Path
 	}
 	return nil
 }
 
-func (x *ReturnRefProperties) GetPropName() string {
+func (x *AggregateGroup_GroupedBy) GetValue() *structpb.Value {
 	if x != nil {
-		return x.PropName
+		return x.Value
+	}
+	return nil
+}
+
+type TextAggregation_Occurrence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value  string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Occurs int64  `protobuf:"varint,2,opt,name=occurs,proto3" json:"occurs,omitempty"`
+}
+
+func (x *TextAggregation_Occurrence) Reset() {
+	*x = TextAggregation_Occurrence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_weaviate_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextAggregation_Occurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextAggregation_Occurrence) ProtoMessage() {}
+
+func (x *TextAggregation_Occurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_weaviate_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextAggregation_Occurrence.ProtoReflect.Descriptor instead.
+func (*TextAggregation_Occurrence) Descriptor() ([]byte, []int) {
+	return file_weaviate_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *TextAggregation_Occurrence) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
 
+func (x *TextAggregation_Occurrence) GetOccurs() int64 {
+	if x != nil {
+		return x.Occurs
+	}
+	return 0
+}
+
 var File_weaviate_proto protoreflect.FileDescriptor
 
 var file_weaviate_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x0c, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x1c,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe1, 0x03, 0x0a,
-	0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x57, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0b,
-	0x6e, 0x65, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x4e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3f, 0x0a,
-	0x0b, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x38,
-	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x68, 0x79, 0x62, 0x72,
-	0x69, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x48,
-	0x79, 0x62, 0x72, 0x69, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x0c, 0x68, 0x79, 0x62, 0x72, 0x69, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
-	0x3f, 0x0a, 0x0b, 0x62, 0x6d, 0x32, 0x35, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x42, 0x4d, 0x32, 0x35, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x62, 0x6d, 0x32, 0x35, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x22, 0x92, 0x02, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x76,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69,
-	0x78, 0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69,
-	0x78, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x7e, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x70,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x10, 0x6e, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x12, 0x42, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76,
-	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x78, 0x0a, 0x12, 0x48, 0x79, 0x62, 0x72, 0x69, 0x64, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x22,
-	0x48, 0x0a, 0x10, 0x42, 0x4d, 0x32, 0x35, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x0d, 0x52, 0x65,
-	0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
-	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x2d,
-	0x0a, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x45, 0x0a,
-	0x11, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
-	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x52, 0x10, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x10, 0x4e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74,
-	0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69,
-	0x6e, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x22, 0x81, 0x01, 0x0a, 0x10, 0x4e, 0x65, 0x61, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e,
-	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x09, 0x63, 0x65, 0x72, 0x74,
-	0x61, 0x69, 0x6e, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x64, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x65,
-	0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x22, 0x57, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f,
-	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x22, 0xa8, 0x01,
-	0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e,
-	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x58,
-	0x0a, 0x15, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f,
-	0x70, 0x73, 0x52, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xc5, 0x04, 0x0a, 0x15, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f,
-	0x70, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x70,
-	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x50, 0x72,
-	0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x40, 0x0a, 0x1d, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69,
-	0x78, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x19, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55,
-	0x6e, 0x69, 0x78, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x69,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x12,
-	0x2b, 0x0a, 0x11, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x65,
-	0x73, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x65, 0x72, 0x74,
-	0x61, 0x69, 0x6e, 0x74, 0x79, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73,
-	0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65,
-	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x6c, 0x61,
-	0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x15,
-	0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72,
-	0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x65, 0x78, 0x70,
-	0x6c, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
-	0x22, 0xb8, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x01, 0x0a,
+	0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x63, 0x6f, 0x6e,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x22, 0x98, 0x07, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x1a, 0x9b, 0x03, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x65,
 	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x12, 0x6e, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66,
 	0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x10, 0x6e, 0x6f, 0x6e, 0x52,
-	0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x09,
+	0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x09,
 	0x72, 0x65, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x52, 0x08, 0x72, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x13, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
-	0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x32,
-	0x4e, 0x0a, 0x08, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
-	0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
-	0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42,
-	0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65,
-	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f,
-	0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x27, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x52, 0x65, 0x66, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x08, 0x72, 0x65, 0x66, 0x50, 0x72, 0x6f,
+	0x70, 0x73, 0x12, 0x5e, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x5e, 0x0a, 0x14, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x49, 0x6e, 0x74, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x12,
+	0x69, 0x6e, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x1a, 0x40, 0x0a, 0x12, 0x49, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x49, 0x0a, 0x12, 0x49, 0x6e, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a,
+	0x65, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x75,
+	0x69, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x1a, 0x44, 0x0a, 0x0b, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xb1, 0x01, 0x0a,
+	0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x63,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x22, 0xb7, 0x02, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x1a, 0x4c, 0x0a, 0x0a,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x45, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x1a, 0x37, 0x0a, 0x0b, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0xc9, 0x07, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x57, 0x0a, 0x15, 0x61,
+	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x14,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3f, 0x0a, 0x0b, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x6e, 0x65, 0x61, 0x72,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x45, 0x0a, 0x0d, 0x68, 0x79, 0x62, 0x72, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x79, 0x62, 0x72, 0x69, 0x64, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0c, 0x68, 0x79, 0x62, 0x72, 0x69,
+	0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x3f, 0x0a, 0x0b, 0x62, 0x6d, 0x32, 0x35, 0x5f,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x4d, 0x32, 0x35,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x62, 0x6d,
+	0x32, 0x35, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2f, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79,
+	0x12, 0x30, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x11, 0x63, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x0d,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x11, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x49, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x63,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x22, 0x5b, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x3a, 0x0a,
+	0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x73, 0x0a, 0x07, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xc6,
+	0x05, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x02, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x23,
+	0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x67, 0x65,
+	0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x6f, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x47, 0x65, 0x6f, 0x22, 0xbf, 0x02, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x54,
+	0x48, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f,
+	0x52, 0x5f, 0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x45,
+	0x51, 0x55, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x4f, 0x52, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x10, 0x05, 0x12, 0x1c,
+	0x0a, 0x18, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x5f,
+	0x54, 0x48, 0x41, 0x4e, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x4e, 0x44, 0x10, 0x07, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4f, 0x52, 0x10, 0x08, 0x12,
+	0x10, 0x0a, 0x0c, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x10,
+	0x09, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x49, 0x4e, 0x5f, 0x47, 0x45, 0x4f, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x0a,
+	0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4c, 0x49, 0x4b,
+	0x45, 0x10, 0x0b, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f,
+	0x49, 0x53, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x0c, 0x42, 0x0c, 0x0a, 0x0a, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6c, 0x0a, 0x14, 0x47, 0x65, 0x6f, 0x43, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x92, 0x02, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x7e, 0x0a, 0x0a, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x5f, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x66,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x12, 0x48,
+	0x79, 0x62, 0x72, 0x69, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x48, 0x0a, 0x10,
+	0x42, 0x4d, 0x32, 0x35, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x11, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x10, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x22, 0xb0, 0x01, 0x0a, 0x10, 0x4e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21,
+	0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x00, 0x52, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x65,
+	0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x10, 0x4e, 0x65, 0x61, 0x72, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x65, 0x72,
+	0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x09,
+	0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e,
+	0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x9e, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x12, 0x45, 0x0a, 0x10, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0xd5, 0x01, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x15, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x52, 0x14, 0x61,
+	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x22, 0x8b, 0x05, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55,
+	0x6e, 0x69, 0x78, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
+	0x12, 0x31, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55,
+	0x6e, 0x69, 0x78, 0x12, 0x40, 0x0a, 0x1d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x65,
+	0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x11, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x10, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x22, 0xb8, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x12, 0x6e, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x66, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x10, 0x6e, 0x6f, 0x6e,
+	0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a,
+	0x09, 0x72, 0x65, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x08, 0x72, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x13,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0xbc, 0x09, 0x0a, 0x10, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x19,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x01, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x3f, 0x0a, 0x0b, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x68, 0x79, 0x62, 0x72, 0x69, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x48, 0x79, 0x62, 0x72, 0x69, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x68, 0x79, 0x62, 0x72, 0x69, 0x64, 0x12, 0x41,
+	0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x67, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x1a, 0xc9, 0x01, 0x0a, 0x0b, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x37, 0x0a, 0x15, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x00, 0x52, 0x13, 0x74, 0x6f, 0x70, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f,
+	0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x8c, 0x03, 0x0a, 0x0a, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54,
+	0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47,
+	0x41, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x41,
+	0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x03,
+	0x12, 0x15, 0x0a, 0x11, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4d,
+	0x45, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x47, 0x47, 0x52, 0x45,
+	0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12,
+	0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4d, 0x41, 0x58, 0x49, 0x4d,
+	0x55, 0x4d, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54,
+	0x4f, 0x52, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x55, 0x4d, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e,
+	0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x55, 0x4d, 0x10, 0x08,
+	0x12, 0x19, 0x0a, 0x15, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x54,
+	0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x54, 0x52, 0x55, 0x45, 0x10, 0x09, 0x12, 0x1a, 0x0a, 0x16, 0x41,
+	0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f,
+	0x46, 0x41, 0x4c, 0x53, 0x45, 0x10, 0x0a, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x47, 0x47, 0x52, 0x45,
+	0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45,
+	0x5f, 0x54, 0x52, 0x55, 0x45, 0x10, 0x0b, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x47, 0x47, 0x52, 0x45,
+	0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45,
+	0x5f, 0x46, 0x41, 0x4c, 0x53, 0x45, 0x10, 0x0c, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x4f, 0x50, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x52,
+	0x52, 0x45, 0x4e, 0x43, 0x45, 0x53, 0x10, 0x0d, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x54, 0x4f, 0x10, 0x0e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x5a, 0x0a, 0x0e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x34, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x22, 0x8c, 0x02, 0x0a, 0x0e,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x45,
+	0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x42, 0x79, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x64, 0x42, 0x79, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x1a, 0x4d, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x42, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x87, 0x03, 0x0a, 0x11, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x61,
+	0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09,
+	0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3c,
+	0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x33, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x42, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x02, 0x0a, 0x14, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63,
+	0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x02, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
+	0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x06, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x06, 0x0a, 0x04, 0x5f,
+	0x73, 0x75, 0x6d, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x54, 0x65, 0x78, 0x74, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x51, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x0a, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x63, 0x63,
+	0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa9, 0x02, 0x0a, 0x12,
+	0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x75, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46,
+	0x61, 0x6c, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x03, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x54, 0x72,
+	0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x04, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x46, 0x61, 0x6c,
+	0x73, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x72,
+	0x75, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x5f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x65,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
+	0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x07,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x22, 0x37, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x2a, 0x89, 0x01, 0x0a, 0x10, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x21,
+	0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x53, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4c, 0x45,
+	0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x53, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x43, 0x59,
+	0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x43, 0x4f, 0x4e, 0x53, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45,
+	0x4c, 0x5f, 0x51, 0x55, 0x4f, 0x52, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f,
+	0x4e, 0x53, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x41, 0x4c, 0x4c, 0x10, 0x03, 0x32, 0xc8, 0x02, 0x0a, 0x08, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0b,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x2e, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x09, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x12, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1181,47 +4118,130 @@ func file_weaviate_proto_rawDescGZIP() []byte {
 }
 
 var (
-	file_weaviate_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-	file_weaviate_proto_goTypes  = []interface{}{
-		(*SearchRequest)(nil),         // 0: weaviategrpc.SearchRequest
-		(*AdditionalProperties)(nil),  // 1: weaviategrpc.AdditionalProperties
-		(*Properties)(nil),            // 2: weaviategrpc.Properties
-		(*HybridSearchParams)(nil),    // 3: weaviategrpc.HybridSearchParams
-		(*BM25SearchParams)(nil),      // 4: weaviategrpc.BM25SearchParams
-		(*RefProperties)(nil),         // 5: weaviategrpc.RefProperties
-		(*NearVectorParams)(nil),      // 6: weaviategrpc.NearVectorParams
-		(*NearObjectParams)(nil),      // 7: weaviategrpc.NearObjectParams
-		(*SearchReply)(nil),           // 8: weaviategrpc.SearchReply
-		(*SearchResult)(nil),          // 9: weaviategrpc.SearchResult
-		(*ResultAdditionalProps)(nil), // 10: weaviategrpc.ResultAdditionalProps
-		(*ResultProperties)(nil),      // 11: weaviategrpc.ResultProperties
-		(*ReturnRefProperties)(nil),   // 12: weaviategrpc.ReturnRefProperties
-		(*structpb.Struct)(nil),       // 13: google.protobuf.Struct
+	file_weaviate_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+	file_weaviate_proto_msgTypes  = make([]protoimpl.MessageInfo, 43)
+	file_weaviate_proto_goTypes   = []interface{}{
+		(ConsistencyLevel)(0),                  // 0: weaviategrpc.ConsistencyLevel
+		(Filters_Operator)(0),                  // 1: weaviategrpc.Filters.Operator
+		(AggregateRequest_Aggregator)(0),       // 2: weaviategrpc.AggregateRequest.Aggregator
+		(*BatchObjectsRequest)(nil),            // 3: weaviategrpc.BatchObjectsRequest
+		(*BatchObject)(nil),                    // 4: weaviategrpc.BatchObject
+		(*BatchStreamRequest)(nil),             // 5: weaviategrpc.BatchStreamRequest
+		(*BatchStreamReply)(nil),               // 6: weaviategrpc.BatchStreamReply
+		(*BatchObjectsReply)(nil),              // 7: weaviategrpc.BatchObjectsReply
+		(*SearchRequest)(nil),                  // 8: weaviategrpc.SearchRequest
+		(*ModuleArgument)(nil),                 // 9: weaviategrpc.ModuleArgument
+		(*SortBy)(nil),                         // 10: weaviategrpc.SortBy
+		(*GroupBy)(nil),                        // 11: weaviategrpc.GroupBy
+		(*Filters)(nil),                        // 12: weaviategrpc.Filters
+		(*GeoCoordinatesFilter)(nil),           // 13: weaviategrpc.GeoCoordinatesFilter
+		(*AdditionalProperties)(nil),           // 14: weaviategrpc.AdditionalProperties
+		(*Properties)(nil),                     // 15: weaviategrpc.Properties
+		(*HybridSearchParams)(nil),             // 16: weaviategrpc.HybridSearchParams
+		(*BM25SearchParams)(nil),               // 17: weaviategrpc.BM25SearchParams
+		(*RefProperties)(nil),                  // 18: weaviategrpc.RefProperties
+		(*NearVectorParams)(nil),               // 19: weaviategrpc.NearVectorParams
+		(*NearObjectParams)(nil),               // 20: weaviategrpc.NearObjectParams
+		(*SearchReply)(nil),                    // 21: weaviategrpc.SearchReply
+		(*GroupByResult)(nil),                  // 22: weaviategrpc.GroupByResult
+		(*SearchResult)(nil),                   // 23: weaviategrpc.SearchResult
+		(*ResultAdditionalProps)(nil),          // 24: weaviategrpc.ResultAdditionalProps
+		(*ResultProperties)(nil),               // 25: weaviategrpc.ResultProperties
+		(*ReturnRefProperties)(nil),            // 26: weaviategrpc.ReturnRefProperties
+		(*AggregateRequest)(nil),               // 27: weaviategrpc.AggregateRequest
+		(*AggregateReply)(nil),                 // 28: weaviategrpc.AggregateReply
+		(*AggregateGroup)(nil),                 // 29: weaviategrpc.AggregateGroup
+		(*AggregateProperty)(nil),              // 30: weaviategrpc.AggregateProperty
+		(*NumericalAggregation)(nil),           // 31: weaviategrpc.NumericalAggregation
+		(*TextAggregation)(nil),                // 32: weaviategrpc.TextAggregation
+		(*BooleanAggregation)(nil),             // 33: weaviategrpc.BooleanAggregation
+		(*DateAggregation)(nil),                // 34: weaviategrpc.DateAggregation
+		(*ReferenceAggregation)(nil),           // 35: weaviategrpc.ReferenceAggregation
+		(*BatchObject_Properties)(nil),         // 36: weaviategrpc.BatchObject.Properties
+		(*BatchObject_IntArrayProperties)(nil), // 37: weaviategrpc.BatchObject.IntArrayProperties
+		(*BatchObject_RefProperties)(nil),      // 38: weaviategrpc.BatchObject.RefProperties
+		(*BatchObject_NamedVector)(nil),        // 39: weaviategrpc.BatchObject.NamedVector
+		nil,                                    // 40: weaviategrpc.BatchObject.Properties.IntPropertiesEntry
+		(*BatchStreamReply_BatchError)(nil),    // 41: weaviategrpc.BatchStreamReply.BatchError
+		(*BatchObjectsReply_BatchResult)(nil),  // 42: weaviategrpc.BatchObjectsReply.BatchResult
+		(*AggregateRequest_Aggregation)(nil),   // 43: weaviategrpc.AggregateRequest.Aggregation
+		(*AggregateGroup_GroupedBy)(nil),       // 44: weaviategrpc.AggregateGroup.GroupedBy
+		(*TextAggregation_Occurrence)(nil),     // 45: weaviategrpc.TextAggregation.Occurrence
+		(*structpb.Struct)(nil),                // 46: google.protobuf.Struct
+		(*structpb.Value)(nil),                 // 47: google.protobuf.Value
 	}
 )
 
 var file_weaviate_proto_depIdxs = []int32{
-	1,  // 0: weaviategrpc.SearchRequest.additional_properties:type_name -> weaviategrpc.AdditionalProperties
-	6,  // 1: weaviategrpc.SearchRequest.near_vector:type_name -> weaviategrpc.NearVectorParams
-	7,  // 2: weaviategrpc.SearchRequest.near_object:type_name -> weaviategrpc.NearObjectParams
-	2,  // 3: weaviategrpc.SearchRequest.properties:type_name -> weaviategrpc.Properties
-	3,  // 4: weaviategrpc.SearchRequest.hybrid_search:type_name -> weaviategrpc.HybridSearchParams
-	4,  // 5: weaviategrpc.SearchRequest.bm25_search:type_name -> weaviategrpc.BM25SearchParams
-	5,  // 6: weaviategrpc.Properties.ref_properties:type_name -> weaviategrpc.RefProperties
-	2,  // 7: weaviategrpc.RefProperties.linked_properties:type_name -> weaviategrpc.Properties
-	9,  // 8: weaviategrpc.SearchReply.results:type_name -> weaviategrpc.SearchResult
-	11, // 9: weaviategrpc.SearchResult.properties:type_name -> weaviategrpc.ResultProperties
-	10, // 10: weaviategrpc.SearchResult.additional_properties:type_name -> weaviategrpc.ResultAdditionalProps
-	13, // 11: weaviategrpc.ResultProperties.non_ref_properties:type_name -> google.protobuf.Struct
-	12, // 12: weaviategrpc.ResultProperties.ref_props:type_name -> weaviategrpc.ReturnRefProperties
-	11, // 13: weaviategrpc.ReturnRefProperties.properties:type_name -> weaviategrpc.ResultProperties
-	0,  // 14: weaviategrpc.Weaviate.Search:input_type -> weaviategrpc.SearchRequest
-	8,  // 15: weaviategrpc.Weaviate.Search:output_type -> weaviategrpc.SearchReply
-	15, // [15:16] is the sub-list for method output_type
-	14, // [14:15] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	4,  // 0: weaviategrpc.BatchObjectsRequest.objects:type_name -> weaviategrpc.BatchObject
+	0,  // 1: weaviategrpc.BatchObjectsRequest.consistency_level:type_name -> weaviategrpc.ConsistencyLevel
+	36, // 2: weaviategrpc.BatchObject.properties:type_name -> weaviategrpc.BatchObject.Properties
+	39, // 3: weaviategrpc.BatchObject.vectors:type_name -> weaviategrpc.BatchObject.NamedVector
+	4,  // 4: weaviategrpc.BatchStreamRequest.objects:type_name -> weaviategrpc.BatchObject
+	0,  // 5: weaviategrpc.BatchStreamRequest.consistency_level:type_name -> weaviategrpc.ConsistencyLevel
+	41, // 6: weaviategrpc.BatchStreamReply.errors:type_name -> weaviategrpc.BatchStreamReply.BatchError
+	42, // 7: weaviategrpc.BatchObjectsReply.results:type_name -> weaviategrpc.BatchObjectsReply.BatchResult
+	14, // 8: weaviategrpc.SearchRequest.additional_properties:type_name -> weaviategrpc.AdditionalProperties
+	19, // 9: weaviategrpc.SearchRequest.near_vector:type_name -> weaviategrpc.NearVectorParams
+	20, // 10: weaviategrpc.SearchRequest.near_object:type_name -> weaviategrpc.NearObjectParams
+	15, // 11: weaviategrpc.SearchRequest.properties:type_name -> weaviategrpc.Properties
+	16, // 12: weaviategrpc.SearchRequest.hybrid_search:type_name -> weaviategrpc.HybridSearchParams
+	17, // 13: weaviategrpc.SearchRequest.bm25_search:type_name -> weaviategrpc.BM25SearchParams
+	12, // 14: weaviategrpc.SearchRequest.filters:type_name -> weaviategrpc.Filters
+	10, // 15: weaviategrpc.SearchRequest.sort_by:type_name -> weaviategrpc.SortBy
+	11, // 16: weaviategrpc.SearchRequest.group_by:type_name -> weaviategrpc.GroupBy
+	0,  // 17: weaviategrpc.SearchRequest.consistency_level:type_name -> weaviategrpc.ConsistencyLevel
+	9,  // 18: weaviategrpc.SearchRequest.module_search:type_name -> weaviategrpc.ModuleArgument
+	9,  // 19: weaviategrpc.SearchRequest.module_additional:type_name -> weaviategrpc.ModuleArgument
+	46, // 20: weaviategrpc.ModuleArgument.arguments:type_name -> google.protobuf.Struct
+	1,  // 21: weaviategrpc.Filters.operator:type_name -> weaviategrpc.Filters.Operator
+	12, // 22: weaviategrpc.Filters.filters:type_name -> weaviategrpc.Filters
+	13, // 23: weaviategrpc.Filters.value_geo:type_name -> weaviategrpc.GeoCoordinatesFilter
+	18, // 24: weaviategrpc.Properties.ref_properties:type_name -> weaviategrpc.RefProperties
+	15, // 25: weaviategrpc.RefProperties.linked_properties:type_name -> weaviategrpc.Properties
+	23, // 26: weaviategrpc.SearchReply.results:type_name -> weaviategrpc.SearchResult
+	22, // 27: weaviategrpc.SearchReply.group_by_results:type_name -> weaviategrpc.GroupByResult
+	23, // 28: weaviategrpc.GroupByResult.hits:type_name -> weaviategrpc.SearchResult
+	25, // 29: weaviategrpc.SearchResult.properties:type_name -> weaviategrpc.ResultProperties
+	24, // 30: weaviategrpc.SearchResult.additional_properties:type_name -> weaviategrpc.ResultAdditionalProps
+	46, // 31: weaviategrpc.ResultAdditionalProps.module_additional:type_name -> google.protobuf.Struct
+	46, // 32: weaviategrpc.ResultProperties.non_ref_properties:type_name -> google.protobuf.Struct
+	26, // 33: weaviategrpc.ResultProperties.ref_props:type_name -> weaviategrpc.ReturnRefProperties
+	25, // 34: weaviategrpc.ReturnRefProperties.properties:type_name -> weaviategrpc.ResultProperties
+	43, // 35: weaviategrpc.AggregateRequest.aggregations:type_name -> weaviategrpc.AggregateRequest.Aggregation
+	12, // 36: weaviategrpc.AggregateRequest.filters:type_name -> weaviategrpc.Filters
+	19, // 37: weaviategrpc.AggregateRequest.near_vector:type_name -> weaviategrpc.NearVectorParams
+	20, // 38: weaviategrpc.AggregateRequest.near_object:type_name -> weaviategrpc.NearObjectParams
+	16, // 39: weaviategrpc.AggregateRequest.hybrid:type_name -> weaviategrpc.HybridSearchParams
+	9,  // 40: weaviategrpc.AggregateRequest.module_search:type_name -> weaviategrpc.ModuleArgument
+	29, // 41: weaviategrpc.AggregateReply.groups:type_name -> weaviategrpc.AggregateGroup
+	44, // 42: weaviategrpc.AggregateGroup.grouped_by:type_name -> weaviategrpc.AggregateGroup.GroupedBy
+	30, // 43: weaviategrpc.AggregateGroup.properties:type_name -> weaviategrpc.AggregateProperty
+	31, // 44: weaviategrpc.AggregateProperty.numerical:type_name -> weaviategrpc.NumericalAggregation
+	32, // 45: weaviategrpc.AggregateProperty.text:type_name -> weaviategrpc.TextAggregation
+	33, // 46: weaviategrpc.AggregateProperty.boolean:type_name -> weaviategrpc.BooleanAggregation
+	34, // 47: weaviategrpc.AggregateProperty.date:type_name -> weaviategrpc.DateAggregation
+	35, // 48: weaviategrpc.AggregateProperty.reference:type_name -> weaviategrpc.ReferenceAggregation
+	45, // 49: weaviategrpc.TextAggregation.top_occurrences:type_name -> weaviategrpc.TextAggregation.Occurrence
+	46, // 50: weaviategrpc.BatchObject.Properties.non_ref_properties:type_name -> google.protobuf.Struct
+	38, // 51: weaviategrpc.BatchObject.Properties.ref_props:type_name -> weaviategrpc.BatchObject.RefProperties
+	40, // 52: weaviategrpc.BatchObject.Properties.int_properties:type_name -> weaviategrpc.BatchObject.Properties.IntPropertiesEntry
+	37, // 53: weaviategrpc.BatchObject.Properties.int_array_properties:type_name -> weaviategrpc.BatchObject.IntArrayProperties
+	2,  // 54: weaviategrpc.AggregateRequest.Aggregation.aggregators:type_name -> weaviategrpc.AggregateRequest.Aggregator
+	47, // 55: weaviategrpc.AggregateGroup.GroupedBy.value:type_name -> google.protobuf.Value
+	8,  // 56: weaviategrpc.Weaviate.Search:input_type -> weaviategrpc.SearchRequest
+	3,  // 57: weaviategrpc.Weaviate.BatchObjects:input_type -> weaviategrpc.BatchObjectsRequest
+	5,  // 58: weaviategrpc.Weaviate.BatchStream:input_type -> weaviategrpc.BatchStreamRequest
+	27, // 59: weaviategrpc.Weaviate.Aggregate:input_type -> weaviategrpc.AggregateRequest
+	21, // 60: weaviategrpc.Weaviate.Search:output_type -> weaviategrpc.SearchReply
+	7,  // 61: weaviategrpc.Weaviate.BatchObjects:output_type -> weaviategrpc.BatchObjectsReply
+	6,  // 62: weaviategrpc.Weaviate.BatchStream:output_type -> weaviategrpc.BatchStreamReply
+	28, // 63: weaviategrpc.Weaviate.Aggregate:output_type -> weaviategrpc.AggregateReply
+	60, // [60:64] is the sub-list for method output_type
+	56, // [56:60] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_weaviate_proto_init() }
@@ -1231,6 +4251,66 @@ func file_weaviate_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_weaviate_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchObjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchObject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchStreamReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchObjectsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchRequest); i {
 			case 0:
 				return &v.state
@@ -1242,7 +4322,67 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
-		file_weaviate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_weaviate_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleArgument); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortBy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupBy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoCoordinatesFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdditionalProperties); i {
 			case 0:
 				return &v.state
@@ -1254,7 +4394,7 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
-		file_weaviate_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_weaviate_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Properties); i {
 			case 0:
 				return &v.state
@@ -1266,7 +4406,7 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
-		file_weaviate_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_weaviate_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HybridSearchParams); i {
 			case 0:
 				return &v.state
@@ -1278,7 +4418,7 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
-		file_weaviate_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_weaviate_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BM25SearchParams); i {
 			case 0:
 				return &v.state
@@ -1290,7 +4430,7 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
-		file_weaviate_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_weaviate_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefProperties); i {
 			case 0:
 				return &v.state
@@ -1302,7 +4442,7 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
-		file_weaviate_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_weaviate_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NearVectorParams); i {
 			case 0:
 				return &v.state
@@ -1314,7 +4454,7 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
-		file_weaviate_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_weaviate_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NearObjectParams); i {
 			case 0:
 				return &v.state
@@ -1326,7 +4466,7 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
-		file_weaviate_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_weaviate_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchReply); i {
 			case 0:
 				return &v.state
@@ -1338,7 +4478,19 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
-		file_weaviate_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_weaviate_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupByResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchResult); i {
 			case 0:
 				return &v.state
@@ -1350,7 +4502,7 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
-		file_weaviate_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_weaviate_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResultAdditionalProps); i {
 			case 0:
 				return &v.state
@@ -1362,7 +4514,7 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
-		file_weaviate_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_weaviate_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResultProperties); i {
 			case 0:
 				return &v.state
@@ -1374,7 +4526,7 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
-		file_weaviate_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_weaviate_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReturnRefProperties); i {
 			case 0:
 				return &v.state
@@ -1386,21 +4538,263 @@ func file_weaviate_proto_init() {
 				return nil
 			}
 		}
+		file_weaviate_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateProperty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumericalAggregation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextAggregation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BooleanAggregation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DateAggregation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReferenceAggregation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchObject_Properties); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchObject_IntArrayProperties); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchObject_RefProperties); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchObject_NamedVector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchStreamReply_BatchError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchObjectsReply_BatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateRequest_Aggregation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateGroup_GroupedBy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_weaviate_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextAggregation_Occurrence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_weaviate_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*Filters_ValueText)(nil),
+		(*Filters_ValueInt)(nil),
+		(*Filters_ValueBoolean)(nil),
+		(*Filters_ValueNumber)(nil),
+		(*Filters_ValueDate)(nil),
+		(*Filters_ValueGeo)(nil),
+	}
+	file_weaviate_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*AggregateProperty_Numerical)(nil),
+		(*AggregateProperty_Text)(nil),
+		(*AggregateProperty_Boolean)(nil),
+		(*AggregateProperty_Date)(nil),
+		(*AggregateProperty_Reference)(nil),
 	}
-	file_weaviate_proto_msgTypes[6].OneofWrappers = []interface{}{}
-	file_weaviate_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_weaviate_proto_msgTypes[40].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_weaviate_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      3,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_weaviate_proto_goTypes,
 		DependencyIndexes: file_weaviate_proto_depIdxs,
+		EnumInfos:         file_weaviate_proto_enumTypes,
 		MessageInfos:      file_weaviate_proto_msgTypes,
 	}.Build()
 	File_weaviate_proto = out.File
@@ -191,6 +191,29 @@ func (a *azureClient) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
 
+// Delete removes all objects stored under backupID
+func (a *azureClient) Delete(ctx context.Context, backupID string) error {
+	prefix := a.makeObjectName(backupID) + "/"
+	pager := a.client.NewListBlobsFlatPager(a.config.Container,
+		&azblob.ListBlobsFlatOptions{Prefix: to.Ptr(prefix)})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list blobs '%s'", prefix))
+		}
+		for _, blob := range page.Segment.BlobItems {
+			if blob.Name == nil {
+				continue
+			}
+			_, err := a.client.DeleteBlob(ctx, a.config.Container, *blob.Name, nil)
+			if err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+				return backup.NewErrInternal(errors.Wrapf(err, "delete blob '%s'", *blob.Name))
+			}
+		}
+	}
+	return nil
+}
+
 func (a *azureClient) WriteToFile(ctx context.Context, backupID, key, destPath string) error {
 	dir := path.Dir(destPath)
 	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
@@ -133,6 +133,15 @@ func (m *Module) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
 
+// Delete removes all objects stored under backupID
+func (m *Module) Delete(ctx context.Context, backupID string) error {
+	dir := m.makeBackupDirPath(backupID)
+	if err := os.RemoveAll(dir); err != nil {
+		return errors.Wrapf(err, "remove dir '%s'", dir)
+	}
+	return nil
+}
+
 func (m *Module) WriteToFile(ctx context.Context, backupID, key, destPath string) error {
 	sourcePath, err := m.getObjectPath(ctx, backupID, key)
 	if err != nil {
@@ -52,3 +52,21 @@ func TestBackend_StoreBackup(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestBackend_Delete(t *testing.T) {
+	ctx := context.Background()
+	module := New()
+	assert.Nil(t, module.initBackupBackend(ctx, t.TempDir()))
+
+	assert.Nil(t, module.PutObject(ctx, "offload_shard", "shard.json", []byte("{}")))
+	assert.Nil(t, module.PutObject(ctx, "other", "shard.json", []byte("{}")))
+
+	assert.Nil(t, module.Delete(ctx, "offload_shard"))
+
+	_, err := module.GetObject(ctx, "offload_shard", "shard.json")
+	assert.NotNil(t, err)
+	_, err = module.GetObject(ctx, "other", "shard.json")
+	assert.Nil(t, err)
+	// deleting what does not exist is not an error
+	assert.Nil(t, module.Delete(ctx, "offload_shard"))
+}
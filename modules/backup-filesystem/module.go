@@ -92,5 +92,6 @@ func (m *Module) makeBackupDirPath(id string) string {
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.BackupBackend(New())
+	_ = modulecapabilities.OffloadBackend(New())
 	_ = modulecapabilities.MetaProvider(New())
 )
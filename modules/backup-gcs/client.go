@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
@@ -236,6 +237,29 @@ func (g *gcsClient) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
 
+// Delete removes all objects stored under backupID
+func (g *gcsClient) Delete(ctx context.Context, backupID string) error {
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return errors.Wrap(err, "find bucket")
+	}
+
+	prefix := g.makeObjectName(backupID) + "/"
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return nil
+		}
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list objects '%s'", prefix))
+		}
+		if err := bucket.Object(attrs.Name).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", attrs.Name))
+		}
+	}
+}
+
 // WriteToFile downloads an object and store its content in destPath
 // The file destPath will be created if it doesn't exit
 func (g *gcsClient) WriteToFile(ctx context.Context, backupID, key, destPath string) (err error) {
@@ -162,6 +162,54 @@ func (s *s3Client) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
 
+// Delete removes all objects stored under backupID
+func (s *s3Client) Delete(ctx context.Context, backupID string) error {
+	prefix := s.makeObjectName(backupID) + "/"
+	listed := s.client.ListObjects(ctx, s.config.Bucket,
+		minio.ListObjectsOptions{Prefix: prefix, Recursive: true})
+
+	// RemoveObjects skips listing errors, so they are filtered out
+	// and reported here
+	var listErr error
+	objects := make(chan minio.ObjectInfo)
+	listDone := make(chan struct{})
+	go func() {
+		defer close(listDone)
+		defer close(objects)
+		for obj := range listed {
+			if obj.Err != nil {
+				if listErr == nil {
+					listErr = errors.Wrapf(obj.Err, "list objects '%s'", prefix)
+				}
+				continue
+			}
+			select {
+			case objects <- obj:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var rmErr error
+	for res := range s.client.RemoveObjects(ctx, s.config.Bucket, objects, minio.RemoveObjectsOptions{}) {
+		if rmErr == nil {
+			rmErr = errors.Wrapf(res.Err, "remove object '%s'", res.ObjectName)
+		}
+	}
+	<-listDone
+	if err := ctx.Err(); err != nil {
+		return backup.NewErrContextExpired(errors.Wrapf(err, "delete objects '%s'", prefix))
+	}
+	if rmErr != nil {
+		return backup.NewErrInternal(rmErr)
+	}
+	if listErr != nil {
+		return backup.NewErrInternal(listErr)
+	}
+	return nil
+}
+
 // WriteFile downloads contents of an object to a local file destPath
 func (s *s3Client) WriteToFile(ctx context.Context, backupID, key, destPath string) error {
 	object := s.makeObjectName(backupID, key)
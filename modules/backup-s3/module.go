@@ -106,5 +106,6 @@ func (m *Module) MetaInfo() (map[string]interface{}, error) {
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.BackupBackend(New())
+	_ = modulecapabilities.OffloadBackend(New())
 	_ = modulecapabilities.MetaProvider(New())
 )
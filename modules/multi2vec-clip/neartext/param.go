@@ -16,15 +16,16 @@ import (
 )
 
 type NearTextParams struct {
-	Values       []string
-	Limit        int
-	MoveTo       ExploreMove
-	MoveAwayFrom ExploreMove
-	Certainty    float64
-	Distance     float64
-	WithDistance bool
-	Network      bool
-	Autocorrect  bool
+	Values        []string
+	Limit         int
+	MoveTo        ExploreMove
+	MoveAwayFrom  ExploreMove
+	Certainty     float64
+	Distance      float64
+	WithDistance  bool
+	Network       bool
+	Autocorrect   bool
+	TargetVectors []string
 }
 
 func (n NearTextParams) GetCertainty() float64 {
@@ -39,6 +40,10 @@ func (n NearTextParams) SimilarityMetricProvided() bool {
 	return n.Certainty != 0 || n.WithDistance
 }
 
+func (n NearTextParams) GetTargetVectors() []string {
+	return n.TargetVectors
+}
+
 // ExploreMove moves an existing Search Vector closer (or further away from) a specific other search term
 type ExploreMove struct {
 	Values  []string
@@ -44,9 +44,13 @@ func (f *fakeSchemaGetter) CopyShardingState(class string) *sharding.State {
 }
 
 func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error) { return "", nil }
-func (f *fakeSchemaGetter) TenantShard(class, tenant string) string        { return tenant }
+func (f *fakeSchemaGetter) TenantShard(class, tenant string) (string, string) {
+	return tenant, models.TenantActivityStatusHOT
+}
 func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string { return "" }
 
+func (f *fakeSchemaGetter) ResolveAlias(name string) string { return name }
+
 func (f *fakeSchemaGetter) Nodes() []string {
 	panic("not implemented")
 }
@@ -325,7 +329,7 @@ type fakeModulesProvider struct {
 	contextualClassifier modulecapabilities.Classifier
 }
 
-func (fmp *fakeModulesProvider) VectorFromInput(ctx context.Context, className string, input string) ([]float32, error) {
+func (fmp *fakeModulesProvider) VectorFromInput(ctx context.Context, className, input, targetVector string) ([]float32, error) {
 	panic("not implemented")
 }
 
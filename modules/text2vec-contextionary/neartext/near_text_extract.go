@@ -74,6 +74,16 @@ func (g *GraphQLArgumentsProvider) extractNearTextFn(source map[string]interface
 		args.MoveAwayFrom = g.extractMovement(moveAwayFrom)
 	}
 
+	// targetVectors is an optional arg, so it could be nil
+	targetVectors, ok := source["targetVectors"]
+	if ok {
+		targetVectorsArray := targetVectors.([]interface{})
+		args.TargetVectors = make([]string, len(targetVectorsArray))
+		for i, value := range targetVectorsArray {
+			args.TargetVectors[i] = value.(string)
+		}
+	}
+
 	return &args
 }
 
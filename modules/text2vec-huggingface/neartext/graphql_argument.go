@@ -61,6 +61,10 @@ func (g *GraphQLArgumentsProvider) nearTextFields(prefix string) graphql.InputOb
 			Description: descriptions.Distance,
 			Type:        graphql.Float,
 		},
+		"targetVectors": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TargetVectors,
+			Type:        graphql.NewList(graphql.String),
+		},
 		"moveAwayFrom": &graphql.InputObjectFieldConfig{
 			Description: descriptions.VectorMovement,
 			Type: graphql.NewInputObject(
@@ -54,6 +54,19 @@ func Test_extractNearTextFn(t *testing.T) {
 				Network:      true,
 			},
 		},
+		{
+			"Extract with concepts and target vectors",
+			args{
+				source: map[string]interface{}{
+					"concepts":      []interface{}{"c1", "c2", "c3"},
+					"targetVectors": []interface{}{"title"},
+				},
+			},
+			&NearTextParams{
+				Values:        []string{"c1", "c2", "c3"},
+				TargetVectors: []string{"title"},
+			},
+		},
 		{
 			"Extract with concepts, certainty, limit and network",
 			args{
@@ -57,7 +57,15 @@ func testGetSchemaWithoutClient(t *testing.T) {
 							"distribution": "log-normal",
 							"type":         "kmeans",
 						},
-						"segments":      float64(0),
+						"oversamplingFactor": float64(0),
+						"segments":           float64(0),
+						"trainingLimit":      float64(100000),
+					},
+					"bq": map[string]interface{}{
+						"enabled": false,
+					},
+					"sq": map[string]interface{}{
+						"enabled":       false,
 						"trainingLimit": float64(100000),
 					},
 				},
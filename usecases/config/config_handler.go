@@ -95,6 +95,11 @@ type Config struct {
 	ReindexSetToRoaringsetAtStartup     bool           `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
 	IndexMissingTextFilterableAtStartup bool           `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
 	DisableGraphQL                      bool           `json:"disable_graphql" yaml:"disable_graphql"`
+	TenantOffloadBackend                string         `json:"tenant_offload_backend" yaml:"tenant_offload_backend"`
+	// StopwordPresets are named stopword lists which classes can reference in
+	// invertedIndexConfig.stopwords.preset in addition to the built-in ones.
+	// They need to be configured identically on all nodes of a cluster.
+	StopwordPresets map[string][]string `json:"stopword_presets" yaml:"stopword_presets"`
 }
 
 type moduleProvider interface {
@@ -269,6 +269,10 @@ func FromEnv(config *Config) error {
 	}
 
 	config.DisableGraphQL = enabled(os.Getenv("DISABLE_GRAPHQL"))
+
+	if v := os.Getenv("TENANT_OFFLOAD_BACKEND"); v != "" {
+		config.TenantOffloadBackend = v
+	}
 	return nil
 }
 
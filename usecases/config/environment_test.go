@@ -407,6 +407,30 @@ func TestEnvironmentDisableGraphQL(t *testing.T) {
 	}
 }
 
+func TestEnvironmentTenantOffloadBackend(t *testing.T) {
+	factors := []struct {
+		name     string
+		value    []string
+		expected string
+	}{
+		{"Valid: backup-s3", []string{"backup-s3"}, "backup-s3"},
+		{"Valid: backup-filesystem", []string{"backup-filesystem"}, "backup-filesystem"},
+		{"not given", []string{}, ""},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("TENANT_OFFLOAD_BACKEND", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			require.Nil(t, err)
+			require.Equal(t, tt.expected, conf.TenantOffloadBackend)
+		})
+	}
+}
+
 func TestEnvironmentPrometheusGroupClasses_OldName(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -0,0 +1,295 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+// ExtractSearchParamsFromValues is the counterpart of ExtractSearchParams for
+// APIs other than GraphQL. The arguments are plain values, e.g. decoded from
+// JSON or protobuf, which are coerced according to the modules' GraphQL
+// argument definitions, so that the modules' extract functions can be reused
+// unchanged.
+func (p *Provider) ExtractSearchParamsFromValues(arguments map[string]interface{},
+	className string,
+) (map[string]interface{}, error) {
+	class, err := p.getClass(className)
+	if err != nil {
+		return nil, err
+	}
+
+	extracted := map[string]interface{}{}
+	for _, module := range p.GetAll() {
+		if !p.shouldIncludeClassArgument(class, module.Name(), module.Type()) {
+			continue
+		}
+		args, ok := module.(modulecapabilities.GraphQLArguments)
+		if !ok {
+			continue
+		}
+		for name, argument := range args.Arguments() {
+			raw, ok := arguments[name]
+			if !ok || argument.GetArgumentsFunction == nil || argument.ExtractFunction == nil {
+				continue
+			}
+
+			value, err := coerceInputValue(argument.GetArgumentsFunction(class.Class).Type, raw)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			source, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s: expected an object, got %T", name, raw)
+			}
+			extracted[name] = argument.ExtractFunction(source)
+		}
+	}
+
+	for name := range arguments {
+		if _, ok := extracted[name]; !ok {
+			return nil, fmt.Errorf("unknown search argument %q for class %q", name, className)
+		}
+	}
+
+	return extracted, nil
+}
+
+// ExtractAdditionalFieldFromValues is the counterpart of ExtractAdditionalField
+// for APIs other than GraphQL, see ExtractSearchParamsFromValues
+func (p *Provider) ExtractAdditionalFieldFromValues(className, name string,
+	arguments map[string]interface{},
+) (interface{}, error) {
+	class, err := p.getClass(className)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, module := range p.GetAll() {
+		if !p.shouldIncludeClassArgument(class, module.Name(), module.Type()) {
+			continue
+		}
+		arg, ok := module.(modulecapabilities.AdditionalProperties)
+		if !ok {
+			continue
+		}
+		additionalProperty, ok := arg.AdditionalProperties()[name]
+		if !ok || additionalProperty.GraphQLExtractFunction == nil {
+			continue
+		}
+
+		var astArgs []*ast.Argument
+		if len(arguments) > 0 {
+			if additionalProperty.GraphQLFieldFunction == nil {
+				return nil, fmt.Errorf("%s: does not take any arguments", name)
+			}
+			field := additionalProperty.GraphQLFieldFunction(class.Class)
+			astArgs, err = argumentsToAST(field.Args, arguments)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+		}
+		return additionalProperty.GraphQLExtractFunction(astArgs), nil
+	}
+
+	return nil, fmt.Errorf("unknown additional property %q for class %q", name, className)
+}
+
+// coerceInputValue converts a plain value into what the GraphQL library would
+// pass to a resolver for an argument of the given type
+func coerceInputValue(t graphql.Input, value interface{}) (interface{}, error) {
+	if nonNull, ok := t.(*graphql.NonNull); ok {
+		if value == nil {
+			return nil, fmt.Errorf("value of type %s is required", nonNull)
+		}
+		return coerceInputValue(nonNull.OfType, value)
+	}
+
+	if value == nil {
+		return nil, nil
+	}
+
+	switch typed := t.(type) {
+	case *graphql.List:
+		list, ok := value.([]interface{})
+		if !ok {
+			// same as in GraphQL a single value is accepted for a list
+			list = []interface{}{value}
+		}
+		out := make([]interface{}, len(list))
+		for i := range list {
+			v, err := coerceInputValue(typed.OfType, list[i])
+			if err != nil {
+				return nil, err
+			}
+			out[i] = v
+		}
+		return out, nil
+	case *graphql.InputObject:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected an object of type %s, got %T", typed, value)
+		}
+		fields := typed.Fields()
+		for name := range obj {
+			if _, ok := fields[name]; !ok {
+				return nil, fmt.Errorf("unknown field %q of type %s", name, typed)
+			}
+		}
+		out := map[string]interface{}{}
+		for name, field := range fields {
+			raw, ok := obj[name]
+			if !ok {
+				if field.DefaultValue != nil {
+					out[name] = field.DefaultValue
+					continue
+				}
+				if _, required := field.Type.(*graphql.NonNull); required {
+					return nil, fmt.Errorf("field %q of type %s is required", name, typed)
+				}
+				continue
+			}
+			v, err := coerceInputValue(field.Type, raw)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", name, err)
+			}
+			out[name] = v
+		}
+		return out, nil
+	case *graphql.Scalar:
+		if f, ok := value.(float64); ok && typed == graphql.Int && f != math.Trunc(f) {
+			return nil, fmt.Errorf("invalid value %v for type %s", value, typed)
+		}
+		parsed := typed.ParseValue(value)
+		if parsed == nil {
+			return nil, fmt.Errorf("invalid value %v for type %s", value, typed)
+		}
+		return parsed, nil
+	case *graphql.Enum:
+		parsed := typed.ParseValue(value)
+		if parsed == nil {
+			return nil, fmt.Errorf("invalid value %v for type %s", value, typed)
+		}
+		return parsed, nil
+	default:
+		return nil, fmt.Errorf("unsupported input type %s", t)
+	}
+}
+
+// argumentsToAST builds the AST arguments the GraphQL library would hand to
+// the extract functions of additional properties
+func argumentsToAST(configs graphql.FieldConfigArgument,
+	arguments map[string]interface{},
+) ([]*ast.Argument, error) {
+	out := make([]*ast.Argument, 0, len(arguments))
+	for name, raw := range arguments {
+		config, ok := configs[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown argument %q", name)
+		}
+		value, err := coerceInputValue(config.Type, raw)
+		if err != nil {
+			return nil, fmt.Errorf("argument %q: %w", name, err)
+		}
+		astValue, err := valueToAST(config.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("argument %q: %w", name, err)
+		}
+		if astValue == nil {
+			// the GraphQL library has no null literal, a null argument is the
+			// same as an omitted one
+			continue
+		}
+		out = append(out, ast.NewArgument(&ast.Argument{
+			Name:  ast.NewName(&ast.Name{Value: name}),
+			Value: astValue,
+		}))
+	}
+	return out, nil
+}
+
+// valueToAST converts a value coerced by coerceInputValue into its AST
+// literal. It returns nil for a null value, which has no literal.
+func valueToAST(t graphql.Input, value interface{}) (ast.Value, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if nonNull, ok := t.(*graphql.NonNull); ok {
+		return valueToAST(nonNull.OfType, value)
+	}
+
+	switch typed := t.(type) {
+	case *graphql.List:
+		list, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a list for type %s, got %T", typed, value)
+		}
+		values := make([]ast.Value, len(list))
+		for i := range list {
+			v, err := valueToAST(typed.OfType, list[i])
+			if err != nil {
+				return nil, err
+			}
+			if v == nil {
+				return nil, fmt.Errorf("null values are not supported in lists of type %s", typed)
+			}
+			values[i] = v
+		}
+		return ast.NewListValue(&ast.ListValue{Values: values}), nil
+	case *graphql.InputObject:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected an object for type %s, got %T", typed, value)
+		}
+		fields := make([]*ast.ObjectField, 0, len(obj))
+		for name, raw := range obj {
+			field, ok := typed.Fields()[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown field %q of type %s", name, typed)
+			}
+			v, err := valueToAST(field.Type, raw)
+			if err != nil {
+				return nil, err
+			}
+			if v == nil {
+				// same as for arguments a null field is omitted
+				continue
+			}
+			fields = append(fields, ast.NewObjectField(&ast.ObjectField{
+				Name:  ast.NewName(&ast.Name{Value: name}),
+				Value: v,
+			}))
+		}
+		return ast.NewObjectValue(&ast.ObjectValue{Fields: fields}), nil
+	case *graphql.Enum:
+		return ast.NewEnumValue(&ast.EnumValue{Value: fmt.Sprint(typed.Serialize(value))}), nil
+	}
+
+	switch v := value.(type) {
+	case int:
+		return ast.NewIntValue(&ast.IntValue{Value: strconv.Itoa(v)}), nil
+	case float64:
+		return ast.NewFloatValue(&ast.FloatValue{Value: strconv.FormatFloat(v, 'f', -1, 64)}), nil
+	case string:
+		return ast.NewStringValue(&ast.StringValue{Value: v}), nil
+	case bool:
+		return ast.NewBooleanValue(&ast.BooleanValue{Value: v}), nil
+	default:
+		return nil, fmt.Errorf("unsupported value %v of type %T", value, value)
+	}
+}
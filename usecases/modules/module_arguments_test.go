@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+type dummyTypedArgumentsModule struct {
+	dummyText2VecModuleNoCapabilities
+}
+
+func (m *dummyTypedArgumentsModule) Arguments() map[string]modulecapabilities.GraphQLArgument {
+	return map[string]modulecapabilities.GraphQLArgument{
+		"nearThing": {
+			GetArgumentsFunction: func(classname string) *graphql.ArgumentConfig {
+				return &graphql.ArgumentConfig{
+					Type: graphql.NewInputObject(graphql.InputObjectConfig{
+						Name: classname + "NearThingInpObj",
+						Fields: graphql.InputObjectConfigFieldMap{
+							"concepts":  &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.String))},
+							"limit":     &graphql.InputObjectFieldConfig{Type: graphql.Int},
+							"certainty": &graphql.InputObjectFieldConfig{Type: graphql.Float},
+						},
+					}),
+				}
+			},
+			ExtractFunction: func(source map[string]interface{}) interface{} {
+				return source
+			},
+		},
+	}
+}
+
+func (m *dummyTypedArgumentsModule) AdditionalProperties() map[string]modulecapabilities.AdditionalProperty {
+	return map[string]modulecapabilities.AdditionalProperty{
+		"generate": {
+			GraphQLNames: []string{"generate"},
+			GraphQLFieldFunction: func(classname string) *graphql.Field {
+				return &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"singleResult": &graphql.ArgumentConfig{
+							Type: graphql.NewInputObject(graphql.InputObjectConfig{
+								Name: classname + "SingleResultInpObj",
+								Fields: graphql.InputObjectConfigFieldMap{
+									"prompt":   &graphql.InputObjectFieldConfig{Type: graphql.String},
+									"maxWords": &graphql.InputObjectFieldConfig{Type: graphql.Int},
+								},
+							}),
+						},
+					},
+				}
+			},
+			GraphQLExtractFunction: func(args []*ast.Argument) interface{} {
+				return args
+			},
+		},
+	}
+}
+
+func newTypedArgumentsProvider(t *testing.T) *Provider {
+	p := NewProvider()
+	p.SetSchemaGetter(getFakeSchemaGetter())
+	p.Register(&dummyTypedArgumentsModule{newDummyText2VecModule("mod1")})
+	logger, _ := test.NewNullLogger()
+	require.Nil(t, p.Init(context.Background(), nil, logger))
+	return p
+}
+
+func TestExtractSearchParamsFromValues(t *testing.T) {
+	p := newTypedArgumentsProvider(t)
+
+	t.Run("values are coerced to GraphQL types", func(t *testing.T) {
+		extracted, err := p.ExtractSearchParamsFromValues(map[string]interface{}{
+			"nearThing": map[string]interface{}{
+				"concepts":  []interface{}{"foo", "bar"},
+				"limit":     float64(3),
+				"certainty": float64(0.7),
+			},
+		}, "ClassOne")
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"nearThing": map[string]interface{}{
+				"concepts":  []interface{}{"foo", "bar"},
+				"limit":     3,
+				"certainty": 0.7,
+			},
+		}, extracted)
+	})
+
+	tests := []struct {
+		name        string
+		className   string
+		arguments   map[string]interface{}
+		expectedErr string
+	}{
+		{
+			name:      "missing required field",
+			className: "ClassOne",
+			arguments: map[string]interface{}{
+				"nearThing": map[string]interface{}{"limit": float64(3)},
+			},
+			expectedErr: "nearThing: field \"concepts\" of type ClassOneNearThingInpObj is required",
+		},
+		{
+			name:      "fractional int",
+			className: "ClassOne",
+			arguments: map[string]interface{}{
+				"nearThing": map[string]interface{}{"concepts": []interface{}{"foo"}, "limit": 2.5},
+			},
+			expectedErr: "nearThing: field \"limit\": invalid value 2.5 for type Int",
+		},
+		{
+			name:      "unknown field",
+			className: "ClassOne",
+			arguments: map[string]interface{}{
+				"nearThing": map[string]interface{}{"concepts": []interface{}{"foo"}, "other": true},
+			},
+			expectedErr: "nearThing: unknown field \"other\" of type ClassOneNearThingInpObj",
+		},
+		{
+			name:      "unknown argument",
+			className: "ClassOne",
+			arguments: map[string]interface{}{
+				"nearOther": map[string]interface{}{},
+			},
+			expectedErr: "unknown search argument \"nearOther\" for class \"ClassOne\"",
+		},
+		{
+			name:      "argument of other vectorizer",
+			className: "ClassTwo",
+			arguments: map[string]interface{}{
+				"nearThing": map[string]interface{}{"concepts": []interface{}{"foo"}},
+			},
+			expectedErr: "unknown search argument \"nearThing\" for class \"ClassTwo\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := p.ExtractSearchParamsFromValues(tt.arguments, tt.className)
+			require.NotNil(t, err)
+			assert.Equal(t, tt.expectedErr, err.Error())
+		})
+	}
+}
+
+func TestExtractAdditionalFieldFromValues(t *testing.T) {
+	p := newTypedArgumentsProvider(t)
+
+	t.Run("arguments are converted to AST", func(t *testing.T) {
+		extracted, err := p.ExtractAdditionalFieldFromValues("ClassOne", "generate", map[string]interface{}{
+			"singleResult": map[string]interface{}{"prompt": "summarize {text}", "maxWords": float64(10)},
+		})
+		require.Nil(t, err)
+
+		args, ok := extracted.([]*ast.Argument)
+		require.True(t, ok)
+		require.Len(t, args, 1)
+		assert.Equal(t, "singleResult", args[0].Name.Value)
+
+		fields := map[string]ast.Value{}
+		for _, field := range args[0].Value.(*ast.ObjectValue).Fields {
+			fields[field.Name.Value] = field.Value
+		}
+		assert.Equal(t, "summarize {text}", fields["prompt"].(*ast.StringValue).Value)
+		assert.Equal(t, "10", fields["maxWords"].(*ast.IntValue).Value)
+	})
+
+	t.Run("null arguments and fields are omitted", func(t *testing.T) {
+		extracted, err := p.ExtractAdditionalFieldFromValues("ClassOne", "generate", map[string]interface{}{
+			"singleResult": map[string]interface{}{"prompt": "summarize {text}", "maxWords": nil},
+		})
+		require.Nil(t, err)
+
+		args, ok := extracted.([]*ast.Argument)
+		require.True(t, ok)
+		require.Len(t, args, 1)
+		fields := args[0].Value.(*ast.ObjectValue).Fields
+		require.Len(t, fields, 1)
+		assert.Equal(t, "prompt", fields[0].Name.Value)
+
+		extracted, err = p.ExtractAdditionalFieldFromValues("ClassOne", "generate", map[string]interface{}{
+			"singleResult": nil,
+		})
+		require.Nil(t, err)
+		assert.Empty(t, extracted)
+	})
+
+	t.Run("without arguments", func(t *testing.T) {
+		extracted, err := p.ExtractAdditionalFieldFromValues("ClassOne", "generate", nil)
+		require.Nil(t, err)
+		assert.Nil(t, extracted)
+	})
+
+	t.Run("unknown argument", func(t *testing.T) {
+		_, err := p.ExtractAdditionalFieldFromValues("ClassOne", "generate", map[string]interface{}{
+			"groupedResult": map[string]interface{}{},
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, "generate: unknown argument \"groupedResult\"", err.Error())
+	})
+
+	t.Run("unknown additional property", func(t *testing.T) {
+		_, err := p.ExtractAdditionalFieldFromValues("ClassOne", "answer", nil)
+		require.NotNil(t, err)
+		assert.Equal(t, "unknown additional property \"answer\" for class \"ClassOne\"", err.Error())
+	})
+}
@@ -17,9 +17,10 @@ import (
 )
 
 type ClassBasedModuleConfig struct {
-	class      *models.Class
-	moduleName string
-	tenant     string
+	class        *models.Class
+	moduleName   string
+	tenant       string
+	targetVector string
 }
 
 func NewClassBasedModuleConfig(class *models.Class,
@@ -32,6 +33,20 @@ func NewClassBasedModuleConfig(class *models.Class,
 	}
 }
 
+// NewClassBasedModuleConfigWithTargetVector returns a config which reads the
+// class level module settings from the vectorizer config of the given named
+// vector instead of the class' moduleConfig
+func NewClassBasedModuleConfigWithTargetVector(class *models.Class,
+	moduleName, tenant, targetVector string,
+) *ClassBasedModuleConfig {
+	return &ClassBasedModuleConfig{
+		class:        class,
+		moduleName:   moduleName,
+		tenant:       tenant,
+		targetVector: targetVector,
+	}
+}
+
 func NewCrossClassModuleConfig() *ClassBasedModuleConfig {
 	// explicitly setting tenant to "" in order to flag that a cross class search
 	// is being done without a tenant context
@@ -48,6 +63,18 @@ func (cbmc *ClassBasedModuleConfig) Tenant() string {
 
 func (cbmc *ClassBasedModuleConfig) ClassByModuleName(moduleName string) map[string]interface{} {
 	defaultConf := map[string]interface{}{}
+	if cbmc.targetVector != "" {
+		vectorConfig, ok := cbmc.class.VectorConfig[cbmc.targetVector]
+		if !ok {
+			return defaultConf
+		}
+		vectorizer, settings, err := schema.NamedVectorVectorizer(vectorConfig)
+		if err != nil || vectorizer != moduleName {
+			return defaultConf
+		}
+		return settings
+	}
+
 	asMap, ok := cbmc.class.ModuleConfig.(map[string]interface{})
 	if !ok {
 		return defaultConf
@@ -23,6 +23,8 @@ import (
 // SetClassDefaults sets the module-specific defaults for the class itself, but
 // also for each prop
 func (p *Provider) SetClassDefaults(class *models.Class) {
+	p.setNamedVectorDefaults(class)
+
 	if class.Vectorizer == "none" {
 		// the class does not use a vectorizer, nothing to do for us
 		return
@@ -38,7 +40,32 @@ func (p *Provider) SetClassDefaults(class *models.Class) {
 	cfg := NewClassBasedModuleConfig(class, class.Vectorizer, "")
 
 	p.setPerClassConfigDefaults(class, cfg, cc)
-	p.setPerPropertyConfigDefaults(class, cfg, cc)
+	p.setPerPropertyConfigDefaults(class, class.Vectorizer, cc)
+}
+
+// setNamedVectorDefaults sets the module-specific defaults for the vectorizer
+// of each named vector, as well as the property defaults of those modules
+func (p *Provider) setNamedVectorDefaults(class *models.Class) {
+	for targetVector, vectorConfig := range class.VectorConfig {
+		moduleName, _, err := schema.NamedVectorVectorizer(vectorConfig)
+		if err != nil || moduleName == "none" {
+			// invalid configs are rejected by the class validation
+			continue
+		}
+
+		cc, ok := p.GetByName(moduleName).(modulecapabilities.ClassConfigurator)
+		if !ok {
+			continue
+		}
+
+		cfg := NewClassBasedModuleConfigWithTargetVector(class, moduleName, "", targetVector)
+		vectorConfig.Vectorizer = map[string]interface{}{
+			moduleName: mergeConfigDefaults(cc.ClassConfigDefaults(), cfg.Class()),
+		}
+		class.VectorConfig[targetVector] = vectorConfig
+
+		p.setPerPropertyConfigDefaults(class, moduleName, cc)
+	}
 }
 
 // SetSinglePropertyDefaults can be used when a property is added later, e.g.
@@ -58,22 +85,13 @@ func (p *Provider) SetSinglePropertyDefaults(class *models.Class,
 		return
 	}
 
-	p.setSinglePropertyConfigDefaults(class, prop, cc)
+	p.setSinglePropertyConfigDefaults(prop, class.Vectorizer, cc)
 }
 
 func (p *Provider) setPerClassConfigDefaults(class *models.Class,
 	cfg *ClassBasedModuleConfig, cc modulecapabilities.ClassConfigurator,
 ) {
-	modDefaults := cc.ClassConfigDefaults()
-	userSpecified := cfg.Class()
-	mergedConfig := map[string]interface{}{}
-
-	for key, value := range modDefaults {
-		mergedConfig[key] = value
-	}
-	for key, value := range userSpecified {
-		mergedConfig[key] = value
-	}
+	mergedConfig := mergeConfigDefaults(cc.ClassConfigDefaults(), cfg.Class())
 
 	if class.ModuleConfig == nil {
 		class.ModuleConfig = map[string]interface{}{}
@@ -83,25 +101,37 @@ func (p *Provider) setPerClassConfigDefaults(class *models.Class,
 }
 
 func (p *Provider) setPerPropertyConfigDefaults(class *models.Class,
-	cfg *ClassBasedModuleConfig, cc modulecapabilities.ClassConfigurator,
+	moduleName string, cc modulecapabilities.ClassConfigurator,
 ) {
 	for _, prop := range class.Properties {
-		p.setSinglePropertyConfigDefaults(class, prop, cc)
+		p.setSinglePropertyConfigDefaults(prop, moduleName, cc)
 	}
 }
 
-func (p *Provider) setSinglePropertyConfigDefaults(class *models.Class,
-	prop *models.Property, cc modulecapabilities.ClassConfigurator,
+func (p *Provider) setSinglePropertyConfigDefaults(prop *models.Property,
+	moduleName string, cc modulecapabilities.ClassConfigurator,
 ) {
 	dt, _ := schema.GetValueDataTypeFromString(prop.DataType[0])
-	modDefaults := cc.PropertyConfigDefaults(dt)
-	mergedConfig := map[string]interface{}{}
 	userSpecified := make(map[string]interface{})
 
-	if prop.ModuleConfig != nil {
-		userSpecified = prop.ModuleConfig.(map[string]interface{})[class.Vectorizer].(map[string]interface{})
+	if asMap, ok := prop.ModuleConfig.(map[string]interface{}); ok {
+		if moduleConfig, ok := asMap[moduleName].(map[string]interface{}); ok {
+			userSpecified = moduleConfig
+		}
 	}
 
+	mergedConfig := mergeConfigDefaults(cc.PropertyConfigDefaults(dt), userSpecified)
+
+	if prop.ModuleConfig == nil {
+		prop.ModuleConfig = map[string]interface{}{}
+	}
+
+	prop.ModuleConfig.(map[string]interface{})[moduleName] = mergedConfig
+}
+
+func mergeConfigDefaults(modDefaults, userSpecified map[string]interface{}) map[string]interface{} {
+	mergedConfig := map[string]interface{}{}
+
 	for key, value := range modDefaults {
 		mergedConfig[key] = value
 	}
@@ -109,14 +139,14 @@ func (p *Provider) setSinglePropertyConfigDefaults(class *models.Class,
 		mergedConfig[key] = value
 	}
 
-	if prop.ModuleConfig == nil {
-		prop.ModuleConfig = map[string]interface{}{}
-	}
-
-	prop.ModuleConfig.(map[string]interface{})[class.Vectorizer] = mergedConfig
+	return mergedConfig
 }
 
 func (p *Provider) ValidateClass(ctx context.Context, class *models.Class) error {
+	if err := p.validateNamedVectors(ctx, class); err != nil {
+		return err
+	}
+
 	if class.Vectorizer == "none" {
 		// the class does not use a vectorizer, nothing to do for us
 		return nil
@@ -143,3 +173,24 @@ func (p *Provider) ValidateClass(ctx context.Context, class *models.Class) error
 
 	return nil
 }
+
+func (p *Provider) validateNamedVectors(ctx context.Context, class *models.Class) error {
+	for targetVector, vectorConfig := range class.VectorConfig {
+		moduleName, _, err := schema.NamedVectorVectorizer(vectorConfig)
+		if err != nil {
+			return errors.Wrapf(err, "named vector %q", targetVector)
+		}
+
+		cc, ok := p.GetByName(moduleName).(modulecapabilities.ClassConfigurator)
+		if !ok {
+			continue
+		}
+
+		cfg := NewClassBasedModuleConfigWithTargetVector(class, moduleName, "", targetVector)
+		if err := cc.ValidateClass(ctx, class, cfg); err != nil {
+			return errors.Wrapf(err, "named vector %q: module '%s'", targetVector, moduleName)
+		}
+	}
+
+	return nil
+}
@@ -90,4 +90,52 @@ func TestClassBasedModuleConfig(t *testing.T) {
 		assert.Equal(t, map[string]interface{}{"propLevel": "bar"},
 			cfg.Property("some-prop"))
 	})
+
+	t.Run("with a target vector", func(t *testing.T) {
+		class := &models.Class{
+			Class: "Test",
+			ModuleConfig: map[string]interface{}{
+				"my-module": map[string]interface{}{
+					"classLevel": "foo",
+				},
+			},
+			VectorConfig: map[string]models.VectorConfig{
+				"title": {
+					Vectorizer: map[string]interface{}{
+						"my-module": map[string]interface{}{
+							"classLevel": "title",
+						},
+					},
+				},
+				"image": {
+					Vectorizer: map[string]interface{}{
+						"other-module": map[string]interface{}{
+							"classLevel": "image",
+						},
+					},
+				},
+			},
+			Properties: []*models.Property{
+				{
+					Name: "some-prop",
+					ModuleConfig: map[string]interface{}{
+						"my-module": map[string]interface{}{
+							"propLevel": "bar",
+						},
+					},
+				},
+			},
+		}
+
+		cfg := NewClassBasedModuleConfigWithTargetVector(class, "my-module", "tenant", "title")
+		assert.Equal(t, map[string]interface{}{"classLevel": "title"}, cfg.Class())
+		assert.Equal(t, map[string]interface{}{"propLevel": "bar"},
+			cfg.Property("some-prop"))
+
+		cfg = NewClassBasedModuleConfigWithTargetVector(class, "my-module", "tenant", "image")
+		assert.Equal(t, map[string]interface{}{}, cfg.Class())
+
+		cfg = NewClassBasedModuleConfigWithTargetVector(class, "my-module", "tenant", "missing")
+		assert.Equal(t, map[string]interface{}{}, cfg.Class())
+	})
 }
@@ -257,7 +257,7 @@ func (p *Provider) shouldIncludeClassArgument(class *models.Class, module string
 	moduleType modulecapabilities.ModuleType,
 ) bool {
 	if p.isVectorizerModule(moduleType) {
-		return class.Vectorizer == module
+		return class.Vectorizer == module || namedVectorsUseModule(class, module)
 	}
 	if moduleConfig, ok := class.ModuleConfig.(map[string]interface{}); ok {
 		existsConfigForModule := moduleConfig[module] != nil
@@ -270,6 +270,36 @@ func (p *Provider) shouldIncludeClassArgument(class *models.Class, module string
 	return p.isOnlyOneModuleEnabledOfAGivenType(moduleType)
 }
 
+// namedVectorsUseModule indicates whether any named vector of the class is
+// vectorized by the given module
+func namedVectorsUseModule(class *models.Class, module string) bool {
+	for _, vectorConfig := range class.VectorConfig {
+		if moduleName, _, err := schema.NamedVectorVectorizer(vectorConfig); err == nil && moduleName == module {
+			return true
+		}
+	}
+	return false
+}
+
+// targetVectorizer returns the vectorizer module of the given target vector,
+// an empty target vector refers to the class level vectorizer
+func targetVectorizer(class *models.Class, targetVector string) (string, error) {
+	if targetVector == "" {
+		return class.Vectorizer, nil
+	}
+
+	vectorConfig, ok := class.VectorConfig[targetVector]
+	if !ok {
+		return "", errors.Errorf("class %q does not have target vector %q",
+			class.Class, targetVector)
+	}
+	moduleName, _, err := schema.NamedVectorVectorizer(vectorConfig)
+	if err != nil {
+		return "", errors.Wrapf(err, "target vector %q", targetVector)
+	}
+	return moduleName, nil
+}
+
 func (p *Provider) shouldCrossClassIncludeClassArgument(class *models.Class, module string,
 	moduleType modulecapabilities.ModuleType,
 ) bool {
@@ -606,15 +636,25 @@ func (p *Provider) RestApiAdditionalProperties(includeProp string, class *models
 // Get { Class() } for example
 func (p *Provider) VectorFromSearchParam(ctx context.Context,
 	className string, param string, params interface{},
-	findVectorFn modulecapabilities.FindVectorFn, tenant string,
+	findVectorFn modulecapabilities.FindVectorFn, tenant, targetVector string,
 ) ([]float32, error) {
 	class, err := p.getClass(className)
 	if err != nil {
 		return nil, err
 	}
 
+	targetModule, err := targetVectorizer(class, targetVector)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, mod := range p.GetAll() {
 		if p.shouldIncludeClassArgument(class, mod.Name(), mod.Type()) {
+			if p.isVectorizerModule(mod.Type()) && mod.Name() != targetModule {
+				// the module vectorizes a different vector of the class
+				continue
+			}
+
 			var moduleName string
 			var vectorSearches modulecapabilities.ArgumentVectorForParams
 			if searcher, ok := mod.(modulecapabilities.Searcher); ok {
@@ -622,13 +662,13 @@ func (p *Provider) VectorFromSearchParam(ctx context.Context,
 				vectorSearches = searcher.VectorSearches()
 			} else if searchers, ok := mod.(modulecapabilities.DependencySearcher); ok {
 				if dependencySearchers := searchers.VectorSearches(); dependencySearchers != nil {
-					moduleName = class.Vectorizer
-					vectorSearches = dependencySearchers[class.Vectorizer]
+					moduleName = targetModule
+					vectorSearches = dependencySearchers[targetModule]
 				}
 			}
 			if vectorSearches != nil {
 				if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
-					cfg := NewClassBasedModuleConfig(class, moduleName, tenant)
+					cfg := NewClassBasedModuleConfigWithTargetVector(class, moduleName, tenant, targetVector)
 					vector, err := searchVectorFn(ctx, params, class.Class, findVectorFn, cfg)
 					if err != nil {
 						return nil, errors.Errorf("vectorize params: %v", err)
@@ -639,6 +679,11 @@ func (p *Provider) VectorFromSearchParam(ctx context.Context,
 		}
 	}
 
+	if schema.NamedVectorsEnabled(class) {
+		return nil, errors.Errorf("vectorizer %q of target vector %q does not support %q",
+			targetModule, targetVector, param)
+	}
+
 	panic("VectorFromParams was called without any known params present")
 }
 
@@ -668,18 +713,23 @@ func (p *Provider) CrossClassVectorFromSearchParam(ctx context.Context,
 }
 
 func (p *Provider) VectorFromInput(ctx context.Context,
-	className string, input string,
+	className, input, targetVector string,
 ) ([]float32, error) {
 	class, err := p.getClass(className)
 	if err != nil {
 		return nil, err
 	}
 
+	targetModule, err := targetVectorizer(class, targetVector)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, mod := range p.GetAll() {
-		if p.shouldIncludeClassArgument(class, mod.Name(), mod.Type()) {
+		if mod.Name() == targetModule && p.shouldIncludeClassArgument(class, mod.Name(), mod.Type()) {
 			if vectorizer, ok := mod.(modulecapabilities.InputVectorizer); ok {
 				// does not access any objects, therefore tenant is irrelevant
-				cfg := NewClassBasedModuleConfig(class, mod.Name(), "")
+				cfg := NewClassBasedModuleConfigWithTargetVector(class, mod.Name(), "", targetVector)
 				return vectorizer.VectorizeInput(ctx, input, cfg)
 			}
 		}
@@ -69,7 +69,7 @@ func TestModulesWithSearchers(t *testing.T) {
 		p.Init(context.Background(), nil, logger)
 
 		res, err := p.VectorFromSearchParam(context.Background(), "MyClass",
-			"nearGrape", nil, fakeFindVector, "")
+			"nearGrape", nil, fakeFindVector, "", "")
 
 		require.Nil(t, err)
 		assert.Equal(t, []float32{1, 2, 3, 4}, res)
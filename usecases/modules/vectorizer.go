@@ -29,8 +29,8 @@ const (
 	errorVectorizerCapability = "module %q exists, but does not provide the " +
 		"Vectorizer or ReferenceVectorizer capability"
 
-	errorVectorIndexType = "vector index config (%T) is not of type HNSW, " +
-		"but objects manager is restricted to HNSW"
+	errorVectorIndexType = "vector index config (%T) is not a recognized " +
+		"vector index config"
 
 	warningVectorIgnored = "This vector will be ignored. If you meant to index " +
 		"the vector, make sure to set vectorIndexConfig.skip to 'false'. If the previous " +
@@ -84,13 +84,23 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	objectDiff *moduletools.ObjectDiff, findObjectFn modulecapabilities.FindObjectFn,
 	logger logrus.FieldLogger,
 ) error {
-	hnswConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
+	if err := p.updateNamedVectors(ctx, object, class, objectDiff, findObjectFn); err != nil {
+		return err
+	}
+
+	vectorIndexConfig, ok := class.VectorIndexConfig.(schema.VectorIndexConfig)
 	if !ok {
 		return fmt.Errorf(errorVectorIndexType, class.VectorIndexConfig)
 	}
 
+	// only hnsw supports skipping the vector index
+	var skip bool
+	if hnswConfig, ok := vectorIndexConfig.(hnsw.UserConfig); ok {
+		skip = hnswConfig.Skip
+	}
+
 	if class.Vectorizer == config.VectorizerModuleNone {
-		if hnswConfig.Skip && len(object.Vector) > 0 {
+		if skip && len(object.Vector) > 0 {
 			logger.WithField("className", object.Class).
 				Warningf(warningSkipVectorProvided)
 		}
@@ -98,7 +108,7 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 		return nil
 	}
 
-	if hnswConfig.Skip {
+	if skip {
 		logger.WithField("className", object.Class).
 			WithField("vectorizer", class.Vectorizer).
 			Warningf(warningSkipVectorGenerated, class.Vectorizer)
@@ -140,6 +150,84 @@ func (p *Provider) UpdateVector(ctx context.Context, object *models.Object, clas
 	return nil
 }
 
+// updateNamedVectors vectorizes each named vector of the class which has a
+// vectorizer configured and was not provided explicitly
+func (p *Provider) updateNamedVectors(ctx context.Context, object *models.Object,
+	class *models.Class, objectDiff *moduletools.ObjectDiff,
+	findObjectFn modulecapabilities.FindObjectFn,
+) error {
+	for targetVector, vectorConfig := range class.VectorConfig {
+		if _, ok := object.Vectors[targetVector]; ok {
+			continue
+		}
+
+		moduleName, settings, err := schema.NamedVectorVectorizer(vectorConfig)
+		if err != nil {
+			return errors.Wrapf(err, "named vector %q", targetVector)
+		}
+		if moduleName == config.VectorizerModuleNone {
+			continue
+		}
+
+		found := p.GetByName(moduleName)
+		if found == nil {
+			return fmt.Errorf("no vectorizer %q found for named vector %q",
+				moduleName, targetVector)
+		}
+
+		// vectorizers always set the object's vector, so they are given a copy
+		// of the object which only contains the source properties of the
+		// named vector
+		source := &models.Object{
+			Class:      object.Class,
+			ID:         object.ID,
+			Tenant:     object.Tenant,
+			Properties: sourceProperties(object.Properties, schema.NamedVectorSourceProperties(settings)),
+		}
+		cfg := NewClassBasedModuleConfigWithTargetVector(class, moduleName, "", targetVector)
+
+		switch vectorizer := found.(type) {
+		case modulecapabilities.Vectorizer:
+			if err := vectorizer.VectorizeObject(ctx, source,
+				objectDiff.ForTargetVector(targetVector), cfg); err != nil {
+				return fmt.Errorf("update vector %q: %w", targetVector, err)
+			}
+		case modulecapabilities.ReferenceVectorizer:
+			if err := vectorizer.VectorizeObject(ctx, source, cfg, findObjectFn); err != nil {
+				return fmt.Errorf("update reference vector %q: %w", targetVector, err)
+			}
+		default:
+			return errors.Errorf(errorVectorizerCapability, moduleName)
+		}
+
+		if source.Vector != nil {
+			if object.Vectors == nil {
+				object.Vectors = models.Vectors{}
+			}
+			object.Vectors[targetVector] = source.Vector
+		}
+	}
+
+	return nil
+}
+
+// sourceProperties restricts the properties to the given names, no names
+// means all properties are used
+func sourceProperties(properties models.PropertySchema, names []string) models.PropertySchema {
+	asMap, ok := properties.(map[string]interface{})
+	if !ok || len(names) == 0 {
+		return properties
+	}
+
+	out := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		if value, ok := asMap[name]; ok {
+			out[name] = value
+		}
+	}
+	return out
+}
+
 func (p *Provider) VectorizerName(className string) (string, error) {
 	name, _, err := p.getClassVectorizer(className)
 	if err != nil {
@@ -206,6 +206,65 @@ func TestProvider_UpdateVector(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("with named vectors", func(t *testing.T) {
+		ctx := context.Background()
+		modName := "some-vzr"
+		className := "SomeClass"
+		mod := newDummyModule(modName, modulecapabilities.Text2Vec)
+		class := &models.Class{
+			Class:      className,
+			Vectorizer: "none",
+			VectorConfig: map[string]models.VectorConfig{
+				"title": {
+					Vectorizer: map[string]interface{}{
+						modName: map[string]interface{}{
+							"properties": []interface{}{"title"},
+						},
+					},
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+				"provided": {
+					Vectorizer: map[string]interface{}{
+						modName: map[string]interface{}{},
+					},
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+				"custom": {
+					Vectorizer: map[string]interface{}{
+						"none": map[string]interface{}{},
+					},
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+			},
+			VectorIndexConfig: hnsw.UserConfig{},
+		}
+		sch := schema.Schema{Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		}}
+		repo := &fakeObjectsRepo{}
+		logger, _ := test.NewNullLogger()
+
+		p := NewProvider()
+		p.Register(mod)
+		p.SetSchemaGetter(&fakeSchemaGetter{sch})
+
+		obj := &models.Object{
+			Class:      className,
+			ID:         newUUID(),
+			Properties: map[string]interface{}{"title": "foo", "body": "bar"},
+			Vectors:    models.Vectors{"provided": {4, 5, 6}},
+		}
+		err := p.UpdateVector(ctx, obj, class, nil, repo.Object, logger)
+		assert.Nil(t, err)
+		assert.Nil(t, obj.Vector)
+		assert.Equal(t, models.Vectors{
+			"title":    {1, 2, 3},
+			"provided": {4, 5, 6},
+		}, obj.Vectors)
+		assert.Equal(t, map[string]interface{}{"title": "foo", "body": "bar"},
+			obj.Properties)
+	})
+
 	t.Run("with nonexistent class", func(t *testing.T) {
 		ctx := context.Background()
 		class := &models.Class{
@@ -250,8 +309,8 @@ func TestProvider_UpdateVector(t *testing.T) {
 
 		obj := &models.Object{Class: className, ID: newUUID()}
 		err := p.UpdateVector(ctx, obj, class, nil, repo.Object, logger)
-		expectedErr := "vector index config (struct {}) is not of type HNSW, " +
-			"but objects manager is restricted to HNSW"
+		expectedErr := "vector index config (struct {}) is not a recognized " +
+			"vector index config"
 		assert.EqualError(t, err, expectedErr)
 	})
 }
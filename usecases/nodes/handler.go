@@ -25,6 +25,8 @@ type authorizer interface {
 
 type db interface {
 	GetNodeStatus(ctx context.Context, className string) ([]*models.NodeStatus, error)
+	GetTenantsStatus(ctx context.Context, className, tenant string,
+		offset, limit *int64) ([]*models.NodeTenantStatus, int64, error)
 }
 
 type Manager struct {
@@ -48,3 +50,14 @@ func (m *Manager) GetNodeStatus(ctx context.Context,
 	}
 	return m.db.GetNodeStatus(ctx, className)
 }
+
+// GetTenantsStatus returns the status and node placement of a page of the
+// tenants of a multi-tenant class, as well as the total number of tenants
+func (m *Manager) GetTenantsStatus(ctx context.Context, principal *models.Principal,
+	className, tenant string, offset, limit *int64,
+) ([]*models.NodeTenantStatus, int64, error) {
+	if err := m.authorizer.Authorize(principal, "list", "nodes"); err != nil {
+		return nil, 0, err
+	}
+	return m.db.GetTenantsStatus(ctx, className, tenant, offset, limit)
+}
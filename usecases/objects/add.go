@@ -33,12 +33,26 @@ type schemaManager interface {
 	) (*models.Class, error)
 	AddClassProperty(ctx context.Context, principal *models.Principal,
 		class string, property *models.Property) error
+	// MergeClassObjectProperty adds the nested properties of an object or
+	// object[] property which do not exist yet
+	MergeClassObjectProperty(ctx context.Context, principal *models.Principal,
+		class string, property *models.Property) error
+	AddTenants(ctx context.Context, principal *models.Principal,
+		class string, tenants []*models.Tenant) error
+	// TenantShard returns shard name and activity status of the tenant
+	TenantShard(class, tenant string) (string, string)
+	// ResolveAlias returns the class name an alias points to, or the name
+	// itself if it is not an alias
+	ResolveAlias(name string) string
 }
 
 // AddObject Class Instance to the connected DB.
 func (m *Manager) AddObject(ctx context.Context, principal *models.Principal, object *models.Object,
 	repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
+	if object != nil {
+		object.Class = m.resolveAlias(object.Class)
+	}
 	err := m.authorizer.Authorize(principal, "create", "objects")
 	if err != nil {
 		return nil, err
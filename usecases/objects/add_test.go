@@ -101,6 +101,24 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 		assert.Equal(t, uuidDuringCreation, res.ID, "check that connector add ID and user response match")
 	})
 
+	t.Run("with an alias as class", func(t *testing.T) {
+		reset()
+		manager.schemaManager.(*fakeSchemaManager).aliases = map[string]string{"Bar": "Foo"}
+
+		ctx := context.Background()
+		object := &models.Object{
+			Vector: []float32{0.1, 0.2, 0.3},
+			Class:  "Bar",
+		}
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+
+		res, err := manager.AddObject(ctx, nil, object, nil)
+		require.Nil(t, err)
+		assert.Equal(t, "Foo", res.Class)
+		assert.Equal(t, "Foo", vectorRepo.Mock.Calls[0].Arguments.Get(0).(*models.Object).Class)
+	})
+
 	t.Run("with an explicit (correct) ID set", func(t *testing.T) {
 		reset()
 
@@ -15,6 +15,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -113,16 +114,24 @@ func (m *autoSchemaManager) updateClass(ctx context.Context, principal *models.P
 	className string, properties []*models.Property, existingProperties []*models.Property,
 ) error {
 	propertiesToAdd := []*models.Property{}
+	propertiesToMerge := []*models.Property{}
 	for _, prop := range properties {
-		found := false
+		var found *models.Property
 		for _, classProp := range existingProperties {
 			if classProp.Name == schema.LowercaseFirstLetter(prop.Name) {
-				found = true
+				found = classProp
 				break
 			}
 		}
-		if !found {
+		if found == nil {
 			propertiesToAdd = append(propertiesToAdd, prop)
+			continue
+		}
+		if _, ok := schema.AsNested(found.DataType); !ok {
+			continue
+		}
+		if hasNewNestedProperties(found.NestedProperties, prop.NestedProperties) {
+			propertiesToMerge = append(propertiesToMerge, prop)
 		}
 	}
 	for _, newProp := range propertiesToAdd {
@@ -134,9 +143,43 @@ func (m *autoSchemaManager) updateClass(ctx context.Context, principal *models.P
 			return err
 		}
 	}
+	for _, mergeProp := range propertiesToMerge {
+		m.logger.
+			WithField("auto_schema", "updateClass").
+			Debugf("update class %s merge nested properties of property %s", className, mergeProp.Name)
+		err := m.schemaManager.MergeClassObjectProperty(ctx, principal, className, mergeProp)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// hasNewNestedProperties returns whether the inferred nested properties
+// contain any nested property which does not exist yet, recursively for
+// nested objects
+func hasNewNestedProperties(existing, inferred []*models.NestedProperty) bool {
+	for _, nestedProp := range inferred {
+		var found *models.NestedProperty
+		for _, existingProp := range existing {
+			if existingProp.Name == nestedProp.Name {
+				found = existingProp
+				break
+			}
+		}
+		if found == nil {
+			return true
+		}
+		if _, ok := schema.AsNested(found.DataType); !ok {
+			continue
+		}
+		if hasNewNestedProperties(found.NestedProperties, nestedProp.NestedProperties) {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *autoSchemaManager) getProperties(object *models.Object) []*models.Property {
 	properties := []*models.Property{}
 	if props, ok := object.Properties.(map[string]interface{}); ok {
@@ -148,6 +191,9 @@ func (m *autoSchemaManager) getProperties(object *models.Object) []*models.Prope
 				DataType:    m.getDataTypes(dt),
 				Description: "This property was generated by Weaviate's auto-schema feature on " + now.Format(time.ANSIC),
 			}
+			if _, ok := schema.AsNested(property.DataType); ok {
+				property.NestedProperties = m.getNestedProperties(nestedObjects([]interface{}{value}))
+			}
 			properties = append(properties, property)
 		}
 	}
@@ -186,13 +232,16 @@ func (m *autoSchemaManager) determineType(value interface{}) []schema.DataType {
 		if v["input"] != nil {
 			return []schema.DataType{schema.DataTypePhoneNumber}
 		}
-		return fallbackDataType
+		return []schema.DataType{schema.DataTypeObject}
 	case []interface{}:
 		if len(v) > 0 {
 			dataType := []schema.DataType{}
 			for i := range v {
 				switch arrayVal := v[i].(type) {
 				case map[string]interface{}:
+					if _, ok := arrayVal["beacon"]; !ok {
+						return []schema.DataType{schema.DataTypeObjectArray}
+					}
 					if len(arrayVal) > 0 {
 						for k, v := range arrayVal {
 							if k == "beacon" {
@@ -240,3 +289,78 @@ func (m *autoSchemaManager) determineType(value interface{}) []schema.DataType {
 		return fallbackDataType
 	}
 }
+
+// getNestedProperties infers the nested properties from all keys set in the
+// given objects, as the objects of an object[] may differ in their keys
+func (m *autoSchemaManager) getNestedProperties(objects []map[string]interface{}) []*models.NestedProperty {
+	var names []string
+	values := map[string][]interface{}{}
+	for _, object := range objects {
+		for name, value := range object {
+			if value == nil {
+				continue
+			}
+			if _, ok := values[name]; !ok {
+				names = append(names, name)
+			}
+			values[name] = append(values[name], value)
+		}
+	}
+	sort.Strings(names)
+
+	nestedProps := make([]*models.NestedProperty, len(names))
+	for i, name := range names {
+		dataType := m.determineNestedType(values[name][0])
+		nestedProps[i] = &models.NestedProperty{
+			Name:     name,
+			DataType: []string{string(dataType)},
+		}
+		if dataType == schema.DataTypeObject || dataType == schema.DataTypeObjectArray {
+			nestedProps[i].NestedProperties = m.getNestedProperties(nestedObjects(values[name]))
+		}
+	}
+	return nestedProps
+}
+
+// determineNestedType determines the data type of a nested property. Unlike
+// top level properties, nested properties are neither references, nor
+// geoCoordinates or phoneNumbers, and never use the deprecated string types.
+func (m *autoSchemaManager) determineNestedType(value interface{}) schema.DataType {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return schema.DataTypeObject
+	case []interface{}:
+		if len(v) > 0 {
+			if _, ok := v[0].(map[string]interface{}); ok {
+				return schema.DataTypeObjectArray
+			}
+		}
+	}
+
+	switch dataType := m.determineType(value)[0]; dataType {
+	case schema.DataTypeString:
+		return schema.DataTypeText
+	case schema.DataTypeStringArray:
+		return schema.DataTypeTextArray
+	default:
+		return dataType
+	}
+}
+
+// nestedObjects collects the objects of object and object[] values
+func nestedObjects(values []interface{}) []map[string]interface{} {
+	var objects []map[string]interface{}
+	for _, value := range values {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			objects = append(objects, v)
+		case []interface{}:
+			for _, elem := range v {
+				if object, ok := elem.(map[string]interface{}); ok {
+					objects = append(objects, object)
+				}
+			}
+		}
+	}
+	return objects
+}
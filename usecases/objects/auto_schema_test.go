@@ -475,6 +475,135 @@ func Test_autoSchemaManager_autoSchema_update(t *testing.T) {
 	assert.Equal(t, "int[]", getProperty((schemaAfter.Objects.Classes)[0].Properties, "numberArray").DataType[0])
 }
 
+func Test_autoSchemaManager_autoSchema_nested(t *testing.T) {
+	// given
+	schemaManager := &fakeSchemaManager{}
+	logger, _ := test.NewNullLogger()
+	autoSchemaManager := &autoSchemaManager{
+		schemaManager: schemaManager,
+		vectorRepo:    &fakeVectorRepo{},
+		config: config.AutoSchema{
+			Enabled:       true,
+			DefaultString: schema.DataTypeString.String(),
+			DefaultNumber: "int",
+			DefaultDate:   "date",
+		},
+		logger: logger,
+	}
+	obj := &models.Object{
+		Class: "Person",
+		Properties: map[string]interface{}{
+			"address": map[string]interface{}{
+				"city": "Amsterdam",
+				"geo": map[string]interface{}{
+					"since": "2002-10-02T15:00:00Z",
+				},
+			},
+			"pets": []interface{}{
+				map[string]interface{}{"name": "Bowser"},
+				map[string]interface{}{"age": json.Number("3"), "tags": []interface{}{"cat"}},
+			},
+		},
+	}
+
+	// when
+	err := autoSchemaManager.autoSchema(context.Background(), &models.Principal{}, obj)
+
+	// then
+	require.Nil(t, err)
+	properties := schemaManager.GetSchemaResponse.Objects.Classes[0].Properties
+	address := getProperty(properties, "address")
+	require.NotNil(t, address)
+	assert.Equal(t, []string{"object"}, address.DataType)
+	assert.Equal(t, []*models.NestedProperty{
+		{Name: "city", DataType: []string{"text"}},
+		{
+			Name:     "geo",
+			DataType: []string{"object"},
+			NestedProperties: []*models.NestedProperty{
+				{Name: "since", DataType: []string{"date"}},
+			},
+		},
+	}, address.NestedProperties)
+
+	pets := getProperty(properties, "pets")
+	require.NotNil(t, pets)
+	assert.Equal(t, []string{"object[]"}, pets.DataType)
+	assert.Equal(t, []*models.NestedProperty{
+		{Name: "age", DataType: []string{"int"}},
+		{Name: "name", DataType: []string{"text"}},
+		{Name: "tags", DataType: []string{"text[]"}},
+	}, pets.NestedProperties)
+}
+
+func Test_autoSchemaManager_autoSchema_mergeNested(t *testing.T) {
+	// given
+	schemaManager := &fakeSchemaManager{}
+	logger, _ := test.NewNullLogger()
+	autoSchemaManager := &autoSchemaManager{
+		schemaManager: schemaManager,
+		vectorRepo:    &fakeVectorRepo{},
+		config: config.AutoSchema{
+			Enabled:       true,
+			DefaultString: schema.DataTypeText.String(),
+			DefaultNumber: "int",
+			DefaultDate:   "date",
+		},
+		logger: logger,
+	}
+	objects := []*models.Object{
+		{
+			Class: "Person",
+			Properties: map[string]interface{}{
+				"address": map[string]interface{}{
+					"city": "Amsterdam",
+					"geo":  map[string]interface{}{"lat": json.Number("52")},
+				},
+			},
+		},
+		{
+			Class: "Person",
+			Properties: map[string]interface{}{
+				"address": map[string]interface{}{
+					"city": "Berlin",
+					"zip":  "10115",
+					"geo":  map[string]interface{}{"lon": json.Number("13")},
+				},
+			},
+		},
+		{
+			Class: "Person",
+			Properties: map[string]interface{}{
+				"address": map[string]interface{}{"zip": "1012"},
+			},
+		},
+	}
+
+	// when
+	for _, obj := range objects {
+		require.Nil(t, autoSchemaManager.autoSchema(context.Background(), &models.Principal{}, obj))
+	}
+
+	// then
+	properties := schemaManager.GetSchemaResponse.Objects.Classes[0].Properties
+	address := getProperty(properties, "address")
+	require.NotNil(t, address)
+	assert.Equal(t, []*models.NestedProperty{
+		{Name: "city", DataType: []string{"text"}},
+		{
+			Name:     "geo",
+			DataType: []string{"object"},
+			NestedProperties: []*models.NestedProperty{
+				{Name: "lat", DataType: []string{"int"}},
+				{Name: "lon", DataType: []string{"int"}},
+			},
+		},
+		{Name: "zip", DataType: []string{"text"}},
+	}, address.NestedProperties)
+	assert.Len(t, schemaManager.mergedProperties, 1,
+		"objects without new nested properties don't change the schema")
+}
+
 func getProperty(properties []*models.Property, name string) *models.Property {
 	for _, prop := range properties {
 		if prop.Name == name {
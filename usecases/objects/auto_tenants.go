@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// autoTenantManager creates missing tenants of classes with
+// autoTenantCreation enabled before their objects are imported
+type autoTenantManager struct {
+	// mutex deduplicates tenant creation across concurrent batches
+	mutex         sync.Mutex
+	schemaManager schemaManager
+	logger        logrus.FieldLogger
+}
+
+func newAutoTenantManager(schemaManager schemaManager, logger logrus.FieldLogger,
+) *autoTenantManager {
+	return &autoTenantManager{
+		schemaManager: schemaManager,
+		logger:        logger,
+	}
+}
+
+// addMissingTenants creates all tenants referenced by the given objects which
+// do not exist yet. It returns the errors which occurred per class and tenant,
+// so that only the objects of the tenants which could not be created fail.
+//
+// Like auto-schema, the tenants are created on behalf of the principal of the
+// batch, so besides creating batch objects it needs the permission to update
+// the tenants of the schema. Without it the objects fail as forbidden.
+func (m *autoTenantManager) addMissingTenants(ctx context.Context, principal *models.Principal,
+	objects []*models.Object,
+) map[string]map[string]error {
+	tenants := map[string]map[string]struct{}{}
+	for _, obj := range objects {
+		if obj == nil || obj.Tenant == "" || obj.Class == "" {
+			continue
+		}
+		className := schema.UppercaseClassName(obj.Class)
+		if tenants[className] == nil {
+			tenants[className] = map[string]struct{}{}
+		}
+		tenants[className][obj.Tenant] = struct{}{}
+	}
+	if len(tenants) == 0 {
+		return nil
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	errs := map[string]map[string]error{}
+	for className, names := range tenants {
+		class, err := m.schemaManager.GetClass(ctx, principal, className)
+		if err != nil || class == nil || !autoTenantCreationEnabled(class) {
+			// missing classes and tenants are reported during validation and import
+			continue
+		}
+		missing := m.missingTenants(className, names)
+		if len(missing) == 0 {
+			continue
+		}
+		m.logger.
+			WithField("auto_tenant_creation", "addTenants").
+			Debugf("create %d tenants for class %s", len(missing), className)
+		if err := m.schemaManager.AddTenants(ctx, principal, className, missing); err != nil {
+			if classErrs := m.addTenantsOneByOne(ctx, principal, className, names); len(classErrs) > 0 {
+				errs[className] = classErrs
+			}
+		}
+	}
+	return errs
+}
+
+// addTenantsOneByOne creates the tenants which are still missing after
+// creating them all at once failed. They might have been created concurrently
+// by another node in the meantime. Creating the others one by one makes sure
+// that one tenant which cannot be created does not fail the others.
+func (m *autoTenantManager) addTenantsOneByOne(ctx context.Context, principal *models.Principal,
+	className string, names map[string]struct{},
+) map[string]error {
+	errs := map[string]error{}
+	for _, tenant := range m.missingTenants(className, names) {
+		if err := m.schemaManager.AddTenants(ctx, principal, className,
+			[]*models.Tenant{tenant}); err != nil {
+			if shard, _ := m.schemaManager.TenantShard(className, tenant.Name); shard != "" {
+				continue
+			}
+			errs[tenant.Name] = fmt.Errorf("auto create tenant %q of class %s: %w",
+				tenant.Name, className, err)
+		}
+	}
+	return errs
+}
+
+func (m *autoTenantManager) missingTenants(className string, names map[string]struct{},
+) []*models.Tenant {
+	var missing []*models.Tenant
+	for name := range names {
+		if shard, _ := m.schemaManager.TenantShard(className, name); shard == "" {
+			missing = append(missing, &models.Tenant{Name: name})
+		}
+	}
+	sort.Slice(missing, func(i, j int) bool { return missing[i].Name < missing[j].Name })
+	return missing
+}
+
+func autoTenantCreationEnabled(class *models.Class) bool {
+	return schema.MultiTenancyEnabled(class) && class.MultiTenancyConfig.AutoTenantCreation
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
 	"golang.org/x/sync/errgroup"
 )
@@ -58,6 +59,7 @@ func (b *BatchManager) addObjects(ctx context.Context, principal *models.Princip
 	}
 
 	batchObjects := b.validateObjectsConcurrently(ctx, principal, classes, fields, repl)
+	b.addMissingTenants(ctx, principal, classes, batchObjects)
 	b.metrics.BatchOp("total_preprocessing", beforePreProcessing.UnixNano())
 
 	var (
@@ -74,6 +76,30 @@ func (b *BatchManager) addObjects(ctx context.Context, principal *models.Princip
 	return res, nil
 }
 
+// addMissingTenants creates the missing tenants of all valid objects and marks
+// the objects whose tenants could not be created as failed
+func (b *BatchManager) addMissingTenants(ctx context.Context, principal *models.Principal,
+	classes []*models.Object, batchObjects BatchObjects,
+) {
+	valid := make([]*models.Object, 0, len(batchObjects))
+	for _, obj := range batchObjects {
+		if obj.Err == nil {
+			valid = append(valid, classes[obj.OriginalIndex])
+		}
+	}
+	tenantErrs := b.autoTenantManager.addMissingTenants(ctx, principal, valid)
+	if len(tenantErrs) == 0 {
+		return
+	}
+	for i, obj := range batchObjects {
+		object := classes[obj.OriginalIndex]
+		className := schema.UppercaseClassName(object.Class)
+		if err := tenantErrs[className][object.Tenant]; err != nil && obj.Err == nil {
+			batchObjects[i].Err = err
+		}
+	}
+}
+
 func (b *BatchManager) validateObjectForm(classes []*models.Object) error {
 	if len(classes) == 0 {
 		return fmt.Errorf("cannot be empty, need at least one object for batching")
@@ -120,6 +146,8 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 
 	ec := &errorcompounder.ErrorCompounder{}
 
+	concept.Class = b.resolveAlias(concept.Class)
+
 	// Auto Schema
 	err := b.autoSchemaManager.autoSchema(ctx, principal, concept)
 	ec.Add(err)
@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -470,3 +471,120 @@ func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
 	require.NotNil(t, addedObjects[0].Object.Properties)
 	require.NotNil(t, addedObjects[1].Object.Properties)
 }
+
+func Test_BatchManager_AddObjects_AutoTenantCreation(t *testing.T) {
+	var (
+		vectorRepo      *fakeVectorRepo
+		modulesProvider *fakeModulesProvider
+		schemaManager   *fakeSchemaManager
+		manager         *BatchManager
+	)
+	schema := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             "AutoTenants",
+					VectorIndexConfig: hnsw.UserConfig{},
+					MultiTenancyConfig: &models.MultiTenancyConfig{
+						Enabled:            true,
+						AutoTenantCreation: true,
+					},
+				},
+				{
+					Class:              "ManualTenants",
+					VectorIndexConfig:  hnsw.UserConfig{},
+					MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+				},
+			},
+		},
+	}
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		schemaManager = &fakeSchemaManager{
+			GetSchemaResponse: schema,
+			tenants: map[string]map[string]struct{}{
+				"AutoTenants": {"existing": {}},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		modulesProvider = getFakeModulesProvider()
+		manager = NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			schemaManager, &config.WeaviateConfig{}, logger, &fakeAuthorizer{}, nil)
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+	}
+	objects := func() []*models.Object {
+		return []*models.Object{
+			{Class: "AutoTenants", Tenant: "existing"},
+			{Class: "AutoTenants", Tenant: "new"},
+			{Class: "AutoTenants", Tenant: "new"},
+			{Class: "ManualTenants", Tenant: "unknown"},
+		}
+	}
+	ctx := context.Background()
+
+	t.Run("missing tenants are created once", func(t *testing.T) {
+		reset()
+		res, err := manager.AddObjects(ctx, nil, objects(), []*string{}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 4)
+		for _, obj := range res {
+			assert.Nil(t, obj.Err)
+		}
+		assert.Equal(t, [][]*models.Tenant{{{Name: "new"}}}, schemaManager.addedTenants)
+		_, ok := schemaManager.tenants["ManualTenants"]
+		assert.False(t, ok, "tenants of classes without auto creation are not created")
+	})
+
+	t.Run("existing tenants are not created again", func(t *testing.T) {
+		reset()
+		schemaManager.tenants["AutoTenants"]["new"] = struct{}{}
+		_, err := manager.AddObjects(ctx, nil, objects(), []*string{}, nil)
+		require.Nil(t, err)
+		assert.Empty(t, schemaManager.addedTenants)
+	})
+
+	t.Run("failed tenant creation fails objects of the tenant", func(t *testing.T) {
+		reset()
+		schemaManager.addTenantsErr = fmt.Errorf("cluster unavailable")
+		res, err := manager.AddObjects(ctx, nil, objects(), []*string{}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 4)
+		assert.Nil(t, res[0].Err, "objects of existing tenants do not fail")
+		for _, obj := range res[1:3] {
+			assert.ErrorContains(t, obj.Err, "cluster unavailable")
+		}
+		assert.Nil(t, res[3].Err)
+	})
+
+	t.Run("a tenant which cannot be created does not fail other tenants", func(t *testing.T) {
+		reset()
+		schemaManager.addTenantErrs = map[string]error{"invalid": fmt.Errorf("invalid tenant name")}
+		res, err := manager.AddObjects(ctx, nil, append(objects(),
+			&models.Object{Class: "AutoTenants", Tenant: "invalid"}), []*string{}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 5)
+		for _, obj := range res[:4] {
+			assert.Nil(t, obj.Err)
+		}
+		assert.ErrorContains(t, res[4].Err, "invalid tenant name")
+		assert.Equal(t, [][]*models.Tenant{{{Name: "new"}}}, schemaManager.addedTenants)
+	})
+
+	t.Run("tenants are created with the permissions of the batch principal", func(t *testing.T) {
+		reset()
+		principal := &models.Principal{Username: "importer"}
+		schemaManager.addTenantsErr = autherrs.NewForbidden(principal, "update", "schema/tenants")
+		res, err := manager.AddObjects(ctx, principal, objects(), []*string{}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 4)
+		assert.Same(t, principal, schemaManager.addTenantsPrincipal)
+		assert.Nil(t, res[0].Err)
+		for _, obj := range res[1:3] {
+			var forbidden autherrs.Forbidden
+			assert.ErrorAs(t, obj.Err, &forbidden)
+		}
+		assert.Nil(t, res[3].Err)
+	})
+}
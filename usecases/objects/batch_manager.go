@@ -31,6 +31,7 @@ type BatchManager struct {
 	vectorRepo        BatchVectorRepo
 	modulesProvider   ModulesProvider
 	autoSchemaManager *autoSchemaManager
+	autoTenantManager *autoTenantManager
 	metrics           *Metrics
 }
 
@@ -63,6 +64,16 @@ func NewBatchManager(vectorRepo BatchVectorRepo, modulesProvider ModulesProvider
 		modulesProvider:   modulesProvider,
 		authorizer:        authorizer,
 		autoSchemaManager: newAutoSchemaManager(schemaManager, vectorRepo, config, logger),
+		autoTenantManager: newAutoTenantManager(schemaManager, logger),
 		metrics:           NewMetrics(prom),
 	}
 }
+
+// resolveAlias returns the name of the class the given alias points to, or
+// the name itself if it is not an alias
+func (b *BatchManager) resolveAlias(class string) string {
+	if class == "" {
+		return class
+	}
+	return b.schemaManager.ResolveAlias(class)
+}
@@ -148,6 +148,13 @@ func (b *BatchManager) validateReference(ctx context.Context, principal *models.
 			target.PeerName))
 	}
 
+	if source != nil {
+		source.Class = schema.ClassName(b.resolveAlias(source.Class.String()))
+	}
+	if target != nil {
+		target.Class = b.resolveAlias(target.Class)
+	}
+
 	if len(validateErrors) == 0 {
 		err = nil
 	} else {
@@ -45,6 +45,20 @@ type fakeSchemaManager struct {
 	}
 	GetSchemaResponse schema.Schema
 	GetschemaErr      error
+
+	// tenants of each class, all tenants exist if nil
+	tenants       map[string]map[string]struct{}
+	addTenantsErr error
+	// errors of single tenants, which fail every request containing them
+	addTenantErrs       map[string]error
+	addTenantsPrincipal *models.Principal
+	addedTenants        [][]*models.Tenant
+
+	// aliases mapped to the classes they point to
+	aliases map[string]string
+
+	// properties passed to MergeClassObjectProperty
+	mergedProperties []*models.Property
 }
 
 func (f *fakeSchemaManager) UpdatePropertyAddDataType(ctx context.Context, principal *models.Principal,
@@ -67,9 +81,24 @@ func (f *fakeSchemaManager) GetSchema(principal *models.Principal) (schema.Schem
 }
 
 func (f *fakeSchemaManager) ShardOwner(class, shard string) (string, error) { return "", nil }
-func (f *fakeSchemaManager) TenantShard(class, tenant string) string        { return tenant }
+func (f *fakeSchemaManager) TenantShard(class, tenant string) (string, string) {
+	if f.tenants == nil {
+		return tenant, models.TenantActivityStatusHOT
+	}
+	if _, ok := f.tenants[class][tenant]; ok {
+		return tenant, models.TenantActivityStatusHOT
+	}
+	return "", ""
+}
 func (f *fakeSchemaManager) ShardFromUUID(class string, uuid []byte) string { return "" }
 
+func (f *fakeSchemaManager) ResolveAlias(name string) string {
+	if class, ok := f.aliases[name]; ok {
+		return class
+	}
+	return name
+}
+
 func (f *fakeSchemaManager) GetClass(ctx context.Context, principal *models.Principal,
 	name string,
 ) (*models.Class, error) {
@@ -119,6 +148,64 @@ func (f *fakeSchemaManager) AddClassProperty(ctx context.Context, principal *mod
 	return nil
 }
 
+func (f *fakeSchemaManager) MergeClassObjectProperty(ctx context.Context, principal *models.Principal,
+	class string, property *models.Property,
+) error {
+	f.mergedProperties = append(f.mergedProperties, property)
+	for _, c := range f.GetSchemaResponse.Objects.Classes {
+		if c.Class != class {
+			continue
+		}
+		for _, prop := range c.Properties {
+			if prop.Name == property.Name {
+				prop.NestedProperties = fakeMergeNestedProperties(prop.NestedProperties,
+					property.NestedProperties)
+			}
+		}
+	}
+	return nil
+}
+
+func fakeMergeNestedProperties(existing, update []*models.NestedProperty) []*models.NestedProperty {
+	for _, nestedProp := range update {
+		found := false
+		for _, existingProp := range existing {
+			if existingProp.Name == nestedProp.Name {
+				existingProp.NestedProperties = fakeMergeNestedProperties(
+					existingProp.NestedProperties, nestedProp.NestedProperties)
+				found = true
+				break
+			}
+		}
+		if !found {
+			existing = append(existing, nestedProp)
+		}
+	}
+	return existing
+}
+
+func (f *fakeSchemaManager) AddTenants(ctx context.Context, principal *models.Principal,
+	class string, tenants []*models.Tenant,
+) error {
+	f.addTenantsPrincipal = principal
+	if f.addTenantsErr != nil {
+		return f.addTenantsErr
+	}
+	for _, tenant := range tenants {
+		if err := f.addTenantErrs[tenant.Name]; err != nil {
+			return err
+		}
+	}
+	f.addedTenants = append(f.addedTenants, tenants)
+	if f.tenants[class] == nil {
+		f.tenants[class] = map[string]struct{}{}
+	}
+	for _, tenant := range tenants {
+		f.tenants[class][tenant.Name] = struct{}{}
+	}
+	return nil
+}
+
 type fakeLocks struct {
 	Err error
 }
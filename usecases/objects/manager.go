@@ -143,6 +143,15 @@ func NewManager(locks locks, schemaManager schemaManager,
 	}
 }
 
+// resolveAlias returns the name of the class the given alias points to, or
+// the name itself if it is not an alias
+func (m *Manager) resolveAlias(class string) string {
+	if class == "" {
+		return class
+	}
+	return m.schemaManager.ResolveAlias(class)
+}
+
 func generateUUID() (strfmt.UUID, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
@@ -31,6 +31,7 @@ type MergeDocument struct {
 	PrimitiveSchema      map[string]interface{}      `json:"primitiveSchema"`
 	References           BatchReferences             `json:"references"`
 	Vector               []float32                   `json:"vector"`
+	Vectors              map[string][]float32        `json:"vectors"`
 	UpdateTime           int64                       `json:"updateTime"`
 	AdditionalProperties models.AdditionalProperties `json:"additionalProperties"`
 	PropertiesToDelete   []string                    `json:"propertiesToDelete"`
@@ -39,6 +40,9 @@ type MergeDocument struct {
 func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	updates *models.Object, repl *additional.ReplicationProperties,
 ) *Error {
+	if updates != nil {
+		updates.Class = m.resolveAlias(updates.Class)
+	}
 	if err := m.validateInputs(updates); err != nil {
 		return &Error{"bad request", StatusBadRequest, err}
 	}
@@ -93,7 +97,7 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 	cls, id := updates.Class, updates.ID
 	primitive, refs := m.splitPrimitiveAndRefs(updates.Properties.(map[string]interface{}), cls, id)
 	objWithVec, err := m.mergeObjectSchemaAndVectorize(ctx, cls, obj.Schema,
-		primitive, principal, obj.Vector, updates.Vector)
+		primitive, principal, obj.Vector, updates.Vector, obj.Vectors, updates.Vectors)
 	if err != nil {
 		return &Error{"merge and vectorize", StatusInternalServerError, err}
 	}
@@ -103,6 +107,7 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 		PrimitiveSchema:    primitive,
 		References:         refs,
 		Vector:             objWithVec.Vector,
+		Vectors:            namedVectors(objWithVec.Vectors),
 		UpdateTime:         m.timeSource.Now(),
 		PropertiesToDelete: propertiesToDelete,
 	}
@@ -134,6 +139,7 @@ func (m *Manager) validateInputs(updates *models.Object) error {
 func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className string,
 	old interface{}, new map[string]interface{},
 	principal *models.Principal, oldVec, newVec []float32,
+	oldVectors, newVectors models.Vectors,
 ) (*models.Object, error) {
 	var merged map[string]interface{}
 	var vector []float32
@@ -149,6 +155,9 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 		}
 
 		objDiff = moduletools.NewObjectDiff(oldVec)
+		for targetVector, vec := range oldVectors {
+			objDiff.WithTargetVector(targetVector, vec)
+		}
 		for key, value := range new {
 			objDiff.WithProp(key, oldMap[key], value)
 			oldMap[key] = value
@@ -170,7 +179,7 @@ func (m *Manager) mergeObjectSchemaAndVectorize(ctx context.Context, className s
 
 	// Note: vector could be a nil vector in case a vectorizer is configured,
 	// then the vectorizer will set it
-	obj := &models.Object{Class: className, Properties: merged, Vector: vector}
+	obj := &models.Object{Class: className, Properties: merged, Vector: vector, Vectors: newVectors}
 	class, err := m.schemaManager.GetClass(ctx, principal, className)
 	if err != nil {
 		return nil, err
@@ -215,3 +224,16 @@ func (m *Manager) splitPrimitiveAndRefs(in map[string]interface{}, sourceClass s
 
 	return primitive, outRefs
 }
+
+// namedVectors converts the named vectors of an object into their storage
+// representation
+func namedVectors(in models.Vectors) map[string][]float32 {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string][]float32, len(in))
+	for name, vec := range in {
+		out[name] = vec
+	}
+	return out
+}
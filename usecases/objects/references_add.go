@@ -31,6 +31,7 @@ import (
 func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Principal,
 	input *AddReferenceInput, repl *additional.ReplicationProperties, tenant string,
 ) *Error {
+	input.Class = m.resolveAlias(input.Class)
 	m.metrics.AddReferenceInc()
 	defer m.metrics.AddReferenceDec()
 
@@ -37,6 +37,7 @@ type DeleteReferenceInput struct {
 func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.Principal,
 	input *DeleteReferenceInput, repl *additional.ReplicationProperties, tenant string,
 ) *Error {
+	input.Class = m.resolveAlias(input.Class)
 	m.metrics.DeleteReferenceInc()
 	defer m.metrics.DeleteReferenceDec()
 
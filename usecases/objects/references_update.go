@@ -42,6 +42,7 @@ type PutReferenceInput struct {
 func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.Principal,
 	input *PutReferenceInput, repl *additional.ReplicationProperties, tenant string,
 ) *Error {
+	input.Class = m.resolveAlias(input.Class)
 	m.metrics.UpdateReferenceInc()
 	defer m.metrics.UpdateReferenceDec()
 
@@ -155,6 +156,9 @@ func validateReferenceSchema(class, property string, sch schema.Schema) error {
 	if dt.IsPrimitive() {
 		return fmt.Errorf("property '%s' is a primitive datatype, not a reference-type", property)
 	}
+	if dt.IsNested() {
+		return fmt.Errorf("property '%s' is an object datatype, not a reference-type", property)
+	}
 
 	return nil
 }
@@ -23,6 +23,9 @@ import (
 func (m *Manager) ValidateObject(ctx context.Context, principal *models.Principal,
 	obj *models.Object, repl *additional.ReplicationProperties,
 ) error {
+	if obj != nil {
+		obj.Class = m.resolveAlias(obj.Class)
+	}
 	err := m.authorizer.Authorize(principal, "validate", "objects")
 	if err != nil {
 		return err
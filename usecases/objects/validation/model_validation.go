@@ -18,6 +18,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/usecases/config"
 )
@@ -84,9 +85,24 @@ func (v *Validator) Object(ctx context.Context, class *models.Class,
 		return err
 	}
 
+	if err := validateNamedVectors(class, incoming); err != nil {
+		return err
+	}
+
 	return v.properties(ctx, class, incoming, existing)
 }
 
+// validateNamedVectors makes sure that every named vector of the object is
+// declared in the class' vector config
+func validateNamedVectors(class *models.Class, incoming *models.Object) error {
+	for name := range incoming.Vectors {
+		if !schema.HasNamedVector(class, name) {
+			return fmt.Errorf("class '%s' does not have named vector '%s'", class.Class, name)
+		}
+	}
+	return nil
+}
+
 func validateClass(class string) error {
 	// If the given class is empty, return an error
 	if class == "" {
@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestValidator_ObjectNamedVectors(t *testing.T) {
+	class := &models.Class{
+		Class: "Product",
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {
+				Vectorizer:      map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType: "hnsw",
+			},
+		},
+	}
+	validator := New(nil, &config.WeaviateConfig{}, nil)
+
+	t.Run("with a declared named vector", func(t *testing.T) {
+		obj := &models.Object{
+			Class:   "Product",
+			Vectors: models.Vectors{"title": []float32{1, 2, 3}},
+		}
+		assert.Nil(t, validator.Object(context.Background(), class, obj, nil))
+	})
+
+	t.Run("with an undeclared named vector", func(t *testing.T) {
+		obj := &models.Object{
+			Class:   "Product",
+			Vectors: models.Vectors{"image": []float32{1, 2, 3}},
+		}
+		err := validator.Object(context.Background(), class, obj, nil)
+		assert.EqualError(t, err, "class 'Product' does not have named vector 'image'")
+	})
+}
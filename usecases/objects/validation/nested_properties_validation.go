@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// extractAndValidateNestedProperty validates the value of a property of data
+// type object or object[] against the nested properties of the property
+func (v *Validator) extractAndValidateNestedProperty(ctx context.Context, path string,
+	pv interface{}, className string, dataType schema.DataType,
+	nestedProps []*models.NestedProperty,
+) (interface{}, error) {
+	if dataType == schema.DataTypeObject {
+		return v.nestedObject(ctx, path, pv, className, nestedProps)
+	}
+
+	values, ok := pv.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid object array property '%s' on class '%s': not an array, but %T",
+			path, className, pv)
+	}
+	objects := make([]interface{}, len(values))
+	for i, value := range values {
+		object, err := v.nestedObject(ctx, fmt.Sprintf("%s[%d]", path, i), value, className, nestedProps)
+		if err != nil {
+			return nil, err
+		}
+		objects[i] = object
+	}
+	return objects, nil
+}
+
+func (v *Validator) nestedObject(ctx context.Context, path string, pv interface{},
+	className string, nestedProps []*models.NestedProperty,
+) (map[string]interface{}, error) {
+	values, ok := pv.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid object property '%s' on class '%s': not an object, but %T",
+			path, className, pv)
+	}
+
+	object := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		if value == nil {
+			continue // nil values are removed and filtered out
+		}
+
+		nestedProp := nestedPropertyByName(nestedProps, name)
+		if nestedProp == nil {
+			return nil, fmt.Errorf("invalid object property '%s' on class '%s': no such nested property '%s'",
+				path, className, name)
+		}
+
+		nestedPath := path + schema.NestedPropertySeparator + name
+		dataType := schema.DataType(nestedProp.DataType[0])
+		var (
+			data interface{}
+			err  error
+		)
+		if _, ok := schema.AsNested(nestedProp.DataType); ok {
+			data, err = v.extractAndValidateNestedProperty(ctx, nestedPath, value, className,
+				dataType, nestedProp.NestedProperties)
+		} else {
+			data, err = v.extractAndValidateProperty(ctx, nestedPath, value, className, &dataType)
+		}
+		if err != nil {
+			return nil, err
+		}
+		object[name] = data
+	}
+	return object, nil
+}
+
+func nestedPropertyByName(nestedProps []*models.NestedProperty, name string) *models.NestedProperty {
+	for _, nestedProp := range nestedProps {
+		if nestedProp.Name == name {
+			return nestedProp
+		}
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestPropertyOfTypeObjectValidation(t *testing.T) {
+	class := &models.Class{
+		Class: "Person",
+		Properties: []*models.Property{
+			{
+				Name:     "address",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: schema.DataTypeText.PropString()},
+					{Name: "zip", DataType: schema.DataTypeInt.PropString()},
+					{
+						Name:     "geo",
+						DataType: schema.DataTypeObject.PropString(),
+						NestedProperties: []*models.NestedProperty{
+							{Name: "since", DataType: schema.DataTypeDate.PropString()},
+						},
+					},
+				},
+			},
+			{
+				Name:     "pets",
+				DataType: schema.DataTypeObjectArray.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+					{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+					{Name: "id", DataType: schema.DataTypeUUID.PropString()},
+				},
+			},
+		},
+	}
+
+	type test struct {
+		name          string
+		props         map[string]interface{}
+		expectedErr   string
+		expectedProps map[string]interface{}
+	}
+
+	tests := []test{
+		{
+			name: "valid object and object array",
+			props: map[string]interface{}{
+				"address": map[string]interface{}{
+					"city": "Amsterdam",
+					"zip":  json.Number("1012"),
+					"geo": map[string]interface{}{
+						"since": "2017-07-21T17:32:28Z",
+					},
+				},
+				"pets": []interface{}{
+					map[string]interface{}{
+						"name": "Bowser",
+						"tags": []interface{}{"dog", "brown"},
+						"id":   "6ba0ed9f-d8d4-4f4b-8b8e-5bba0b6d0e0a",
+					},
+					map[string]interface{}{
+						"name": "Mittens",
+						"tags": nil,
+					},
+				},
+			},
+			expectedProps: map[string]interface{}{
+				"address": map[string]interface{}{
+					"city": "Amsterdam",
+					"zip":  int64(1012),
+					"geo": map[string]interface{}{
+						"since": time.Date(2017, 7, 21, 17, 32, 28, 0, time.UTC),
+					},
+				},
+				"pets": []interface{}{
+					map[string]interface{}{
+						"name": "Bowser",
+						"tags": []interface{}{"dog", "brown"},
+						"id":   uuid.MustParse("6ba0ed9f-d8d4-4f4b-8b8e-5bba0b6d0e0a"),
+					},
+					map[string]interface{}{
+						"name": "Mittens",
+					},
+				},
+			},
+		},
+		{
+			name: "object is not a map",
+			props: map[string]interface{}{
+				"address": "Amsterdam",
+			},
+			expectedErr: "invalid object property 'address' on class 'Person': not an object, but string",
+		},
+		{
+			name: "object array is not an array",
+			props: map[string]interface{}{
+				"pets": map[string]interface{}{"name": "Bowser"},
+			},
+			expectedErr: "invalid object array property 'pets' on class 'Person': " +
+				"not an array, but map[string]interface {}",
+		},
+		{
+			name: "unknown nested property",
+			props: map[string]interface{}{
+				"address": map[string]interface{}{"street": "Prinsengracht"},
+			},
+			expectedErr: "invalid object property 'address' on class 'Person': no such nested property 'street'",
+		},
+		{
+			name: "invalid value of nested property",
+			props: map[string]interface{}{
+				"address": map[string]interface{}{
+					"geo": map[string]interface{}{"since": "yesterday"},
+				},
+			},
+			expectedErr: "invalid date property 'address.geo.since' on class 'Person'",
+		},
+		{
+			name: "invalid value within object array",
+			props: map[string]interface{}{
+				"pets": []interface{}{
+					map[string]interface{}{"name": "Bowser"},
+					map[string]interface{}{"name": 7},
+				},
+			},
+			expectedErr: "invalid text property 'pets[1].name' on class 'Person'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			validator := New(fakeExists, &config.WeaviateConfig{}, nil)
+
+			obj := &models.Object{
+				Class:      "Person",
+				Properties: test.props,
+			}
+			err := validator.properties(context.Background(), class, obj, nil)
+			if test.expectedErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expectedProps, obj.Properties)
+		})
+	}
+}
@@ -82,9 +82,22 @@ func (v *Validator) properties(ctx context.Context, class *models.Class,
 			return err
 		}
 
-		data, err := v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
-		if err != nil {
-			return err
+		var data interface{}
+		if *dataType == schema.DataTypeObject || *dataType == schema.DataTypeObjectArray {
+			prop, err := schema.GetPropertyByName(class, propertyKeyLowerCase)
+			if err != nil {
+				return err
+			}
+			data, err = v.extractAndValidateNestedProperty(ctx, propertyKeyLowerCase, propertyValue,
+				className, *dataType, prop.NestedProperties)
+			if err != nil {
+				return err
+			}
+		} else {
+			data, err = v.extractAndValidateProperty(ctx, propertyKeyLowerCase, propertyValue, className, dataType)
+			if err != nil {
+				return err
+			}
 		}
 
 		returnSchema[propertyKeyLowerCase] = data
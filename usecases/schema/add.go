@@ -31,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -138,6 +139,9 @@ func (m *Manager) addClass(ctx context.Context, class *models.Class,
 	class.Properties = schema.LowercaseAllPropertyNames(class.Properties)
 	if class.ShardingConfig != nil && schema.MultiTenancyEnabled(class) {
 		return nil, fmt.Errorf("cannot have both shardingConfig and multiTenancyConfig")
+	} else if class.MultiTenancyConfig != nil && class.MultiTenancyConfig.AutoTenantCreation &&
+		!class.MultiTenancyConfig.Enabled {
+		return nil, fmt.Errorf("autoTenantCreation requires multiTenancyConfig to be enabled")
 	} else if class.MultiTenancyConfig == nil {
 		class.MultiTenancyConfig = &models.MultiTenancyConfig{}
 	} else if class.MultiTenancyConfig.Enabled {
@@ -232,12 +236,18 @@ func (m *Manager) addClassApplyChanges(ctx context.Context, class *models.Class,
 }
 
 func (m *Manager) setClassDefaults(class *models.Class) {
+	if class.Vectorizer == "" && schema.NamedVectorsEnabled(class) {
+		// named vectors configure their own vectorizers, so the default
+		// vectorizer must not be applied to the class level vector
+		class.Vectorizer = config.VectorizerModuleNone
+	}
+
 	if class.Vectorizer == "" {
 		class.Vectorizer = m.config.DefaultVectorizerModule
 	}
 
 	if class.VectorIndexType == "" {
-		class.VectorIndexType = "hnsw"
+		class.VectorIndexType = vectorindex.DefaultVectorIndexType
 	}
 
 	if m.config.DefaultVectorDistanceMetric != "" {
@@ -248,6 +258,8 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 		}
 	}
 
+	m.setNamedVectorDefaults(class)
+
 	setInvertedConfigDefaults(class)
 	for _, prop := range class.Properties {
 		setPropertyDefaults(prop)
@@ -256,9 +268,54 @@ func (m *Manager) setClassDefaults(class *models.Class) {
 	m.moduleConfig.SetClassDefaults(class)
 }
 
+func (m *Manager) setNamedVectorDefaults(class *models.Class) {
+	for name, vectorConfig := range class.VectorConfig {
+		if vectorConfig.Vectorizer == nil {
+			vectorConfig.Vectorizer = map[string]interface{}{
+				config.VectorizerModuleNone: map[string]interface{}{},
+			}
+		}
+
+		if vectorConfig.VectorIndexType == "" {
+			vectorConfig.VectorIndexType = vectorindex.DefaultVectorIndexType
+		}
+
+		if m.config.DefaultVectorDistanceMetric != "" {
+			if vectorConfig.VectorIndexConfig == nil {
+				vectorConfig.VectorIndexConfig = map[string]interface{}{"distance": m.config.DefaultVectorDistanceMetric}
+			} else if asMap, ok := vectorConfig.VectorIndexConfig.(map[string]interface{}); ok && asMap["distance"] == nil {
+				asMap["distance"] = m.config.DefaultVectorDistanceMetric
+			}
+		}
+
+		class.VectorConfig[name] = vectorConfig
+	}
+}
+
 func setPropertyDefaults(prop *models.Property) {
 	setPropertyDefaultTokenization(prop)
 	setPropertyDefaultIndexing(prop)
+	setNestedPropertiesDefaults(prop.NestedProperties)
+}
+
+// setNestedPropertiesDefaults applies the same defaults as for top level
+// properties to nested properties, recursively
+func setNestedPropertiesDefaults(nestedProps []*models.NestedProperty) {
+	for _, nestedProp := range nestedProps {
+		prop := &models.Property{
+			DataType:        nestedProp.DataType,
+			Tokenization:    nestedProp.Tokenization,
+			IndexFilterable: nestedProp.IndexFilterable,
+			IndexSearchable: nestedProp.IndexSearchable,
+		}
+		setPropertyDefaultTokenization(prop)
+		setPropertyDefaultIndexing(prop)
+
+		nestedProp.Tokenization = prop.Tokenization
+		nestedProp.IndexFilterable = prop.IndexFilterable
+		nestedProp.IndexSearchable = prop.IndexSearchable
+		setNestedPropertiesDefaults(nestedProp.NestedProperties)
+	}
 }
 
 func setPropertyDefaultTokenization(prop *models.Property) {
@@ -427,6 +484,10 @@ func (m *Manager) validateProperty(
 		return err
 	}
 
+	if err := m.validateNestedProperties(property, propertyDataType); err != nil {
+		return err
+	}
+
 	if err := m.validatePropertyIndexing(property); err != nil {
 		return err
 	}
@@ -438,19 +499,41 @@ func (m *Manager) validateProperty(
 func (m *Manager) parseVectorIndexConfig(ctx context.Context,
 	class *models.Class,
 ) error {
-	if class.VectorIndexType != "hnsw" {
+	switch class.VectorIndexType {
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT,
+		vectorindex.VectorIndexTypeDYNAMIC:
+	default:
 		return errors.Errorf(
 			"parse vector index config: unsupported vector index type: %q",
 			class.VectorIndexType)
 	}
 
-	parsed, err := m.hnswConfigParser(class.VectorIndexConfig)
+	parsed, err := m.configParser(class.VectorIndexConfig, class.VectorIndexType)
 	if err != nil {
 		return errors.Wrap(err, "parse vector index config")
 	}
 
 	class.VectorIndexConfig = parsed
 
+	for name, vectorConfig := range class.VectorConfig {
+		switch vectorConfig.VectorIndexType {
+		case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT,
+			vectorindex.VectorIndexTypeDYNAMIC:
+		default:
+			return errors.Errorf(
+				"parse vector index config of named vector %q: unsupported vector index type: %q",
+				name, vectorConfig.VectorIndexType)
+		}
+
+		parsed, err := m.configParser(vectorConfig.VectorIndexConfig, vectorConfig.VectorIndexType)
+		if err != nil {
+			return errors.Wrapf(err, "parse vector index config of named vector %q", name)
+		}
+
+		vectorConfig.VectorIndexConfig = parsed
+		class.VectorConfig[name] = vectorConfig
+	}
+
 	return nil
 }
 
@@ -530,6 +530,27 @@ func TestAddClass(t *testing.T) {
 			require.Nil(t, err)
 		})
 
+		t.Run("autoTenantCreation without multi tenancy enabled", func(t *testing.T) {
+			mgr := newSchemaManager()
+			err := mgr.AddClass(context.Background(),
+				nil,
+				&models.Class{
+					Class: "NewClass",
+					Properties: []*models.Property{
+						{
+							Name:     "uuidProp",
+							DataType: []string{"uuid"},
+						},
+					},
+					MultiTenancyConfig: &models.MultiTenancyConfig{
+						AutoTenantCreation: true,
+					},
+				},
+			)
+			require.NotNil(t, err)
+			require.Equal(t, "autoTenantCreation requires multiTenancyConfig to be enabled", err.Error())
+		})
+
 		t.Run("multiTenancyConfig and shardingConfig both provided but multi tenancy is nil", func(t *testing.T) {
 			mgr := newSchemaManager()
 			err := mgr.AddClass(context.Background(),
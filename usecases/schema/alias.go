@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// GetClassAliases returns all class aliases sorted by name
+func (m *Manager) GetClassAliases(principal *models.Principal) ([]*models.Alias, error) {
+	err := m.Authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := m.schemaCache.copyAliases()
+	res := make([]*models.Alias, 0, len(aliases))
+	for alias, class := range aliases {
+		res = append(res, &models.Alias{Alias: alias, Class: class})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Alias < res[j].Alias })
+	return res, nil
+}
+
+// SetClassAlias creates an alias for a class or atomically repoints an
+// existing alias to another class. Objects and queries which use the alias
+// are served by the class it points to.
+func (m *Manager) SetClassAlias(ctx context.Context, principal *models.Principal,
+	alias, className string,
+) error {
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	return m.setClassAlias(ctx, alias, className)
+}
+
+func (m *Manager) setClassAlias(ctx context.Context, alias, className string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	alias = schema.UppercaseClassName(alias)
+	className = schema.UppercaseClassName(className)
+	if err := m.validateAlias(alias, className); err != nil {
+		return err
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, setAlias,
+		AliasPayload{Alias: alias, Class: className}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally. See
+		// addClassProperty for the reasoning.
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.setClassAliasApplyChanges(ctx, alias, className)
+}
+
+func (m *Manager) validateAlias(alias, className string) error {
+	if _, err := schema.ValidateClassName(alias); err != nil {
+		return fmt.Errorf("invalid alias: %w", err)
+	}
+	for _, class := range m.schemaCache.ObjectSchema.Classes {
+		if strings.EqualFold(alias, class.Class) {
+			return fmt.Errorf("alias %q conflicts with existing class %q", alias, class.Class)
+		}
+	}
+	for existing := range m.schemaCache.Aliases {
+		if existing != alias && strings.EqualFold(alias, existing) {
+			return fmt.Errorf("alias %q conflicts with existing alias %q", alias, existing)
+		}
+	}
+	if m.getClassByName(className) == nil {
+		return fmt.Errorf("class %q does not exist", className)
+	}
+	return nil
+}
+
+func (m *Manager) setClassAliasApplyChanges(ctx context.Context, alias, className string) error {
+	m.schemaCache.setAlias(alias, className)
+	if err := m.repo.SaveAliases(ctx, m.schemaCache.copyAliases()); err != nil {
+		return errors.Wrap(err, "save aliases")
+	}
+	m.logger.
+		WithField("action", "schema.set_alias").
+		WithField("alias", alias).
+		WithField("class", className).
+		Info("alias points to class")
+	m.triggerSchemaUpdateCallbacks()
+	return nil
+}
+
+// DeleteClassAlias removes an alias, the class it pointed to is not affected
+func (m *Manager) DeleteClassAlias(ctx context.Context, principal *models.Principal,
+	alias string,
+) error {
+	err := m.Authorizer.Authorize(principal, "delete", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	return m.deleteClassAlias(ctx, alias)
+}
+
+func (m *Manager) deleteClassAlias(ctx context.Context, alias string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	alias = schema.UppercaseClassName(alias)
+	if _, ok := m.schemaCache.copyAliases()[alias]; !ok {
+		return ErrNotFound
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, deleteAlias,
+		AliasPayload{Alias: alias}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.deleteClassAliasApplyChanges(ctx, alias)
+}
+
+func (m *Manager) deleteClassAliasApplyChanges(ctx context.Context, alias string) error {
+	m.schemaCache.deleteAlias(alias)
+	if err := m.repo.SaveAliases(ctx, m.schemaCache.copyAliases()); err != nil {
+		return errors.Wrap(err, "save aliases")
+	}
+	m.triggerSchemaUpdateCallbacks()
+	return nil
+}
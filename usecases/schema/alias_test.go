@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestClassAliases(t *testing.T) {
+	ctx := context.Background()
+
+	newManager := func(t *testing.T) *Manager {
+		m := newSchemaManager()
+		for _, name := range []string{"Article_v1", "Article_v2"} {
+			err := m.AddClass(ctx, nil, &models.Class{
+				Class: name,
+				Properties: []*models.Property{
+					{Name: "title", DataType: schema.DataTypeText.PropString()},
+				},
+			})
+			require.Nil(t, err)
+		}
+		return m
+	}
+
+	t.Run("set, resolve and repoint an alias", func(t *testing.T) {
+		m := newManager(t)
+
+		require.Nil(t, m.SetClassAlias(ctx, nil, "article", "Article_v1"))
+		assert.Equal(t, "Article_v1", m.ResolveAlias("Article"))
+		assert.Equal(t, "Article_v2", m.ResolveAlias("Article_v2"))
+		assert.Equal(t, "Unknown", m.ResolveAlias("Unknown"))
+
+		require.Nil(t, m.SetClassAlias(ctx, nil, "Article", "Article_v2"))
+		assert.Equal(t, "Article_v2", m.ResolveAlias("Article"))
+
+		aliases, err := m.GetClassAliases(nil)
+		require.Nil(t, err)
+		assert.Equal(t, []*models.Alias{{Alias: "Article", Class: "Article_v2"}}, aliases)
+
+		repo := m.repo.(*fakeRepo)
+		assert.Equal(t, map[string]string{"Article": "Article_v2"}, repo.schema.Aliases)
+	})
+
+	t.Run("schema update callbacks receive the aliases", func(t *testing.T) {
+		m := newManager(t)
+		var updated schema.Schema
+		m.RegisterSchemaUpdateCallback(func(s schema.Schema) { updated = s })
+
+		require.Nil(t, m.SetClassAlias(ctx, nil, "Article", "Article_v1"))
+		assert.Equal(t, map[string]string{"Article": "Article_v1"}, updated.Aliases)
+
+		require.Nil(t, m.DeleteClassAlias(ctx, nil, "Article"))
+		assert.Empty(t, updated.Aliases)
+	})
+
+	t.Run("invalid aliases", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			alias  string
+			class  string
+			errMsg string
+		}{
+			{
+				name:   "alias with the name of a class",
+				alias:  "article_V1",
+				class:  "Article_v2",
+				errMsg: "conflicts with existing class",
+			},
+			{
+				name:   "nonexistent class",
+				alias:  "Article",
+				class:  "Article_v3",
+				errMsg: "does not exist",
+			},
+			{
+				name:   "invalid name",
+				alias:  "Article!",
+				class:  "Article_v2",
+				errMsg: "invalid alias",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				m := newManager(t)
+
+				err := m.SetClassAlias(ctx, nil, test.alias, test.class)
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.errMsg)
+			})
+		}
+	})
+
+	t.Run("alias conflicting with another alias", func(t *testing.T) {
+		m := newManager(t)
+
+		require.Nil(t, m.SetClassAlias(ctx, nil, "ArticleAlias", "Article_v1"))
+		err := m.SetClassAlias(ctx, nil, "ARTICLEALIAS", "Article_v2")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "conflicts with existing alias")
+	})
+
+	t.Run("class with the name of an alias", func(t *testing.T) {
+		m := newManager(t)
+
+		require.Nil(t, m.SetClassAlias(ctx, nil, "Article", "Article_v1"))
+		err := m.AddClass(ctx, nil, &models.Class{Class: "Article"})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "already exists as an alias")
+	})
+
+	t.Run("delete an alias and the class it pointed to", func(t *testing.T) {
+		m := newManager(t)
+
+		require.Nil(t, m.SetClassAlias(ctx, nil, "Article", "Article_v1"))
+		err := m.DeleteClass(ctx, nil, "Article_v1")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "target of alias(es) Article")
+
+		require.Nil(t, m.DeleteClassAlias(ctx, nil, "Article"))
+		assert.Equal(t, "Article", m.ResolveAlias("Article"))
+		assert.Equal(t, ErrNotFound, m.DeleteClassAlias(ctx, nil, "Article"))
+		require.Nil(t, m.DeleteClass(ctx, nil, "Article_v1"))
+	})
+}
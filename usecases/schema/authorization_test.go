@@ -80,7 +80,19 @@ func Test_Schema_Authorization(t *testing.T) {
 		},
 		{
 			methodName:       "DeleteClassProperty",
-			additionalArgs:   []interface{}{"somename", "someprop"},
+			additionalArgs:   []interface{}{"somename", "someprop", false},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "UpdateClassProperty",
+			additionalArgs:   []interface{}{"somename", "someprop", &models.Property{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "MergeClassObjectProperty",
+			additionalArgs:   []interface{}{"somename", &models.Property{}},
 			expectedVerb:     "update",
 			expectedResource: "schema/objects",
 		},
@@ -96,6 +108,12 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: tenantsPath,
 		},
+		{
+			methodName:       "UpdateTenants",
+			additionalArgs:   []interface{}{"className", []*models.Tenant{{Name: "P1", ActivityStatus: models.TenantActivityStatusCOLD}}},
+			expectedVerb:     "update",
+			expectedResource: tenantsPath,
+		},
 		{
 			methodName:       "DeleteTenants",
 			additionalArgs:   []interface{}{"className", []string{"P1"}},
@@ -108,6 +126,23 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "get",
 			expectedResource: tenantsPath,
 		},
+		{
+			methodName:       "GetClassAliases",
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		{
+			methodName:       "SetClassAlias",
+			additionalArgs:   []interface{}{"aliasName", "className"},
+			expectedVerb:     "update",
+			expectedResource: "schema/objects",
+		},
+		{
+			methodName:       "DeleteClassAlias",
+			additionalArgs:   []interface{}{"aliasName"},
+			expectedVerb:     "delete",
+			expectedResource: "schema/objects",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -123,7 +158,8 @@ func Test_Schema_Authorization(t *testing.T) {
 				"TryLock", "RLocker", "TryRLock", // introduced by sync.Mutex in go 1.18
 				"Nodes", "NodeName", "ClusterHealthScore", "ClusterStatus", "ResolveParentNodes",
 				"CopyShardingState", "TxManager", "RestoreClass",
-				"ShardOwner", "TenantShard", "ShardFromUUID", "LockGuard", "RLockGuard", "ShardReplicas":
+				"ShardOwner", "TenantShard", "ShardFromUUID", "LockGuard", "RLockGuard", "ShardReplicas",
+				"ResolveAlias":
 				// don't require auth on methods which are exported because other
 				// packages need to call them for maintenance and other regular jobs,
 				// but aren't user facing
@@ -148,7 +184,7 @@ func Test_Schema_Authorization(t *testing.T) {
 				require.Nil(t, err)
 
 				var args []interface{}
-				if test.methodName == "GetSchema" {
+				if test.methodName == "GetSchema" || test.methodName == "GetClassAliases" {
 					// no context on this method
 					args = append([]interface{}{principal}, test.additionalArgs...)
 				} else {
@@ -13,6 +13,7 @@ package schema
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/weaviate/weaviate/entities/models"
@@ -24,6 +25,9 @@ import (
 type State struct {
 	ObjectSchema  *models.Schema `json:"object"`
 	ShardingState map[string]*sharding.State
+
+	// Aliases maps alternative names to the names of existing classes
+	Aliases map[string]string `json:"aliases,omitempty"`
 }
 
 // NewState returns a new state with room for nClasses classes
@@ -74,15 +78,18 @@ func (s *schemaCache) ShardReplicas(class, shard string) ([]string, error) {
 	return x.BelongsToNodes, nil
 }
 
-// TenantShard returns shard name for the provided tenant
-func (s *schemaCache) TenantShard(class, tenant string) string {
+// TenantShard returns shard name and activity status for the provided tenant
+func (s *schemaCache) TenantShard(class, tenant string) (string, string) {
 	s.RLock()
 	defer s.RUnlock()
 	ss := s.ShardingState[class]
 	if ss == nil {
-		return ""
+		return "", ""
 	}
-	return ss.Shard(tenant, "")
+	if shard := ss.Shard(tenant, ""); shard != "" {
+		return shard, ss.Physical[shard].ActivityStatus()
+	}
+	return "", ""
 }
 
 // ShardFromUUID returns shard name of the provided uuid
@@ -96,6 +103,56 @@ func (s *schemaCache) ShardFromUUID(class string, uuid []byte) string {
 	return ss.PhysicalShard(uuid)
 }
 
+// ResolveAlias returns the name of the class the alias points to. If name is
+// not an alias, it is returned as is.
+func (s *schemaCache) ResolveAlias(name string) string {
+	s.RLock()
+	defer s.RUnlock()
+	if class, ok := s.Aliases[name]; ok {
+		return class
+	}
+	return name
+}
+
+// aliasesOf returns the sorted aliases which point to the given class
+func (s *schemaCache) aliasesOf(className string) []string {
+	s.RLock()
+	defer s.RUnlock()
+	var aliases []string
+	for alias, class := range s.Aliases {
+		if class == className {
+			aliases = append(aliases, alias)
+		}
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
+func (s *schemaCache) copyAliases() map[string]string {
+	s.RLock()
+	defer s.RUnlock()
+	aliases := make(map[string]string, len(s.Aliases))
+	for alias, class := range s.Aliases {
+		aliases[alias] = class
+	}
+	return aliases
+}
+
+func (s *schemaCache) setAlias(alias, className string) {
+	s.Lock()
+	defer s.Unlock()
+	if s.Aliases == nil {
+		s.Aliases = make(map[string]string)
+	}
+	s.Aliases[alias] = className
+}
+
+func (s *schemaCache) deleteAlias(alias string) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.Aliases, alias)
+}
+
 func (s *schemaCache) CopyShardingState(className string) *sharding.State {
 	s.RLock()
 	defer s.RUnlock()
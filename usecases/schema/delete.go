@@ -13,6 +13,8 @@ package schema
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
@@ -32,6 +34,11 @@ func (m *Manager) deleteClass(ctx context.Context, className string) error {
 	m.Lock()
 	defer m.Unlock()
 
+	if aliases := m.schemaCache.aliasesOf(className); len(aliases) > 0 {
+		return fmt.Errorf("class %q is the target of alias(es) %s, "+
+			"delete or repoint them first", className, strings.Join(aliases, ", "))
+	}
+
 	tx, err := m.cluster.BeginTransaction(ctx, DeleteClass,
 		DeleteClassPayload{className}, DefaultTxTTL)
 	if err != nil {
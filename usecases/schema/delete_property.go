@@ -13,21 +13,94 @@ package schema
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
-// DeleteClassProperty from existing Schema
+// DeleteClassProperty from existing Schema. The values of the property are no
+// longer returned, but remain in the stored objects unless purge is set, in
+// which case they are removed before returning. The property can not be
+// added again meanwhile, as the schema is locked.
 func (m *Manager) DeleteClassProperty(ctx context.Context, principal *models.Principal,
-	class string, property string,
+	class string, property string, purge bool,
 ) error {
 	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
 	if err != nil {
 		return err
 	}
 
-	return fmt.Errorf("deleting a property is currently not supported, see " +
-		"https://github.com/weaviate/weaviate/issues/973 for details.")
-	// return m.deleteClassProperty(ctx, class, property, kind.Action)
+	return m.deleteClassProperty(ctx, class, property, purge)
+}
+
+func (m *Manager) deleteClassProperty(ctx context.Context,
+	className, propName string, purge bool,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	class, err := schema.GetClassByName(m.schemaCache.ObjectSchema, className)
+	if err != nil {
+		return err
+	}
+	propName = schema.LowercaseFirstLetter(propName)
+	if _, err := schema.GetPropertyByName(class, propName); err != nil {
+		return err
+	}
+	if err := m.validateNoOffloadedTenants(className); err != nil {
+		return fmt.Errorf("delete property %q: %w", propName, err)
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, DeleteProperty,
+		DeletePropertyPayload{className, propName, purge}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally. See
+		// addClassProperty for the reasoning.
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.deleteClassPropertyApplyChanges(ctx, className, propName, purge)
+}
+
+func (m *Manager) deleteClassPropertyApplyChanges(ctx context.Context,
+	className, propName string, purge bool,
+) error {
+	class, err := schema.GetClassByName(m.schemaCache.ObjectSchema, className)
+	if err != nil {
+		return err
+	}
+
+	properties := make([]*models.Property, 0, len(class.Properties))
+	for _, prop := range class.Properties {
+		if prop.Name != propName {
+			properties = append(properties, prop)
+		}
+	}
+	class.Properties = properties
+
+	metadata, err := json.Marshal(&class)
+	if err != nil {
+		return fmt.Errorf("marshal class %s: %w", className, err)
+	}
+	m.logger.
+		WithField("action", "schema.delete_property").
+		Debug("saving updated schema to configuration store")
+	err = m.repo.UpdateClass(ctx, ClassPayload{Name: className, Metadata: metadata})
+	if err != nil {
+		return err
+	}
+	m.triggerSchemaUpdateCallbacks()
+
+	// will result in a mismatch between schema and index if function below fails
+	return m.migrator.DropProperty(ctx, className, propName, purge)
 }
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestDeleteClassPropertyWithTenants(t *testing.T) {
+	ctx := context.Background()
+
+	newManager := func(t *testing.T) *Manager {
+		m := newSchemaManager()
+		require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+			Class:              "Car",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+			Properties: []*models.Property{
+				{Name: "color", DataType: schema.DataTypeText.PropString()},
+				{Name: "brand", DataType: schema.DataTypeText.PropString()},
+			},
+			ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+		}))
+		require.Nil(t, m.AddTenants(ctx, nil, "Car", []*models.Tenant{
+			{Name: "hot", ActivityStatus: models.TenantActivityStatusHOT},
+			{Name: "cold", ActivityStatus: models.TenantActivityStatusCOLD},
+		}))
+		return m
+	}
+
+	t.Run("delete with hot and cold tenants", func(t *testing.T) {
+		m := newManager(t)
+
+		require.Nil(t, m.DeleteClassProperty(ctx, nil, "Car", "color", true))
+		assert.Len(t, m.getClassByName("Car").Properties, 1)
+	})
+
+	t.Run("delete with an offloaded tenant", func(t *testing.T) {
+		m := newManager(t)
+		require.Nil(t, m.UpdateTenants(ctx, nil, "Car", []*models.Tenant{
+			{Name: "cold", ActivityStatus: models.TenantActivityStatusOFFLOADED},
+		}))
+
+		err := m.DeleteClassProperty(ctx, nil, "Car", "color", true)
+		assert.ErrorContains(t, err, `tenant "cold" is offloaded`)
+		assert.Len(t, m.getClassByName("Car").Properties, 2)
+	})
+}
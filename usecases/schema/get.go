@@ -36,14 +36,13 @@ func (m *Manager) GetSchema(principal *models.Principal) (schema.Schema, error)
 // could leak the schema to an unauthorized user, is intended to be used for
 // non-user triggered processes, such as regular updates / maintenance / etc
 func (m *Manager) GetSchemaSkipAuth() schema.Schema {
-	return schema.Schema{
-		Objects: m.schemaCache.ObjectSchema,
-	}
+	return m.getSchema()
 }
 
 func (m *Manager) getSchema() schema.Schema {
 	return schema.Schema{
 		Objects: m.schemaCache.ObjectSchema,
+		Aliases: m.schemaCache.copyAliases(),
 	}
 }
 
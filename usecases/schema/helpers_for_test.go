@@ -53,10 +53,19 @@ func (f *fakeRepo) NewShards(ctx context.Context, class string, shards []KeyValu
 	return nil
 }
 
+func (f *fakeRepo) UpdateShards(ctx context.Context, class string, shards []KeyValuePair) error {
+	return nil
+}
+
 func (f *fakeRepo) DeleteShards(_ context.Context, class string, _ []string) error {
 	return nil
 }
 
+func (f *fakeRepo) SaveAliases(ctx context.Context, aliases map[string]string) error {
+	f.schema.Aliases = aliases
+	return nil
+}
+
 type fakeAuthorizer struct{}
 
 func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
@@ -71,7 +80,11 @@ func (f fakeVectorConfig) IndexType() string {
 	return "fake"
 }
 
-func dummyParseVectorConfig(in interface{}) (schema.VectorIndexConfig, error) {
+func (f fakeVectorConfig) DistanceName() string {
+	return "fake"
+}
+
+func dummyParseVectorConfig(in interface{}, vectorIndexType string) (schema.VectorIndexConfig, error) {
 	return fakeVectorConfig{raw: in}, nil
 }
 
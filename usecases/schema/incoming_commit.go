@@ -26,14 +26,28 @@ func (m *Manager) handleCommit(ctx context.Context, tx *cluster.Transaction) err
 		return m.handleAddClassCommit(ctx, tx)
 	case AddProperty:
 		return m.handleAddPropertyCommit(ctx, tx)
+	case DeleteProperty:
+		return m.handleDeletePropertyCommit(ctx, tx)
+	case UpdateProperty:
+		return m.handleUpdatePropertyCommit(ctx, tx)
+	case RenameProperty:
+		return m.handleRenamePropertyCommit(ctx, tx)
+	case MergeObjectProperty:
+		return m.handleMergeObjectPropertyCommit(ctx, tx)
 	case DeleteClass:
 		return m.handleDeleteClassCommit(ctx, tx)
 	case UpdateClass:
 		return m.handleUpdateClassCommit(ctx, tx)
+	case RenameClass:
+		return m.handleRenameClassCommit(ctx, tx)
 	case addTenants:
 		return m.handleAddTenantsCommit(ctx, tx)
+	case updateTenants:
+		return m.handleUpdateTenantsCommit(ctx, tx)
 	case deleteTenants:
 		return m.handleDeleteTenantsCommit(ctx, tx)
+	case setAlias, deleteAlias:
+		return m.handleAliasCommit(ctx, tx)
 	default:
 		return errors.Errorf("unrecognized commit type %q", tx.Type)
 	}
@@ -107,6 +121,84 @@ func (m *Manager) handleAddPropertyCommit(ctx context.Context,
 	return m.addClassPropertyApplyChanges(ctx, pl.ClassName, pl.Property)
 }
 
+func (m *Manager) handleDeletePropertyCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(DeletePropertyPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be DeletePropertyPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.deleteClassPropertyApplyChanges(ctx, pl.ClassName, pl.PropertyName, pl.Purge)
+}
+
+func (m *Manager) handleUpdatePropertyCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(UpdatePropertyPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be UpdatePropertyPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.updateClassPropertyApplyChanges(ctx, pl.ClassName, pl.Property)
+}
+
+func (m *Manager) handleRenamePropertyCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(RenamePropertyPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be RenamePropertyPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.renameClassPropertyApplyChanges(ctx, pl.ClassName, pl.PropertyName, pl.NewName)
+}
+
+func (m *Manager) handleMergeObjectPropertyCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(MergeObjectPropertyPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be MergeObjectPropertyPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.mergeClassObjectPropertyApplyChanges(ctx, pl.ClassName, pl.Property)
+}
+
+func (m *Manager) handleAliasCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(AliasPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be AliasPayload, but got %T",
+			tx.Payload)
+	}
+
+	if tx.Type == deleteAlias {
+		return m.deleteClassAliasApplyChanges(ctx, pl.Alias)
+	}
+	return m.setClassAliasApplyChanges(ctx, pl.Alias, pl.Class)
+}
+
 func (m *Manager) handleDeleteClassCommit(ctx context.Context,
 	tx *cluster.Transaction,
 ) error {
@@ -145,6 +237,21 @@ func (m *Manager) handleUpdateClassCommit(ctx context.Context,
 	return m.updateClassApplyChanges(ctx, pl.ClassName, pl.Class, pl.State)
 }
 
+func (m *Manager) handleRenameClassCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	pl, ok := tx.Payload.(RenameClassPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be RenameClassPayload, but got %T",
+			tx.Payload)
+	}
+
+	return m.renameClassApplyChanges(ctx, pl.ClassName, pl.NewName)
+}
+
 func (m *Manager) handleAddTenantsCommit(ctx context.Context,
 	tx *cluster.Transaction,
 ) error {
@@ -170,6 +277,31 @@ func (m *Manager) handleAddTenantsCommit(ctx context.Context,
 	return err
 }
 
+func (m *Manager) handleUpdateTenantsCommit(ctx context.Context,
+	tx *cluster.Transaction,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	req, ok := tx.Payload.(UpdateTenantsPayload)
+	if !ok {
+		return errors.Errorf("expected commit payload to be UpdateTenants, but got %T",
+			tx.Payload)
+	}
+	cls := m.getClassByName(req.Class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", req.Class, ErrNotFound)
+	}
+
+	err := m.onUpdateTenants(ctx, cls, req)
+	if err != nil {
+		m.logger.WithField("action", "on_update_tenants").
+			WithField("n", len(req.Tenants)).
+			WithField("class", cls.Class).Error(err)
+	}
+	return err
+}
+
 func (m *Manager) handleDeleteTenantsCommit(ctx context.Context,
 	tx *cluster.Transaction,
 ) error {
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
@@ -59,10 +60,22 @@ func (n *NilMigrator) NewTenants(ctx context.Context, class *models.Class, tenan
 	return func(bool) {}, nil
 }
 
+func (n *NilMigrator) UpdateTenants(ctx context.Context, class *models.Class, updates []*migrate.UpdateTenantPayload) (commit func(success bool), err error) {
+	return func(bool) {}, nil
+}
+
 func (n *NilMigrator) DeleteTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error) {
 	return func(bool) {}, nil
 }
 
+func (n *NilMigrator) OffloadTenant(ctx context.Context, class *models.Class, tenant string) error {
+	return nil
+}
+
+func (n *NilMigrator) OnloadTenant(ctx context.Context, class *models.Class, tenant string) error {
+	return nil
+}
+
 func (n *NilMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
@@ -71,7 +84,11 @@ func (n *NilMigrator) UpdatePropertyAddDataType(ctx context.Context, className s
 	return nil
 }
 
-func (n *NilMigrator) DropProperty(ctx context.Context, className string, propName string) error {
+func (n *NilMigrator) DropProperty(ctx context.Context, className string, propName string, purge bool) error {
+	return nil
+}
+
+func (n *NilMigrator) UpdatePropertyIndexes(ctx context.Context, className string, old, updated *models.Property) error {
 	return nil
 }
 
@@ -83,6 +100,10 @@ func (n *NilMigrator) UpdateVectorIndexConfig(ctx context.Context, className str
 	return nil
 }
 
+func (n *NilMigrator) UpdateVectorIndexConfigs(ctx context.Context, className string, updated map[string]schema.VectorIndexConfig) error {
+	return nil
+}
+
 func (n *NilMigrator) ValidateInvertedIndexConfigUpdate(ctx context.Context, old, updated *models.InvertedIndexConfig) error {
 	return nil
 }
@@ -475,11 +496,6 @@ func testAddInvalidPropertyWithEmptyDataTypeDuringCreation(t *testing.T, lsm *Ma
 }
 
 func testDropProperty(t *testing.T, lsm *Manager) {
-	// TODO: https://github.com/weaviate/weaviate/issues/973
-	// Remove skip
-
-	t.Skip()
-
 	t.Parallel()
 
 	var properties []*models.Property = []*models.Property{
@@ -497,7 +513,11 @@ func testDropProperty(t *testing.T, lsm *Manager) {
 	assert.Len(t, objectClasses[0].Properties, 1)
 
 	// Now drop the property
-	lsm.DeleteClassProperty(context.Background(), nil, "Car", "color")
+	err = lsm.DeleteClassProperty(context.Background(), nil, "Car", "unknown", false)
+	require.NotNil(t, err)
+
+	err = lsm.DeleteClassProperty(context.Background(), nil, "Car", "color", false)
+	require.Nil(t, err)
 
 	objectClasses = testGetClasses(lsm)
 	require.Len(t, objectClasses, 1)
@@ -0,0 +1,174 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// MergeClassObjectProperty adds the nested properties of the given object or
+// object[] property which are not part of the existing property of the same
+// name yet. Existing nested properties are left unchanged.
+func (m *Manager) MergeClassObjectProperty(ctx context.Context, principal *models.Principal,
+	class string, property *models.Property,
+) error {
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return err
+	}
+
+	return m.mergeClassObjectProperty(ctx, class, property)
+}
+
+func (m *Manager) mergeClassObjectProperty(ctx context.Context,
+	className string, prop *models.Property,
+) error {
+	m.Lock()
+	defer m.Unlock()
+
+	class, err := schema.GetClassByName(m.schemaCache.ObjectSchema, className)
+	if err != nil {
+		return err
+	}
+	existing, err := schema.GetPropertyByName(class, schema.LowercaseFirstLetter(prop.Name))
+	if err != nil {
+		return err
+	}
+	if _, ok := schema.AsNested(existing.DataType); !ok {
+		return fmt.Errorf("property %q: nested properties can only be merged into "+
+			"object/object[] properties", existing.Name)
+	}
+
+	nestedProps, changed := mergeNestedProperties(existing.NestedProperties, prop.NestedProperties)
+	if !changed {
+		return nil
+	}
+	merged := *existing
+	merged.NestedProperties = nestedProps
+
+	sch := m.getSchema()
+	propertyDataType, err := (&sch).FindPropertyDataType(merged.DataType)
+	if err != nil {
+		return fmt.Errorf("property %q: invalid dataType: %w", merged.Name, err)
+	}
+	if err := m.validateNestedProperties(&merged, propertyDataType); err != nil {
+		return err
+	}
+
+	tx, err := m.cluster.BeginTransaction(ctx, MergeObjectProperty,
+		MergeObjectPropertyPayload{className, &merged}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally. See
+		// addClassProperty for the reasoning.
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.mergeClassObjectPropertyApplyChanges(ctx, className, &merged)
+}
+
+// mergeNestedProperties returns the existing nested properties extended by
+// the ones of the update which do not exist yet, recursively for nested
+// objects. The existing nested properties are not modified, changed ones are
+// copied. It reports whether any nested property was added.
+func mergeNestedProperties(existing, update []*models.NestedProperty,
+) ([]*models.NestedProperty, bool) {
+	merged := make([]*models.NestedProperty, len(existing), len(existing)+len(update))
+	copy(merged, existing)
+
+	changed := false
+	for _, nestedProp := range update {
+		i := nestedPropertyIndex(merged, nestedProp.Name)
+		if i < 0 {
+			added := *nestedProp
+			setNestedPropertiesDefaults([]*models.NestedProperty{&added})
+			merged = append(merged, &added)
+			changed = true
+			continue
+		}
+
+		if _, ok := schema.AsNested(merged[i].DataType); !ok {
+			continue
+		}
+		if _, ok := schema.AsNested(nestedProp.DataType); !ok {
+			continue
+		}
+		children, childrenChanged := mergeNestedProperties(merged[i].NestedProperties,
+			nestedProp.NestedProperties)
+		if childrenChanged {
+			updated := *merged[i]
+			updated.NestedProperties = children
+			merged[i] = &updated
+			changed = true
+		}
+	}
+	return merged, changed
+}
+
+func nestedPropertyIndex(nestedProps []*models.NestedProperty, name string) int {
+	for i, nestedProp := range nestedProps {
+		if nestedProp.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *Manager) mergeClassObjectPropertyApplyChanges(ctx context.Context,
+	className string, merged *models.Property,
+) error {
+	class, err := schema.GetClassByName(m.schemaCache.ObjectSchema, className)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i, prop := range class.Properties {
+		if prop.Name == merged.Name {
+			class.Properties[i] = merged
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("property %q not found in class %q", merged.Name, className)
+	}
+
+	metadata, err := json.Marshal(&class)
+	if err != nil {
+		return fmt.Errorf("marshal class %s: %w", className, err)
+	}
+	m.logger.
+		WithField("action", "schema.merge_object_property").
+		Debug("saving updated schema to configuration store")
+	err = m.repo.UpdateClass(ctx, ClassPayload{Name: className, Metadata: metadata})
+	if err != nil {
+		return err
+	}
+	m.triggerSchemaUpdateCallbacks()
+
+	// creates the indexes of the added nested properties, the indexes of the
+	// existing ones are kept as they are
+	return m.migrator.AddProperty(ctx, className, merged)
+}
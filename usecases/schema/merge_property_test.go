@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestMergeClassObjectProperty(t *testing.T) {
+	ctx := context.Background()
+	vTrue := true
+
+	newManager := func(t *testing.T) *Manager {
+		m := newSchemaManager()
+		require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+			Class: "Person",
+			Properties: []*models.Property{
+				{Name: "name", DataType: schema.DataTypeText.PropString()},
+				{
+					Name:     "address",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "city", DataType: schema.DataTypeText.PropString()},
+						{
+							Name:     "geo",
+							DataType: schema.DataTypeObject.PropString(),
+							NestedProperties: []*models.NestedProperty{
+								{Name: "lat", DataType: schema.DataTypeNumber.PropString()},
+							},
+						},
+					},
+				},
+			},
+		}))
+		return m
+	}
+
+	t.Run("merge new nested properties", func(t *testing.T) {
+		m := newManager(t)
+		before := m.getClassByName("Person").Properties[1]
+
+		err := m.MergeClassObjectProperty(ctx, nil, "Person", &models.Property{
+			Name:     "address",
+			DataType: schema.DataTypeObject.PropString(),
+			NestedProperties: []*models.NestedProperty{
+				{Name: "city", DataType: schema.DataTypeText.PropString()},
+				{Name: "zip", DataType: schema.DataTypeText.PropString()},
+				{
+					Name:     "geo",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "lon", DataType: schema.DataTypeNumber.PropString()},
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		address := m.getClassByName("Person").Properties[1]
+		require.Len(t, address.NestedProperties, 3)
+		assert.Equal(t, "city", address.NestedProperties[0].Name)
+		geo := address.NestedProperties[1]
+		require.Len(t, geo.NestedProperties, 2)
+		assert.Equal(t, "lat", geo.NestedProperties[0].Name)
+		assert.Equal(t, "lon", geo.NestedProperties[1].Name)
+		zip := address.NestedProperties[2]
+		assert.Equal(t, "zip", zip.Name)
+		assert.Equal(t, models.PropertyTokenizationWord, zip.Tokenization)
+		assert.Equal(t, &vTrue, zip.IndexFilterable)
+		assert.Equal(t, &vTrue, zip.IndexSearchable)
+
+		assert.Len(t, before.NestedProperties, 2, "previous property is not modified")
+		assert.Len(t, before.NestedProperties[1].NestedProperties, 1)
+	})
+
+	t.Run("nothing to merge", func(t *testing.T) {
+		m := newManager(t)
+		before := m.getClassByName("Person").Properties[1]
+
+		err := m.MergeClassObjectProperty(ctx, nil, "Person", &models.Property{
+			Name:     "address",
+			DataType: schema.DataTypeObject.PropString(),
+			NestedProperties: []*models.NestedProperty{
+				{Name: "city", DataType: schema.DataTypeText.PropString()},
+			},
+		})
+		require.Nil(t, err)
+		assert.Same(t, before, m.getClassByName("Person").Properties[1])
+	})
+
+	t.Run("invalid merges", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			prop   *models.Property
+			errMsg string
+		}{
+			{
+				name:   "unknown property",
+				prop:   &models.Property{Name: "unknown"},
+				errMsg: "no such prop",
+			},
+			{
+				name: "primitive property",
+				prop: &models.Property{
+					Name: "name",
+					NestedProperties: []*models.NestedProperty{
+						{Name: "first", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+				errMsg: "can only be merged into object/object[] properties",
+			},
+			{
+				name: "invalid nested data type",
+				prop: &models.Property{
+					Name: "address",
+					NestedProperties: []*models.NestedProperty{
+						{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+					},
+				},
+				errMsg: "is not allowed for nested properties",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				m := newManager(t)
+				err := m.MergeClassObjectProperty(ctx, nil, "Person", tt.prop)
+				assert.ErrorContains(t, err, tt.errMsg)
+				assert.Len(t, m.getClassByName("Person").Properties[1].NestedProperties, 2)
+			})
+		}
+	})
+}
@@ -22,6 +22,12 @@ import (
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
+// UpdateTenantPayload describes the desired activity status of a tenant
+type UpdateTenantPayload struct {
+	Name   string
+	Status string
+}
+
 // Migrator represents both the input and output interface of the Composer
 type Migrator interface {
 	AddClass(ctx context.Context, class *models.Class, shardingState *sharding.State) error
@@ -34,14 +40,23 @@ type Migrator interface {
 		prop *models.Property) error
 	UpdateProperty(ctx context.Context, className string,
 		propName string, newName *string) error
+	DropProperty(ctx context.Context, className string,
+		propName string, purge bool) error
+	UpdatePropertyIndexes(ctx context.Context, className string,
+		old, updated *models.Property) error
 
 	NewTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error)
+	UpdateTenants(ctx context.Context, class *models.Class, updates []*UpdateTenantPayload) (commit func(success bool), err error)
 	DeleteTenants(ctx context.Context, class *models.Class, tenants []string) (commit func(success bool), err error)
+	OffloadTenant(ctx context.Context, class *models.Class, tenant string) error
+	OnloadTenant(ctx context.Context, class *models.Class, tenant string) error
 
 	ValidateVectorIndexConfigUpdate(ctx context.Context,
 		old, updated schema.VectorIndexConfig) error
 	UpdateVectorIndexConfig(ctx context.Context, className string,
 		updated schema.VectorIndexConfig) error
+	UpdateVectorIndexConfigs(ctx context.Context, className string,
+		updated map[string]schema.VectorIndexConfig) error
 	ValidateInvertedIndexConfigUpdate(ctx context.Context,
 		old, updated *models.InvertedIndexConfig) error
 	UpdateInvertedIndexConfig(ctx context.Context, className string,
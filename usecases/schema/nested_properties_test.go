@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestAddClass_NestedProperties(t *testing.T) {
+	ctx := context.Background()
+	vFalse := false
+	vTrue := true
+
+	t.Run("set defaults on nested properties", func(t *testing.T) {
+		mgr := newSchemaManager()
+		err := mgr.AddClass(ctx, nil, &models.Class{
+			Class: "Person",
+			Properties: []*models.Property{
+				{
+					Name:     "address",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "city", DataType: schema.DataTypeText.PropString()},
+						{
+							Name:     "geo",
+							DataType: schema.DataTypeObjectArray.PropString(),
+							NestedProperties: []*models.NestedProperty{
+								{Name: "zip", DataType: schema.DataTypeInt.PropString()},
+							},
+						},
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		prop := mgr.getClassByName("Person").Properties[0]
+		assert.Equal(t, &vTrue, prop.IndexFilterable)
+		assert.Equal(t, &vFalse, prop.IndexSearchable)
+		assert.Empty(t, prop.Tokenization)
+
+		city := prop.NestedProperties[0]
+		assert.Equal(t, models.PropertyTokenizationWord, city.Tokenization)
+		assert.Equal(t, &vTrue, city.IndexFilterable)
+		assert.Equal(t, &vTrue, city.IndexSearchable)
+
+		zip := prop.NestedProperties[1].NestedProperties[0]
+		assert.Empty(t, zip.Tokenization)
+		assert.Equal(t, &vTrue, zip.IndexFilterable)
+		assert.Equal(t, &vFalse, zip.IndexSearchable)
+	})
+
+	t.Run("invalid nested properties", func(t *testing.T) {
+		type testCase struct {
+			name        string
+			prop        *models.Property
+			expectedErr string
+		}
+
+		testCases := []testCase{
+			{
+				name: "object without nested properties",
+				prop: &models.Property{
+					Name:     "address",
+					DataType: schema.DataTypeObject.PropString(),
+				},
+				expectedErr: "property 'address': nestedProperties must be set for object/object[] data types",
+			},
+			{
+				name: "nested properties on a primitive data type",
+				prop: &models.Property{
+					Name:     "address",
+					DataType: schema.DataTypeText.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "city", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+				expectedErr: "property 'address': nestedProperties are allowed only for object/object[] data types",
+			},
+			{
+				name: "invalid nested property name",
+				prop: &models.Property{
+					Name:     "address",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "the city", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+				expectedErr: "'the city' is not a valid property name",
+			},
+			{
+				name: "duplicate nested property names",
+				prop: &models.Property{
+					Name:     "address",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "city", DataType: schema.DataTypeText.PropString()},
+						{Name: "City", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+				expectedErr: "conflict for nested property \"City\"",
+			},
+			{
+				name: "unsupported nested data type",
+				prop: &models.Property{
+					Name:     "address",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+					},
+				},
+				expectedErr: "data type 'geoCoordinates' is not allowed for nested properties",
+			},
+			{
+				name: "nested object without nested properties",
+				prop: &models.Property{
+					Name:     "address",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{Name: "geo", DataType: schema.DataTypeObject.PropString()},
+					},
+				},
+				expectedErr: "property 'address.geo': nestedProperties must be set",
+			},
+			{
+				name: "invalid tokenization of nested property",
+				prop: &models.Property{
+					Name:     "address",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{
+							Name:         "zip",
+							DataType:     schema.DataTypeInt.PropString(),
+							Tokenization: models.PropertyTokenizationWord,
+						},
+					},
+				},
+				expectedErr: "property 'address.zip': Tokenization is not allowed for data type 'int'",
+			},
+			{
+				name: "searchable index on a non-text nested property",
+				prop: &models.Property{
+					Name:     "address",
+					DataType: schema.DataTypeObject.PropString(),
+					NestedProperties: []*models.NestedProperty{
+						{
+							Name:            "zip",
+							DataType:        schema.DataTypeInt.PropString(),
+							IndexSearchable: &vTrue,
+						},
+					},
+				},
+				expectedErr: "property 'address.zip': `indexSearchable` is allowed only for text/text[] data types",
+			},
+			{
+				name: "tokenization of an object property",
+				prop: &models.Property{
+					Name:         "address",
+					DataType:     schema.DataTypeObject.PropString(),
+					Tokenization: models.PropertyTokenizationWord,
+					NestedProperties: []*models.NestedProperty{
+						{Name: "city", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+				expectedErr: "Tokenization is not allowed for object data type",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				mgr := newSchemaManager()
+				err := mgr.AddClass(ctx, nil, &models.Class{
+					Class:      "Person",
+					Properties: []*models.Property{tc.prop},
+				})
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			})
+		}
+	})
+}
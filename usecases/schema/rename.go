@@ -0,0 +1,203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// validateRenameClass makes sure that the class can be renamed to the given
+// name. The new name has to be a valid class name which is not used by any
+// other class or alias.
+func (m *Manager) validateRenameClass(className, newName string) error {
+	if _, err := schema.ValidateClassName(newName); err != nil {
+		return err
+	}
+	if err := m.validateClassNameUniqueness(newName); err != nil {
+		return err
+	}
+	if err := m.validateNoOffloadedTenants(className); err != nil {
+		return fmt.Errorf("rename class %q: %w", className, err)
+	}
+	return nil
+}
+
+// validateNoOffloadedTenants rejects changes to classes with offloaded
+// tenants, which would have to be applied to files not available locally
+func (m *Manager) validateNoOffloadedTenants(className string) error {
+	ss := m.schemaCache.CopyShardingState(className)
+	if ss == nil {
+		return nil
+	}
+	for name, physical := range ss.Physical {
+		if physical.IsOffloaded() {
+			return fmt.Errorf("tenant %q is offloaded, activate it first", name)
+		}
+	}
+	return nil
+}
+
+// renameClass renames the class cluster-wide. References to the class in the
+// data types of other properties and aliases pointing to the class are
+// updated as well. Must be called while holding the manager lock.
+func (m *Manager) renameClass(ctx context.Context, className, newName string) error {
+	tx, err := m.cluster.BeginTransaction(ctx, RenameClass,
+		RenameClassPayload{className, newName}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally. See
+		// addClassProperty for the reasoning.
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.renameClassApplyChanges(ctx, className, newName)
+}
+
+func (m *Manager) renameClassApplyChanges(ctx context.Context, className, newName string) error {
+	class := m.getClassByName(className)
+	if class == nil {
+		return fmt.Errorf("class %q: %w", className, ErrNotFound)
+	}
+
+	m.schemaCache.LockGuard(func() {
+		class.Class = newName
+		for _, other := range m.schemaCache.ObjectSchema.Classes {
+			for _, prop := range other.Properties {
+				for i, dataType := range prop.DataType {
+					if dataType == className {
+						prop.DataType[i] = newName
+					}
+				}
+			}
+		}
+		if ss, ok := m.schemaCache.ShardingState[className]; ok {
+			ss.IndexID = newName
+			m.schemaCache.ShardingState[newName] = ss
+			delete(m.schemaCache.ShardingState, className)
+		}
+		for alias, target := range m.schemaCache.Aliases {
+			if target == className {
+				m.schemaCache.Aliases[alias] = newName
+			}
+		}
+	})
+
+	m.logger.
+		WithField("action", "schema.rename_class").
+		WithField("class", className).
+		WithField("new_name", newName).
+		Info("renaming class")
+	err := m.schemaCache.RLockGuard(func() error { return m.repo.Save(ctx, m.schemaCache.State) })
+	if err != nil {
+		return errors.Wrap(err, "save schema")
+	}
+	m.triggerSchemaUpdateCallbacks()
+
+	// will result in a mismatch between schema and index if function below fails
+	return m.migrator.UpdateClass(ctx, className, &newName)
+}
+
+// validateRenameProperty makes sure that the property of the class can be
+// renamed to the given name
+func (m *Manager) validateRenameProperty(class *models.Class, prop *models.Property,
+	newName string,
+) error {
+	if _, err := schema.ValidatePropertyName(newName); err != nil {
+		return err
+	}
+	if err := schema.ValidateReservedPropertyName(newName); err != nil {
+		return err
+	}
+	for _, other := range class.Properties {
+		if strings.EqualFold(other.Name, newName) {
+			return fmt.Errorf("class %q: conflict for property %q: already in use",
+				class.Class, newName)
+		}
+	}
+	if len(prop.DataType) == 1 && prop.DataType[0] == schema.DataTypeGeoCoordinates.String() {
+		return fmt.Errorf("property %q: renaming is not supported for data type %q",
+			prop.Name, schema.DataTypeGeoCoordinates)
+	}
+	if dataType, ok := schema.AsNested(prop.DataType); ok {
+		return fmt.Errorf("property %q: renaming is not supported for data type %q",
+			prop.Name, dataType)
+	}
+	if err := m.validateNoOffloadedTenants(class.Class); err != nil {
+		return fmt.Errorf("rename property %q: %w", prop.Name, err)
+	}
+	return nil
+}
+
+// renameClassProperty renames the property of the class cluster-wide. Must be
+// called while holding the manager lock.
+func (m *Manager) renameClassProperty(ctx context.Context,
+	className, propName, newName string,
+) error {
+	tx, err := m.cluster.BeginTransaction(ctx, RenameProperty,
+		RenamePropertyPayload{className, propName, newName}, DefaultTxTTL)
+	if err != nil {
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.renameClassPropertyApplyChanges(ctx, className, propName, newName)
+}
+
+func (m *Manager) renameClassPropertyApplyChanges(ctx context.Context,
+	className, propName, newName string,
+) error {
+	class, err := schema.GetClassByName(m.schemaCache.ObjectSchema, className)
+	if err != nil {
+		return err
+	}
+	prop, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return err
+	}
+
+	m.schemaCache.LockGuard(func() { prop.Name = newName })
+
+	metadata, err := json.Marshal(&class)
+	if err != nil {
+		return fmt.Errorf("marshal class %s: %w", className, err)
+	}
+	m.logger.
+		WithField("action", "schema.rename_property").
+		WithField("class", className).
+		WithField("property", propName).
+		WithField("new_name", newName).
+		Info("renaming property")
+	err = m.repo.UpdateClass(ctx, ClassPayload{Name: className, Metadata: metadata})
+	if err != nil {
+		return err
+	}
+	m.triggerSchemaUpdateCallbacks()
+
+	// will result in a mismatch between schema and index if function below fails
+	return m.migrator.UpdateProperty(ctx, className, propName, &newName)
+}
@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestRenameClass(t *testing.T) {
+	ctx := context.Background()
+
+	newManager := func(t *testing.T) *Manager {
+		m := newSchemaManager()
+		require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+			Class: "Author",
+			Properties: []*models.Property{
+				{Name: "name", DataType: schema.DataTypeText.PropString()},
+				{Name: "friends", DataType: []string{"Author"}},
+			},
+		}))
+		require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: schema.DataTypeText.PropString()},
+				{Name: "writtenBy", DataType: []string{"Author"}},
+			},
+		}))
+		return m
+	}
+
+	t.Run("rename a referenced class with an alias", func(t *testing.T) {
+		m := newManager(t)
+		require.Nil(t, m.SetClassAlias(ctx, nil, "Writer", "Author"))
+
+		err := m.UpdateClass(ctx, nil, "Author", &models.Class{
+			Class:      "person",
+			Properties: m.getClassByName("Author").Properties,
+		})
+		require.Nil(t, err)
+
+		assert.Nil(t, m.getClassByName("Author"))
+		renamed := m.getClassByName("Person")
+		require.NotNil(t, renamed)
+		assert.Equal(t, []string{"Person"}, renamed.Properties[1].DataType)
+		article := m.getClassByName("Article")
+		assert.Equal(t, []string{"Person"}, article.Properties[1].DataType)
+		assert.Equal(t, "Person", m.ResolveAlias("Writer"))
+
+		assert.Nil(t, m.CopyShardingState("Author"))
+		ss := m.CopyShardingState("Person")
+		require.NotNil(t, ss)
+		assert.Equal(t, "Person", ss.IndexID)
+
+		repo := m.repo.(*fakeRepo)
+		sch := schema.Schema{Objects: repo.schema.ObjectSchema}
+		assert.NotNil(t, sch.GetClass("Person"))
+		assert.Nil(t, sch.GetClass("Author"))
+		assert.Contains(t, repo.schema.ShardingState, "Person")
+		assert.NotContains(t, repo.schema.ShardingState, "Author")
+	})
+
+	t.Run("rename to the name of an existing class", func(t *testing.T) {
+		m := newManager(t)
+
+		err := m.UpdateClass(ctx, nil, "Author", &models.Class{
+			Class:      "Article",
+			Properties: m.getClassByName("Author").Properties,
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "already exists")
+		assert.NotNil(t, m.getClassByName("Author"))
+	})
+
+	t.Run("rename to the name of an alias", func(t *testing.T) {
+		m := newManager(t)
+		require.Nil(t, m.SetClassAlias(ctx, nil, "Writer", "Author"))
+
+		err := m.UpdateClass(ctx, nil, "Article", &models.Class{
+			Class:      "Writer",
+			Properties: m.getClassByName("Article").Properties,
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "already exists as an alias")
+	})
+}
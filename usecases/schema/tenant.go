@@ -14,12 +14,16 @@ package schema
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/cluster"
 	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/schema/migrate"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
@@ -28,6 +32,13 @@ var regexTenantName = regexp.MustCompile(`^` + schema.ShardNameRegexCore + `$`)
 // tenantsPath is the main path used for authorization
 const tenantsPath = "schema/tenants"
 
+const (
+	// transferCommitAttempts is how often the final activity status of a
+	// transferred tenant is committed, while other transactions are running
+	transferCommitAttempts = 10
+	transferCommitBackoff  = time.Second
+)
+
 // AddTenants is used to add new tenants to a class
 // Class must exist and has partitioning enabled
 func (m *Manager) AddTenants(ctx context.Context,
@@ -39,14 +50,19 @@ func (m *Manager) AddTenants(ctx context.Context,
 		return err
 	}
 	tenantNames := make([]string, len(tenants))
+	statuses := make(map[string]string, len(tenants))
 	for i, tenant := range tenants {
 		tenantNames[i] = tenant.Name
+		statuses[tenant.Name] = tenant.ActivityStatus
 	}
 
 	// validation
 	if err := validateTenants(tenantNames); err != nil {
 		return err
 	}
+	if err := validateActivityStatuses(tenants, true); err != nil {
+		return err
+	}
 	cls := m.getClassByName(class)
 	if cls == nil {
 		return fmt.Errorf("class %q: %w", class, ErrNotFound)
@@ -66,7 +82,7 @@ func (m *Manager) AddTenants(ctx context.Context,
 	}
 	i := 0
 	for name, owners := range partitions {
-		request.Tenants[i] = Tenant{Name: name, Nodes: owners}
+		request.Tenants[i] = Tenant{Name: name, Nodes: owners, Status: schema.ActivityStatus(statuses[name])}
 		i++
 	}
 
@@ -122,6 +138,30 @@ func validateTenants(tenants []string) error {
 	return nil
 }
 
+// validateActivityStatuses checks the requested activity statuses. New tenants
+// can be created without status, but cannot be created as offloaded
+func validateActivityStatuses(tenants []*models.Tenant, creation bool) error {
+	for _, tenant := range tenants {
+		switch status := tenant.ActivityStatus; status {
+		case models.TenantActivityStatusHOT, models.TenantActivityStatusCOLD:
+			continue
+		case models.TenantActivityStatusOFFLOADED:
+			if !creation {
+				continue
+			}
+			return uco.NewErrInvalidUserInput("tenant %q cannot be created with activity status %q", tenant.Name, status)
+		case "":
+			if creation {
+				continue
+			}
+			return uco.NewErrInvalidUserInput("missing activity status for tenant %q", tenant.Name)
+		default:
+			return uco.NewErrInvalidUserInput("invalid activity status %q for tenant %q", status, tenant.Name)
+		}
+	}
+	return nil
+}
+
 func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request AddTenantsPayload,
 ) error {
 	st := sharding.State{
@@ -131,7 +171,7 @@ func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request
 	pairs := make([]KeyValuePair, 0, len(request.Tenants))
 	for _, p := range request.Tenants {
 		if _, ok := st.Physical[p.Name]; !ok {
-			p := st.AddPartition(p.Name, p.Nodes)
+			p := st.AddPartition(p.Name, p.Nodes, schema.ActivityStatus(p.Status))
 			data, err := json.Marshal(p)
 			if err != nil {
 				return fmt.Errorf("cannot marshal partition %s: %w", p.Name, err)
@@ -141,7 +181,8 @@ func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request
 	}
 	shards := make([]string, 0, len(request.Tenants))
 	for _, p := range request.Tenants {
-		if st.IsLocalShard(p.Name) {
+		// cold tenants are only registered, their shards are loaded once activated
+		if st.IsLocalShard(p.Name) && schema.ActivityStatus(p.Status) == models.TenantActivityStatusHOT {
 			shards = append(shards, p.Name)
 		}
 	}
@@ -170,6 +211,236 @@ func (m *Manager) onAddTenants(ctx context.Context, class *models.Class, request
 	return nil
 }
 
+// UpdateTenants is used to set activity status of tenants of a class.
+//
+// Class must exist and has partitioning enabled
+func (m *Manager) UpdateTenants(ctx context.Context, principal *models.Principal,
+	class string, tenants []*models.Tenant,
+) error {
+	if err := m.Authorizer.Authorize(principal, "update", tenantsPath); err != nil {
+		return err
+	}
+	tenantNames := make([]string, len(tenants))
+	for i, tenant := range tenants {
+		tenantNames[i] = tenant.Name
+	}
+
+	// validation
+	if err := validateTenants(tenantNames); err != nil {
+		return err
+	}
+	if err := validateActivityStatuses(tenants, false); err != nil {
+		return err
+	}
+	cls := m.getClassByName(class)
+	if cls == nil {
+		return fmt.Errorf("class %q: %w", class, ErrNotFound)
+	}
+	if !schema.MultiTenancyEnabled(cls) {
+		return fmt.Errorf("multi-tenancy is not enabled for class %q", class)
+	}
+
+	request := UpdateTenantsPayload{
+		Class:   class,
+		Tenants: make([]TenantUpdate, len(tenants)),
+	}
+	if err := m.schemaCache.RLockGuard(func() error {
+		ss := m.schemaCache.ShardingState[cls.Class]
+		if ss == nil {
+			return fmt.Errorf("sharding state %w", ErrNotFound)
+		}
+		for i, tenant := range tenants {
+			p, ok := ss.Physical[tenant.Name]
+			if !ok {
+				return uco.NewErrInvalidUserInput("tenant %q does not exist", tenant.Name)
+			}
+			tu, err := tenantUpdate(tenant.Name, tenant.ActivityStatus, p.ActivityStatus())
+			if err != nil {
+				return err
+			}
+			request.Tenants[i] = tu
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return m.updateTenants(ctx, cls, request)
+}
+
+// tenantUpdate returns the update of a tenant from its current to the
+// requested activity status. The files of tenants which are offloaded or
+// activated again are transferred in the background, in the meantime the
+// tenants are OFFLOADING or ONLOADING. A transfer can be reverted, but not
+// requested again while it is running.
+func tenantUpdate(name, requested, current string) (TenantUpdate, error) {
+	tu := TenantUpdate{Name: name, Status: requested}
+	switch current {
+	case models.TenantActivityStatusOFFLOADING:
+		if requested == models.TenantActivityStatusOFFLOADED {
+			return tu, uco.NewErrInvalidUserInput("tenant %q is already being offloaded", name)
+		}
+	case models.TenantActivityStatusONLOADING:
+		if requested != models.TenantActivityStatusOFFLOADED {
+			return tu, uco.NewErrInvalidUserInput("tenant %q is already being onloaded", name)
+		}
+	case models.TenantActivityStatusOFFLOADED:
+		if requested != models.TenantActivityStatusOFFLOADED {
+			tu.Status, tu.Target = models.TenantActivityStatusONLOADING, requested
+		}
+	default:
+		if requested == models.TenantActivityStatusOFFLOADED {
+			tu.Status, tu.Target = models.TenantActivityStatusOFFLOADING, current
+		}
+	}
+	return tu, nil
+}
+
+// updateTenants commits the update of tenants cluster-wide
+func (m *Manager) updateTenants(ctx context.Context, cls *models.Class, request UpdateTenantsPayload) error {
+	// open cluster-wide transaction
+	tx, err := m.cluster.BeginTransaction(ctx, updateTenants,
+		request, DefaultTxTTL)
+	if err != nil {
+		return fmt.Errorf("open cluster-wide transaction: %w", err)
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	err = m.onUpdateTenants(ctx, cls, request) // actual update
+	if err != nil {
+		m.logger.WithField("action", "update_tenants").
+			WithField("n", len(request.Tenants)).
+			WithField("class", cls.Class).Error(err)
+	}
+
+	return err
+}
+
+func (m *Manager) onUpdateTenants(ctx context.Context, class *models.Class, request UpdateTenantsPayload,
+) error {
+	ss := m.schemaCache.CopyShardingState(class.Class)
+	if ss == nil {
+		return fmt.Errorf("sharding state %w", ErrNotFound)
+	}
+	ss.SetLocalName(m.clusterState.LocalName())
+
+	updated := make(map[string]sharding.Physical, len(request.Tenants))
+	pairs := make([]KeyValuePair, 0, len(request.Tenants))
+	updates := make([]*migrate.UpdateTenantPayload, 0, len(request.Tenants))
+	transfers := make([]TenantUpdate, 0, len(request.Tenants))
+	for _, tu := range request.Tenants {
+		p, ok := ss.Physical[tu.Name]
+		if !ok {
+			continue // tenant has been deleted in the meantime
+		}
+		previous := p.ActivityStatus()
+		p.Status = tu.Status
+		data, err := json.Marshal(p)
+		if err != nil {
+			return fmt.Errorf("cannot marshal partition %s: %w", p.Name, err)
+		}
+		updated[p.Name] = p
+		pairs = append(pairs, KeyValuePair{p.Name, data})
+		if ss.IsLocalShard(p.Name) {
+			updates = append(updates, &migrate.UpdateTenantPayload{Name: p.Name, Status: p.Status})
+			switch p.Status {
+			case models.TenantActivityStatusOFFLOADING, models.TenantActivityStatusONLOADING:
+				if previous != p.Status {
+					transfers = append(transfers, tu)
+				}
+			}
+		}
+	}
+
+	commit, err := m.migrator.UpdateTenants(ctx, class, updates)
+	if err != nil {
+		return fmt.Errorf("migrator.update_tenants: %w", err)
+	}
+
+	m.logger.
+		WithField("action", "schema.update_tenants").
+		Debug("saving updated schema to configuration store")
+
+	if err := m.repo.UpdateShards(ctx, class.Class, pairs); err != nil {
+		commit(false) // rollback update of tenants
+		return err
+	}
+	commit(true) // commit update of tenants
+	m.schemaCache.LockGuard(func() {
+		if ost := m.schemaCache.ShardingState[request.Class]; ost != nil {
+			for name, p := range updated {
+				if _, ok := ost.Physical[name]; ok {
+					ost.Physical[name] = p
+				}
+			}
+		}
+	})
+
+	// files of OFFLOADING and ONLOADING tenants are transferred outside of
+	// the schema update, as this may take a long time
+	for _, tu := range transfers {
+		go m.transferTenant(class, tu)
+	}
+
+	return nil
+}
+
+// transferTenant uploads or downloads the files of an OFFLOADING or ONLOADING
+// tenant and commits its final activity status once the transfer is over
+func (m *Manager) transferTenant(class *models.Class, tu TenantUpdate) {
+	ctx := context.Background()
+	logger := m.logger.WithField("action", "transfer_tenant").
+		WithField("class", class.Class).
+		WithField("tenant", tu.Name)
+
+	var err error
+	final := tu.Target
+	if tu.Status == models.TenantActivityStatusOFFLOADING {
+		if err = m.migrator.OffloadTenant(ctx, class, tu.Name); err == nil {
+			final = models.TenantActivityStatusOFFLOADED
+		}
+	} else {
+		if err = m.migrator.OnloadTenant(ctx, class, tu.Name); err != nil {
+			final = models.TenantActivityStatusOFFLOADED
+		}
+	}
+	if err != nil {
+		logger.WithError(err).Errorf("tenant is set back to %s", final)
+	}
+
+	// the transfer has been reverted or another replica has already
+	// completed it in the meantime
+	var current string
+	m.schemaCache.RLockGuard(func() error {
+		if ss := m.schemaCache.ShardingState[class.Class]; ss != nil {
+			current = ss.Physical[tu.Name].ActivityStatus()
+		}
+		return nil
+	})
+	if current != tu.Status {
+		return
+	}
+
+	request := UpdateTenantsPayload{
+		Class:   class.Class,
+		Tenants: []TenantUpdate{{Name: tu.Name, Status: final}},
+	}
+	for attempt := 1; ; attempt++ {
+		err := m.updateTenants(ctx, class, request)
+		if err == nil {
+			return
+		}
+		if !errors.Is(err, cluster.ErrConcurrentTransaction) || attempt == transferCommitAttempts {
+			logger.WithError(err).Errorf("cannot set tenant to %s", final)
+			return
+		}
+		time.Sleep(transferCommitBackoff)
+	}
+}
+
 // DeleteTenants is used to delete tenants of a class.
 //
 // Class must exist and has partitioning enabled
@@ -259,8 +530,8 @@ func (m *Manager) GetTenants(ctx context.Context, principal *models.Principal, c
 		if ss := m.schemaCache.ShardingState[cls.Class]; ss != nil {
 			tenants = make([]*models.Tenant, len(ss.Physical))
 			i := 0
-			for tenant := range ss.Physical {
-				tenants[i] = &models.Tenant{Name: tenant}
+			for tenant, p := range ss.Physical {
+				tenants[i] = &models.Tenant{Name: tenant, ActivityStatus: p.ActivityStatus()}
 				i++
 			}
 		}
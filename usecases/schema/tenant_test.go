@@ -14,8 +14,10 @@ package schema
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 )
@@ -91,6 +93,45 @@ func TestAddTenants(t *testing.T) {
 			},
 			errMsg: "tenant",
 		},
+		{
+			name:    "InvalidActivityStatus",
+			Class:   "C1",
+			tenants: []*models.Tenant{{Name: "Aaaa", ActivityStatus: "WARM"}},
+			initial: &models.Class{
+				Class:              cls,
+				MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+				Properties:         properties,
+				ReplicationConfig:  repConfig,
+			},
+			errMsg: "invalid activity status",
+		},
+		{
+			name:    "OffloadedActivityStatus",
+			Class:   "C1",
+			tenants: []*models.Tenant{{Name: "Aaaa", ActivityStatus: models.TenantActivityStatusOFFLOADED}},
+			initial: &models.Class{
+				Class:              cls,
+				MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+				Properties:         properties,
+				ReplicationConfig:  repConfig,
+			},
+			errMsg: "cannot be created",
+		},
+		{
+			name:  "SuccessWithActivityStatus",
+			Class: "C1",
+			tenants: []*models.Tenant{
+				{Name: "Aaaa", ActivityStatus: models.TenantActivityStatusHOT},
+				{Name: "Bbbb", ActivityStatus: models.TenantActivityStatusCOLD},
+			},
+			initial: &models.Class{
+				Class:              cls,
+				MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+				Properties:         properties,
+				ReplicationConfig:  repConfig,
+			},
+			errMsg: "",
+		},
 		{
 			name:    "Success",
 			Class:   "C1",
@@ -118,6 +159,10 @@ func TestAddTenants(t *testing.T) {
 			ss := sm.schemaCache.ShardingState[test.Class]
 			assert.NotNil(t, ss, test.name)
 			assert.Equal(t, len(ss.Physical), len(test.tenants), test.name)
+			for _, tenant := range test.tenants {
+				assert.Equal(t, schema.ActivityStatus(tenant.ActivityStatus),
+					ss.Physical[tenant.Name].ActivityStatus(), test.name)
+			}
 		} else {
 			assert.ErrorContains(t, err, test.errMsg, test.name)
 		}
@@ -249,3 +294,197 @@ func TestDeleteTenants(t *testing.T) {
 
 	}
 }
+
+func TestUpdateTenants(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		tenants = []*models.Tenant{
+			{Name: "USER1"},
+			{Name: "USER2", ActivityStatus: models.TenantActivityStatusCOLD},
+		}
+		cls = "C1"
+	)
+
+	tests := []struct {
+		name    string
+		class   string
+		tenants []*models.Tenant
+		errMsg  string
+	}{
+		{
+			name:    "UnknownClass",
+			class:   "UnknownClass",
+			tenants: []*models.Tenant{{Name: "USER1", ActivityStatus: models.TenantActivityStatusCOLD}},
+			errMsg:  ErrNotFound.Error(),
+		},
+		{
+			name:    "UnknownTenant",
+			class:   "C1",
+			tenants: []*models.Tenant{{Name: "USER3", ActivityStatus: models.TenantActivityStatusCOLD}},
+			errMsg:  "does not exist",
+		},
+		{
+			name:    "MissingActivityStatus",
+			class:   "C1",
+			tenants: []*models.Tenant{{Name: "USER1"}},
+			errMsg:  "missing activity status",
+		},
+		{
+			name:    "InvalidActivityStatus",
+			class:   "C1",
+			tenants: []*models.Tenant{{Name: "USER1", ActivityStatus: "WARM"}},
+			errMsg:  "invalid activity status",
+		},
+		{
+			name:    "IntermediateActivityStatus",
+			class:   "C1",
+			tenants: []*models.Tenant{{Name: "USER1", ActivityStatus: models.TenantActivityStatusOFFLOADING}},
+			errMsg:  "invalid activity status",
+		},
+		{
+			name:  "Success",
+			class: "C1",
+			tenants: []*models.Tenant{
+				{Name: "USER1", ActivityStatus: models.TenantActivityStatusCOLD},
+				{Name: "USER2", ActivityStatus: models.TenantActivityStatusHOT},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		sm := newSchemaManager()
+		class := &models.Class{
+			Class:              cls,
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+			Properties: []*models.Property{
+				{
+					Name:     "uUID",
+					DataType: schema.DataTypeText.PropString(),
+				},
+			},
+			ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+		}
+		if err := sm.AddClass(ctx, nil, class); err != nil {
+			t.Fatalf("%s: add class: %v", test.name, err)
+		}
+		if err := sm.AddTenants(ctx, nil, cls, tenants); err != nil {
+			t.Fatalf("%s: add tenants: %v", test.name, err)
+		}
+
+		err := sm.UpdateTenants(ctx, nil, test.class, test.tenants)
+		if test.errMsg != "" {
+			assert.ErrorContains(t, err, test.errMsg, test.name)
+			continue
+		}
+		require.Nil(t, err, test.name)
+
+		got, err := sm.GetTenants(ctx, nil, cls)
+		require.Nil(t, err, test.name)
+		want := []*models.Tenant{
+			{Name: "USER1", ActivityStatus: models.TenantActivityStatusCOLD},
+			{Name: "USER2", ActivityStatus: models.TenantActivityStatusHOT},
+		}
+		assert.ElementsMatch(t, want, got, test.name)
+	}
+}
+
+func TestUpdateTenantsOffload(t *testing.T) {
+	ctx := context.Background()
+	sm := newSchemaManager()
+	class := &models.Class{
+		Class:              "C1",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		ReplicationConfig:  &models.ReplicationConfig{Factor: 1},
+	}
+	require.Nil(t, sm.AddClass(ctx, nil, class))
+	require.Nil(t, sm.AddTenants(ctx, nil, "C1", []*models.Tenant{{Name: "USER1"}}))
+
+	status := func() string {
+		got, err := sm.GetTenants(ctx, nil, "C1")
+		if err != nil || len(got) != 1 {
+			return ""
+		}
+		return got[0].ActivityStatus
+	}
+
+	// the files are transferred in the background, then the final status is set
+	require.Nil(t, sm.UpdateTenants(ctx, nil, "C1", []*models.Tenant{
+		{Name: "USER1", ActivityStatus: models.TenantActivityStatusOFFLOADED},
+	}))
+	assert.Eventually(t, func() bool {
+		return status() == models.TenantActivityStatusOFFLOADED
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Nil(t, sm.UpdateTenants(ctx, nil, "C1", []*models.Tenant{
+		{Name: "USER1", ActivityStatus: models.TenantActivityStatusCOLD},
+	}))
+	assert.Eventually(t, func() bool {
+		return status() == models.TenantActivityStatusCOLD
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestTenantUpdate(t *testing.T) {
+	tests := []struct {
+		requested, current string
+		want               TenantUpdate
+		errMsg             string
+	}{
+		{
+			requested: models.TenantActivityStatusCOLD,
+			current:   models.TenantActivityStatusHOT,
+			want:      TenantUpdate{Name: "T", Status: models.TenantActivityStatusCOLD},
+		},
+		{
+			requested: models.TenantActivityStatusOFFLOADED,
+			current:   models.TenantActivityStatusCOLD,
+			want: TenantUpdate{
+				Name: "T", Status: models.TenantActivityStatusOFFLOADING,
+				Target: models.TenantActivityStatusCOLD,
+			},
+		},
+		{
+			requested: models.TenantActivityStatusHOT,
+			current:   models.TenantActivityStatusOFFLOADED,
+			want: TenantUpdate{
+				Name: "T", Status: models.TenantActivityStatusONLOADING,
+				Target: models.TenantActivityStatusHOT,
+			},
+		},
+		{
+			requested: models.TenantActivityStatusOFFLOADED,
+			current:   models.TenantActivityStatusOFFLOADED,
+			want:      TenantUpdate{Name: "T", Status: models.TenantActivityStatusOFFLOADED},
+		},
+		{
+			requested: models.TenantActivityStatusHOT,
+			current:   models.TenantActivityStatusOFFLOADING,
+			want:      TenantUpdate{Name: "T", Status: models.TenantActivityStatusHOT},
+		},
+		{
+			requested: models.TenantActivityStatusOFFLOADED,
+			current:   models.TenantActivityStatusONLOADING,
+			want:      TenantUpdate{Name: "T", Status: models.TenantActivityStatusOFFLOADED},
+		},
+		{
+			requested: models.TenantActivityStatusOFFLOADED,
+			current:   models.TenantActivityStatusOFFLOADING,
+			errMsg:    "already being offloaded",
+		},
+		{
+			requested: models.TenantActivityStatusCOLD,
+			current:   models.TenantActivityStatusONLOADING,
+			errMsg:    "already being onloaded",
+		},
+	}
+
+	for _, test := range tests {
+		name := test.current + "->" + test.requested
+		got, err := tenantUpdate("T", test.requested, test.current)
+		if test.errMsg != "" {
+			assert.ErrorContains(t, err, test.errMsg, name)
+			continue
+		}
+		require.Nil(t, err, name)
+		assert.Equal(t, test.want, got, name)
+	}
+}
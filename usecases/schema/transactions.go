@@ -26,12 +26,24 @@ const (
 	AddClass    cluster.TransactionType = "add_class"
 	AddProperty cluster.TransactionType = "add_property"
 
+	DeleteProperty cluster.TransactionType = "delete_property"
+	UpdateProperty cluster.TransactionType = "update_property"
+	RenameProperty cluster.TransactionType = "rename_property"
+
+	MergeObjectProperty cluster.TransactionType = "merge_object_property"
+
 	// tenant types
 	addTenants    cluster.TransactionType = "add_tenants"
+	updateTenants cluster.TransactionType = "update_tenants"
 	deleteTenants cluster.TransactionType = "delete_tenants"
 
+	// alias types
+	setAlias    cluster.TransactionType = "set_alias"
+	deleteAlias cluster.TransactionType = "delete_alias"
+
 	DeleteClass cluster.TransactionType = "delete_class"
 	UpdateClass cluster.TransactionType = "update_class"
+	RenameClass cluster.TransactionType = "rename_class"
 
 	// read-only
 	ReadSchema cluster.TransactionType = "read_schema"
@@ -49,10 +61,43 @@ type AddPropertyPayload struct {
 	Property  *models.Property `json:"property"`
 }
 
+type DeletePropertyPayload struct {
+	ClassName    string `json:"className"`
+	PropertyName string `json:"propertyName"`
+	Purge        bool   `json:"purge,omitempty"`
+}
+
+type UpdatePropertyPayload struct {
+	ClassName string           `json:"className"`
+	Property  *models.Property `json:"property"`
+}
+
+type MergeObjectPropertyPayload struct {
+	ClassName string           `json:"className"`
+	Property  *models.Property `json:"property"`
+}
+
+type RenamePropertyPayload struct {
+	ClassName    string `json:"className"`
+	PropertyName string `json:"propertyName"`
+	NewName      string `json:"newName"`
+}
+
 // Tenant represents properties of a specific tenant (physical shard)
 type Tenant struct {
-	Name  string   `json:"name"`
-	Nodes []string `json:"nodes"`
+	Name   string   `json:"name"`
+	Nodes  []string `json:"nodes"`
+	Status string   `json:"status,omitempty"`
+}
+
+// TenantUpdate represents the new activity status of a specific tenant.
+// Target is the status an OFFLOADING or ONLOADING tenant ends up with once
+// the transfer of its files is over: the status to revert to if offloading
+// fails, or the one requested when onloading.
+type TenantUpdate struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Target string `json:"target,omitempty"`
 }
 
 // AddTenantsPayload allows for adding multiple tenants to a class
@@ -61,12 +106,25 @@ type AddTenantsPayload struct {
 	Tenants []Tenant `json:"tenants"`
 }
 
+// UpdateTenantsPayload allows for updating multiple tenants of a class
+type UpdateTenantsPayload struct {
+	Class   string         `json:"class_name"`
+	Tenants []TenantUpdate `json:"tenants"`
+}
+
 // DeleteTenantsPayload allows for removing multiple tenants from a class
 type DeleteTenantsPayload struct {
 	Class   string   `json:"class_name"`
 	Tenants []string `json:"tenants"`
 }
 
+// AliasPayload points an alias to a class, the class is empty when the
+// alias is deleted
+type AliasPayload struct {
+	Alias string `json:"alias"`
+	Class string `json:"class,omitempty"`
+}
+
 type DeleteClassPayload struct {
 	ClassName string `json:"className"`
 }
@@ -81,6 +139,11 @@ type UpdateClassPayload struct {
 	State *sharding.State `json:"state"`
 }
 
+type RenameClassPayload struct {
+	ClassName string `json:"className"`
+	NewName   string `json:"newName"`
+}
+
 type ReadSchemaPayload struct {
 	Schema *State `json:"schema"`
 }
@@ -93,14 +156,28 @@ func UnmarshalTransaction(txType cluster.TransactionType,
 		return unmarshalRawJson[AddClassPayload](payload)
 	case AddProperty:
 		return unmarshalRawJson[AddPropertyPayload](payload)
+	case DeleteProperty:
+		return unmarshalRawJson[DeletePropertyPayload](payload)
+	case UpdateProperty:
+		return unmarshalRawJson[UpdatePropertyPayload](payload)
+	case RenameProperty:
+		return unmarshalRawJson[RenamePropertyPayload](payload)
+	case MergeObjectProperty:
+		return unmarshalRawJson[MergeObjectPropertyPayload](payload)
 	case DeleteClass:
 		return unmarshalRawJson[DeleteClassPayload](payload)
 	case UpdateClass:
 		return unmarshalRawJson[UpdateClassPayload](payload)
+	case RenameClass:
+		return unmarshalRawJson[RenameClassPayload](payload)
 	case ReadSchema:
 		return unmarshalRawJson[ReadSchemaPayload](payload)
 	case addTenants:
 		return unmarshalRawJson[AddTenantsPayload](payload)
+	case updateTenants:
+		return unmarshalRawJson[UpdateTenantsPayload](payload)
+	case setAlias, deleteAlias:
+		return unmarshalRawJson[AliasPayload](payload)
 	case deleteTenants:
 		return unmarshalRawJson[DeleteTenantsPayload](payload)
 	default:
@@ -38,6 +38,18 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 	if initial == nil {
 		return ErrNotFound
 	}
+
+	// a changed class name renames the class after all other changes were
+	// applied
+	newName := ""
+	if updated.Class != "" && schema.UppercaseClassName(updated.Class) != initial.Class {
+		newName = schema.UppercaseClassName(updated.Class)
+		if err := m.validateRenameClass(initial.Class, newName); err != nil {
+			return err
+		}
+		updated.Class = initial.Class
+	}
+
 	mtEnabled, err := validateUpdatingMT(initial, updated)
 	if err != nil {
 		return err
@@ -65,6 +77,14 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return errors.Wrap(err, "vector index config")
 	}
 
+	for targetVector, vectorConfig := range updated.VectorConfig {
+		if err := m.migrator.ValidateVectorIndexConfigUpdate(ctx,
+			initial.VectorConfig[targetVector].VectorIndexConfig.(schema.VectorIndexConfig),
+			vectorConfig.VectorIndexConfig.(schema.VectorIndexConfig)); err != nil {
+			return errors.Wrapf(err, "vector index config of named vector %q", targetVector)
+		}
+	}
+
 	if err := m.migrator.ValidateInvertedIndexConfigUpdate(ctx,
 		initial.InvertedIndexConfig, updated.InvertedIndexConfig); err != nil {
 		return errors.Wrap(err, "inverted index config")
@@ -103,7 +123,14 @@ func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
 		return errors.Wrap(err, "commit cluster-wide transaction")
 	}
 
-	return m.updateClassApplyChanges(ctx, className, updated, updatedState)
+	if err := m.updateClassApplyChanges(ctx, className, updated, updatedState); err != nil {
+		return err
+	}
+
+	if newName != "" {
+		return m.renameClass(ctx, updated.Class, newName)
+	}
+	return nil
 }
 
 // validateUpdatingMT validates toggling MT and returns whether mt is enabled
@@ -115,6 +142,8 @@ func validateUpdatingMT(current, update *models.Class) (enabled bool, err error)
 		} else {
 			err = fmt.Errorf("enabling multi-tenancy for an existing class is not supported")
 		}
+	} else if !enabled && update.MultiTenancyConfig != nil && update.MultiTenancyConfig.AutoTenantCreation {
+		err = fmt.Errorf("autoTenantCreation requires multiTenancyConfig to be enabled")
 	}
 	return
 }
@@ -145,6 +174,16 @@ func (m *Manager) updateClassApplyChanges(ctx context.Context, className string,
 		return errors.Wrap(err, "vector index config")
 	}
 
+	if len(updated.VectorConfig) > 0 {
+		vectorIndexConfigs := make(map[string]schema.VectorIndexConfig, len(updated.VectorConfig))
+		for targetVector, vectorConfig := range updated.VectorConfig {
+			vectorIndexConfigs[targetVector] = vectorConfig.VectorIndexConfig.(schema.VectorIndexConfig)
+		}
+		if err := m.migrator.UpdateVectorIndexConfigs(ctx, className, vectorIndexConfigs); err != nil {
+			return errors.Wrap(err, "named vector index configs")
+		}
+	}
+
 	if err := m.migrator.UpdateInvertedIndexConfig(ctx, className,
 		updated.InvertedIndexConfig); err != nil {
 		return errors.Wrap(err, "inverted index config")
@@ -221,6 +260,35 @@ func (m *Manager) validateImmutableFields(initial, updated *models.Class) error
 		return errors.Errorf("module config is immutable")
 	}
 
+	if err := validateImmutableNamedVectors(initial, updated); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateImmutableNamedVectors makes sure that named vectors are neither
+// added nor removed and that only their vector index config changes
+func validateImmutableNamedVectors(initial, updated *models.Class) error {
+	if len(initial.VectorConfig) != len(updated.VectorConfig) {
+		return errors.Errorf("named vectors are immutable: attempted change from %d to %d named vectors",
+			len(initial.VectorConfig), len(updated.VectorConfig))
+	}
+
+	for targetVector, initialConfig := range initial.VectorConfig {
+		updatedConfig, ok := updated.VectorConfig[targetVector]
+		if !ok {
+			return errors.Errorf("named vectors are immutable: named vector %q is missing", targetVector)
+		}
+		if !reflect.DeepEqual(initialConfig.Vectorizer, updatedConfig.Vectorizer) {
+			return errors.Errorf("vectorizer of named vector %q is immutable", targetVector)
+		}
+		if initialConfig.VectorIndexType != updatedConfig.VectorIndexType {
+			return errors.Errorf("vector index type of named vector %q is immutable: attempted change from %q to %q",
+				targetVector, initialConfig.VectorIndexType, updatedConfig.VectorIndexType)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,251 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// UpdateClassProperty changes the index settings (indexFilterable,
+// indexSearchable, indexPositions and tokenization) of an existing property. The affected
+// inverted indexes are rebuilt in the background on every shard. A changed
+// name renames the property. It returns the property as stored in the schema
+// after the update.
+func (m *Manager) UpdateClassProperty(ctx context.Context, principal *models.Principal,
+	className string, propName string, prop *models.Property,
+) (*models.Property, error) {
+	err := m.Authorizer.Authorize(principal, "update", "schema/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	return m.updateClassProperty(ctx, className, propName, prop)
+}
+
+func (m *Manager) updateClassProperty(ctx context.Context,
+	className, propName string, prop *models.Property,
+) (*models.Property, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	class, err := schema.GetClassByName(m.schemaCache.ObjectSchema, className)
+	if err != nil {
+		return nil, err
+	}
+	propName = schema.LowercaseFirstLetter(propName)
+	existing, err := schema.GetPropertyByName(class, propName)
+	if err != nil {
+		return nil, err
+	}
+
+	// a changed name renames the property after the index settings were
+	// updated
+	newName := ""
+	if prop != nil && prop.Name != "" && schema.LowercaseFirstLetter(prop.Name) != existing.Name {
+		newName = schema.LowercaseFirstLetter(prop.Name)
+		if err := m.validateRenameProperty(class, existing, newName); err != nil {
+			return nil, err
+		}
+		withoutName := *prop
+		withoutName.Name = ""
+		prop = &withoutName
+	}
+
+	if newName == "" || hasIndexSettings(prop) {
+		updated, err := m.mergePropertyIndexSettings(existing, prop)
+		if err != nil {
+			return nil, err
+		}
+		if propertyIndexSettingsChanged(existing, updated) {
+			// the indexes of offloaded tenants can not be rebuilt
+			if err := m.validateNoOffloadedTenants(className); err != nil {
+				return nil, fmt.Errorf("update property %q: %w", propName, err)
+			}
+			if err := m.updatePropertyIndexSettings(ctx, className, updated); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if newName != "" {
+		if err := m.renameClassProperty(ctx, className, propName, newName); err != nil {
+			return nil, err
+		}
+		propName = newName
+	}
+	return schema.GetPropertyByName(class, propName)
+}
+
+func (m *Manager) updatePropertyIndexSettings(ctx context.Context,
+	className string, updated *models.Property,
+) error {
+	tx, err := m.cluster.BeginTransaction(ctx, UpdateProperty,
+		UpdatePropertyPayload{className, updated}, DefaultTxTTL)
+	if err != nil {
+		// possible causes for errors could be nodes down (we expect every node to
+		// the up for a schema transaction) or concurrent transactions from other
+		// nodes
+		return errors.Wrap(err, "open cluster-wide transaction")
+	}
+
+	if err := m.cluster.CommitWriteTransaction(ctx, tx); err != nil {
+		// Only log the commit error, but do not abort the changes locally. See
+		// addClassProperty for the reasoning.
+		m.logger.WithError(err).Errorf("not every node was able to commit")
+	}
+
+	return m.updateClassPropertyApplyChanges(ctx, className, updated)
+}
+
+// mergePropertyIndexSettings returns a copy of the existing property with the
+// index settings of the update applied. Any other setting of the update has
+// to either be empty or match the existing property.
+func (m *Manager) mergePropertyIndexSettings(existing, update *models.Property,
+) (*models.Property, error) {
+	if update == nil {
+		return nil, fmt.Errorf("property update must not be empty")
+	}
+	if update.Name != "" && schema.LowercaseFirstLetter(update.Name) != existing.Name {
+		return nil, fmt.Errorf("property %q: name can not be changed", existing.Name)
+	}
+	if len(update.DataType) > 0 && !dataTypesEqual(update.DataType, existing.DataType) {
+		return nil, fmt.Errorf("property %q: dataType can not be changed", existing.Name)
+	}
+	if update.IndexInverted != nil {
+		return nil, fmt.Errorf("property %q: `indexInverted` is deprecated, "+
+			"use `indexFilterable` and `indexSearchable` instead", existing.Name)
+	}
+
+	sch := m.getSchema()
+	propertyDataType, err := (&sch).FindPropertyDataType(existing.DataType)
+	if err != nil {
+		return nil, fmt.Errorf("property %q: invalid dataType: %w", existing.Name, err)
+	}
+	if !propertyDataType.IsPrimitive() {
+		return nil, fmt.Errorf("property %q: index settings can only be changed "+
+			"for primitive data types", existing.Name)
+	}
+	if propertyDataType.AsPrimitive() == schema.DataTypeGeoCoordinates {
+		return nil, fmt.Errorf("property %q: index settings can not be changed "+
+			"for data type %q", existing.Name, schema.DataTypeGeoCoordinates)
+	}
+
+	updated := *existing
+	if update.IndexFilterable != nil {
+		updated.IndexFilterable = update.IndexFilterable
+	}
+	if update.IndexSearchable != nil {
+		updated.IndexSearchable = update.IndexSearchable
+	}
+	if update.IndexPositions != nil {
+		updated.IndexPositions = update.IndexPositions
+	}
+	if update.Tokenization != "" {
+		updated.Tokenization = update.Tokenization
+	}
+	if updated.IndexInverted != nil && (update.IndexFilterable != nil || update.IndexSearchable != nil) {
+		// property was created before filterable and searchable indexes were
+		// split, keep the setting which was not changed
+		vFalse := false
+		if updated.IndexFilterable == nil {
+			updated.IndexFilterable = updated.IndexInverted
+		}
+		if updated.IndexSearchable == nil {
+			updated.IndexSearchable = &vFalse
+			if dt := propertyDataType.AsPrimitive(); dt == schema.DataTypeText || dt == schema.DataTypeTextArray {
+				updated.IndexSearchable = updated.IndexInverted
+			}
+		}
+		updated.IndexInverted = nil
+	}
+
+	if err := m.validatePropertyTokenization(updated.Tokenization, propertyDataType); err != nil {
+		return nil, fmt.Errorf("property %q: %w", existing.Name, err)
+	}
+	if err := m.validatePropertyIndexing(&updated); err != nil {
+		return nil, fmt.Errorf("property %q: %w", existing.Name, err)
+	}
+	return &updated, nil
+}
+
+// hasIndexSettings returns whether the update contains any setting besides
+// the name of the property
+func hasIndexSettings(update *models.Property) bool {
+	return update.IndexFilterable != nil || update.IndexSearchable != nil ||
+		update.IndexPositions != nil || update.IndexInverted != nil || update.Tokenization != "" || len(update.DataType) > 0
+}
+
+func dataTypesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func propertyIndexSettingsChanged(existing, updated *models.Property) bool {
+	boolChanged := func(a, b *bool) bool {
+		return (a == nil) != (b == nil) || (a != nil && *a != *b)
+	}
+	return existing.Tokenization != updated.Tokenization ||
+		boolChanged(existing.IndexInverted, updated.IndexInverted) ||
+		boolChanged(existing.IndexFilterable, updated.IndexFilterable) ||
+		boolChanged(existing.IndexSearchable, updated.IndexSearchable) ||
+		boolChanged(existing.IndexPositions, updated.IndexPositions)
+}
+
+func (m *Manager) updateClassPropertyApplyChanges(ctx context.Context,
+	className string, updated *models.Property,
+) error {
+	class, err := schema.GetClassByName(m.schemaCache.ObjectSchema, className)
+	if err != nil {
+		return err
+	}
+
+	var old *models.Property
+	for i, prop := range class.Properties {
+		if prop.Name == updated.Name {
+			old = prop
+			class.Properties[i] = updated
+			break
+		}
+	}
+	if old == nil {
+		return fmt.Errorf("property %q not found in class %q", updated.Name, className)
+	}
+
+	metadata, err := json.Marshal(&class)
+	if err != nil {
+		return fmt.Errorf("marshal class %s: %w", className, err)
+	}
+	m.logger.
+		WithField("action", "schema.update_property").
+		Debug("saving updated schema to configuration store")
+	err = m.repo.UpdateClass(ctx, ClassPayload{Name: className, Metadata: metadata})
+	if err != nil {
+		return err
+	}
+	m.triggerSchemaUpdateCallbacks()
+
+	// will result in a mismatch between schema and index if function below fails
+	return m.migrator.UpdatePropertyIndexes(ctx, className, old, updated)
+}
@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestUpdateClassProperty(t *testing.T) {
+	vTrue := true
+	vFalse := false
+	ctx := context.Background()
+
+	newManager := func(t *testing.T) *Manager {
+		m := newSchemaManager()
+		err := m.AddClass(ctx, nil, &models.Class{
+			Class: "Car",
+			Properties: []*models.Property{
+				{Name: "name", DataType: schema.DataTypeText.PropString()},
+				{Name: "horsepower", DataType: schema.DataTypeInt.PropString()},
+				{Name: "location", DataType: schema.DataTypeGeoCoordinates.PropString()},
+			},
+		})
+		require.Nil(t, err)
+		return m
+	}
+
+	t.Run("changing tokenization and indexes of a text property", func(t *testing.T) {
+		m := newManager(t)
+
+		prop, err := m.UpdateClassProperty(ctx, nil, "Car", "name", &models.Property{
+			Tokenization:    models.PropertyTokenizationField,
+			IndexSearchable: &vFalse,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, models.PropertyTokenizationField, prop.Tokenization)
+		assert.False(t, *prop.IndexSearchable)
+		assert.True(t, *prop.IndexFilterable)
+
+		stored, err := schema.GetPropertyByName(m.getClassByName("Car"), "name")
+		require.Nil(t, err)
+		assert.Equal(t, prop, stored)
+	})
+
+	t.Run("disabling the filterable index of an int property", func(t *testing.T) {
+		m := newManager(t)
+
+		prop, err := m.UpdateClassProperty(ctx, nil, "Car", "horsepower", &models.Property{
+			IndexFilterable: &vFalse,
+		})
+		require.Nil(t, err)
+		assert.False(t, *prop.IndexFilterable)
+	})
+
+	t.Run("enabling token positions of a text property", func(t *testing.T) {
+		m := newManager(t)
+
+		prop, err := m.UpdateClassProperty(ctx, nil, "Car", "name", &models.Property{
+			IndexPositions: &vTrue,
+		})
+		require.Nil(t, err)
+		assert.True(t, *prop.IndexPositions)
+	})
+
+	t.Run("renaming a property", func(t *testing.T) {
+		m := newManager(t)
+
+		prop, err := m.UpdateClassProperty(ctx, nil, "Car", "name", &models.Property{
+			Name:            "Title",
+			IndexSearchable: &vFalse,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "title", prop.Name)
+		assert.False(t, *prop.IndexSearchable)
+
+		class := m.getClassByName("Car")
+		_, err = schema.GetPropertyByName(class, "name")
+		assert.NotNil(t, err)
+		stored, err := schema.GetPropertyByName(class, "title")
+		require.Nil(t, err)
+		assert.Equal(t, prop, stored)
+	})
+
+	t.Run("invalid updates", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			propName string
+			update   *models.Property
+			errMsg   string
+		}{
+			{
+				name:     "unknown property",
+				propName: "color",
+				update:   &models.Property{IndexFilterable: &vFalse},
+				errMsg:   "no such prop",
+			},
+			{
+				name:     "renaming to the name of another property",
+				propName: "name",
+				update:   &models.Property{Name: "Horsepower"},
+				errMsg:   "conflict for property \"horsepower\"",
+			},
+			{
+				name:     "renaming to a reserved name",
+				propName: "name",
+				update:   &models.Property{Name: "id"},
+				errMsg:   "is a reserved property name",
+			},
+			{
+				name:     "renaming a geo coordinates property",
+				propName: "location",
+				update:   &models.Property{Name: "position"},
+				errMsg:   "renaming is not supported",
+			},
+			{
+				name:     "changing the data type",
+				propName: "name",
+				update:   &models.Property{DataType: schema.DataTypeInt.PropString()},
+				errMsg:   "dataType can not be changed",
+			},
+			{
+				name:     "searchable index on int",
+				propName: "horsepower",
+				update:   &models.Property{IndexSearchable: &vTrue},
+				errMsg:   "`indexSearchable` is allowed only for text/text[] data types",
+			},
+			{
+				name:     "token positions on int",
+				propName: "horsepower",
+				update:   &models.Property{IndexPositions: &vTrue},
+				errMsg:   "`indexPositions` is allowed only for text/text[] data types",
+			},
+			{
+				name:     "token positions without searchable index",
+				propName: "name",
+				update:   &models.Property{IndexPositions: &vTrue, IndexSearchable: &vFalse},
+				errMsg:   "`indexPositions` requires `indexSearchable` to be enabled",
+			},
+			{
+				name:     "tokenization on int",
+				propName: "horsepower",
+				update:   &models.Property{Tokenization: models.PropertyTokenizationWord},
+				errMsg:   "Tokenization is not allowed for data type 'int'",
+			},
+			{
+				name:     "geo coordinates",
+				propName: "location",
+				update:   &models.Property{IndexFilterable: &vFalse},
+				errMsg:   "can not be changed for data type",
+			},
+			{
+				name:     "deprecated indexInverted",
+				propName: "name",
+				update:   &models.Property{IndexInverted: &vFalse},
+				errMsg:   "`indexInverted` is deprecated",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				m := newManager(t)
+
+				_, err := m.UpdateClassProperty(ctx, nil, "Car", test.propName, test.update)
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.errMsg)
+			})
+		}
+	})
+}
+
+func TestUpdateClassPropertyWithTenants(t *testing.T) {
+	ctx := context.Background()
+
+	newManager := func(t *testing.T) *Manager {
+		m := newSchemaManager()
+		require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+			Class:              "Car",
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+			Properties: []*models.Property{
+				{Name: "color", DataType: schema.DataTypeText.PropString()},
+			},
+			ReplicationConfig: &models.ReplicationConfig{Factor: 1},
+		}))
+		require.Nil(t, m.AddTenants(ctx, nil, "Car", []*models.Tenant{
+			{Name: "hot", ActivityStatus: models.TenantActivityStatusHOT},
+			{Name: "cold", ActivityStatus: models.TenantActivityStatusCOLD},
+		}))
+		return m
+	}
+
+	t.Run("update with hot and cold tenants", func(t *testing.T) {
+		m := newManager(t)
+
+		prop, err := m.UpdateClassProperty(ctx, nil, "Car", "color", &models.Property{
+			Tokenization: models.PropertyTokenizationField,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, models.PropertyTokenizationField, prop.Tokenization)
+	})
+
+	t.Run("update with an offloaded tenant", func(t *testing.T) {
+		m := newManager(t)
+		require.Nil(t, m.UpdateTenants(ctx, nil, "Car", []*models.Tenant{
+			{Name: "cold", ActivityStatus: models.TenantActivityStatusOFFLOADED},
+		}))
+
+		_, err := m.UpdateClassProperty(ctx, nil, "Car", "color", &models.Property{
+			Tokenization: models.PropertyTokenizationField,
+		})
+		assert.ErrorContains(t, err, `tenant "cold" is offloaded`)
+		stored, err := schema.GetPropertyByName(m.getClassByName("Car"), "color")
+		require.Nil(t, err)
+		assert.Equal(t, models.PropertyTokenizationWord, stored.Tokenization)
+	})
+}
@@ -44,12 +44,16 @@ func TestClassUpdates(t *testing.T) {
 
 		tests := []test{
 			{
-				name:    "attempting a name change",
-				initial: &models.Class{Class: "InitialName"},
-				update:  &models.Class{Class: "UpdatedName"},
-				expectedError: errors.Errorf(
-					"class name is immutable: " +
-						"attempted change from \"InitialName\" to \"UpdatedName\""),
+				name:          "renaming the class",
+				initial:       &models.Class{Class: "InitialName"},
+				update:        &models.Class{Class: "UpdatedName"},
+				expectedError: nil,
+			},
+			{
+				name:          "renaming the class to an invalid name",
+				initial:       &models.Class{Class: "InitialName"},
+				update:        &models.Class{Class: "Updated-Name"},
+				expectedError: errors.Errorf("'Updated-Name' is not a valid class name"),
 			},
 			{
 				name:    "attempting to modify the vectorizer",
@@ -350,6 +354,55 @@ func TestClassUpdates(t *testing.T) {
 		})
 	})
 
+	t.Run("update named vector index config", func(t *testing.T) {
+		sm := newSchemaManager()
+		migrator := &configMigrator{}
+		sm.migrator = migrator
+
+		namedVectorClass := func(vectorizer string, setting string) *models.Class {
+			return &models.Class{
+				Class: "ClassWithNamedVectors",
+				VectorConfig: map[string]models.VectorConfig{
+					"title": {
+						Vectorizer:      map[string]interface{}{vectorizer: map[string]interface{}{}},
+						VectorIndexType: "hnsw",
+						VectorIndexConfig: map[string]interface{}{
+							"setting-1": setting,
+						},
+					},
+				},
+			}
+		}
+
+		t.Run("create an initial class", func(t *testing.T) {
+			err := sm.AddClass(context.Background(), nil, namedVectorClass("none", "value-1"))
+			require.Nil(t, err)
+		})
+
+		t.Run("attempt to change the vectorizer of a named vector", func(t *testing.T) {
+			err := sm.UpdateClass(context.Background(), nil, "ClassWithNamedVectors",
+				namedVectorClass("text2vec-contextionary", "value-1"))
+			require.NotNil(t, err)
+			assert.Equal(t, "vectorizer of named vector \"title\" is immutable", err.Error())
+		})
+
+		t.Run("update the vector index config of a named vector", func(t *testing.T) {
+			err := sm.UpdateClass(context.Background(), nil, "ClassWithNamedVectors",
+				namedVectorClass("none", "updated-value"))
+			require.Nil(t, err)
+
+			expected := map[string]schema.VectorIndexConfig{
+				"title": fakeVectorConfig{
+					raw: map[string]interface{}{
+						"distance":  "cosine",
+						"setting-1": "updated-value",
+					},
+				},
+			}
+			assert.Equal(t, expected, migrator.vectorConfigsUpdateCalledWith)
+		})
+	})
+
 	t.Run("update sharding config", func(t *testing.T) {
 		t.Run("with a validation error (immutable field)", func(t *testing.T) {
 			sm := newSchemaManager()
@@ -386,6 +439,7 @@ type configMigrator struct {
 	vectorConfigValidateCalledWith schema.VectorIndexConfig
 	vectorConfigUpdateCalled       bool
 	vectorConfigUpdateCalledWith   schema.VectorIndexConfig
+	vectorConfigsUpdateCalledWith  map[string]schema.VectorIndexConfig
 }
 
 func (m *configMigrator) ValidateVectorIndexConfigUpdate(ctx context.Context,
@@ -402,3 +456,10 @@ func (m *configMigrator) UpdateVectorIndexConfig(ctx context.Context,
 	m.vectorConfigUpdateCalled = true
 	return nil
 }
+
+func (m *configMigrator) UpdateVectorIndexConfigs(ctx context.Context,
+	className string, updated map[string]schema.VectorIndexConfig,
+) error {
+	m.vectorConfigsUpdateCalledWith = updated
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -34,6 +35,11 @@ func (m *Manager) validateClassNameUniqueness(className string) error {
 			return fmt.Errorf("class name %q already exists", className)
 		}
 	}
+	for alias := range m.schemaCache.Aliases {
+		if strings.EqualFold(className, alias) {
+			return fmt.Errorf("class name %q already exists as an alias", className)
+		}
+	}
 
 	return nil
 }
@@ -58,7 +64,10 @@ func (m *Manager) validatePropertyTokenization(tokenization string, propertyData
 		case schema.DataTypeText, schema.DataTypeTextArray:
 			switch tokenization {
 			case models.PropertyTokenizationField, models.PropertyTokenizationWord,
-				models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase:
+				models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase,
+				models.PropertyTokenizationASCIIFolding, models.PropertyTokenizationSnowballEnglish,
+				models.PropertyTokenizationSnowballGerman, models.PropertyTokenizationSnowballFrench,
+				models.PropertyTokenizationCjkBigram:
 				return nil
 			}
 		default:
@@ -73,9 +82,110 @@ func (m *Manager) validatePropertyTokenization(tokenization string, propertyData
 	if tokenization == "" {
 		return nil
 	}
+	if propertyDataType.IsNested() {
+		return fmt.Errorf("Tokenization is not allowed for object data type")
+	}
 	return fmt.Errorf("Tokenization is not allowed for reference data type")
 }
 
+// nestedLeafDataTypes are the data types allowed for nested properties, apart
+// from object and object[] themselves
+var nestedLeafDataTypes = map[schema.DataType]bool{
+	schema.DataTypeText: true, schema.DataTypeTextArray: true,
+	schema.DataTypeInt: true, schema.DataTypeIntArray: true,
+	schema.DataTypeNumber: true, schema.DataTypeNumberArray: true,
+	schema.DataTypeBoolean: true, schema.DataTypeBooleanArray: true,
+	schema.DataTypeDate: true, schema.DataTypeDateArray: true,
+	schema.DataTypeUUID: true, schema.DataTypeUUIDArray: true,
+}
+
+// validateNestedProperties makes sure that nested properties are set for
+// object and object[] properties only, and validates them recursively
+func (m *Manager) validateNestedProperties(prop *models.Property,
+	propertyDataType schema.PropertyDataType,
+) error {
+	if !propertyDataType.IsNested() {
+		if len(prop.NestedProperties) > 0 {
+			return fmt.Errorf("property '%s': nestedProperties are allowed only for "+
+				"object/object[] data types", prop.Name)
+		}
+		return nil
+	}
+
+	return m.validateNestedPropertyList(prop.Name, prop.NestedProperties)
+}
+
+func (m *Manager) validateNestedPropertyList(path string,
+	nestedProps []*models.NestedProperty,
+) error {
+	if len(nestedProps) == 0 {
+		return fmt.Errorf("property '%s': nestedProperties must be set for "+
+			"object/object[] data types", path)
+	}
+
+	existingNames := map[string]bool{}
+	for _, nestedProp := range nestedProps {
+		nestedPath := path + schema.NestedPropertySeparator + nestedProp.Name
+		if _, err := schema.ValidatePropertyName(nestedProp.Name); err != nil {
+			return fmt.Errorf("property '%s': %v", path, err)
+		}
+		if existingNames[strings.ToLower(nestedProp.Name)] {
+			return fmt.Errorf("property '%s': conflict for nested property %q: provided multiple times",
+				path, nestedProp.Name)
+		}
+		existingNames[strings.ToLower(nestedProp.Name)] = true
+
+		if len(nestedProp.DataType) != 1 {
+			return fmt.Errorf("property '%s': invalid dataType: nested properties "+
+				"need exactly one data type", nestedPath)
+		}
+
+		if _, ok := schema.AsNested(nestedProp.DataType); ok {
+			if nestedProp.Tokenization != "" {
+				return fmt.Errorf("property '%s': Tokenization is not allowed for object data type",
+					nestedPath)
+			}
+			if nestedProp.IndexSearchable != nil && *nestedProp.IndexSearchable {
+				return fmt.Errorf("property '%s': `indexSearchable` is allowed only for "+
+					"text/text[] data types", nestedPath)
+			}
+			if err := m.validateNestedPropertyList(nestedPath, nestedProp.NestedProperties); err != nil {
+				return err
+			}
+			continue
+		}
+
+		dataType := schema.DataType(nestedProp.DataType[0])
+		if !nestedLeafDataTypes[dataType] {
+			return fmt.Errorf("property '%s': invalid dataType: data type '%s' is not "+
+				"allowed for nested properties", nestedPath, dataType)
+		}
+		if len(nestedProp.NestedProperties) > 0 {
+			return fmt.Errorf("property '%s': nestedProperties are allowed only for "+
+				"object/object[] data types", nestedPath)
+		}
+
+		propertyDataType, err := (&schema.Schema{}).FindPropertyDataTypeWithRefs(
+			nestedProp.DataType, false, "")
+		if err != nil {
+			return fmt.Errorf("property '%s': invalid dataType: %v", nestedPath, err)
+		}
+		if err := m.validatePropertyTokenization(nestedProp.Tokenization, propertyDataType); err != nil {
+			return fmt.Errorf("property '%s': %v", nestedPath, err)
+		}
+		if err := m.validatePropertyIndexing(&models.Property{
+			DataType:        nestedProp.DataType,
+			IndexFilterable: nestedProp.IndexFilterable,
+			IndexSearchable: nestedProp.IndexSearchable,
+			IndexPositions:  nestedProp.IndexPositions,
+		}); err != nil {
+			return fmt.Errorf("property '%s': %v", nestedPath, err)
+		}
+	}
+
+	return nil
+}
+
 func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 	if prop.IndexInverted != nil {
 		if prop.IndexFilterable != nil || prop.IndexSearchable != nil {
@@ -99,6 +209,20 @@ func (m *Manager) validatePropertyIndexing(prop *models.Property) error {
 		}
 	}
 
+	if prop.IndexPositions != nil && *prop.IndexPositions {
+		switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
+		case schema.DataTypeString, schema.DataTypeStringArray,
+			schema.DataTypeText, schema.DataTypeTextArray:
+			if (prop.IndexSearchable != nil && !*prop.IndexSearchable) ||
+				(prop.IndexInverted != nil && !*prop.IndexInverted) {
+				return fmt.Errorf("`indexPositions` requires `indexSearchable` to be enabled")
+			}
+		default:
+			return fmt.Errorf("`indexPositions` is allowed only for text/text[] data types. " +
+				"For other data types set false or leave empty")
+		}
+	}
+
 	return nil
 }
 
@@ -111,9 +235,49 @@ func (m *Manager) validateVectorSettings(ctx context.Context, class *models.Clas
 		return err
 	}
 
+	for name, vectorConfig := range class.VectorConfig {
+		if err := m.validateNamedVector(class, name, vectorConfig); err != nil {
+			return errors.Wrapf(err, "named vector %q", name)
+		}
+	}
+
 	return nil
 }
 
+func (m *Manager) validateNamedVector(class *models.Class, name string,
+	vectorConfig models.VectorConfig,
+) error {
+	if err := schema.ValidateNamedVectorName(name); err != nil {
+		return err
+	}
+
+	moduleName, settings, err := schema.NamedVectorVectorizer(vectorConfig)
+	if err != nil {
+		return err
+	}
+
+	if moduleName != config.VectorizerModuleNone {
+		if err := m.vectorizerValidator.ValidateVectorizer(moduleName); err != nil {
+			return errors.Wrap(err, "vectorizer")
+		}
+	}
+
+	for _, propName := range schema.NamedVectorSourceProperties(settings) {
+		if _, err := schema.GetPropertyByName(class, propName); err != nil {
+			return errors.Errorf("source property %q does not exist", propName)
+		}
+	}
+
+	switch vectorConfig.VectorIndexType {
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT,
+		vectorindex.VectorIndexTypeDYNAMIC:
+		return nil
+	default:
+		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
+			vectorConfig.VectorIndexType)
+	}
+}
+
 func (m *Manager) validateVectorizer(ctx context.Context, class *models.Class) error {
 	if class.Vectorizer == config.VectorizerModuleNone {
 		return nil
@@ -128,7 +292,8 @@ func (m *Manager) validateVectorizer(ctx context.Context, class *models.Class) e
 
 func (m *Manager) validateVectorIndex(ctx context.Context, class *models.Class) error {
 	switch class.VectorIndexType {
-	case "hnsw":
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT,
+		vectorindex.VectorIndexTypeDYNAMIC:
 		return nil
 	default:
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
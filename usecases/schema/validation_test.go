@@ -556,6 +556,14 @@ func (pdt *fakePropertyDataType) IsReference() bool {
 	return !pdt.IsPrimitive()
 }
 
+func (pdt *fakePropertyDataType) IsNested() bool {
+	return false
+}
+
+func (pdt *fakePropertyDataType) AsNested() schema.DataType {
+	return ""
+}
+
 func (pdt *fakePropertyDataType) Classes() []schema.ClassName {
 	if pdt.IsPrimitive() {
 		return nil
@@ -566,3 +574,74 @@ func (pdt *fakePropertyDataType) Classes() []schema.ClassName {
 func (pdt *fakePropertyDataType) ContainsClass(name schema.ClassName) bool {
 	return false
 }
+
+func Test_Validation_NamedVectors(t *testing.T) {
+	class := &models.Class{
+		Class: "Product",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+
+	type testCase struct {
+		name           string
+		vectorName     string
+		vectorConfig   models.VectorConfig
+		expectedErrMsg string
+	}
+
+	testCases := []testCase{
+		{
+			name:       "valid named vector",
+			vectorName: "title_vector",
+			vectorConfig: models.VectorConfig{
+				Vectorizer: map[string]interface{}{
+					"none": map[string]interface{}{"properties": []interface{}{"title"}},
+				},
+				VectorIndexType: "hnsw",
+			},
+		},
+		{
+			name:       "invalid name",
+			vectorName: "title-vector",
+			vectorConfig: models.VectorConfig{
+				Vectorizer:      map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType: "hnsw",
+			},
+			expectedErrMsg: "is not a valid vector name",
+		},
+		{
+			name:       "missing source property",
+			vectorName: "body_vector",
+			vectorConfig: models.VectorConfig{
+				Vectorizer: map[string]interface{}{
+					"none": map[string]interface{}{"properties": []interface{}{"body"}},
+				},
+				VectorIndexType: "hnsw",
+			},
+			expectedErrMsg: "source property \"body\" does not exist",
+		},
+		{
+			name:       "unsupported vector index type",
+			vectorName: "title_vector",
+			vectorConfig: models.VectorConfig{
+				Vectorizer:      map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType: "unknown",
+			},
+			expectedErrMsg: "unrecognized or unsupported vectorIndexType \"unknown\"",
+		},
+	}
+
+	mgr := newSchemaManager()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := mgr.validateNamedVector(class, tc.vectorName, tc.vectorConfig)
+			if tc.expectedErrMsg == "" {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), tc.expectedErrMsg)
+		})
+	}
+}
@@ -74,7 +74,7 @@ type RemoteIndexClient interface {
 	MultiGetObjects(ctx context.Context, hostname, indexName, shardName string,
 		ids []strfmt.UUID) ([]*storobj.Object, error)
 	SearchShard(ctx context.Context, hostname, indexName, shardName string,
-		searchVector []float32, limit int, filters *filters.LocalFilter,
+		searchVector []float32, targetVector string, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 		additional additional.Properties,
@@ -237,7 +237,7 @@ func (ri *RemoteIndex) MultiGetObjects(ctx context.Context, shardName string,
 }
 
 func (ri *RemoteIndex) SearchShard(ctx context.Context, shardName string,
-	searchVector []float32, limit int, filters *filters.LocalFilter,
+	searchVector []float32, targetVector string, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	additional additional.Properties, replEnabled bool,
@@ -252,7 +252,7 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shardName string,
 		return nil, nil, errors.Errorf("resolve node name %q to host", owner)
 	}
 
-	objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shardName, searchVector, limit,
+	objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shardName, searchVector, targetVector, limit,
 		filters, keywordRanking, sort, cursor, groupBy, additional)
 	if replEnabled {
 		storobj.AddOwnership(objs, owner, shardName)
@@ -20,6 +20,7 @@ import (
 
 type RemoteNodeClient interface {
 	GetNodeStatus(ctx context.Context, hostName string, className string) (*models.NodeStatus, error)
+	GetShardsStatus(ctx context.Context, hostName string, className string, shards []string) ([]*models.NodeShardStatus, error)
 }
 
 type RemoteNode struct {
@@ -41,3 +42,13 @@ func (rn *RemoteNode) GetNodeStatus(ctx context.Context, nodeName string, classN
 	}
 	return rn.client.GetNodeStatus(ctx, host, className)
 }
+
+func (rn *RemoteNode) GetShardsStatus(ctx context.Context, nodeName string, className string,
+	shards []string,
+) ([]*models.NodeShardStatus, error) {
+	host, ok := rn.nodeResolver.NodeHostname(nodeName)
+	if !ok {
+		return nil, fmt.Errorf("resolve node name %q to host", nodeName)
+	}
+	return rn.client.GetShardsStatus(ctx, host, className, shards)
+}
@@ -19,6 +19,7 @@ import (
 
 type RemoteNodeIncomingRepo interface {
 	IncomingGetNodeStatus(ctx context.Context, className string) (*models.NodeStatus, error)
+	IncomingGetShardsStatus(ctx context.Context, className string, shards []string) ([]*models.NodeShardStatus, error)
 }
 
 type RemoteNodeIncoming struct {
@@ -34,3 +35,9 @@ func NewRemoteNodeIncoming(repo RemoteNodeIncomingRepo) *RemoteNodeIncoming {
 func (rni *RemoteNodeIncoming) GetNodeStatus(ctx context.Context, className string) (*models.NodeStatus, error) {
 	return rni.repo.IncomingGetNodeStatus(ctx, className)
 }
+
+func (rni *RemoteNodeIncoming) GetShardsStatus(ctx context.Context, className string,
+	shards []string,
+) ([]*models.NodeShardStatus, error) {
+	return rni.repo.IncomingGetShardsStatus(ctx, className, shards)
+}
@@ -18,6 +18,8 @@ import (
 	"sort"
 
 	"github.com/spaolacci/murmur3"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/cluster"
 )
 
@@ -63,6 +65,27 @@ type Physical struct {
 
 	LegacyBelongsToNodeForBackwardCompat string   `json:"belongsToNode,omitempty"`
 	BelongsToNodes                       []string `json:"belongsToNodes,omitempty"`
+
+	Status string `json:"status,omitempty"`
+}
+
+// ActivityStatus returns the activity status of the physical shard.
+// Shards persisted without a status are considered HOT
+func (p Physical) ActivityStatus() string {
+	return schema.ActivityStatus(p.Status)
+}
+
+// IsOffloaded returns whether the files of the shard are stored in the
+// offload backend, or are being transferred from or to it. In each case they
+// cannot be relied on to be available locally.
+func (p Physical) IsOffloaded() bool {
+	switch p.ActivityStatus() {
+	case models.TenantActivityStatusOFFLOADED, models.TenantActivityStatusOFFLOADING,
+		models.TenantActivityStatusONLOADING:
+		return true
+	default:
+		return false
+	}
 }
 
 // BelongsToNode for backward-compatibility when there was no replication. It
@@ -328,11 +351,12 @@ func (s *State) GetPartitions(nodes nodes, shards []string, replFactor int64) (m
 }
 
 // AddPartition to physical shards
-func (s *State) AddPartition(name string, nodes []string) Physical {
+func (s *State) AddPartition(name string, nodes []string, status string) Physical {
 	p := Physical{
 		Name:           name,
 		BelongsToNodes: nodes,
 		OwnsPercentage: 1.0,
+		Status:         status,
 	}
 	s.Physical[name] = p
 	return p
@@ -488,6 +512,7 @@ func (p Physical) DeepCopy() Physical {
 		OwnsVirtual:    ownsVirtualCopy,
 		OwnsPercentage: p.OwnsPercentage,
 		BelongsToNodes: belongsCopy,
+		Status:         p.Status,
 	}
 }
 
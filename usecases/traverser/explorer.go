@@ -58,7 +58,7 @@ type ModulesProvider interface {
 	ValidateSearchParam(name string, value interface{}, className string) error
 	CrossClassValidateSearchParam(name string, value interface{}) error
 	VectorFromSearchParam(ctx context.Context, className string, param string,
-		params interface{}, findVectorFn modulecapabilities.FindVectorFn, tenant string) ([]float32, error)
+		params interface{}, findVectorFn modulecapabilities.FindVectorFn, tenant, targetVector string) ([]float32, error)
 	CrossClassVectorFromSearchParam(ctx context.Context, param string,
 		params interface{}, findVectorFn modulecapabilities.FindVectorFn) ([]float32, error)
 	GetExploreAdditionalExtend(ctx context.Context, in []search.Result,
@@ -67,7 +67,7 @@ type ModulesProvider interface {
 	ListExploreAdditionalExtend(ctx context.Context, in []search.Result,
 		moduleParams map[string]interface{},
 		argumentModuleParams map[string]interface{}) ([]search.Result, error)
-	VectorFromInput(ctx context.Context, className string, input string) ([]float32, error)
+	VectorFromInput(ctx context.Context, className, input, targetVector string) ([]float32, error)
 }
 
 type objectsSearcher interface {
@@ -90,7 +90,7 @@ type objectsSearcher interface {
 
 type hybridSearcher interface {
 	SparseObjectSearch(ctx context.Context, params dto.GetParams) ([]*storobj.Object, []float32, error)
-	DenseObjectSearch(context.Context, string, []float32, int, int,
+	DenseObjectSearch(context.Context, string, []float32, string, int, int,
 		*filters.LocalFilter, additional.Properties, string) ([]*storobj.Object, []float32, error)
 	ResolveReferences(ctx context.Context, objs search.Results, props search.SelectProperties,
 		groupBy *searchparams.GroupBy, additional additional.Properties, tenant string) (search.Results, error)
@@ -141,6 +141,15 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return e.getClassKeywordBased(ctx, params)
 	}
 
+	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 ||
+		params.HybridSearch != nil {
+		targetVector, err := e.targetVectorFromParams(params)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid 'targetVectors' parameter")
+		}
+		params.TargetVector = targetVector
+	}
+
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
 		return e.getClassVectorSearch(ctx, params)
 	}
@@ -269,7 +278,7 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 			hybridSearchLimit = hybrid.DefaultLimit
 		}
 		res, dists, err := e.searcher.DenseObjectSearch(ctx,
-			params.ClassName, vec, 0, hybridSearchLimit, params.Filters,
+			params.ClassName, vec, params.TargetVector, 0, hybridSearchLimit, params.Filters,
 			params.AdditionalProperties, params.Tenant)
 		if err != nil {
 			return nil, nil, err
@@ -292,6 +301,7 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 		Keyword:      params.KeywordRanking,
 		Class:        params.ClassName,
 		Autocut:      params.Pagination.Autocut,
+		TargetVector: params.TargetVector,
 	}, e.logger, sparseSearch, denseSearch,
 		postProcess, e.modulesProvider)
 
@@ -628,7 +638,50 @@ func (e *Explorer) vectorFromParams(ctx context.Context,
 	params dto.GetParams,
 ) ([]float32, error) {
 	return e.nearParamsVector.vectorFromParams(ctx, params.NearVector,
-		params.NearObject, params.ModuleParams, params.ClassName, params.Tenant)
+		params.NearObject, params.ModuleParams, params.ClassName, params.Tenant, params.TargetVector)
+}
+
+// targetVectorFromParams returns the named vector to be searched, an empty
+// target vector refers to the class level vector
+func (e *Explorer) targetVectorFromParams(params dto.GetParams) (string, error) {
+	var targetVectors []string
+	if params.NearVector != nil {
+		targetVectors = append(targetVectors, params.NearVector.TargetVectors...)
+	}
+	if params.NearObject != nil {
+		targetVectors = append(targetVectors, params.NearObject.TargetVectors...)
+	}
+	if params.HybridSearch != nil {
+		targetVectors = append(targetVectors, params.HybridSearch.TargetVectors...)
+	}
+	for _, value := range params.ModuleParams {
+		if param, ok := value.(modulecapabilities.TargetVectorsParam); ok {
+			targetVectors = append(targetVectors, param.GetTargetVectors()...)
+		}
+	}
+
+	switch len(targetVectors) {
+	case 0:
+		return "", nil
+	case 1:
+	default:
+		return "", errors.Errorf("multiple target vectors are not supported, got %v", targetVectors)
+	}
+
+	targetVector := targetVectors[0]
+	if e.schemaGetter == nil {
+		return targetVector, nil
+	}
+	sch := e.schemaGetter.GetSchemaSkipAuth()
+	class := sch.GetClass(schema.ClassName(params.ClassName))
+	if class == nil {
+		return "", errors.Errorf("class %q not found in schema", params.ClassName)
+	}
+	if !schema.HasNamedVector(class, targetVector) {
+		return "", errors.Errorf("class %q does not have target vector %q",
+			params.ClassName, targetVector)
+	}
+	return targetVector, nil
 }
 
 func (e *Explorer) vectorFromExploreParams(ctx context.Context,
@@ -689,12 +742,12 @@ func (e *Explorer) checkCertaintyCompatibility(className string) error {
 	if class == nil {
 		return errors.Errorf("failed to get class: %s", className)
 	}
-	hnswConfig, err := typeAssertVectorIndex(class)
+	distance, err := vectorIndexDistance(class)
 	if err != nil {
 		return err
 	}
-	if hnswConfig.Distance != hnsw.DistanceCosine {
-		return certaintyUnsupportedError(hnswConfig.Distance)
+	if distance != hnsw.DistanceCosine {
+		return certaintyUnsupportedError(distance)
 	}
 
 	return nil
@@ -140,7 +140,7 @@ func (f *fakeVectorSearcher) SparseObjectSearch(ctx context.Context,
 }
 
 func (f *fakeVectorSearcher) DenseObjectSearch(context.Context, string,
-	[]float32, int, int, *filters.LocalFilter, additional.Properties, string,
+	[]float32, string, int, int, *filters.LocalFilter, additional.Properties, string,
 ) ([]*storobj.Object, []float32, error) {
 	return nil, nil, nil
 }
@@ -232,9 +232,13 @@ func (f *fakeSchemaGetter) CopyShardingState(class string) *sharding.State {
 }
 
 func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error) { return shard, nil }
-func (f *fakeSchemaGetter) TenantShard(class, tenant string) string        { return tenant }
+func (f *fakeSchemaGetter) TenantShard(class, tenant string) (string, string) {
+	return tenant, models.TenantActivityStatusHOT
+}
 func (f *fakeSchemaGetter) ShardFromUUID(class string, uuid []byte) string { return string(uuid) }
 
+func (f *fakeSchemaGetter) ResolveAlias(name string) string { return name }
+
 func (f *fakeSchemaGetter) Nodes() []string {
 	panic("not implemented")
 }
@@ -30,9 +30,10 @@ const DefaultLimit = 100
 
 type Params struct {
 	*searchparams.HybridSearch
-	Keyword *searchparams.KeywordRanking
-	Class   string
-	Autocut int
+	Keyword      *searchparams.KeywordRanking
+	Class        string
+	Autocut      int
+	TargetVector string
 }
 
 // Result facilitates the pairing of a search result with its internal doc id.
@@ -74,7 +75,7 @@ type postProcFunc func(hybridResults Results) (postProcResults []search.Result,
 
 type modulesProvider interface {
 	VectorFromInput(ctx context.Context,
-		className string, input string) ([]float32, error)
+		className, input, targetVector string) ([]float32, error)
 }
 
 type Searcher struct {
@@ -333,7 +334,7 @@ func (s *Searcher) decideSearchVector(ctx context.Context) ([]float32, error) {
 }
 
 func (s *Searcher) vectorFromModuleInput(ctx context.Context, class, input string) ([]float32, error) {
-	vector, err := s.modulesProvider.VectorFromInput(ctx, class, input)
+	vector, err := s.modulesProvider.VectorFromInput(ctx, class, input, s.params.TargetVector)
 	if err != nil {
 		return nil, fmt.Errorf("get vector input from modules provider: %w", err)
 	}
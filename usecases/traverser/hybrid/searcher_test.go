@@ -447,7 +447,7 @@ type fakeModuleProvider struct {
 }
 
 func (f *fakeModuleProvider) VectorFromInput(ctx context.Context,
-	className string, input string,
+	className, input, targetVector string,
 ) ([]float32, error) {
 	args := f.Called(ctx, className, input)
 	return args.Get(0).([]float32), nil
@@ -45,7 +45,7 @@ func newNearParamsVector(modulesProvider ModulesProvider, search nearParamsSearc
 
 func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 	nearVector *searchparams.NearVector, nearObject *searchparams.NearObject,
-	moduleParams map[string]interface{}, className string, tenant string,
+	moduleParams map[string]interface{}, className, tenant, targetVector string,
 ) ([]float32, error) {
 	err := v.validateNearParams(nearVector, nearObject, moduleParams, className)
 	if err != nil {
@@ -54,7 +54,7 @@ func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 
 	if len(moduleParams) == 1 {
 		for name, value := range moduleParams {
-			return v.vectorFromModules(ctx, className, name, value, tenant)
+			return v.vectorFromModules(ctx, className, name, value, tenant, targetVector)
 		}
 	}
 
@@ -63,7 +63,7 @@ func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 	}
 
 	if nearObject != nil {
-		vector, err := v.vectorFromNearObjectParams(ctx, className, nearObject, tenant)
+		vector, err := v.vectorFromNearObjectParams(ctx, className, nearObject, tenant, targetVector)
 		if err != nil {
 			return nil, errors.Errorf("nearObject params: %v", err)
 		}
@@ -143,11 +143,11 @@ func (v *nearParamsVector) validateNearParams(nearVector *searchparams.NearVecto
 }
 
 func (v *nearParamsVector) vectorFromModules(ctx context.Context,
-	className, paramName string, paramValue interface{}, tenant string,
+	className, paramName string, paramValue interface{}, tenant, targetVector string,
 ) ([]float32, error) {
 	if v.modulesProvider != nil {
 		vector, err := v.modulesProvider.VectorFromSearchParam(ctx,
-			className, paramName, paramValue, v.findVector, tenant,
+			className, paramName, paramValue, v.findTargetVectorFn(targetVector), tenant, targetVector,
 		)
 		if err != nil {
 			return nil, errors.Errorf("vectorize params: %v", err)
@@ -158,17 +158,31 @@ func (v *nearParamsVector) vectorFromModules(ctx context.Context,
 }
 
 func (v *nearParamsVector) findVector(ctx context.Context, className string, id strfmt.UUID, tenant string) ([]float32, error) {
+	return v.findTargetVector(ctx, className, id, tenant, "")
+}
+
+// findTargetVectorFn binds the given target vector to a FindVectorFn, so
+// that modules resolving objects find the vector of the searched vector space
+func (v *nearParamsVector) findTargetVectorFn(targetVector string) modulecapabilities.FindVectorFn {
+	return func(ctx context.Context, className string, id strfmt.UUID, tenant string) ([]float32, error) {
+		return v.findTargetVector(ctx, className, id, tenant, targetVector)
+	}
+}
+
+func (v *nearParamsVector) findTargetVector(ctx context.Context, className string,
+	id strfmt.UUID, tenant, targetVector string,
+) ([]float32, error) {
 	switch className {
 	case "":
 		// Explore cross class searches where we don't have class context
 		return v.crossClassFindVector(ctx, id)
 	default:
-		return v.classFindVector(ctx, className, id, tenant)
+		return v.classFindVector(ctx, className, id, tenant, targetVector)
 	}
 }
 
 func (v *nearParamsVector) classFindVector(ctx context.Context, className string,
-	id strfmt.UUID, tenant string,
+	id strfmt.UUID, tenant, targetVector string,
 ) ([]float32, error) {
 	res, err := v.search.Object(ctx, className, id, search.SelectProperties{}, additional.Properties{}, nil, tenant)
 	if err != nil {
@@ -177,6 +191,13 @@ func (v *nearParamsVector) classFindVector(ctx context.Context, className string
 	if res == nil {
 		return nil, errors.New("vector not found")
 	}
+	if targetVector != "" {
+		vector, ok := res.Vectors[targetVector]
+		if !ok {
+			return nil, errors.Errorf("vector not found for target vector %q", targetVector)
+		}
+		return vector, nil
+	}
 	return res.Vector, nil
 }
 
@@ -202,11 +223,11 @@ func (v *nearParamsVector) crossClassFindVector(ctx context.Context, id strfmt.U
 func (v *nearParamsVector) crossClassVectorFromNearObjectParams(ctx context.Context,
 	params *searchparams.NearObject,
 ) ([]float32, error) {
-	return v.vectorFromNearObjectParams(ctx, "", params, "")
+	return v.vectorFromNearObjectParams(ctx, "", params, "", "")
 }
 
 func (v *nearParamsVector) vectorFromNearObjectParams(ctx context.Context,
-	className string, params *searchparams.NearObject, tenant string,
+	className string, params *searchparams.NearObject, tenant, targetVector string,
 ) ([]float32, error) {
 	if len(params.ID) == 0 && len(params.Beacon) == 0 {
 		return nil, errors.New("empty id and beacon")
@@ -228,7 +249,7 @@ func (v *nearParamsVector) vectorFromNearObjectParams(ctx context.Context,
 		}
 	}
 
-	return v.findVector(ctx, targetClassName, id, tenant)
+	return v.findTargetVector(ctx, targetClassName, id, tenant, targetVector)
 }
 
 func (v *nearParamsVector) extractCertaintyFromParams(nearVector *searchparams.NearVector,
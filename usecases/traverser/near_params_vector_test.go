@@ -19,6 +19,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -195,6 +196,7 @@ func Test_nearParamsVector_vectorFromParams(t *testing.T) {
 		nearObject   *searchparams.NearObject
 		moduleParams map[string]interface{}
 		className    string
+		targetVector string
 	}
 	tests := []struct {
 		name    string
@@ -263,6 +265,29 @@ func Test_nearParamsVector_vectorFromParams(t *testing.T) {
 			want:    []float32{0.0, 0.0, 0.0},
 			wantErr: false,
 		},
+		{
+			name: "Should get named vector from nearObject",
+			args: args{
+				nearObject: &searchparams.NearObject{
+					ID: "uuid",
+				},
+				className:    "Class",
+				targetVector: "title",
+			},
+			want:    []float32{2.0, 2.0, 2.0},
+			wantErr: false,
+		},
+		{
+			name: "Should fail to get missing named vector from nearObject",
+			args: args{
+				nearObject: &searchparams.NearObject{
+					ID: "uuid",
+				},
+				className:    "Class",
+				targetVector: "body",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -270,7 +295,7 @@ func Test_nearParamsVector_vectorFromParams(t *testing.T) {
 				modulesProvider: &fakeModulesProvider{},
 				search:          &fakeNearParamsSearcher{},
 			}
-			got, err := e.vectorFromParams(tt.args.ctx, tt.args.nearVector, tt.args.nearObject, tt.args.moduleParams, tt.args.className, "")
+			got, err := e.vectorFromParams(tt.args.ctx, tt.args.nearVector, tt.args.nearObject, tt.args.moduleParams, tt.args.className, "", tt.args.targetVector)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("nearParamsVector.vectorFromParams() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -389,7 +414,8 @@ func (f *fakeNearParamsSearcher) Object(ctx context.Context, className string, i
 		}, nil
 	} else {
 		return &search.Result{
-			Vector: []float32{1.0, 1.0, 1.0},
+			Vector:  []float32{1.0, 1.0, 1.0},
+			Vectors: models.Vectors{"title": []float32{2.0, 2.0, 2.0}},
 		}, nil
 	}
 }
@@ -20,12 +20,17 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
 // Aggregate resolves meta queries
 func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	params *aggregation.Params,
 ) (interface{}, error) {
+	params.ClassName = schema.ClassName(t.resolveAlias(params.ClassName.String()))
+	t.resolveFilterAliases(params.Filters)
+
 	t.metrics.QueriesAggregateInc(params.ClassName.String())
 	defer t.metrics.QueriesAggregateDec(params.ClassName.String())
 
@@ -42,6 +47,10 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 
 	inspector := newTypeInspector(t.schemaGetter)
 
+	if err := validateAggregateTargetVectors(params); err != nil {
+		return nil, err
+	}
+
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
 		className := params.ClassName.String()
 		err = t.nearParamsVector.validateNearParams(params.NearVector,
@@ -50,7 +59,7 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 			return nil, err
 		}
 		searchVector, err := t.nearParamsVector.vectorFromParams(ctx,
-			params.NearVector, params.NearObject, params.ModuleParams, className, params.Tenant)
+			params.NearVector, params.NearObject, params.ModuleParams, className, params.Tenant, "")
 		if err != nil {
 			return nil, err
 		}
@@ -66,7 +75,7 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 
 	if params.Hybrid != nil && params.Hybrid.Vector == nil && params.Hybrid.Query != "" {
 		vec, err := t.nearParamsVector.modulesProvider.
-			VectorFromInput(ctx, params.ClassName.String(), params.Hybrid.Query)
+			VectorFromInput(ctx, params.ClassName.String(), params.Hybrid.Query, "")
 		if err != nil {
 			return nil, err
 		}
@@ -86,3 +95,27 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 
 	return inspector.WithTypes(res, *params)
 }
+
+// validateAggregateTargetVectors rejects named vectors as aggregations are
+// only supported on the class level vector
+func validateAggregateTargetVectors(params *aggregation.Params) error {
+	var targetVectors []string
+	if params.NearVector != nil {
+		targetVectors = append(targetVectors, params.NearVector.TargetVectors...)
+	}
+	if params.NearObject != nil {
+		targetVectors = append(targetVectors, params.NearObject.TargetVectors...)
+	}
+	if params.Hybrid != nil {
+		targetVectors = append(targetVectors, params.Hybrid.TargetVectors...)
+	}
+	for _, value := range params.ModuleParams {
+		if param, ok := value.(modulecapabilities.TargetVectorsParam); ok {
+			targetVectors = append(targetVectors, param.GetTargetVectors()...)
+		}
+	}
+	if len(targetVectors) > 0 {
+		return errors.Errorf("aggregations do not support target vectors, got %v", targetVectors)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2023 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// resolveAlias returns the name of the class the given alias points to, or
+// the name itself if it is not an alias
+func (t *Traverser) resolveAlias(className string) string {
+	if className == "" {
+		return className
+	}
+	return t.schemaGetter.ResolveAlias(className)
+}
+
+// resolveFilterAliases replaces aliases used as classes in the paths of the
+// filter with the classes they point to
+func (t *Traverser) resolveFilterAliases(filter *filters.LocalFilter) {
+	if filter == nil {
+		return
+	}
+	t.resolveClauseAliases(filter.Root)
+}
+
+func (t *Traverser) resolveClauseAliases(clause *filters.Clause) {
+	if clause == nil {
+		return
+	}
+	for path := clause.On; path != nil; path = path.Child {
+		path.Class = schema.ClassName(t.resolveAlias(path.Class.String()))
+	}
+	for i := range clause.Operands {
+		t.resolveClauseAliases(&clause.Operands[i])
+	}
+}
@@ -25,6 +25,9 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 ) ([]interface{}, error) {
 	before := time.Now()
 
+	params.ClassName = t.resolveAlias(params.ClassName)
+	t.resolveFilterAliases(params.Filters)
+
 	ok := t.ratelimiter.TryInc()
 	if !ok {
 		// we currently have no concept of error status code or typed errors in
@@ -58,14 +58,14 @@ func (t *Traverser) validateCrossClassDistanceCompatibility() (distType string,
 			continue
 		}
 
-		hnswConfig, assertErr := typeAssertVectorIndex(class)
+		distance, assertErr := vectorIndexDistance(class)
 		if assertErr != nil {
 			err = assertErr
 			return
 		}
 
-		distancerTypes[hnswConfig.Distance] = struct{}{}
-		classDistanceConfigs[class.Class] = hnswConfig.Distance
+		distancerTypes[distance] = struct{}{}
+		classDistanceConfigs[class.Class] = distance
 	}
 
 	if len(distancerTypes) != 1 {
@@ -103,26 +103,26 @@ func (t *Traverser) validateGetDistanceParams(params dto.GetParams) error {
 		return fmt.Errorf("failed to find class '%s' in schema", params.ClassName)
 	}
 
-	hnswConfig, err := typeAssertVectorIndex(class)
+	distance, err := vectorIndexDistance(class)
 	if err != nil {
 		return err
 	}
 
-	if hnswConfig.Distance != hnsw.DistanceCosine {
-		return certaintyUnsupportedError(hnswConfig.Distance)
+	if distance != hnsw.DistanceCosine {
+		return certaintyUnsupportedError(distance)
 	}
 
 	return nil
 }
 
-func typeAssertVectorIndex(class *models.Class) (hnsw.UserConfig, error) {
-	hnswConfig, ok := class.VectorIndexConfig.(hnsw.UserConfig)
+func vectorIndexDistance(class *models.Class) (string, error) {
+	vectorConfig, ok := class.VectorIndexConfig.(schema.VectorIndexConfig)
 	if !ok {
-		return hnsw.UserConfig{}, fmt.Errorf("class '%s' vector index: config is not hnsw.UserConfig: %T",
+		return "", fmt.Errorf("class '%s' vector index: config is not schema.VectorIndexConfig: %T",
 			class.Class, class.VectorIndexConfig)
 	}
 
-	return hnswConfig, nil
+	return vectorConfig.DistanceName(), nil
 }
 
 func crossClassDistCompatError(classDistanceConfigs map[string]string) error {